@@ -75,10 +75,100 @@ func (store *RDbStore) GetAllByHeight(height int64) ([]entity_event.Event, error
 		return nil, fmt.Errorf("error building get all events by height selection SQL: %v", err)
 	}
 
-	rows, err := store.rdbHandle.Query(sql, args...)
+	events, err := store.queryEvents(sql, args...)
 	if err != nil {
 		return nil, fmt.Errorf("error executing get all events by height selection SQL: %v", err)
 	}
+
+	return events, nil
+}
+
+// GetAllByHeightRange returns all events with height between fromHeight and toHeight
+// (inclusive) ordered by height and insertion order. When names is not empty, only events with
+// one of the names are returned.
+func (store *RDbStore) GetAllByHeightRange(
+	fromHeight int64,
+	toHeight int64,
+	names []string,
+) ([]entity_event.Event, error) {
+	stmtBuilder := store.rdbHandle.StmtBuilder.Select(
+		"uuid", "height", "name", "version", "payload",
+	).From(
+		store.table,
+	).Where(
+		"height >= ? AND height <= ?", fromHeight, toHeight,
+	)
+	if len(names) > 0 {
+		stmtBuilder = stmtBuilder.Where(sq.Eq{"name": names})
+	}
+	sql, args, err := stmtBuilder.OrderBy("height", "id").ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building get all events by height range selection SQL: %v", err)
+	}
+
+	events, err := store.queryEvents(sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error executing get all events by height range selection SQL: %v", err)
+	}
+
+	return events, nil
+}
+
+// GetAllRawAfterId returns at most limit events with id greater than afterId ordered by id, without
+// decoding their payloads. Events are inserted height by height, so they are in height order as
+// well.
+func (store *RDbStore) GetAllRawAfterId(afterId int64, limit uint64) ([]RawEvent, error) {
+	sql, args, err := store.rdbHandle.StmtBuilder.Select(
+		"id", "uuid", "height", "name", "version", "payload",
+	).From(
+		store.table,
+	).Where(
+		"id > ?", afterId,
+	).OrderBy("id").Limit(limit).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building get all raw events after id selection SQL: %v", err)
+	}
+
+	rows, err := store.rdbHandle.Query(sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error executing get all raw events after id selection SQL: %v", err)
+	}
+	defer rows.Close()
+
+	events := make([]RawEvent, 0)
+	for rows.Next() {
+		var event RawEvent
+		if err := rows.Scan(
+			&event.Id, &event.UUID, &event.Height, &event.Name, &event.Version, &event.Payload,
+		); err != nil {
+			if errors.Is(err, rdb.ErrNoRows) {
+				return nil, nil
+			} else {
+				return nil, fmt.Errorf("error scanning raw event row: %v", err)
+			}
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// RawEvent is an event row as stored. Payload is the JSON encoded by the event's ToJSON()
+type RawEvent struct {
+	Id      int64
+	UUID    string
+	Height  int64
+	Name    string
+	Version int
+	Payload string
+}
+
+func (store *RDbStore) queryEvents(sql string, args ...interface{}) ([]entity_event.Event, error) {
+	rows, err := store.rdbHandle.Query(sql, args...)
+	if err != nil {
+		return nil, err
+	}
 	defer rows.Close()
 
 	events := make([]entity_event.Event, 0)
@@ -95,7 +185,7 @@ func (store *RDbStore) GetAllByHeight(height int64) ([]entity_event.Event, error
 			if errors.Is(err, rdb.ErrNoRows) {
 				return nil, nil
 			} else {
-				return nil, fmt.Errorf("error executing get each event by height selection SQL: %v", err)
+				return nil, fmt.Errorf("error scanning event row: %v", err)
 			}
 		}
 
@@ -196,3 +286,23 @@ func (store *RDbStore) InsertAllWithRDbHandle(rdbHandle *rdb.Handle, events []en
 
 	return nil
 }
+
+// DeleteAllAfterHeightWithRDbHandle deletes all events with height greater than the provided
+// height. It returns the number of deleted events.
+func (store *RDbStore) DeleteAllAfterHeightWithRDbHandle(rdbHandle *rdb.Handle, height int64) (int64, error) {
+	sql, args, err := rdbHandle.StmtBuilder.Delete(
+		store.table,
+	).Where(
+		"height > ?", height,
+	).ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("error building events deletion SQL: %v", err)
+	}
+
+	execResult, err := rdbHandle.Exec(sql, args...)
+	if err != nil {
+		return 0, fmt.Errorf("error executing events deletion SQL: %v", err)
+	}
+
+	return execResult.RowsAffected(), nil
+}
@@ -1,6 +1,8 @@
 package event_test
 
 import (
+	"fmt"
+
 	"github.com/crypto-com/chain-indexing/entity/event/test"
 	. "github.com/crypto-com/chain-indexing/test"
 	. "github.com/onsi/ginkgo"
@@ -74,5 +76,50 @@ var _ = Describe("RdbEventStore", func() {
 				Expect(latestHeight).To(Equal(primptr.Int64(1)))
 			})
 		})
+
+		Describe("GetAllByHeightRange", func() {
+			It("should return events within the height range with the provided names ordered by height", func() {
+				registry := event.NewRegistry()
+				decoder := func(name string) event.Decoder {
+					return func(_ []byte) (event.Event, error) {
+						decoded := test.NewMockEvent()
+						decoded.On("Name").Return(name)
+						return decoded, nil
+					}
+				}
+				registry.Register("AnyEvent", 0, decoder("AnyEvent"))
+				registry.Register("AnyOtherEvent", 0, decoder("AnyOtherEvent"))
+				store := appinterface_event.NewRDbStore(pgxConn.ToHandle(), registry)
+
+				newEvent := func(height int64, name string) event.Event {
+					mockEvent := test.NewMockEvent()
+					mockEvent.On("Height").Return(height)
+					mockEvent.On("Name").Return(name)
+					mockEvent.On("Version").Return(0)
+					mockEvent.On("UUID").Return(fmt.Sprintf("%s-%d", name, height))
+					mockEvent.On("ToJSON").Return("\"MockEvent\"", nil)
+					return mockEvent
+				}
+				err := store.InsertAll([]event.Event{
+					newEvent(3, "AnyEvent"),
+					newEvent(1, "AnyEvent"),
+					newEvent(2, "AnyOtherEvent"),
+					newEvent(2, "AnyEvent"),
+					newEvent(4, "AnyEvent"),
+				})
+				Expect(err).To(BeNil())
+
+				events, err := store.GetAllByHeightRange(1, 3, []string{"AnyEvent"})
+				Expect(err).To(BeNil())
+				Expect(events).To(HaveLen(3))
+				for _, actual := range events {
+					Expect(actual.Name()).To(Equal("AnyEvent"))
+				}
+
+				events, err = store.GetAllByHeightRange(2, 2, []string{})
+				Expect(err).To(BeNil())
+				Expect(events).To(HaveLen(2))
+			})
+		})
 	})
 })
@@ -4,6 +4,12 @@ import "github.com/crypto-com/chain-indexing/entity/event"
 
 type Handler interface {
 	GetLastHandledEventHeight() (*int64, error)
-
 	HandleEvents(blockHeight int64, events []event.Event) error
 }
+
+// CatchUpAware is a Handler which is told when it has handled the events up to the latest height
+type CatchUpAware interface {
+	Handler
+
+	OnCaughtUp()
+}
@@ -9,19 +9,32 @@ import (
 )
 
 var _ Handler = &ProjectionHandler{}
+var _ CatchUpAware = &ProjectionHandler{}
 
 type ProjectionHandler struct {
 	logger     applogger.Logger
 	projection projection_entity.Projection
+	hooks      []projection_entity.HandledEventsHook
+
+	caughtUp bool
 }
 
 func NewProjectionHandler(logger applogger.Logger, projection projection_entity.Projection) *ProjectionHandler {
 	return &ProjectionHandler{
 		logger,
 		projection,
+		make([]projection_entity.HandledEventsHook, 0),
+
+		false,
 	}
 }
 
+// AddHandledEventsHook adds a hook notified after every height handled by the projection once it has
+// caught up with the latest height
+func (handler *ProjectionHandler) AddHandledEventsHook(hook projection_entity.HandledEventsHook) {
+	handler.hooks = append(handler.hooks, hook)
+}
+
 func (handler *ProjectionHandler) GetLastHandledEventHeight() (*int64, error) {
 	return handler.projection.GetLastHandledEventHeight()
 }
@@ -53,9 +66,19 @@ func (handler *ProjectionHandler) HandleEvents(blockHeight int64, events []event
 	}
 
 	logger.Infof("successfully handled events")
+	if handler.caughtUp {
+		for _, hook := range handler.hooks {
+			hook.OnEventsHandled(handler.projection, blockHeight, filteredEvents)
+		}
+	}
 	return nil
 }
 
+// OnCaughtUp starts notifying the hooks of the heights handled afterwards
+func (handler *ProjectionHandler) OnCaughtUp() {
+	handler.caughtUp = true
+}
+
 func isListeningEvent(event event.Event, eventsToListen []string) bool {
 	targetEventName := event.Name()
 	for _, eventName := range eventsToListen {
@@ -0,0 +1,48 @@
+package eventhandler_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/appinterface/eventhandler"
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	event_test "github.com/crypto-com/chain-indexing/entity/event/test"
+	"github.com/crypto-com/chain-indexing/entity/projection"
+	projection_test "github.com/crypto-com/chain-indexing/entity/projection/test"
+	logger_test "github.com/crypto-com/chain-indexing/internal/logger/test"
+)
+
+var _ = Describe("ProjectionHandler", func() {
+	Describe("HandleEvents", func() {
+		It("should notify the hooks only after it has caught up", func() {
+			anyEvent := event_test.NewMockEvent()
+			anyEvent.On("Name").Return("ANY_EVENT")
+
+			mockProjection := projection_test.NewMockProjection()
+			mockProjection.On("GetEventsToListen").Return([]string{"ANY_EVENT"})
+			mockProjection.On("HandleEvents", int64(1), []entity_event.Event{anyEvent}).Return(nil)
+			mockProjection.On("HandleEvents", int64(2), []entity_event.Event{anyEvent}).Return(nil)
+
+			handler := eventhandler.NewProjectionHandler(logger_test.NewFakeLogger(), mockProjection)
+			hook := &recordingHook{}
+			handler.AddHandledEventsHook(hook)
+
+			Expect(handler.HandleEvents(1, []entity_event.Event{anyEvent})).To(Succeed())
+			Expect(hook.heights).To(BeEmpty())
+
+			handler.OnCaughtUp()
+			Expect(handler.HandleEvents(2, []entity_event.Event{anyEvent})).To(Succeed())
+			Expect(hook.heights).To(Equal([]int64{2}))
+
+			mockProjection.AssertExpectations(GinkgoT())
+		})
+	})
+})
+
+type recordingHook struct {
+	heights []int64
+}
+
+func (hook *recordingHook) OnEventsHandled(_ projection.Projection, height int64, _ []entity_event.Event) {
+	hook.heights = append(hook.heights, height)
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	event_interface "github.com/crypto-com/chain-indexing/appinterface/event"
+	"github.com/crypto-com/chain-indexing/appinterface/eventpublisher"
+	"github.com/crypto-com/chain-indexing/appinterface/projection/rdbprojectionbase"
 	"github.com/crypto-com/chain-indexing/appinterface/rdb"
 	"github.com/crypto-com/chain-indexing/appinterface/rdbstatusstore"
 	"github.com/crypto-com/chain-indexing/entity/event"
@@ -17,8 +19,10 @@ type RDbEventStoreHandler struct {
 	logger  applogger.Logger
 	rdbConn rdb.Conn
 
-	eventStore  *event_interface.RDbStore
-	statusStore *rdbstatusstore.RDbStatusStore
+	eventStore           *event_interface.RDbStore
+	statusStore          *rdbstatusstore.RDbStatusStore
+	projectionStore      *rdbprojectionbase.Store
+	publisherCursorStore *eventpublisher.RDbCursorStore
 }
 
 func NewRDbEventStoreHandler(
@@ -33,8 +37,10 @@ func NewRDbEventStoreHandler(
 		}),
 		rdbConn: rdbConn,
 
-		eventStore:  initEventStore(rdbHandle, eventRegistry),
-		statusStore: initStatusStore(rdbHandle),
+		eventStore:           initEventStore(rdbHandle, eventRegistry),
+		statusStore:          initStatusStore(rdbHandle),
+		projectionStore:      rdbprojectionbase.NewStore(rdbprojectionbase.DEFAULT_TABLE),
+		publisherCursorStore: eventpublisher.NewRDbCursorStore(rdbHandle),
 	}
 }
 
@@ -48,6 +54,12 @@ func (handler *RDbEventStoreHandler) HandleEvents(blockHeight int64, events []ev
 	if err != nil {
 		return fmt.Errorf("error when beginning transaction: %v", err)
 	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
 	txHandle := tx.ToHandle()
 
 	if err := handler.eventStore.InsertAllWithRDbHandle(txHandle, events); err != nil {
@@ -61,9 +73,74 @@ func (handler *RDbEventStoreHandler) HandleEvents(blockHeight int64, events []ev
 	if err := tx.Commit(); err != nil {
 		return fmt.Errorf("error committing block synchronization outcomes: %v", err)
 	}
+	committed = true
 	return nil
 }
 
+// RollbackToHeight deletes all persisted events after height and updates the last indexed block
+// height to height. It refuses to roll back when any projection has handled the events after
+// height, because the projected data cannot be rewound. Rebuild or reset those projections in the
+// same transaction with RollbackToHeightWithRDbHandle instead.
+func (handler *RDbEventStoreHandler) RollbackToHeight(height int64) error {
+	tx, err := handler.rdbConn.Begin()
+	if err != nil {
+		return fmt.Errorf("error when beginning transaction: %v", err)
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	deletedCount, err := handler.RollbackToHeightWithRDbHandle(tx.ToHandle(), height)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing rollback: %v", err)
+	}
+	committed = true
+
+	handler.logger.WithFields(applogger.LogFields{
+		"height":       height,
+		"deletedCount": deletedCount,
+	}).Info("rolled back event store")
+	return nil
+}
+
+// RollbackToHeightWithRDbHandle is RollbackToHeight with the changes made by rdbHandle, which is
+// expected to be a transaction handle. Returns the number of deleted events.
+func (handler *RDbEventStoreHandler) RollbackToHeightWithRDbHandle(rdbHandle *rdb.Handle, height int64) (int64, error) {
+	projectionIds, err := handler.projectionStore.FindAllIdsHandledAfterHeight(rdbHandle, height)
+	if err != nil {
+		return 0, fmt.Errorf("error finding projections handled events after height %d: %v", height, err)
+	}
+	if len(projectionIds) > 0 {
+		return 0, fmt.Errorf(
+			"projections %v have handled events after height %d and have to be rebuilt", projectionIds, height,
+		)
+	}
+
+	deletedCount, err := handler.eventStore.DeleteAllAfterHeightWithRDbHandle(rdbHandle, height)
+	if err != nil {
+		return 0, fmt.Errorf("error deleting events after height %d: %v", height, err)
+	}
+	if err := handler.statusStore.UpdateLastIndexedBlockHeightWithRDbHandle(rdbHandle, height); err != nil {
+		return 0, fmt.Errorf("error updating last indexed block height to %d: %v", height, err)
+	}
+	// The event publishers may have published the deleted events, they publish a rollback marker
+	// before the events stored again
+	if deletedCount > 0 {
+		if err := handler.publisherCursorStore.MarkRollbackWithRDbHandle(rdbHandle, height); err != nil {
+			return 0, fmt.Errorf("error marking event publishers rollback to height %d: %v", height, err)
+		}
+	}
+
+	return deletedCount, nil
+}
+
 func initEventStore(rdbHandle *rdb.Handle, registry *event.Registry) *event_interface.RDbStore {
 	return event_interface.NewRDbStore(rdbHandle, registry)
 }
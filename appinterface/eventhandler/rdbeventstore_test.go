@@ -0,0 +1,108 @@
+package eventhandler_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crypto-com/chain-indexing/appinterface/eventhandler"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	rdb_test "github.com/crypto-com/chain-indexing/appinterface/rdb/test"
+	"github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/infrastructure/pg"
+	logger_test "github.com/crypto-com/chain-indexing/internal/logger/test"
+)
+
+var _ = Describe("RDbEventStoreHandler", func() {
+	Describe("RollbackToHeight", func() {
+		var mockConn *rdb_test.MockRDbConn
+		var mockTx *rdb_test.MockRDbTx
+		var handler *eventhandler.RDbEventStoreHandler
+
+		BeforeEach(func() {
+			mockConn = rdb_test.NewMockRDBbConn()
+			mockConn.On("ToHandle").Return(&rdb.Handle{
+				Runner:      mockConn,
+				TypeConv:    &pg.PgxTypeConv{},
+				StmtBuilder: pg.PostgresStmtBuilder,
+			})
+			mockTx = &rdb_test.MockRDbTx{}
+			mockTx.On("ToHandle").Return(&rdb.Handle{
+				Runner:      mockTx,
+				TypeConv:    &pg.PgxTypeConv{},
+				StmtBuilder: pg.PostgresStmtBuilder,
+			})
+			mockConn.On("Begin").Return(mockTx, nil)
+
+			handler = eventhandler.NewRDbEventStoreHandler(
+				logger_test.NewFakeLogger(), mockConn, event.NewRegistry(),
+			)
+		})
+
+		mockProjectionsHandledAfterHeight := func(height int64, projectionIds ...string) {
+			mockRows := &rdb_test.MockRDbRowsResult{}
+			for _, projectionId := range projectionIds {
+				id := projectionId
+				mockRows.On("Next").Return(true).Once()
+				mockRows.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+					*args.Get(0).(*string) = id
+				}).Return(nil).Once()
+			}
+			mockRows.On("Next").Return(false)
+			mockRows.On("Close").Return()
+			mockTx.On(
+				"Query",
+				"SELECT id FROM projections WHERE last_handled_event_height > $1 ORDER BY id FOR UPDATE",
+				height,
+			).Return(mockRows, nil)
+		}
+
+		It("should refuse to roll back when projections have handled events after the height", func() {
+			mockProjectionsHandledAfterHeight(int64(10), "Block", "Validator")
+			mockTx.On("Rollback").Return(nil)
+
+			err := handler.RollbackToHeight(int64(10))
+			Expect(err).To(MatchError(
+				"projections [Block Validator] have handled events after height 10 and have to be rebuilt",
+			))
+
+			mockTx.AssertNotCalled(GinkgoT(), "Exec", rdb_test.MockSQLWithAnyArgs("DELETE FROM events WHERE height > $1", 1)...)
+			mockTx.AssertNotCalled(GinkgoT(), "Commit")
+			mockTx.AssertCalled(GinkgoT(), "Rollback")
+		})
+
+		It("should delete the events, update the last indexed height and mark the rollback in one transaction", func() {
+			mockProjectionsHandledAfterHeight(int64(10))
+
+			mockDeleteResult := &rdb_test.MockRDbExecResult{}
+			mockDeleteResult.On("RowsAffected").Return(int64(5))
+			mockTx.On("Exec", "DELETE FROM events WHERE height > $1", int64(10)).Return(mockDeleteResult, nil)
+
+			mockCountRow := &rdb_test.MockRDbRowResult{}
+			mockCountRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+				*args.Get(0).(*int64) = 1
+			}).Return(nil)
+			mockConn.On("QueryRow", "SELECT COUNT(*) FROM service_status").Return(mockCountRow)
+			mockUpdateResult := &rdb_test.MockRDbExecResult{}
+			mockUpdateResult.On("RowsAffected").Return(int64(1))
+			mockTx.On(
+				"Exec", "UPDATE service_status SET last_indexed_block_height = $1", int64(10),
+			).Return(mockUpdateResult, nil)
+
+			mockMarkResult := &rdb_test.MockRDbExecResult{}
+			mockMarkResult.On("RowsAffected").Return(int64(1))
+			mockTx.On(
+				"Exec",
+				"UPDATE event_publisher_cursors "+
+					"SET pending_rollback_height = LEAST(COALESCE(pending_rollback_height, $1), $2)",
+				int64(10), int64(10),
+			).Return(mockMarkResult, nil)
+
+			mockTx.On("Commit").Return(nil)
+
+			Expect(handler.RollbackToHeight(int64(10))).To(Succeed())
+
+			mockTx.AssertExpectations(GinkgoT())
+		})
+	})
+})
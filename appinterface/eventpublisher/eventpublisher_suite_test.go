@@ -0,0 +1,13 @@
+package eventpublisher_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestEventPublisher(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Event Publisher Suite")
+}
@@ -0,0 +1,241 @@
+package eventpublisher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	event_interface "github.com/crypto-com/chain-indexing/appinterface/event"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+)
+
+const (
+	DEFAULT_BATCH_SIZE     = 100
+	DEFAULT_POLL_INTERVAL  = 1 * time.Second
+	DEFAULT_RETRY_INTERVAL = 5 * time.Second
+)
+
+// ROLLBACK_MARKER_NAME is the event name the sinks publish the rollback marker with
+const ROLLBACK_MARKER_NAME = "Rollback"
+
+// ErrEventTooLarge is returned by the Sink when the event exceeds the max size the sink accepts.
+// Publishing the event again never succeeds.
+var ErrEventTooLarge = errors.New("event exceeds the max size of the sink")
+
+// EventSource is the stored events to publish. It is implemented by event_interface.RDbStore.
+type EventSource interface {
+	GetAllRawAfterId(afterId int64, limit uint64) ([]event_interface.RawEvent, error)
+}
+
+// CursorStore persists the id of the last published event of every publisher
+type CursorStore interface {
+	GetLastPublishedEventId(publisherId string) (*int64, error)
+	UpdateLastPublishedEventId(publisherId string, eventId int64) error
+	// GetPendingRollbackHeight returns the lowest height the event store has been rolled back to
+	// since the publisher last published a rollback, nil if there is no rollback to publish
+	GetPendingRollbackHeight(publisherId string) (*int64, error)
+	// ClearPendingRollbackHeight clears the pending rollback after it has been published. A pending
+	// rollback to another height is kept.
+	ClearPendingRollbackHeight(publisherId string, height int64) error
+}
+
+// Sink is where the events are published to
+type Sink interface {
+	// Publish publishes the event. The sink may buffer the event until Flush. Returns
+	// ErrEventTooLarge when the event exceeds the max size the sink accepts.
+	Publish(event event_interface.RawEvent) error
+	// PublishRollback publishes a marker telling the consumers that the events after the height
+	// have been deleted from the event store and will be stored again. It is buffered the same way.
+	PublishRollback(height int64) error
+	// Flush returns after all the published events have been accepted by the sink. The sink should
+	// recover from the error on the next Publish, the events since the last successful Flush are
+	// published again then.
+	Flush() error
+	Close() error
+}
+
+// PublisherConfig of the Publisher. Default values are used for the zero values.
+type PublisherConfig struct {
+	// Maximum number of events published per flush
+	BatchSize uint64
+	// Wait before checking for new events after publishing all the stored events
+	PollInterval time.Duration
+	// Wait before publishing again after an error
+	RetryInterval time.Duration
+	// Skip the events exceeding the max size of the sink with an error log instead of stopping the
+	// publisher. The cursor advances past the skipped events, so they are never published.
+	SkipOversizedEvents bool
+}
+
+// Publisher tails the event store by event id and publishes the events to the sink. The cursor is
+// persisted after every flushed batch, so the events are published at-least-once: the events after
+// the cursor are published again when the publisher restarts or the sink fails. Events are
+// published in the order they are stored, which is the height order.
+//
+// When the event store is rolled back, a rollback marker is published before the events stored
+// again, so that the consumers can discard the events they have received after the height.
+type Publisher struct {
+	logger applogger.Logger
+	// Id of the publisher identifying its cursor. Publishers to different sinks need different ids.
+	id string
+
+	source      EventSource
+	cursorStore CursorStore
+	sink        Sink
+	config      PublisherConfig
+
+	maybeLastPublishedEventId *int64
+	cursorLoaded              bool
+}
+
+func NewPublisher(
+	logger applogger.Logger,
+	id string,
+	source EventSource,
+	cursorStore CursorStore,
+	sink Sink,
+	config PublisherConfig,
+) *Publisher {
+	if config.BatchSize == 0 {
+		config.BatchSize = DEFAULT_BATCH_SIZE
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = DEFAULT_POLL_INTERVAL
+	}
+	if config.RetryInterval <= 0 {
+		config.RetryInterval = DEFAULT_RETRY_INTERVAL
+	}
+
+	return &Publisher{
+		logger: logger.WithFields(applogger.LogFields{
+			"module":    "EventPublisher",
+			"publisher": id,
+		}),
+		id: id,
+
+		source:      source,
+		cursorStore: cursorStore,
+		sink:        sink,
+		config:      config,
+
+		maybeLastPublishedEventId: nil,
+		cursorLoaded:              false,
+	}
+}
+
+// Run publishes the events until the context is done, and then closes the sink. Returns error
+// when an event exceeds the max size of the sink and SkipOversizedEvents is disabled, since
+// publishing it again never succeeds.
+func (publisher *Publisher) Run(ctx context.Context) error {
+	publisher.logger.Info("event publisher start running")
+	for ctx.Err() == nil {
+		publishedCount, err := publisher.PublishNext()
+		wait := publisher.config.PollInterval
+		if errors.Is(err, ErrEventTooLarge) {
+			_ = publisher.sink.Close()
+			return fmt.Errorf("event publisher stopped: %v", err)
+		} else if err != nil {
+			publisher.logger.Errorf("error publishing events, retrying in %s: %v", publisher.config.RetryInterval, err)
+			wait = publisher.config.RetryInterval
+		} else if uint64(publishedCount) == publisher.config.BatchSize {
+			// Keep publishing without waiting when there may be more stored events
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(wait):
+		}
+	}
+
+	if err := publisher.sink.Close(); err != nil {
+		return fmt.Errorf("error closing event publisher sink: %v", err)
+	}
+	publisher.logger.Info("event publisher stopped")
+	return nil
+}
+
+// PublishNext publishes the next batch of events after the cursor and advances the cursor. Returns
+// the number of published events.
+func (publisher *Publisher) PublishNext() (int, error) {
+	if !publisher.cursorLoaded {
+		maybeLastPublishedEventId, err := publisher.cursorStore.GetLastPublishedEventId(publisher.id)
+		if err != nil {
+			return 0, fmt.Errorf("error getting last published event id: %v", err)
+		}
+		publisher.maybeLastPublishedEventId = maybeLastPublishedEventId
+		publisher.cursorLoaded = true
+	}
+
+	var afterId int64
+	if publisher.maybeLastPublishedEventId != nil {
+		afterId = *publisher.maybeLastPublishedEventId
+	}
+	events, err := publisher.source.GetAllRawAfterId(afterId, publisher.config.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("error getting events after id %d: %v", afterId, err)
+	}
+
+	// The rollback is checked after getting the events, so the events stored again after a rollback
+	// are never published before its marker. The events got with the marker pending may have been
+	// deleted by the rollback and are discarded.
+	maybeRollbackHeight, err := publisher.cursorStore.GetPendingRollbackHeight(publisher.id)
+	if err != nil {
+		return 0, fmt.Errorf("error getting pending rollback height: %v", err)
+	}
+	if maybeRollbackHeight != nil {
+		return 0, publisher.publishRollback(*maybeRollbackHeight)
+	}
+
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	for _, event := range events {
+		if err = publisher.sink.Publish(event); err != nil {
+			if errors.Is(err, ErrEventTooLarge) && publisher.config.SkipOversizedEvents {
+				publisher.logger.WithFields(applogger.LogFields{
+					"eventId":     event.Id,
+					"eventHeight": event.Height,
+					"eventName":   event.Name,
+				}).Errorf("skipped event, the cursor advances past it: %v", err)
+				continue
+			}
+			return 0, fmt.Errorf("error publishing event %d: %w", event.Id, err)
+		}
+	}
+	if err = publisher.sink.Flush(); err != nil {
+		return 0, fmt.Errorf("error flushing events %d-%d: %v", events[0].Id, events[len(events)-1].Id, err)
+	}
+
+	lastPublishedEvent := events[len(events)-1]
+	if err = publisher.cursorStore.UpdateLastPublishedEventId(publisher.id, lastPublishedEvent.Id); err != nil {
+		return 0, fmt.Errorf("error updating last published event id: %v", err)
+	}
+	publisher.maybeLastPublishedEventId = &lastPublishedEvent.Id
+
+	publisher.logger.WithFields(applogger.LogFields{
+		"eventCount": len(events),
+		"lastId":     lastPublishedEvent.Id,
+		"lastHeight": lastPublishedEvent.Height,
+	}).Debugf("published events")
+	return len(events), nil
+}
+
+func (publisher *Publisher) publishRollback(height int64) error {
+	if err := publisher.sink.PublishRollback(height); err != nil {
+		return fmt.Errorf("error publishing rollback to height %d: %v", height, err)
+	}
+	if err := publisher.sink.Flush(); err != nil {
+		return fmt.Errorf("error flushing rollback to height %d: %v", height, err)
+	}
+	if err := publisher.cursorStore.ClearPendingRollbackHeight(publisher.id, height); err != nil {
+		return fmt.Errorf("error clearing pending rollback height: %v", err)
+	}
+
+	publisher.logger.WithFields(applogger.LogFields{
+		"height": height,
+	}).Info("published rollback")
+	return nil
+}
@@ -0,0 +1,332 @@
+package eventpublisher_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	event_interface "github.com/crypto-com/chain-indexing/appinterface/event"
+	"github.com/crypto-com/chain-indexing/appinterface/eventpublisher"
+	. "github.com/crypto-com/chain-indexing/internal/logger/test"
+	"github.com/crypto-com/chain-indexing/internal/primptr"
+)
+
+var _ = Describe("Publisher", func() {
+	var source *fakeEventSource
+	var cursorStore *fakeCursorStore
+	var sink *fakeSink
+	var config eventpublisher.PublisherConfig
+
+	BeforeEach(func() {
+		source = &fakeEventSource{}
+		for id := int64(1); id <= 5; id++ {
+			source.events = append(source.events, event_interface.RawEvent{
+				Id:      id,
+				UUID:    "uuid",
+				Height:  (id + 1) / 2,
+				Name:    "BlockCreated",
+				Version: 1,
+				Payload: "{}",
+			})
+		}
+		cursorStore = &fakeCursorStore{
+			cursors:                make(map[string]int64),
+			pendingRollbackHeights: make(map[string]int64),
+		}
+		sink = &fakeSink{
+			oversizedIds: make(map[int64]bool),
+		}
+		config = eventpublisher.PublisherConfig{
+			BatchSize: 2,
+		}
+	})
+
+	newPublisher := func() *eventpublisher.Publisher {
+		return eventpublisher.NewPublisher(
+			NewFakeLogger(), "anyPublisher", source, cursorStore, sink, config,
+		)
+	}
+
+	It("should publish the events in order and advance the cursor after flush", func() {
+		publisher := newPublisher()
+
+		publishedCount, err := publisher.PublishNext()
+		Expect(err).To(BeNil())
+		Expect(publishedCount).To(Equal(2))
+		Expect(sink.FlushedIds()).To(Equal([]int64{1, 2}))
+		Expect(cursorStore.GetLastPublishedEventId("anyPublisher")).To(Equal(primptr.Int64(2)))
+
+		publishedCount, err = publisher.PublishNext()
+		Expect(err).To(BeNil())
+		Expect(publishedCount).To(Equal(2))
+		publishedCount, err = publisher.PublishNext()
+		Expect(err).To(BeNil())
+		Expect(publishedCount).To(Equal(1))
+		publishedCount, err = publisher.PublishNext()
+		Expect(err).To(BeNil())
+		Expect(publishedCount).To(Equal(0))
+
+		Expect(sink.FlushedIds()).To(Equal([]int64{1, 2, 3, 4, 5}))
+		Expect(cursorStore.GetLastPublishedEventId("anyPublisher")).To(Equal(primptr.Int64(5)))
+	})
+
+	It("should resume from the persisted cursor", func() {
+		_ = cursorStore.UpdateLastPublishedEventId("anyPublisher", 3)
+
+		_, err := newPublisher().PublishNext()
+		Expect(err).To(BeNil())
+		Expect(sink.FlushedIds()).To(Equal([]int64{4, 5}))
+	})
+
+	It("should publish the events again when the sink fails to flush", func() {
+		publisher := newPublisher()
+		sink.failNextFlush = true
+
+		_, err := publisher.PublishNext()
+		Expect(err).NotTo(BeNil())
+		Expect(cursorStore.GetLastPublishedEventId("anyPublisher")).To(BeNil())
+
+		_, err = publisher.PublishNext()
+		Expect(err).To(BeNil())
+		Expect(sink.FlushedIds()).To(Equal([]int64{1, 2}))
+		Expect(cursorStore.GetLastPublishedEventId("anyPublisher")).To(Equal(primptr.Int64(2)))
+	})
+
+	It("should publish the events again when the cursor fails to be persisted", func() {
+		publisher := newPublisher()
+		cursorStore.failNextUpdate = true
+
+		_, err := publisher.PublishNext()
+		Expect(err).NotTo(BeNil())
+
+		_, err = publisher.PublishNext()
+		Expect(err).To(BeNil())
+		Expect(sink.FlushedIds()).To(Equal([]int64{1, 2, 1, 2}))
+	})
+
+	It("should publish all the events and close the sink when the context is done", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() {
+			done <- newPublisher().Run(ctx)
+		}()
+
+		Eventually(func() *int64 {
+			maybeId, _ := cursorStore.GetLastPublishedEventId("anyPublisher")
+			return maybeId
+		}, time.Second).Should(Equal(primptr.Int64(5)))
+
+		cancel()
+		Eventually(done, time.Second).Should(Receive(BeNil()))
+		Expect(sink.FlushedIds()).To(Equal([]int64{1, 2, 3, 4, 5}))
+		Expect(sink.Closed()).To(BeTrue())
+	})
+
+	It("should stop with error and close the sink on the event exceeding the max size of the sink", func() {
+		sink.oversizedIds[3] = true
+
+		done := make(chan error)
+		go func() {
+			done <- newPublisher().Run(context.Background())
+		}()
+
+		Eventually(done, time.Second).Should(Receive(MatchError(ContainSubstring("error publishing event 3"))))
+		Expect(sink.FlushedIds()).To(Equal([]int64{1, 2}))
+		Expect(cursorStore.GetLastPublishedEventId("anyPublisher")).To(Equal(primptr.Int64(2)))
+		Expect(sink.Closed()).To(BeTrue())
+	})
+
+	It("should skip the event exceeding the max size of the sink and advance the cursor past it", func() {
+		config.SkipOversizedEvents = true
+		sink.oversizedIds[4] = true
+		publisher := newPublisher()
+
+		_, err := publisher.PublishNext()
+		Expect(err).To(BeNil())
+		_, err = publisher.PublishNext()
+		Expect(err).To(BeNil())
+		Expect(sink.FlushedIds()).To(Equal([]int64{1, 2, 3}))
+		Expect(cursorStore.GetLastPublishedEventId("anyPublisher")).To(Equal(primptr.Int64(4)))
+
+		_, err = publisher.PublishNext()
+		Expect(err).To(BeNil())
+		Expect(sink.FlushedIds()).To(Equal([]int64{1, 2, 3, 5}))
+	})
+
+	It("should publish the pending rollback before the events and clear it", func() {
+		_ = cursorStore.UpdateLastPublishedEventId("anyPublisher", 2)
+		cursorStore.pendingRollbackHeights["anyPublisher"] = 1
+		publisher := newPublisher()
+
+		publishedCount, err := publisher.PublishNext()
+		Expect(err).To(BeNil())
+		Expect(publishedCount).To(Equal(0))
+		Expect(sink.FlushedRollbacks()).To(Equal([]int64{1}))
+		Expect(sink.FlushedIds()).To(BeEmpty())
+		Expect(cursorStore.GetPendingRollbackHeight("anyPublisher")).To(BeNil())
+
+		_, err = publisher.PublishNext()
+		Expect(err).To(BeNil())
+		Expect(sink.FlushedRollbacks()).To(Equal([]int64{1}))
+		Expect(sink.FlushedIds()).To(Equal([]int64{3, 4}))
+	})
+
+	It("should keep the pending rollback when the sink fails to flush it", func() {
+		cursorStore.pendingRollbackHeights["anyPublisher"] = 1
+		publisher := newPublisher()
+		sink.failNextFlush = true
+
+		_, err := publisher.PublishNext()
+		Expect(err).NotTo(BeNil())
+		Expect(cursorStore.GetPendingRollbackHeight("anyPublisher")).To(Equal(primptr.Int64(1)))
+
+		_, err = publisher.PublishNext()
+		Expect(err).To(BeNil())
+		Expect(sink.FlushedRollbacks()).To(Equal([]int64{1}))
+		Expect(sink.FlushedIds()).To(BeEmpty())
+	})
+})
+
+type fakeEventSource struct {
+	events []event_interface.RawEvent
+}
+
+func (source *fakeEventSource) GetAllRawAfterId(afterId int64, limit uint64) ([]event_interface.RawEvent, error) {
+	events := make([]event_interface.RawEvent, 0)
+	for _, event := range source.events {
+		if event.Id > afterId && uint64(len(events)) < limit {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+type fakeCursorStore struct {
+	sync.Mutex
+	cursors                map[string]int64
+	pendingRollbackHeights map[string]int64
+	failNextUpdate         bool
+}
+
+func (store *fakeCursorStore) GetLastPublishedEventId(publisherId string) (*int64, error) {
+	store.Lock()
+	defer store.Unlock()
+
+	if cursor, ok := store.cursors[publisherId]; ok {
+		return primptr.Int64(cursor), nil
+	}
+	return nil, nil
+}
+
+func (store *fakeCursorStore) UpdateLastPublishedEventId(publisherId string, eventId int64) error {
+	store.Lock()
+	defer store.Unlock()
+
+	if store.failNextUpdate {
+		store.failNextUpdate = false
+		return errors.New("cursor store failure")
+	}
+	store.cursors[publisherId] = eventId
+	return nil
+}
+
+func (store *fakeCursorStore) GetPendingRollbackHeight(publisherId string) (*int64, error) {
+	store.Lock()
+	defer store.Unlock()
+
+	if height, ok := store.pendingRollbackHeights[publisherId]; ok {
+		return primptr.Int64(height), nil
+	}
+	return nil, nil
+}
+
+func (store *fakeCursorStore) ClearPendingRollbackHeight(publisherId string, height int64) error {
+	store.Lock()
+	defer store.Unlock()
+
+	if store.pendingRollbackHeights[publisherId] == height {
+		delete(store.pendingRollbackHeights, publisherId)
+	}
+	return nil
+}
+
+type fakeSink struct {
+	sync.Mutex
+	pendingIds       []int64
+	flushedIds       []int64
+	pendingRollbacks []int64
+	flushedRollbacks []int64
+	oversizedIds     map[int64]bool
+	failNextFlush    bool
+	closed           bool
+}
+
+func (sink *fakeSink) Publish(event event_interface.RawEvent) error {
+	sink.Lock()
+	defer sink.Unlock()
+
+	if sink.oversizedIds[event.Id] {
+		return fmt.Errorf("%w: event %d", eventpublisher.ErrEventTooLarge, event.Id)
+	}
+	sink.pendingIds = append(sink.pendingIds, event.Id)
+	return nil
+}
+
+func (sink *fakeSink) PublishRollback(height int64) error {
+	sink.Lock()
+	defer sink.Unlock()
+
+	sink.pendingRollbacks = append(sink.pendingRollbacks, height)
+	return nil
+}
+
+func (sink *fakeSink) Flush() error {
+	sink.Lock()
+	defer sink.Unlock()
+
+	if sink.failNextFlush {
+		sink.failNextFlush = false
+		sink.pendingIds = nil
+		sink.pendingRollbacks = nil
+		return errors.New("sink failure")
+	}
+	sink.flushedIds = append(sink.flushedIds, sink.pendingIds...)
+	sink.pendingIds = nil
+	sink.flushedRollbacks = append(sink.flushedRollbacks, sink.pendingRollbacks...)
+	sink.pendingRollbacks = nil
+	return nil
+}
+
+func (sink *fakeSink) Close() error {
+	sink.Lock()
+	defer sink.Unlock()
+
+	sink.closed = true
+	return nil
+}
+
+func (sink *fakeSink) FlushedIds() []int64 {
+	sink.Lock()
+	defer sink.Unlock()
+
+	return append([]int64{}, sink.flushedIds...)
+}
+
+func (sink *fakeSink) FlushedRollbacks() []int64 {
+	sink.Lock()
+	defer sink.Unlock()
+
+	return append([]int64{}, sink.flushedRollbacks...)
+}
+
+func (sink *fakeSink) Closed() bool {
+	sink.Lock()
+	defer sink.Unlock()
+
+	return sink.closed
+}
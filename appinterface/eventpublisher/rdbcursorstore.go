@@ -0,0 +1,154 @@
+package eventpublisher
+
+import (
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/internal/primptr"
+)
+
+const DEFAULT_CURSOR_TABLE = "event_publisher_cursors"
+
+var _ CursorStore = &RDbCursorStore{}
+
+// Cursor table should have the following schema
+// | Field                   | Data Type | Constraint  |
+// | ----------------------- | --------- | ----------- |
+// | id                      | VARCHAR   | PRIMARY KEY |
+// | last_published_event_id | INT64     | NOT NULL    |
+// | pending_rollback_height | INT64     |             |
+
+// RDbCursorStore persists the cursors of the publishers using relational database
+type RDbCursorStore struct {
+	rdbHandle *rdb.Handle
+
+	table string
+}
+
+func NewRDbCursorStore(rdbHandle *rdb.Handle) *RDbCursorStore {
+	return &RDbCursorStore{
+		rdbHandle,
+
+		DEFAULT_CURSOR_TABLE,
+	}
+}
+
+// GetLastPublishedEventId returns the id of the last published event of the publisher, nil if no
+// event has been published
+func (store *RDbCursorStore) GetLastPublishedEventId(publisherId string) (*int64, error) {
+	sql, args, err := store.rdbHandle.StmtBuilder.Select(
+		"last_published_event_id",
+	).From(
+		store.table,
+	).Where("id = ?", publisherId).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building last published event id selection SQL: %v", err)
+	}
+
+	var lastPublishedEventId int64
+	if err := store.rdbHandle.QueryRow(sql, args...).Scan(&lastPublishedEventId); err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			return nil, nil
+		} else {
+			return nil, fmt.Errorf("error executing last published event id selection SQL: %v", err)
+		}
+	}
+
+	return primptr.Int64(lastPublishedEventId), nil
+}
+
+// UpdateLastPublishedEventId updates the id of the last published event of the publisher
+func (store *RDbCursorStore) UpdateLastPublishedEventId(publisherId string, eventId int64) error {
+	sql, args, err := store.rdbHandle.StmtBuilder.Insert(
+		store.table,
+	).Columns(
+		"id", "last_published_event_id",
+	).Values(
+		publisherId, eventId,
+	).Suffix(
+		"ON CONFLICT(id) DO UPDATE SET last_published_event_id = EXCLUDED.last_published_event_id",
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building last published event id upsert SQL: %v", err)
+	}
+
+	execResult, err := store.rdbHandle.Exec(sql, args...)
+	if err != nil {
+		return fmt.Errorf("error executing last published event id upsert SQL: %v", err)
+	}
+	if execResult.RowsAffected() == 0 {
+		return errors.New("error executing last published event id upsert SQL: no rows upserted")
+	}
+
+	return nil
+}
+
+// GetPendingRollbackHeight returns the lowest height the event store has been rolled back to since
+// the publisher last published a rollback, nil if there is no rollback to publish
+func (store *RDbCursorStore) GetPendingRollbackHeight(publisherId string) (*int64, error) {
+	sql, args, err := store.rdbHandle.StmtBuilder.Select(
+		"pending_rollback_height",
+	).From(
+		store.table,
+	).Where(
+		"id = ? AND pending_rollback_height IS NOT NULL", publisherId,
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building pending rollback height selection SQL: %v", err)
+	}
+
+	var pendingRollbackHeight int64
+	if err := store.rdbHandle.QueryRow(sql, args...).Scan(&pendingRollbackHeight); err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			return nil, nil
+		} else {
+			return nil, fmt.Errorf("error executing pending rollback height selection SQL: %v", err)
+		}
+	}
+
+	return primptr.Int64(pendingRollbackHeight), nil
+}
+
+// ClearPendingRollbackHeight clears the pending rollback of the publisher when it is still the
+// height, so that a rollback marked in the meantime is kept
+func (store *RDbCursorStore) ClearPendingRollbackHeight(publisherId string, height int64) error {
+	sql, args, err := store.rdbHandle.StmtBuilder.Update(
+		store.table,
+	).Set(
+		"pending_rollback_height", nil,
+	).Where(
+		"id = ? AND pending_rollback_height = ?", publisherId, height,
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building pending rollback height clearing SQL: %v", err)
+	}
+
+	if _, err = store.rdbHandle.Exec(sql, args...); err != nil {
+		return fmt.Errorf("error executing pending rollback height clearing SQL: %v", err)
+	}
+
+	return nil
+}
+
+// MarkRollbackWithRDbHandle marks a pending rollback to the height for all the publishers, using
+// rdbHandle which is expected to be the transaction deleting the events. The lowest height is kept
+// when the publishers have not published the previous rollback yet.
+func (store *RDbCursorStore) MarkRollbackWithRDbHandle(rdbHandle *rdb.Handle, height int64) error {
+	sql, args, err := rdbHandle.StmtBuilder.Update(
+		store.table,
+	).Set(
+		"pending_rollback_height", sq.Expr("LEAST(COALESCE(pending_rollback_height, ?), ?)", height, height),
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building pending rollback height update SQL: %v", err)
+	}
+
+	if _, err = rdbHandle.Exec(sql, args...); err != nil {
+		return fmt.Errorf("error executing pending rollback height update SQL: %v", err)
+	}
+
+	return nil
+}
@@ -1,14 +1,22 @@
 package pagination
 
-import "math"
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidCursor = errors.New("invalid pagination cursor")
 
 // Pagination stores pagination request data
 type Pagination struct {
 	t PaginationType
 
 	offsetParams PaginationOffsetParams
-	// TODO: Cursor pagination
-	// cursorParams *PaginationCursorParams
+	cursorParams PaginationCursorParams
 }
 
 func NewOffsetPagination(page int64, limit int64) *Pagination {
@@ -22,6 +30,19 @@ func NewOffsetPagination(page int64, limit int64) *Pagination {
 	}
 }
 
+// NewCursorPagination creates a cursor pagination returning limit rows after the cursor. Nil cursor
+// starts from the first row.
+func NewCursorPagination(maybeCursor *PaginationCursor, limit int64) *Pagination {
+	return &Pagination{
+		t: PAGINATION_CURSOR,
+
+		cursorParams: PaginationCursorParams{
+			MaybeCursor: maybeCursor,
+			Limit:       limit,
+		},
+	}
+}
+
 func (pagination *Pagination) Type() PaginationType {
 	return pagination.t
 }
@@ -33,10 +54,12 @@ func (pagination *Pagination) OffsetParams() *PaginationOffsetParams {
 	return &pagination.offsetParams
 }
 
-// TODO: Cursor pagination
-// func (pagination *Pagination) CursorParams() *PaginationCursorParams {
-// 	return &pagination.cursorParams
-// }
+func (pagination *Pagination) CursorParams() *PaginationCursorParams {
+	if pagination.Type() != PAGINATION_CURSOR {
+		return nil
+	}
+	return &pagination.cursorParams
+}
 
 func (pagination *Pagination) OffsetResult(totalRecord int64) *PaginationResult {
 	if pagination.Type() != PAGINATION_OFFSET {
@@ -49,6 +72,14 @@ func (pagination *Pagination) OffsetResult(totalRecord int64) *PaginationResult
 	)
 }
 
+// CursorResult returns the cursor pagination result. Nil next cursor means there are no more rows.
+func (pagination *Pagination) CursorResult(maybeNextCursor *PaginationCursor) *PaginationResult {
+	if pagination.Type() != PAGINATION_CURSOR {
+		return nil
+	}
+	return NewCursorPaginationResult(maybeNextCursor, pagination.cursorParams.Limit)
+}
+
 type PaginationOffsetParams struct {
 	Page  int64
 	Limit int64
@@ -58,23 +89,56 @@ func (params *PaginationOffsetParams) Offset() int64 {
 	return params.Limit * (params.Page - 1)
 }
 
-// TODO: Cursor pagination
-// type PaginationCursorParams struct {
-// 	Direction PaginationCursorDirection
-// 	CursorId  interface{}
-// }
+type PaginationCursorParams struct {
+	// Nil to start from the first row
+	MaybeCursor *PaginationCursor
+	Limit       int64
+}
+
+// PaginationCursor is the position of a row, identified by its block height and id, in the
+// ordering of a list. The rows after it are returned in the next page.
+type PaginationCursor struct {
+	BlockHeight int64
+	ID          int64
+}
+
+// Encode encodes the cursor into an opaque string to be passed back by the client
+func (cursor *PaginationCursor) Encode() string {
+	return base64.RawURLEncoding.EncodeToString(
+		[]byte(fmt.Sprintf("%d,%d", cursor.BlockHeight, cursor.ID)),
+	)
+}
 
-// type PaginationCursorDirection = int8
+// DecodePaginationCursor decodes the opaque cursor string encoded by PaginationCursor.Encode
+func DecodePaginationCursor(encoded string) (*PaginationCursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	parts := strings.Split(string(decoded), ",")
+	if len(parts) != 2 {
+		return nil, ErrInvalidCursor
+	}
+	blockHeight, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
 
-// const (
-// 	PAGINATION_CURSOR_NEXT = 0
-// 	PAGINATION_CURSOR_BACK = 1
-// )
+	return &PaginationCursor{
+		BlockHeight: blockHeight,
+		ID:          id,
+	}, nil
+}
 
 type PaginationResult struct {
 	t PaginationType
 
 	offsetResult PaginationOffsetResult
+	cursorResult PaginationCursorResult
 }
 
 func NewOffsetPaginationResult(totalRecord int64, currentPage int64, limit int64) *PaginationResult {
@@ -89,6 +153,17 @@ func NewOffsetPaginationResult(totalRecord int64, currentPage int64, limit int64
 	}
 }
 
+func NewCursorPaginationResult(maybeNextCursor *PaginationCursor, limit int64) *PaginationResult {
+	return &PaginationResult{
+		t: PAGINATION_CURSOR,
+
+		cursorResult: PaginationCursorResult{
+			MaybeNextCursor: maybeNextCursor,
+			Limit:           limit,
+		},
+	}
+}
+
 func (result *PaginationResult) Type() PaginationType {
 	return result.t
 }
@@ -100,6 +175,13 @@ func (result *PaginationResult) OffsetResult() *PaginationOffsetResult {
 	return &result.offsetResult
 }
 
+func (result *PaginationResult) CursorResult() *PaginationCursorResult {
+	if result.Type() != PAGINATION_CURSOR {
+		return nil
+	}
+	return &result.cursorResult
+}
+
 type PaginationOffsetResult struct {
 	TotalRecord int64
 	CurrentPage int64
@@ -110,9 +192,15 @@ func (result *PaginationOffsetResult) TotalPage() int64 {
 	return int64(math.Ceil(float64(result.TotalRecord) / float64(result.Limit)))
 }
 
+type PaginationCursorResult struct {
+	// Nil when there are no more rows
+	MaybeNextCursor *PaginationCursor
+	Limit           int64
+}
+
 type PaginationType = string
 
 const (
 	PAGINATION_OFFSET PaginationType = "offset"
-	// PAGINATION_CURSOR
+	PAGINATION_CURSOR PaginationType = "cursor"
 )
@@ -3,6 +3,7 @@ package polling
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/crypto-com/chain-indexing/appinterface/rdb"
 )
@@ -66,3 +67,36 @@ func (view *Status) FindBy(statusid string) (string, error) {
 
 	return found, nil
 }
+
+// FindAllByKeyPrefix returns the values of the statuses whose key starts with prefix, indexed by key
+func (view *Status) FindAllByKeyPrefix(prefix string) (map[string]string, error) {
+	sql, sqlArgs, err := view.rdb.StmtBuilder.Select(
+		"key",
+		"value",
+	).From("view_status").Where("key LIKE ?", escapeLikePattern(prefix)+"%").ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building status selection sql: %v: %w", err, rdb.ErrPrepare)
+	}
+
+	rowsResult, err := view.rdb.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("error executing status selection sql: %v: %w", err, rdb.ErrQuery)
+	}
+	defer rowsResult.Close()
+
+	statuses := make(map[string]string)
+	for rowsResult.Next() {
+		var key string
+		var value string
+		if err = rowsResult.Scan(&key, &value); err != nil {
+			return nil, fmt.Errorf("error scanning status row: %v: %w", err, rdb.ErrQuery)
+		}
+		statuses[key] = value
+	}
+
+	return statuses, nil
+}
+
+func escapeLikePattern(pattern string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(pattern)
+}
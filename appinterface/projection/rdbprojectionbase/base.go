@@ -57,3 +57,10 @@ func (base *Base) UpdateLastHandledEventHeight(rdbHandle *rdb.Handle, height int
 func (base *Base) GetLastHandledEventHeight() (*int64, error) {
 	return base.store.GetLastHandledEventHeight(base.rdbHandle, base.projectionId)
 }
+
+func (base *Base) ResetLastHandledEventHeight(rdbHandle *rdb.Handle, maybeHeight *int64) error {
+	if err := base.store.ResetLastHandledEventHeight(rdbHandle, base.projectionId, maybeHeight); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package rdbprojectionbase
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	projection_entity "github.com/crypto-com/chain-indexing/entity/projection"
+)
+
+// Rebuildable is a projection keeping its projected data in view tables which are exclusively
+// written by itself. It can be rebuilt by truncating the tables and replaying the events.
+type Rebuildable interface {
+	projection_entity.Projection
+
+	// Returns the view tables written by the projection
+	GetViewTables() []string
+
+	ResetLastHandledEventHeight(rdbHandle *rdb.Handle, maybeHeight *int64) error
+}
+
+// Truncate removes all the projected data of the projection and rewinds its last handled event
+// height, so that the next handled event height is fromHeight. Both changes are made in the same
+// transaction.
+func Truncate(rdbConn rdb.Conn, projection Rebuildable, fromHeight int64) error {
+	rdbTx, err := rdbConn.Begin()
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %v", err)
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = rdbTx.Rollback()
+		}
+	}()
+
+	if err = TruncateWithRDbHandle(rdbTx.ToHandle(), projection, fromHeight); err != nil {
+		return err
+	}
+
+	if err = rdbTx.Commit(); err != nil {
+		return fmt.Errorf("error committing changes: %v", err)
+	}
+	committed = true
+
+	return nil
+}
+
+// TruncateWithRDbHandle is Truncate with the changes made by rdbHandle, which is expected to be a
+// transaction handle
+func TruncateWithRDbHandle(rdbHandle *rdb.Handle, projection Rebuildable, fromHeight int64) error {
+	tables := projection.GetViewTables()
+	if len(tables) == 0 {
+		return errors.New("projection does not declare any view table")
+	}
+	if fromHeight < 0 {
+		return fmt.Errorf("invalid from height: %d", fromHeight)
+	}
+
+	if _, err := rdbHandle.Exec(fmt.Sprintf("TRUNCATE TABLE %s", strings.Join(tables, ", "))); err != nil {
+		return fmt.Errorf("error truncating view tables: %v", err)
+	}
+
+	var maybeLastHandledEventHeight *int64
+	if fromHeight > 0 {
+		lastHandledEventHeight := fromHeight - 1
+		maybeLastHandledEventHeight = &lastHandledEventHeight
+	}
+	if err := projection.ResetLastHandledEventHeight(rdbHandle, maybeLastHandledEventHeight); err != nil {
+		return fmt.Errorf("error resetting last handled event height: %v", err)
+	}
+
+	return nil
+}
@@ -96,3 +96,64 @@ func (impl *Store) GetLastHandledEventHeight(rdbHandle *rdb.Handle, projectionId
 
 	return primptr.Int64(lastHandledEventHeight), nil
 }
+
+// ResetLastHandledEventHeight rewinds the last handled event height of projection id. The
+// projection record is removed when height is nil so that it starts from the very first event.
+func (impl *Store) ResetLastHandledEventHeight(
+	rdbHandle *rdb.Handle,
+	projectionId string,
+	maybeHeight *int64,
+) error {
+	if maybeHeight != nil {
+		return impl.UpdateLastHandledEventHeight(rdbHandle, projectionId, *maybeHeight)
+	}
+
+	sql, args, err := rdbHandle.StmtBuilder.Delete(
+		impl.table,
+	).Where(
+		"id = ?", projectionId,
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building last handled event height deletion SQL: %v", err)
+	}
+
+	if _, err := rdbHandle.Exec(sql, args...); err != nil {
+		return fmt.Errorf("error executing last handled event height deletion SQL: %v", err)
+	}
+
+	return nil
+}
+
+// FindAllIdsHandledAfterHeight returns the ids of the projections which have handled events after
+// height. The projection records are locked until the end of the transaction of rdbHandle.
+func (impl *Store) FindAllIdsHandledAfterHeight(rdbHandle *rdb.Handle, height int64) ([]string, error) {
+	sql, args, err := rdbHandle.StmtBuilder.Select(
+		"id",
+	).From(
+		impl.table,
+	).Where(
+		"last_handled_event_height > ?", height,
+	).OrderBy(
+		"id",
+	).Suffix("FOR UPDATE").ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building projections handled after height selection SQL: %v", err)
+	}
+
+	rows, err := rdbHandle.Query(sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error executing projections handled after height selection SQL: %v", err)
+	}
+	defer rows.Close()
+
+	projectionIds := make([]string, 0)
+	for rows.Next() {
+		var projectionId string
+		if err := rows.Scan(&projectionId); err != nil {
+			return nil, fmt.Errorf("error scanning projection id: %v", err)
+		}
+		projectionIds = append(projectionIds, projectionId)
+	}
+
+	return projectionIds, nil
+}
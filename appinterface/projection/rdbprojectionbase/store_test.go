@@ -52,6 +52,50 @@ var _ = Describe("RdbStoreImpl", func() {
 			})
 		})
 
+		Describe("ResetLastHandledEventHeight", func() {
+			It("should remove projection record when the height is nil", func() {
+				store := rdbprojectionbase.NewStore(rdbprojectionbase.DEFAULT_TABLE)
+
+				anyProjectionId := "projection"
+				err := store.UpdateLastHandledEventHeight(pgxConn.ToHandle(), anyProjectionId, int64(100))
+				Expect(err).To(BeNil())
+
+				err = store.ResetLastHandledEventHeight(pgxConn.ToHandle(), anyProjectionId, nil)
+				Expect(err).To(BeNil())
+
+				Expect(IsProjectionRowExist(pgxConn, anyProjectionId)).To(BeFalse())
+			})
+
+			It("should rewind projection last handled height to the provided height", func() {
+				store := rdbprojectionbase.NewStore(rdbprojectionbase.DEFAULT_TABLE)
+
+				anyProjectionId := "projection"
+				err := store.UpdateLastHandledEventHeight(pgxConn.ToHandle(), anyProjectionId, int64(100))
+				Expect(err).To(BeNil())
+
+				err = store.ResetLastHandledEventHeight(pgxConn.ToHandle(), anyProjectionId, primptr.Int64(10))
+				Expect(err).To(BeNil())
+
+				actualHeight, err := store.GetLastHandledEventHeight(pgxConn.ToHandle(), anyProjectionId)
+				Expect(err).To(BeNil())
+				Expect(actualHeight).To(Equal(primptr.Int64(10)))
+			})
+		})
+
+		Describe("FindAllIdsHandledAfterHeight", func() {
+			It("should return the projections with last handled height after the provided height", func() {
+				store := rdbprojectionbase.NewStore(rdbprojectionbase.DEFAULT_TABLE)
+
+				Expect(store.UpdateLastHandledEventHeight(pgxConn.ToHandle(), "Block", int64(100))).To(BeNil())
+				Expect(store.UpdateLastHandledEventHeight(pgxConn.ToHandle(), "Validator", int64(10))).To(BeNil())
+				Expect(store.UpdateLastHandledEventHeight(pgxConn.ToHandle(), "Account", int64(11))).To(BeNil())
+
+				actual, err := store.FindAllIdsHandledAfterHeight(pgxConn.ToHandle(), int64(10))
+				Expect(err).To(BeNil())
+				Expect(actual).To(Equal([]string{"Account", "Block"}))
+			})
+		})
+
 		It("should update projection last handled height when record already exist", func() {
 			var err error
 
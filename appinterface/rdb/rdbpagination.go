@@ -13,6 +13,7 @@ type RDbPaginationBuilder struct {
 	*pagination_interface.Pagination
 
 	customTotalQueryFn CustomTotalQueryFn
+	maybeCursorKey     *RDbPaginationCursorKey
 	rdbHandle          *Handle
 }
 
@@ -20,6 +21,7 @@ func NewRDbPaginationBuilder(pagination *pagination_interface.Pagination, rdbHan
 	return &RDbPaginationBuilder{
 		pagination,
 
+		nil,
 		nil,
 		rdbHandle,
 	}
@@ -31,6 +33,14 @@ func (pagination *RDbPaginationBuilder) WithCustomTotalQueryFn(fn CustomTotalQue
 	return pagination
 }
 
+// WithCursorKey enables cursor pagination on the statement. The key must match the ORDER BY of the
+// statement.
+func (pagination *RDbPaginationBuilder) WithCursorKey(cursorKey RDbPaginationCursorKey) *RDbPaginationBuilder {
+	pagination.maybeCursorKey = &cursorKey
+
+	return pagination
+}
+
 func (pagination *RDbPaginationBuilder) BuildStmt(stmtBuilder sq.SelectBuilder) *RDbPaginationStmtBuilder {
 	return &RDbPaginationStmtBuilder{
 		Pagination: pagination.Pagination,
@@ -38,6 +48,7 @@ func (pagination *RDbPaginationBuilder) BuildStmt(stmtBuilder sq.SelectBuilder)
 		rdbHandle:          pagination.rdbHandle,
 		stmtBuilder:        stmtBuilder,
 		customTotalQueryFn: pagination.customTotalQueryFn,
+		maybeCursorKey:     pagination.maybeCursorKey,
 	}
 }
 
@@ -58,28 +69,116 @@ type RDbPaginationStmtBuilder struct {
 	rdbHandle          *Handle
 	stmtBuilder        sq.SelectBuilder
 	customTotalQueryFn CustomTotalQueryFn
+	maybeCursorKey     *RDbPaginationCursorKey
+
+	// Cursor pagination states collected from the rows of the page
+	rowCount    int64
+	lastCursor  pagination_interface.PaginationCursor
+	hasMoreRows bool
 }
 
+// ToStmtBuilder returns the statement limited to the page. Cursor pagination panics when the
+// builder has no cursor key, i.e. the list does not support cursor pagination.
+//
+// Cursor pagination selects the cursor columns after the statement columns and fetches one row
+// beyond the page to tell whether there are more rows. The rows must be iterated with NextRow and
+// scanned with ScanArgs.
 func (pagination *RDbPaginationStmtBuilder) ToStmtBuilder() sq.SelectBuilder {
 	switch pagination.Type() {
 	case pagination_interface.PAGINATION_OFFSET:
 
 		params := pagination.OffsetParams()
 		return pagination.stmtBuilder.Suffix("LIMIT ? OFFSET ?", params.Limit, params.Offset())
+	case pagination_interface.PAGINATION_CURSOR:
+
+		params := pagination.CursorParams()
+		cursorKey := pagination.maybeCursorKey
+		return pagination.cursorStmtBuilder().Column(
+			cursorKey.BlockHeightColumn,
+		).Column(
+			cursorKey.IDColumn,
+		).Suffix("LIMIT ?", params.Limit+1)
 	}
 
 	return pagination.stmtBuilder
 }
 
+// NextRow reports whether the current row belongs to the page. It returns false on the row beyond
+// the page fetched by cursor pagination, which must not be scanned.
+func (pagination *RDbPaginationStmtBuilder) NextRow() bool {
+	if pagination.Type() != pagination_interface.PAGINATION_CURSOR {
+		return true
+	}
+
+	if pagination.rowCount >= pagination.CursorParams().Limit {
+		pagination.hasMoreRows = true
+		return false
+	}
+	pagination.rowCount++
+	return true
+}
+
+// ScanArgs appends the cursor column destinations to the row scan destinations under cursor
+// pagination
+func (pagination *RDbPaginationStmtBuilder) ScanArgs(dest ...interface{}) []interface{} {
+	if pagination.Type() != pagination_interface.PAGINATION_CURSOR {
+		return dest
+	}
+
+	return append(dest, &pagination.lastCursor.BlockHeight, &pagination.lastCursor.ID)
+}
+
 func (pagination *RDbPaginationStmtBuilder) Result() (*pagination_interface.PaginationResult, error) {
 	switch pagination.Type() {
 	case pagination_interface.PAGINATION_OFFSET:
 		return pagination.offsetResult()
+	case pagination_interface.PAGINATION_CURSOR:
+		return pagination.cursorResult()
 	}
 
 	return nil, nil
 }
 
+// cursorStmtBuilder returns the statement filtered to the rows after the cursor
+func (pagination *RDbPaginationStmtBuilder) cursorStmtBuilder() sq.SelectBuilder {
+	if pagination.maybeCursorKey == nil {
+		panic("cursor pagination is not supported without cursor key")
+	}
+
+	cursorKey := pagination.maybeCursorKey
+	maybeCursor := pagination.CursorParams().MaybeCursor
+	if maybeCursor == nil {
+		return pagination.stmtBuilder
+	}
+
+	idOperator := cursorKey.afterOperator(cursorKey.IDDesc)
+	if cursorKey.OrderByIDOnly {
+		return pagination.stmtBuilder.Where(
+			fmt.Sprintf("%s %s ?", cursorKey.IDColumn, idOperator), maybeCursor.ID,
+		)
+	}
+
+	return pagination.stmtBuilder.Where(
+		fmt.Sprintf(
+			"(%s %s ? OR (%s = ? AND %s %s ?))",
+			cursorKey.BlockHeightColumn, cursorKey.afterOperator(cursorKey.BlockHeightDesc),
+			cursorKey.BlockHeightColumn, cursorKey.IDColumn, idOperator,
+		),
+		maybeCursor.BlockHeight, maybeCursor.BlockHeight, maybeCursor.ID,
+	)
+}
+
+// cursorResult returns the cursor of the last row of the page as the next cursor, only when there
+// are rows after it. No query is executed.
+func (pagination *RDbPaginationStmtBuilder) cursorResult() (*pagination_interface.PaginationResult, error) {
+	if pagination.CursorParams().Limit <= 0 || !pagination.hasMoreRows {
+		return pagination.CursorResult(nil), nil
+	}
+
+	nextCursor := pagination.lastCursor
+	return pagination.CursorResult(&nextCursor), nil
+}
+
 func (pagination *RDbPaginationStmtBuilder) offsetResult() (*pagination_interface.PaginationResult, error) {
 	var err error
 
@@ -109,6 +208,7 @@ func (pagination *RDbPaginationStmtBuilder) offsetResult() (*pagination_interfac
 }
 
 // RDbPaginationBuilder is the concrete implementation to builds the pagination for SQL query
+// Cursor pagination is not supported.
 type RDbPaginationSQLBuilder struct {
 	*pagination_interface.Pagination
 
@@ -158,6 +258,26 @@ func (pagination *RDbPaginationSQLBuilder) offsetResult() (*pagination_interface
 	return pagination.OffsetResult(total), nil
 }
 
+// RDbPaginationCursorKey describes the columns identifying the cursor position in the ordering of
+// the statement. It must match the ORDER BY of the statement.
+type RDbPaginationCursorKey struct {
+	BlockHeightColumn string
+	BlockHeightDesc   bool
+	IDColumn          string
+	IDDesc            bool
+
+	// The statement is ordered by the id only. The id must be increasing with the block height,
+	// block height is then carried in the cursor but not compared.
+	OrderByIDOnly bool
+}
+
+func (cursorKey *RDbPaginationCursorKey) afterOperator(desc bool) string {
+	if desc {
+		return "<"
+	}
+	return ">"
+}
+
 type CustomTotalQueryFn = func(conn *Handle, originalSelectBuilder sq.SelectBuilder) (int64, error)
 
 //"SELECT reltuples::bigint FROM pg_class where relname='$1';
@@ -0,0 +1,193 @@
+package rdb_test
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	pagination_interface "github.com/crypto-com/chain-indexing/appinterface/pagination"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+)
+
+var _ = Describe("RDbPaginationBuilder", func() {
+	var handle *rdb.Handle
+	var stmtBuilder sq.SelectBuilder
+	BeforeEach(func() {
+		handle = &rdb.Handle{
+			StmtBuilder: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+		}
+		stmtBuilder = handle.StmtBuilder.Select(
+			"hash",
+		).From(
+			"view_transactions",
+		).Where(
+			"success = ?", true,
+		).OrderBy("block_height DESC, id")
+	})
+
+	Describe("Offset pagination", func() {
+		It("should limit the statement with offset", func() {
+			sql, args, err := rdb.NewRDbPaginationBuilder(
+				pagination_interface.NewOffsetPagination(3, 20),
+				handle,
+			).BuildStmt(stmtBuilder).ToStmtBuilder().ToSql()
+
+			Expect(err).To(BeNil())
+			Expect(sql).To(Equal(
+				"SELECT hash FROM view_transactions WHERE success = $1 ORDER BY block_height DESC, id LIMIT $2 OFFSET $3",
+			))
+			Expect(args).To(Equal([]interface{}{true, int64(20), int64(40)}))
+		})
+	})
+
+	Describe("Cursor pagination", func() {
+		cursorKey := rdb.RDbPaginationCursorKey{
+			BlockHeightColumn: "block_height",
+			BlockHeightDesc:   true,
+			IDColumn:          "id",
+			IDDesc:            false,
+		}
+
+		It("should limit the statement from the first row when there is no cursor", func() {
+			sql, args, err := rdb.NewRDbPaginationBuilder(
+				pagination_interface.NewCursorPagination(nil, 20),
+				handle,
+			).WithCursorKey(cursorKey).BuildStmt(stmtBuilder).ToStmtBuilder().ToSql()
+
+			Expect(err).To(BeNil())
+			Expect(sql).To(Equal(
+				"SELECT hash, block_height, id FROM view_transactions WHERE success = $1 ORDER BY block_height DESC, id LIMIT $2",
+			))
+			Expect(args).To(Equal([]interface{}{true, int64(21)}))
+		})
+
+		It("should filter the rows after the cursor following the order", func() {
+			sql, args, err := rdb.NewRDbPaginationBuilder(
+				pagination_interface.NewCursorPagination(&pagination_interface.PaginationCursor{
+					BlockHeight: 1000,
+					ID:          12,
+				}, 20),
+				handle,
+			).WithCursorKey(cursorKey).BuildStmt(stmtBuilder).ToStmtBuilder().ToSql()
+
+			Expect(err).To(BeNil())
+			Expect(sql).To(Equal(
+				"SELECT hash, block_height, id FROM view_transactions WHERE success = $1 AND (block_height < $2 OR (block_height = $3 AND id > $4)) ORDER BY block_height DESC, id LIMIT $5",
+			))
+			Expect(args).To(Equal([]interface{}{true, int64(1000), int64(1000), int64(12), int64(21)}))
+		})
+
+		It("should filter the rows after the cursor by id only when ordered by id", func() {
+			sql, args, err := rdb.NewRDbPaginationBuilder(
+				pagination_interface.NewCursorPagination(&pagination_interface.PaginationCursor{
+					BlockHeight: 1000,
+					ID:          12,
+				}, 20),
+				handle,
+			).WithCursorKey(rdb.RDbPaginationCursorKey{
+				BlockHeightColumn: "block_height",
+				IDColumn:          "id",
+				IDDesc:            true,
+				OrderByIDOnly:     true,
+			}).BuildStmt(handle.StmtBuilder.Select(
+				"hash",
+			).From(
+				"view_transactions",
+			).Where(
+				"success = ?", true,
+			).OrderBy("id DESC")).ToStmtBuilder().ToSql()
+
+			Expect(err).To(BeNil())
+			Expect(sql).To(Equal(
+				"SELECT hash, block_height, id FROM view_transactions WHERE success = $1 AND id < $2 ORDER BY id DESC LIMIT $3",
+			))
+			Expect(args).To(Equal([]interface{}{true, int64(12), int64(21)}))
+		})
+
+		It("should return the last row of the page as next cursor when there is row after the page", func() {
+			paginationStmt := rdb.NewRDbPaginationBuilder(
+				pagination_interface.NewCursorPagination(nil, 2),
+				handle,
+			).WithCursorKey(cursorKey).BuildStmt(stmtBuilder)
+
+			rows := []pagination_interface.PaginationCursor{
+				{BlockHeight: 1000, ID: 12},
+				{BlockHeight: 999, ID: 3},
+				{BlockHeight: 999, ID: 4},
+			}
+			pageRowCount := scanCursorRows(paginationStmt, rows)
+			Expect(pageRowCount).To(Equal(2))
+
+			paginationResult, err := paginationStmt.Result()
+			Expect(err).To(BeNil())
+			Expect(paginationResult.CursorResult().MaybeNextCursor).To(Equal(
+				&pagination_interface.PaginationCursor{BlockHeight: 999, ID: 3},
+			))
+		})
+
+		It("should return nil next cursor when there is no row after the page", func() {
+			paginationStmt := rdb.NewRDbPaginationBuilder(
+				pagination_interface.NewCursorPagination(nil, 2),
+				handle,
+			).WithCursorKey(cursorKey).BuildStmt(stmtBuilder)
+
+			rows := []pagination_interface.PaginationCursor{
+				{BlockHeight: 1000, ID: 12},
+				{BlockHeight: 999, ID: 3},
+			}
+			pageRowCount := scanCursorRows(paginationStmt, rows)
+			Expect(pageRowCount).To(Equal(2))
+
+			paginationResult, err := paginationStmt.Result()
+			Expect(err).To(BeNil())
+			Expect(paginationResult.CursorResult().MaybeNextCursor).To(BeNil())
+		})
+
+		It("should panic when the cursor key is missing", func() {
+			Expect(func() {
+				rdb.NewRDbPaginationBuilder(
+					pagination_interface.NewCursorPagination(nil, 20),
+					handle,
+				).BuildStmt(stmtBuilder).ToStmtBuilder()
+			}).To(Panic())
+		})
+	})
+
+	Describe("PaginationCursor", func() {
+		It("should decode the encoded cursor", func() {
+			cursor := pagination_interface.PaginationCursor{
+				BlockHeight: 1000,
+				ID:          12,
+			}
+
+			decoded, err := pagination_interface.DecodePaginationCursor(cursor.Encode())
+			Expect(err).To(BeNil())
+			Expect(*decoded).To(Equal(cursor))
+		})
+
+		It("should return error when the cursor is invalid", func() {
+			_, err := pagination_interface.DecodePaginationCursor("invalid")
+			Expect(err).To(Equal(pagination_interface.ErrInvalidCursor))
+		})
+	})
+})
+
+// scanCursorRows simulates iterating and scanning the rows returned by the cursor pagination
+// statement. Returns the number of rows in the page.
+func scanCursorRows(paginationStmt *rdb.RDbPaginationStmtBuilder, rows []pagination_interface.PaginationCursor) int {
+	pageRowCount := 0
+	for _, row := range rows {
+		if !paginationStmt.NextRow() {
+			break
+		}
+
+		var hash string
+		scanArgs := paginationStmt.ScanArgs(&hash)
+		Expect(scanArgs).To(HaveLen(3))
+		*(scanArgs[1].(*int64)) = row.BlockHeight
+		*(scanArgs[2].(*int64)) = row.ID
+		pageRowCount++
+	}
+
+	return pageRowCount
+}
@@ -4,12 +4,19 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/crypto-com/chain-indexing/internal/primptr"
 
 	applogger "github.com/crypto-com/chain-indexing/internal/logger"
 
+	"github.com/crypto-com/chain-indexing/appinterface/eventpublisher"
 	"github.com/crypto-com/chain-indexing/infrastructure"
+	"github.com/crypto-com/chain-indexing/infrastructure/push"
+	"github.com/crypto-com/chain-indexing/projection/webhook"
+	webhook_view "github.com/crypto-com/chain-indexing/projection/webhook/view"
+
+	projection_entity "github.com/crypto-com/chain-indexing/entity/projection"
 
 	"github.com/crypto-com/chain-indexing/internal/filereader/toml"
 	"github.com/urfave/cli/v2"
@@ -88,83 +95,296 @@ func CliApp(args []string) error {
 				Usage:   " Cosmos App RPC URL",
 				EnvVars: []string{"COSMOSAPP_URL"},
 			},
+			&cli.StringFlag{
+				Name:    "eventPublisherNATSURL",
+				Usage:   "NATS server URL of the event publisher, which may contain credentials",
+				EnvVars: []string{"EVENT_PUBLISHER_NATS_URL"},
+			},
+			&cli.StringFlag{
+				Name:    "webhookSecretKey",
+				Usage:   "Base64-encoded 32 bytes key encrypting the webhook watch secrets at rest",
+				EnvVars: []string{"WEBHOOK_SECRET_KEY"},
+			},
 		},
 		Action: func(ctx *cli.Context) error {
 			if args := ctx.Args(); args.Len() > 0 {
 				return fmt.Errorf("Unexpected arguments: %q", args.Get(0))
 			}
 
-			// Prepare FileConfig
-			configPath := ctx.String("config")
-			configReader, configFileErr := toml.FromFile(configPath)
-			if configFileErr != nil {
-				return configFileErr
-			}
-			var fileConfig FileConfig
-			readConfigErr := configReader.Read(&fileConfig)
-			if readConfigErr != nil {
-				return readConfigErr
+			config, err := loadConfig(ctx)
+			if err != nil {
+				return err
 			}
+			logger := newLogger(config)
 
-			cliConfig := CLIConfig{
-				LogLevel: ctx.String("logLevel"),
-
-				DatabaseHost:     ctx.String("dbHost"),
-				DatabaseUsername: ctx.String("dbUsername"),
-				DatabasePassword: ctx.String("dbPassword"),
-				DatabaseName:     ctx.String("dbName"),
-				DatabaseSchema:   ctx.String("dbSchema"),
+			// Setup system
+			if config.System.Mode != SYSTEM_MODE_EVENT_STORE && config.System.Mode != SYSTEM_MODE_TENDERMINT_DIRECT {
+				logger.Panicf("unrecognized system mode: %s", config.System.Mode)
+			}
 
-				TendermintHTTPRPCUrl: ctx.String("tendermintURL"),
-				CosmosHTTPRPCUrl:     ctx.String("cosmosAppURL"),
+			shutdownTimeout, err := parseShutdownTimeout(config.HTTP.ShutdownTimeout)
+			if err != nil {
+				return err
+			}
+			webhookDispatcherConfig, err := newWebhookDispatcherConfig(config)
+			if err != nil {
+				return err
 			}
-			if ctx.IsSet("color") {
-				cliConfig.LoggerColor = primptr.Bool(ctx.Bool("color"))
+			var webhookSecretCipher *webhook.SecretCipher
+			if config.Webhook.EnableDispatcher {
+				if webhookSecretCipher, err = webhook.NewSecretCipher(config.Webhook.SecretKey); err != nil {
+					return err
+				}
 			}
-			if ctx.IsSet("dbSSL") {
-				cliConfig.DatabaseSSL = primptr.Bool(ctx.Bool("dbSSL"))
+			shutdownCtx := newShutdownContext(logger)
+
+			rdbConn, err := SetupRDbConn(config, logger)
+			if err != nil {
+				logger.Panicf("error setting up RDb connection: %v", err)
 			}
-			if ctx.IsSet("dgPort") {
-				cliConfig.DatabasePort = primptr.Int32(int32(ctx.Int("dbPort")))
+
+			var eventPublisher *eventpublisher.Publisher
+			if config.EventPublisher.Enable {
+				if eventPublisher, err = NewEventPublisher(logger, rdbConn, config); err != nil {
+					return err
+				}
 			}
 
-			config := Config{
-				fileConfig,
+			var pushBroker *push.Broker
+			handledEventsHooks := make([]projection_entity.HandledEventsHook, 0)
+			if config.Push.Enable {
+				pushBroker = push.NewBroker(
+					config.Push.MaxSubscriptions,
+					config.Push.MaxTopicsPerSubscription,
+					config.Push.BufferSize,
+				)
+				handledEventsHooks = append(handledEventsHooks, push.NewProjectionHook(pushBroker))
 			}
-			config.OverrideByCLIConfig(&cliConfig)
 
-			// Create logger
-			logLevel := parseLogLevel(config.Logger.Level)
-			logger := infrastructure.NewZerologLogger(os.Stdout)
-			logger.SetLogLevel(logLevel)
+			var wg sync.WaitGroup
+			httpAPIServer := NewHTTPAPIServer(logger, rdbConn, config, pushBroker)
+			runUntilShutdown(shutdownCtx, logger, &wg, func() error {
+				return httpAPIServer.Run(shutdownCtx, shutdownTimeout)
+			})
 
-			// Setup system
-			if config.System.Mode != SYSTEM_MODE_EVENT_STORE && config.System.Mode != SYSTEM_MODE_TENDERMINT_DIRECT {
-				logger.Panicf("unrecognized system mode: %s", config.System.Mode)
+			projections := initProjections(logger, rdbConn, config)
+
+			if config.Metrics.Enable {
+				runUntilShutdown(shutdownCtx, logger, &wg, func() error {
+					return RunMetricsServer(shutdownCtx, shutdownTimeout, logger, config, projections)
+				})
 			}
 
-			rdbConn, err := SetupRDbConn(&config, logger)
-			if err != nil {
-				logger.Panicf("error setting up RDb connection: %v", err)
+			indexService := NewIndexService(logger, rdbConn, config, projections, handledEventsHooks)
+			runUntilShutdown(shutdownCtx, logger, &wg, func() error {
+				return indexService.Run(shutdownCtx)
+			})
+
+			if eventPublisher != nil {
+				runUntilShutdown(shutdownCtx, logger, &wg, func() error {
+					return eventPublisher.Run(shutdownCtx)
+				})
 			}
 
-			httpAPIServer := NewHTTPAPIServer(logger, rdbConn, &config)
-			go func() {
-				if runErr := httpAPIServer.Run(); runErr != nil {
-					logger.Panicf("%v", runErr)
-				}
-			}()
+			if config.Webhook.EnableDispatcher {
+				webhookDispatcher := webhook.NewDispatcher(
+					logger, webhook_view.NewDeliveries(rdbConn.ToHandle()), webhookSecretCipher, webhookDispatcherConfig,
+				)
+				runUntilShutdown(shutdownCtx, logger, &wg, func() error {
+					return webhookDispatcher.Run(shutdownCtx)
+				})
+			}
 
-			projections := initProjections(logger, rdbConn, &config)
+			wg.Wait()
+			rdbConn.Close()
+			logger.Info("shutdown completed")
 
-			indexService := NewIndexService(logger, rdbConn, &config, projections)
-			go func() {
-				if runErr := indexService.Run(); runErr != nil {
-					logger.Panicf("%v", runErr)
-				}
-			}()
+			return nil
+		},
+		Commands: []*cli.Command{
+			{
+				Name:  "projection",
+				Usage: "Manage projections",
+				Subcommands: []*cli.Command{
+					{
+						Name: "rebuild",
+						Usage: "Truncate the view tables of a projection and replay it from the event store. " +
+							"Only supported in EVENT_STORE mode. The projection must not be running in " +
+							"another indexing server instance during rebuild",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "name",
+								Usage:    "Projection `NAME` to rebuild. e.g. Validator",
+								Required: true,
+							},
+							&cli.Int64Flag{
+								Name:  "from-height",
+								Value: 0,
+								Usage: "Replay events starting from `HEIGHT`. Events before it are not projected",
+							},
+						},
+						Action: func(ctx *cli.Context) error {
+							config, err := loadConfig(ctx)
+							if err != nil {
+								return err
+							}
+							logger := newLogger(config)
 
-			select {}
+							rdbConn, err := SetupRDbConn(config, logger)
+							if err != nil {
+								return fmt.Errorf("error setting up RDb connection: %v", err)
+							}
+
+							return RebuildProjection(
+								logger, rdbConn, config, ctx.String("name"), ctx.Int64("from-height"),
+							)
+						},
+					},
+				},
+			},
+			newWebhookCommand(),
+			{
+				Name: "rollback",
+				Usage: "Delete the indexed events after a height, e.g. after a chain divergence, and truncate " +
+					"the enabled projections which have handled them. Only supported in EVENT_STORE mode. The " +
+					"indexing server must not be running during rollback",
+				Flags: []cli.Flag{
+					&cli.Int64Flag{
+						Name:     "to-height",
+						Usage:    "Last indexed `HEIGHT` to keep",
+						Required: true,
+					},
+				},
+				Action: func(ctx *cli.Context) error {
+					config, err := loadConfig(ctx)
+					if err != nil {
+						return err
+					}
+					logger := newLogger(config)
+
+					rdbConn, err := SetupRDbConn(config, logger)
+					if err != nil {
+						return fmt.Errorf("error setting up RDb connection: %v", err)
+					}
+					defer rdbConn.Close()
+
+					return RollbackEventStore(logger, rdbConn, config, ctx.Int64("to-height"))
+				},
+			},
+			{
+				Name: "import",
+				Usage: "Index blocks from an archive of Tendermint RPC responses instead of a live node. The " +
+					"indexing server must not be running during import",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name: "archive",
+						Usage: "Archive directory or tarball `PATH` containing genesis.json, " +
+							"block/<height>.json and block_results/<height>.json",
+						Required: true,
+					},
+				},
+				Action: func(ctx *cli.Context) error {
+					config, err := loadConfig(ctx)
+					if err != nil {
+						return err
+					}
+					logger := newLogger(config)
+
+					rdbConn, err := SetupRDbConn(config, logger)
+					if err != nil {
+						return fmt.Errorf("error setting up RDb connection: %v", err)
+					}
+					defer rdbConn.Close()
+
+					return ImportArchive(newShutdownContext(logger), logger, rdbConn, config, ctx.String("archive"))
+				},
+			},
+			{
+				Name: "export",
+				Usage: "Export raw block and block_results responses of a height range from the Tendermint node " +
+					"into an archive with manifest and checksums, which can be indexed by `import`",
+				Flags: []cli.Flag{
+					&cli.Int64Flag{
+						Name:     "from",
+						Usage:    "First `HEIGHT` to export. Height 0 exports genesis as well",
+						Required: true,
+					},
+					&cli.Int64Flag{
+						Name:     "to",
+						Usage:    "Last `HEIGHT` to export, inclusive",
+						Required: true,
+					},
+					&cli.StringFlag{
+						Name:     "out",
+						Usage:    "Output archive `DIR`",
+						Required: true,
+					},
+					&cli.IntFlag{
+						Name:  "concurrency",
+						Value: 10,
+						Usage: "Number of heights to fetch in parallel",
+					},
+				},
+				Action: func(ctx *cli.Context) error {
+					config, err := loadConfig(ctx)
+					if err != nil {
+						return err
+					}
+					logger := newLogger(config)
+
+					return ExportArchive(
+						logger,
+						config,
+						ctx.Int64("from"),
+						ctx.Int64("to"),
+						ctx.String("out"),
+						ctx.Int("concurrency"),
+					)
+				},
+			},
+			{
+				Name:  "cache",
+				Usage: "Manage the on-disk block cache",
+				Subcommands: []*cli.Command{
+					{
+						Name: "warm",
+						Usage: "Fetch block and block_results of a height range into the block cache configured by " +
+							"`block_cache_dir`",
+						Flags: []cli.Flag{
+							&cli.Int64Flag{
+								Name:     "from-height",
+								Usage:    "First `HEIGHT` to fetch",
+								Required: true,
+							},
+							&cli.Int64Flag{
+								Name:     "to-height",
+								Usage:    "Last `HEIGHT` to fetch, inclusive",
+								Required: true,
+							},
+							&cli.IntFlag{
+								Name:  "concurrency",
+								Value: 10,
+								Usage: "Number of heights to fetch in parallel",
+							},
+						},
+						Action: func(ctx *cli.Context) error {
+							config, err := loadConfig(ctx)
+							if err != nil {
+								return err
+							}
+							logger := newLogger(config)
+
+							return WarmBlockCache(
+								logger,
+								config,
+								ctx.Int64("from-height"),
+								ctx.Int64("to-height"),
+								ctx.Int("concurrency"),
+							)
+						},
+					},
+				},
+			},
 		},
 	}
 
@@ -176,6 +396,66 @@ func CliApp(args []string) error {
 	return nil
 }
 
+func loadConfig(ctx *cli.Context) (*Config, error) {
+	// Prepare FileConfig
+	configPath := ctx.String("config")
+	configReader, configFileErr := toml.FromFile(configPath)
+	if configFileErr != nil {
+		return nil, configFileErr
+	}
+	var fileConfig FileConfig
+	readConfigErr := configReader.Read(&fileConfig)
+	if readConfigErr != nil {
+		return nil, readConfigErr
+	}
+
+	cliConfig := CLIConfig{
+		LogLevel: ctx.String("logLevel"),
+
+		DatabaseHost:     ctx.String("dbHost"),
+		DatabaseUsername: ctx.String("dbUsername"),
+		DatabasePassword: ctx.String("dbPassword"),
+		DatabaseName:     ctx.String("dbName"),
+		DatabaseSchema:   ctx.String("dbSchema"),
+
+		TendermintHTTPRPCUrl: ctx.String("tendermintURL"),
+		CosmosHTTPRPCUrl:     ctx.String("cosmosAppURL"),
+
+		EventPublisherNATSURL: ctx.String("eventPublisherNATSURL"),
+
+		WebhookSecretKey: ctx.String("webhookSecretKey"),
+	}
+	if ctx.IsSet("color") {
+		cliConfig.LoggerColor = primptr.Bool(ctx.Bool("color"))
+	}
+	if ctx.IsSet("dbSSL") {
+		cliConfig.DatabaseSSL = primptr.Bool(ctx.Bool("dbSSL"))
+	}
+	if ctx.IsSet("dgPort") {
+		cliConfig.DatabasePort = primptr.Int32(int32(ctx.Int("dbPort")))
+	}
+
+	config := Config{
+		fileConfig,
+	}
+	config.OverrideByCLIConfig(&cliConfig)
+
+	return &config, nil
+}
+
+func newLogger(config *Config) applogger.Logger {
+	logLevel := parseLogLevel(config.Logger.Level)
+	logOutput := os.Stdout
+	// Keep the standard output to the events when the event publisher writes to it
+	if config.EventPublisher.Enable && config.EventPublisher.Sink == EVENT_PUBLISHER_SINK_STDOUT {
+		logOutput = os.Stderr
+	}
+	logger := infrastructure.NewZerologLogger(logOutput)
+	logger.SetLogLevel(logLevel)
+
+	return logger
+}
+
 func parseLogLevel(level string) applogger.LogLevel {
 	switch level {
 	case "panic":
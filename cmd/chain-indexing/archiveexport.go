@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/crypto-com/chain-indexing/infrastructure/tendermint"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+)
+
+// ExportArchive fetches the raw block and block results responses of the height range from the
+// Tendermint node into an archive directory readable by `import`. Height 0 exports the genesis.
+func ExportArchive(
+	logger applogger.Logger,
+	config *Config,
+	fromHeight int64,
+	toHeight int64,
+	outDir string,
+	concurrency int,
+) error {
+	if fromHeight < 0 || toHeight < fromHeight {
+		return fmt.Errorf("invalid height range %d to %d", fromHeight, toHeight)
+	}
+
+	exportLogger := logger.WithFields(applogger.LogFields{
+		"module": "ArchiveExport",
+	})
+
+	client := NewTendermintHTTPClient(
+		config.Tendermint.HTTPRPCUrl, config.Tendermint.Insecure, config.Tendermint.StrictGenesisParsing,
+	)
+	writer, err := tendermint.NewArchiveWriter(outDir, fromHeight, toHeight)
+	if err != nil {
+		return err
+	}
+
+	blockFromHeight := fromHeight
+	if fromHeight == 0 {
+		genesis, genesisErr := client.RawResponse("genesis")
+		if genesisErr != nil {
+			return fmt.Errorf("error requesting genesis: %v", genesisErr)
+		}
+		if _, parseErr := tendermint.ParseGenesisResp(
+			bytes.NewReader(genesis), config.Tendermint.StrictGenesisParsing,
+		); parseErr != nil {
+			return parseErr
+		}
+		if writeErr := writer.WriteGenesis(genesis); writeErr != nil {
+			return writeErr
+		}
+		blockFromHeight = 1
+	}
+
+	if err = forEachHeight(blockFromHeight, toHeight, concurrency, func(height int64) error {
+		if exportErr := exportHeight(client, writer, height); exportErr != nil {
+			return fmt.Errorf("error exporting height %d: %v", height, exportErr)
+		}
+		if height%1000 == 0 {
+			exportLogger.Infof("exported up to around height %d", height)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err = writer.Close(); err != nil {
+		return err
+	}
+
+	exportLogger.Infof("exported height %d to %d into %s", fromHeight, toHeight, outDir)
+	return nil
+}
+
+// exportHeight writes the responses of the height to archive after making sure they are parsable
+func exportHeight(client *tendermint.HTTPClient, writer *tendermint.ArchiveWriter, height int64) error {
+	queryString := "height=" + strconv.FormatInt(height, 10)
+
+	block, err := client.RawResponse("block", queryString)
+	if err != nil {
+		return err
+	}
+	_, rawBlock, err := tendermint.ParseBlockResp(bytes.NewReader(block))
+	if err != nil {
+		return err
+	}
+
+	blockResults, err := client.RawResponse("block_results", queryString)
+	if err != nil {
+		return err
+	}
+	if _, err = tendermint.ParseBlockResultsResp(bytes.NewReader(blockResults)); err != nil {
+		return err
+	}
+
+	if err = writer.WriteBlock(height, block); err != nil {
+		return err
+	}
+	if err = writer.WriteBlockResults(height, blockResults); err != nil {
+		return err
+	}
+	writer.SetChainID(rawBlock.Block.Header.ChainID)
+
+	return nil
+}
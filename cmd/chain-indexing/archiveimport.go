@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	eventhandler_interface "github.com/crypto-com/chain-indexing/appinterface/eventhandler"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/tendermint"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	"github.com/crypto-com/chain-indexing/usecase/parser"
+	"github.com/crypto-com/chain-indexing/usecase/parser/utils"
+)
+
+// ImportArchive indexes the blocks in the archive from the last indexed height up to the highest
+// archived height, without connecting to a Tendermint node. In EVENT_STORE mode the events are
+// persisted to the event store and projections catch up when the indexing server starts. In
+// TENDERMINT_DIRECT mode every enabled projection handles the archived blocks directly.
+func ImportArchive(
+	ctx context.Context,
+	logger applogger.Logger,
+	rdbConn rdb.Conn,
+	config *Config,
+	archivePath string,
+) error {
+	importLogger := logger.WithFields(applogger.LogFields{
+		"module": "ArchiveImport",
+	})
+
+	archiveClient, err := tendermint.NewArchiveClient(archivePath, config.Tendermint.StrictGenesisParsing)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := archiveClient.Close(); closeErr != nil {
+			importLogger.Errorf("error cleaning up archive: %v", closeErr)
+		}
+	}()
+
+	if maybeManifest := archiveClient.Manifest(); maybeManifest != nil {
+		importLogger.Infof(
+			"verifying archive of chain %s from height %d to %d",
+			maybeManifest.ChainID, maybeManifest.FromHeight, maybeManifest.ToHeight,
+		)
+		if verifyErr := archiveClient.Verify(); verifyErr != nil {
+			return verifyErr
+		}
+	}
+
+	latestHeight, err := archiveClient.LatestBlockHeight()
+	if err != nil {
+		return err
+	}
+	importLogger.Infof("importing archive %s up to height %d", archivePath, latestHeight)
+
+	txDecoder := utils.NewTxDecoder()
+	msgParserRegistry := newMsgParserRegistry()
+	switch config.System.Mode {
+	case SYSTEM_MODE_EVENT_STORE:
+		eventRegistry := newEventRegistry()
+		eventStoreHandler := eventhandler_interface.NewRDbEventStoreHandler(logger, rdbConn, eventRegistry)
+
+		syncManager := newArchiveSyncManager(
+			logger, rdbConn, config, txDecoder, msgParserRegistry, archiveClient, EVENT_STORE_HANDLER_NAME, eventStoreHandler,
+		)
+		if syncErr := syncManager.SyncBlocks(ctx, latestHeight); syncErr != nil {
+			return fmt.Errorf("error importing archive to event store: %v", syncErr)
+		}
+	case SYSTEM_MODE_TENDERMINT_DIRECT:
+		for _, projection := range initProjections(logger, rdbConn, config) {
+			projectionLogger := logger.WithFields(applogger.LogFields{
+				"projection": projection.Id(),
+			})
+			syncManager := newArchiveSyncManager(
+				projectionLogger, rdbConn, config, txDecoder, msgParserRegistry, archiveClient, projection.Id(),
+				eventhandler_interface.NewProjectionHandler(projectionLogger, projection),
+			)
+			if syncErr := syncManager.SyncBlocks(ctx, latestHeight); syncErr != nil {
+				return fmt.Errorf("error importing archive to projection `%s`: %v", projection.Id(), syncErr)
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported system mode: %s", config.System.Mode)
+	}
+
+	importLogger.Infof("imported archive %s up to height %d", archivePath, latestHeight)
+	return nil
+}
+
+func newArchiveSyncManager(
+	logger applogger.Logger,
+	rdbConn rdb.Conn,
+	config *Config,
+	txDecoder *utils.TxDecoder,
+	msgParserRegistry *parser.MsgParserRegistry,
+	archiveClient *tendermint.ArchiveClient,
+	eventHandlerName string,
+	eventHandler eventhandler_interface.Handler,
+) *SyncManager {
+	return NewSyncManager(SyncManagerParams{
+		Logger:            logger,
+		RDbConn:           rdbConn,
+		TxDecoder:         txDecoder,
+		MsgParserRegistry: msgParserRegistry,
+		TendermintClient:  archiveClient,
+		EventHandlerName:  eventHandlerName,
+		Config: SyncManagerConfig{
+			WindowSize:           config.Sync.WindowSize,
+			SyncStrategy:         config.Sync.Strategy,
+			StrictGenesisParsing: config.Tendermint.StrictGenesisParsing,
+			AccountAddressPrefix: config.Blockchain.AccountAddressPrefix,
+			StakingDenom:         config.Blockchain.BondingDenom,
+			ContinuityCheck:      config.Sync.ContinuityCheck,
+			OnUndecodableTx:      config.Sync.OnUndecodableTx,
+		},
+	}, eventHandler)
+}
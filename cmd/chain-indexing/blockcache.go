@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+
+	tendermint_interface "github.com/crypto-com/chain-indexing/appinterface/tendermint"
+	"github.com/crypto-com/chain-indexing/infrastructure/tendermint"
+)
+
+const BYTES_PER_MB = 1024 * 1024
+
+// NewTendermintHTTPClient creates a Tendermint HTTP client with TLS verification optionally disabled
+func NewTendermintHTTPClient(
+	tendermintRPCUrl string,
+	insecure bool,
+	strictGenesisParsing bool,
+) *tendermint.HTTPClient {
+	if insecure {
+		return tendermint.NewInsecureHTTPClient(tendermintRPCUrl, strictGenesisParsing)
+	}
+	return tendermint.NewHTTPClient(tendermintRPCUrl, strictGenesisParsing)
+}
+
+// NewBlockCachedTendermintClient decorates the client with the on-disk block cache under cacheDir
+func NewBlockCachedTendermintClient(
+	logger applogger.Logger,
+	client tendermint_interface.Client,
+	cacheDir string,
+	maxSizeMB int64,
+) (*tendermint.CachedClient, error) {
+	return tendermint.NewCachedClient(logger, client, tendermint.CachedClientConfig{
+		Dir:          cacheDir,
+		MaxSizeBytes: maxSizeMB * BYTES_PER_MB,
+	})
+}
+
+// WarmBlockCache fetches block and block results of the height range into the block cache, with
+// `concurrency` heights being fetched in parallel.
+func WarmBlockCache(
+	logger applogger.Logger,
+	config *Config,
+	fromHeight int64,
+	toHeight int64,
+	concurrency int,
+) error {
+	if config.Tendermint.BlockCacheDir == "" {
+		return fmt.Errorf("block cache is not enabled, set `block_cache_dir` under [tendermint] in config")
+	}
+	if fromHeight < 1 || toHeight < fromHeight {
+		return fmt.Errorf("invalid height range %d to %d", fromHeight, toHeight)
+	}
+
+	warmLogger := logger.WithFields(applogger.LogFields{
+		"module": "BlockCacheWarm",
+	})
+
+	cachedClient, err := NewBlockCachedTendermintClient(
+		logger,
+		NewTendermintHTTPClient(
+			config.Tendermint.HTTPRPCUrl, config.Tendermint.Insecure, config.Tendermint.StrictGenesisParsing,
+		),
+		config.Tendermint.BlockCacheDir,
+		config.Tendermint.BlockCacheMaxSizeMB,
+	)
+	if err != nil {
+		return fmt.Errorf("error creating block cache: %v", err)
+	}
+
+	if err = forEachHeight(fromHeight, toHeight, concurrency, func(height int64) error {
+		if warmErr := cachedClient.Warm(height); warmErr != nil {
+			return fmt.Errorf("error warming block cache at height %d: %v", height, warmErr)
+		}
+		if height%1000 == 0 {
+			warmLogger.Infof("warmed block cache up to around height %d", height)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	warmLogger.Infof("warmed block cache from height %d to %d", fromHeight, toHeight)
+	return nil
+}
@@ -37,6 +37,12 @@ func (config *Config) OverrideByCLIConfig(cliConfig *CLIConfig) {
 	if cliConfig.CosmosHTTPRPCUrl != "" {
 		config.CosmosApp.HTTPRPCUrl = cliConfig.CosmosHTTPRPCUrl
 	}
+	if cliConfig.EventPublisherNATSURL != "" {
+		config.EventPublisher.NATSURL = cliConfig.EventPublisherNATSURL
+	}
+	if cliConfig.WebhookSecretKey != "" {
+		config.Webhook.SecretKey = cliConfig.WebhookSecretKey
+	}
 }
 
 type CLIConfig struct {
@@ -53,6 +59,10 @@ type CLIConfig struct {
 
 	TendermintHTTPRPCUrl string
 	CosmosHTTPRPCUrl     string
+
+	EventPublisherNATSURL string
+
+	WebhookSecretKey string
 }
 
 // FileConfig is the struct matches config.toml
@@ -63,11 +73,16 @@ type FileConfig struct {
 	Tendermint TendermintConfig
 	CosmosApp  CosmosAppConfig `toml:"cosmosapp"`
 	HTTP       HTTPConfig
+	Push       PushConfig
+	Webhook    WebhookConfig
 	Debug      DebugConfig
+	Metrics    MetricsConfig
 	Database   DatabaseConfig
 	Postgres   PostgresConfig
 	Logger     LoggerConfig
 	Projection ProjectionConfig
+
+	EventPublisher EventPublisherConfig `toml:"event_publisher"`
 }
 
 type BlockchainConfig struct {
@@ -85,7 +100,10 @@ type SystemConfig struct {
 }
 
 type SyncConfig struct {
-	WindowSize int `toml:"window_size"`
+	WindowSize      int    `toml:"window_size"`
+	Strategy        string `toml:"strategy"`
+	ContinuityCheck bool   `toml:"continuity_check"`
+	OnUndecodableTx string `toml:"on_undecodable_tx"`
 }
 
 type HTTPConfig struct {
@@ -94,6 +112,51 @@ type HTTPConfig struct {
 	CorsAllowedOrigins []string `toml:"cors_allowed_origins"`
 	CorsAllowedMethods []string `toml:"cors_allowed_methods"`
 	CorsAllowedHeaders []string `toml:"cors_allowed_headers"`
+	ShutdownTimeout    string   `toml:"shutdown_timeout"`
+	// Maximum depth and complexity of GraphQL queries. Default values are used when they are 0
+	GraphQLMaxDepth      int `toml:"graphql_max_depth"`
+	GraphQLMaxComplexity int `toml:"graphql_max_complexity"`
+}
+
+type PushConfig struct {
+	Enable bool `toml:"enable"`
+	// Default values are used when they are 0
+	MaxSubscriptions         int `toml:"max_subscriptions"`
+	MaxTopicsPerSubscription int `toml:"max_topics_per_subscription"`
+	BufferSize               int `toml:"buffer_size"`
+}
+
+type WebhookConfig struct {
+	// Run the dispatcher sending the deliveries enqueued by the Webhook projection
+	EnableDispatcher bool `toml:"enable_dispatcher"`
+	// Default values are used when they are empty or 0
+	PollInterval   string `toml:"poll_interval"`
+	BatchSize      uint64 `toml:"batch_size"`
+	MaxAttempts    int    `toml:"max_attempts"`
+	InitialBackoff string `toml:"initial_backoff"`
+	MaxBackoff     string `toml:"max_backoff"`
+	RequestTimeout string `toml:"request_timeout"`
+	// Base64-encoded 32 bytes key encrypting the watch secrets at rest. Prefer providing it by the
+	// CLI flag or ENV to keep it out of the config file.
+	SecretKey string `toml:"secret_key"`
+}
+
+type EventPublisherConfig struct {
+	Enable bool `toml:"enable"`
+	// Id of the cursor persisting the last published event. Use a new id to publish all the events
+	// to a new sink
+	Id string `toml:"id"`
+	// Sink of the events. Possible values: stdout, jsonl, nats
+	Sink              string `toml:"sink"`
+	JSONLFilePath     string `toml:"jsonl_file_path"`
+	NATSURL           string `toml:"nats_url"`
+	NATSSubjectPrefix string `toml:"nats_subject_prefix"`
+	// Default values are used when they are empty or 0
+	BatchSize    uint64 `toml:"batch_size"`
+	PollInterval string `toml:"poll_interval"`
+	// Skip the events exceeding the max size of the sink with an error log. The publisher stops
+	// with an error on such events when it is disabled.
+	SkipOversizedEvents bool `toml:"skip_oversized_events"`
 }
 
 type DebugConfig struct {
@@ -101,10 +164,19 @@ type DebugConfig struct {
 	PprofListeningAddress string `toml:"pprof_listening_address"`
 }
 
+type MetricsConfig struct {
+	Enable           bool   `toml:"enable"`
+	ListeningAddress string `toml:"listening_address"`
+}
+
 type TendermintConfig struct {
 	HTTPRPCUrl           string `toml:"http_rpc_url"`
 	Insecure             bool   `toml:"insecure"`
 	StrictGenesisParsing bool   `toml:"strict_genesis_parsing"`
+	BlockSubscription    string `toml:"block_subscription"`
+	WebSocketUrl         string `toml:"websocket_url"`
+	BlockCacheDir        string `toml:"block_cache_dir"`
+	BlockCacheMaxSizeMB  int64  `toml:"block_cache_max_size_mb"`
 }
 
 type CosmosAppConfig struct {
@@ -136,5 +208,6 @@ type LoggerConfig struct {
 }
 
 type ProjectionConfig struct {
-	Enables []string `toml:"enables"`
+	Enables         []string `toml:"enables"`
+	ReplayBatchSize int64    `toml:"replay_batch_size"`
 }
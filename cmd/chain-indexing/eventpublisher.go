@@ -0,0 +1,90 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	event_interface "github.com/crypto-com/chain-indexing/appinterface/event"
+	"github.com/crypto-com/chain-indexing/appinterface/eventpublisher"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/eventsink"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+)
+
+const DEFAULT_EVENT_PUBLISHER_ID = "default"
+
+const (
+	EVENT_PUBLISHER_SINK_STDOUT = "stdout"
+	EVENT_PUBLISHER_SINK_JSONL  = "jsonl"
+	EVENT_PUBLISHER_SINK_NATS   = "nats"
+)
+
+// NewEventPublisher creates the publisher of the stored events to the configured sink
+func NewEventPublisher(
+	logger applogger.Logger,
+	rdbConn rdb.Conn,
+	config *Config,
+) (*eventpublisher.Publisher, error) {
+	if config.System.Mode != SYSTEM_MODE_EVENT_STORE {
+		return nil, fmt.Errorf("event publisher requires system mode %s", SYSTEM_MODE_EVENT_STORE)
+	}
+
+	var publisherConfig eventpublisher.PublisherConfig
+	var err error
+	if publisherConfig.PollInterval, err = parseOptionalDuration(config.EventPublisher.PollInterval); err != nil {
+		return nil, fmt.Errorf("error parsing event_publisher poll_interval: %v", err)
+	}
+	publisherConfig.BatchSize = config.EventPublisher.BatchSize
+	publisherConfig.SkipOversizedEvents = config.EventPublisher.SkipOversizedEvents
+
+	sink, err := newEventPublisherSink(config)
+	if err != nil {
+		return nil, err
+	}
+
+	id := config.EventPublisher.Id
+	if id == "" {
+		id = DEFAULT_EVENT_PUBLISHER_ID
+	}
+
+	return eventpublisher.NewPublisher(
+		logger,
+		id,
+		event_interface.NewRDbStore(rdbConn.ToHandle(), newEventRegistry()),
+		eventpublisher.NewRDbCursorStore(rdbConn.ToHandle()),
+		sink,
+		publisherConfig,
+	), nil
+}
+
+func newEventPublisherSink(config *Config) (eventpublisher.Sink, error) {
+	switch config.EventPublisher.Sink {
+	case EVENT_PUBLISHER_SINK_STDOUT:
+		return eventsink.NewJSONLSink(os.Stdout), nil
+	case EVENT_PUBLISHER_SINK_JSONL:
+		if config.EventPublisher.JSONLFilePath == "" {
+			return nil, errors.New("event_publisher jsonl_file_path is required by jsonl sink")
+		}
+		sink, err := eventsink.NewJSONLFileSink(config.EventPublisher.JSONLFilePath)
+		if err != nil {
+			return nil, fmt.Errorf("error creating event publisher jsonl sink: %v", err)
+		}
+		return sink, nil
+	case EVENT_PUBLISHER_SINK_NATS:
+		sink, err := eventsink.NewNATSSink(eventsink.NATSSinkConfig{
+			URL:           config.EventPublisher.NATSURL,
+			SubjectPrefix: config.EventPublisher.NATSSubjectPrefix,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error creating event publisher nats sink: %v", err)
+		}
+		return sink, nil
+	default:
+		return nil, fmt.Errorf(
+			"unrecognized event publisher sink: %s, possible values are %s, %s, %s",
+			config.EventPublisher.Sink,
+			EVENT_PUBLISHER_SINK_STDOUT, EVENT_PUBLISHER_SINK_JSONL, EVENT_PUBLISHER_SINK_NATS,
+		)
+	}
+}
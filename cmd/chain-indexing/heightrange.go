@@ -0,0 +1,52 @@
+package main
+
+import "sync"
+
+// forEachHeight calls fn for every height from fromHeight to toHeight inclusively, with up to
+// `concurrency` heights being processed in parallel. Stops on the first error.
+func forEachHeight(fromHeight int64, toHeight int64, concurrency int, fn func(height int64) error) error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	heightCh := make(chan int64)
+	errCh := make(chan error, concurrency)
+	doneCh := make(chan struct{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i += 1 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for height := range heightCh {
+				if err := fn(height); err != nil {
+					errCh <- err
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(doneCh)
+	}()
+
+	for height := fromHeight; height <= toHeight; height += 1 {
+		select {
+		case heightCh <- height:
+		case err := <-errCh:
+			close(heightCh)
+			return err
+		}
+	}
+	close(heightCh)
+
+	<-doneCh
+	select {
+	case err := <-errCh:
+		return err
+	default:
+	}
+
+	return nil
+}
@@ -1,7 +1,9 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/lab259/cors"
 
@@ -12,15 +14,23 @@ import (
 	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
 	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/handlers"
 	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/routes"
+	"github.com/crypto-com/chain-indexing/infrastructure/push"
 	tendermint_infrastructure "github.com/crypto-com/chain-indexing/infrastructure/tendermint"
 	applogger "github.com/crypto-com/chain-indexing/internal/logger"
 )
 
+const (
+	DEFAULT_GRAPHQL_MAX_DEPTH      = 10
+	DEFAULT_GRAPHQL_MAX_COMPLEXITY = 10000
+)
+
 type HTTPAPIServer struct {
 	logger           applogger.Logger
 	rdbConn          rdb.Conn
 	cosmosAppClient  cosmosapp.Client
 	tendermintClient tendermint.Client
+	// Optional. Nil when push API is disabled
+	pushBroker *push.Broker
 
 	validatorAddressPrefix string
 	conNodeAddressPrefix   string
@@ -32,11 +42,21 @@ type HTTPAPIServer struct {
 	corsAllowedMethods []string
 	corsAllowedHeaders []string
 
+	graphqlMaxDepth      int
+	graphqlMaxComplexity int
+
 	pprof DebugConfig
+
+	metricsEnabled bool
 }
 
 // NewIndexService creates a new server instance for polling and indexing
-func NewHTTPAPIServer(logger applogger.Logger, rdbConn rdb.Conn, config *Config) *HTTPAPIServer {
+func NewHTTPAPIServer(
+	logger applogger.Logger,
+	rdbConn rdb.Conn,
+	config *Config,
+	pushBroker *push.Broker,
+) *HTTPAPIServer {
 	var cosmosClient cosmosapp.Client
 	if config.CosmosApp.Insecure {
 		cosmosClient = cosmosapp_infrastructure.NewInsecureHTTPClient(
@@ -67,6 +87,7 @@ func NewHTTPAPIServer(logger applogger.Logger, rdbConn rdb.Conn, config *Config)
 		rdbConn:          rdbConn,
 		cosmosAppClient:  cosmosClient,
 		tendermintClient: tendermintClient,
+		pushBroker:       pushBroker,
 
 		validatorAddressPrefix: config.Blockchain.ValidatorAddressPrefix,
 		conNodeAddressPrefix:   config.Blockchain.ConNodeAddressPrefix,
@@ -77,12 +98,18 @@ func NewHTTPAPIServer(logger applogger.Logger, rdbConn rdb.Conn, config *Config)
 		corsAllowedMethods: config.HTTP.CorsAllowedMethods,
 		corsAllowedHeaders: config.HTTP.CorsAllowedHeaders,
 
+		graphqlMaxDepth:      config.HTTP.GraphQLMaxDepth,
+		graphqlMaxComplexity: config.HTTP.GraphQLMaxComplexity,
+
 		pprof: config.Debug,
+
+		metricsEnabled: config.Metrics.Enable,
 	}
 }
 
-// Run function runs the polling server to index the data from Tendermint
-func (server *HTTPAPIServer) Run() error {
+// Run function runs the HTTP API server until the context is done and then waits at most
+// shutdownTimeout for in-flight requests to complete
+func (server *HTTPAPIServer) Run(ctx context.Context, shutdownTimeout time.Duration) error {
 	httpServer := httpapi.NewServer(
 		server.listeningAddress,
 	).WithLogger(
@@ -99,12 +126,16 @@ func (server *HTTPAPIServer) Run() error {
 		pprofServer = pprofServer.WithPprof(fixPath)
 		go func() {
 			server.logger.Infof("pprof server start listening on: %s%s", server.pprof.PprofListeningAddress, fixPath)
-			if err := pprofServer.ListenAndServe(); err != nil {
+			if err := pprofServer.ListenAndServeWithContext(ctx, shutdownTimeout); err != nil && ctx.Err() == nil {
 				panic(fmt.Errorf("error listening and serving HTTP pprof server: %w", err))
 			}
 		}()
 	}
 
+	if server.metricsEnabled {
+		httpServer = httpServer.WithMetrics()
+	}
+
 	if len(server.corsAllowedOrigins) != 0 {
 		httpServer = httpServer.WithCors(cors.Options{
 			AllowedOrigins: server.corsAllowedOrigins,
@@ -135,6 +166,10 @@ func (server *HTTPAPIServer) Run() error {
 		server.cosmosAppClient,
 		server.validatorAddressPrefix,
 	)
+	authzGrantsHandler := handlers.NewAuthzGrants(server.logger, server.rdbConn.ToHandle())
+	ethereumTransactionsHandler := handlers.NewEthereumTransactions(server.logger, server.rdbConn.ToHandle())
+	feeGrantsHandler := handlers.NewFeeGrants(server.logger, server.rdbConn.ToHandle())
+	ibcHandler := handlers.NewIBC(server.logger, server.rdbConn.ToHandle())
 	proposalsHandler := handlers.NewProposals(
 		server.logger,
 		server.rdbConn.ToHandle(),
@@ -145,6 +180,36 @@ func (server *HTTPAPIServer) Run() error {
 		server.rdbConn.ToHandle(),
 	)
 
+	graphqlMaxDepth := server.graphqlMaxDepth
+	if graphqlMaxDepth == 0 {
+		graphqlMaxDepth = DEFAULT_GRAPHQL_MAX_DEPTH
+	}
+	graphqlMaxComplexity := server.graphqlMaxComplexity
+	if graphqlMaxComplexity == 0 {
+		graphqlMaxComplexity = DEFAULT_GRAPHQL_MAX_COMPLEXITY
+	}
+	graphqlHandler, err := handlers.NewGraphQL(
+		server.logger,
+		server.rdbConn.ToHandle(),
+		server.validatorAddressPrefix,
+		server.conNodeAddressPrefix,
+		graphqlMaxDepth,
+		graphqlMaxComplexity,
+	)
+	if err != nil {
+		return fmt.Errorf("error creating GraphQL handler: %v", err)
+	}
+
+	var pushHandler *handlers.Push
+	if server.pushBroker != nil {
+		pushHandler = handlers.NewPush(server.logger, server.pushBroker)
+		// Ends the streams so that the server can shut down without waiting for them
+		go func() {
+			<-ctx.Done()
+			server.pushBroker.Close()
+		}()
+	}
+
 	routeRegistry := routes.NewRoutesRegistry(
 		searchHandler,
 		blocksHandler,
@@ -155,15 +220,22 @@ func (server *HTTPAPIServer) Run() error {
 		accountTransactionsHandler,
 		accountMessagesHandler,
 		accountsHandler,
+		authzGrantsHandler,
+		ethereumTransactionsHandler,
+		feeGrantsHandler,
+		ibcHandler,
 		proposalsHandler,
 		nftsHandler,
+		graphqlHandler,
+		pushHandler,
 	)
 	routeRegistry.Register(httpServer, server.routePrefix)
 
 	server.logger.Infof("server start listening on: %s", server.listeningAddress)
-	if err := httpServer.ListenAndServe(); err != nil {
+	if err := httpServer.ListenAndServeWithContext(ctx, shutdownTimeout); err != nil {
 		return fmt.Errorf("error listening and serving HTTP API server: %v", err)
 	}
 
+	server.logger.Info("server stopped")
 	return nil
 }
@@ -1,32 +1,44 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"sync"
 
 	"github.com/crypto-com/chain-indexing/usecase/parser/utils"
 
 	event_interface "github.com/crypto-com/chain-indexing/appinterface/event"
 	eventhandler_interface "github.com/crypto-com/chain-indexing/appinterface/eventhandler"
 	"github.com/crypto-com/chain-indexing/appinterface/rdb"
-	"github.com/crypto-com/chain-indexing/entity/event"
+	tendermint_interface "github.com/crypto-com/chain-indexing/appinterface/tendermint"
 	projection_entity "github.com/crypto-com/chain-indexing/entity/projection"
 	applogger "github.com/crypto-com/chain-indexing/internal/logger"
-	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
 )
 
+const EVENT_STORE_HANDLER_NAME = "EventStore"
+
 type IndexService struct {
 	logger      applogger.Logger
 	rdbConn     rdb.Conn
 	projections []projection_entity.Projection
+	hooks       []projection_entity.HandledEventsHook
 
 	systemMode               string
 	accountAddressPrefix     string
 	consNodeAddressPrefix    string
 	bondingDenom             string
 	windowSize               int
+	syncStrategy             string
+	continuityCheck          bool
+	onUndecodableTx          string
 	tendermintHTTPRPCURL     string
 	insecureTendermintClient bool
 	strictGenesisParsing     bool
+	blockSubscription        string
+	tendermintWebSocketURL   string
+	blockCacheDir            string
+	blockCacheMaxSizeMB      int64
+	replayBatchSize          int64
 }
 
 // NewIndexService creates a new server instance for polling and indexing
@@ -35,24 +47,36 @@ func NewIndexService(
 	rdbConn rdb.Conn,
 	config *Config,
 	projections []projection_entity.Projection,
+	hooks []projection_entity.HandledEventsHook,
 ) *IndexService {
 	return &IndexService{
 		logger:      logger,
 		rdbConn:     rdbConn,
 		projections: projections,
+		hooks:       hooks,
 
 		systemMode:               config.System.Mode,
 		consNodeAddressPrefix:    config.Blockchain.ConNodeAddressPrefix,
 		accountAddressPrefix:     config.Blockchain.AccountAddressPrefix,
 		bondingDenom:             config.Blockchain.BondingDenom,
 		windowSize:               config.Sync.WindowSize,
+		syncStrategy:             config.Sync.Strategy,
+		continuityCheck:          config.Sync.ContinuityCheck,
+		onUndecodableTx:          config.Sync.OnUndecodableTx,
 		tendermintHTTPRPCURL:     config.Tendermint.HTTPRPCUrl,
 		insecureTendermintClient: config.Tendermint.Insecure,
 		strictGenesisParsing:     config.Tendermint.StrictGenesisParsing,
+		blockSubscription:        config.Tendermint.BlockSubscription,
+		tendermintWebSocketURL:   config.Tendermint.WebSocketUrl,
+		blockCacheDir:            config.Tendermint.BlockCacheDir,
+		blockCacheMaxSizeMB:      config.Tendermint.BlockCacheMaxSizeMB,
+		replayBatchSize:          config.Projection.ReplayBatchSize,
 	}
 }
 
-func (service *IndexService) Run() error {
+// Run indexes the chain until the context is done. It returns after all in-flight heights are
+// handled.
+func (service *IndexService) Run(ctx context.Context) error {
 	// run polling tendermint manager, update view tables directly
 	infoManager := NewInfoManager(
 		service.logger,
@@ -61,31 +85,47 @@ func (service *IndexService) Run() error {
 		service.insecureTendermintClient,
 		service.strictGenesisParsing,
 	)
-	infoManager.Run()
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		infoManager.Run(ctx)
+	}()
+	defer wg.Wait()
 
 	switch service.systemMode {
 	case SYSTEM_MODE_EVENT_STORE:
-		return service.RunEventStoreMode()
+		return service.RunEventStoreMode(ctx)
 	case SYSTEM_MODE_TENDERMINT_DIRECT:
-		return service.RunTendermintDirectMode()
+		return service.RunTendermintDirectMode(ctx)
 	default:
 		return fmt.Errorf("unsupported system mode: %s", service.systemMode)
 	}
 }
 
-func (service *IndexService) RunEventStoreMode() error {
-	eventRegistry := event.NewRegistry()
-	event_usecase.RegisterEvents(eventRegistry)
+func (service *IndexService) RunEventStoreMode(ctx context.Context) error {
+	eventRegistry := newEventRegistry()
 	eventStore := event_interface.NewRDbStore(service.rdbConn.ToHandle(), eventRegistry)
 
-	projectionManager := projection_entity.NewStoreBasedManager(service.logger, eventStore)
+	projectionManager := projection_entity.NewStoreBasedManagerWithBatchSize(
+		service.logger, eventStore, service.replayBatchSize,
+	)
 
+	for _, hook := range service.hooks {
+		projectionManager.AddHandledEventsHook(hook)
+	}
 	for _, projection := range service.projections {
 		if err := projectionManager.RegisterProjection(projection); err != nil {
 			return fmt.Errorf("error registering projection `%s` to manager %v", projection.Id(), err)
 		}
 	}
-	projectionManager.RunInBackground()
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		projectionManager.Run(ctx)
+	}()
+	defer wg.Wait()
 
 	eventStoreHandler := eventhandler_interface.NewRDbEventStoreHandler(
 		service.logger,
@@ -93,52 +133,109 @@ func (service *IndexService) RunEventStoreMode() error {
 		eventRegistry,
 	)
 	txDecoder := utils.NewTxDecoder()
+	msgParserRegistry := newMsgParserRegistry()
+	tendermintClient, err := service.newTendermintClient()
+	if err != nil {
+		return err
+	}
 	syncManager := NewSyncManager(
 		SyncManagerParams{
-			Logger:    service.logger,
-			RDbConn:   service.rdbConn,
-			TxDecoder: txDecoder,
+			Logger:            service.logger,
+			RDbConn:           service.rdbConn,
+			TxDecoder:         txDecoder,
+			MsgParserRegistry: msgParserRegistry,
+			TendermintClient:  tendermintClient,
+			EventHandlerName:  EVENT_STORE_HANDLER_NAME,
 			Config: SyncManagerConfig{
 				WindowSize:               service.windowSize,
+				SyncStrategy:             service.syncStrategy,
 				TendermintRPCUrl:         service.tendermintHTTPRPCURL,
 				InsecureTendermintClient: service.insecureTendermintClient,
 				StrictGenesisParsing:     service.strictGenesisParsing,
 				AccountAddressPrefix:     service.accountAddressPrefix,
 				StakingDenom:             service.bondingDenom,
+				ContinuityCheck:          service.continuityCheck,
+				OnUndecodableTx:          service.onUndecodableTx,
+				BlockSubscription:        service.blockSubscription,
+				TendermintWebSocketUrl:   service.tendermintWebSocketURL,
 			},
 		},
 		eventStoreHandler,
 	)
-	if err := syncManager.Run(); err != nil {
+	if err := syncManager.Run(ctx); err != nil {
 		return fmt.Errorf("error running sync manager %v", err)
 	}
 
 	return nil
 }
 
-func (service *IndexService) RunTendermintDirectMode() error {
+func (service *IndexService) RunTendermintDirectMode(ctx context.Context) error {
 	txDecoder := utils.NewTxDecoder()
+	msgParserRegistry := newMsgParserRegistry()
+	// Share the client among projections so that the block cache is hit after the first fetch
+	tendermintClient, err := service.newTendermintClient()
+	if err != nil {
+		return err
+	}
 
+	var wg sync.WaitGroup
 	for i := range service.projections {
+		wg.Add(1)
 		go func(projection projection_entity.Projection) {
+			defer wg.Done()
+			projectionHandler := eventhandler_interface.NewProjectionHandler(service.logger, projection)
+			for _, hook := range service.hooks {
+				projectionHandler.AddHandledEventsHook(hook)
+			}
 			syncManager := NewSyncManager(SyncManagerParams{
 				Logger: service.logger.WithFields(applogger.LogFields{
 					"projection": projection.Id(),
 				}),
-				RDbConn:   service.rdbConn,
-				TxDecoder: txDecoder,
+				RDbConn:           service.rdbConn,
+				TxDecoder:         txDecoder,
+				MsgParserRegistry: msgParserRegistry,
+				TendermintClient:  tendermintClient,
+				EventHandlerName:  projection.Id(),
 				Config: SyncManagerConfig{
 					WindowSize:               service.windowSize,
+					SyncStrategy:             service.syncStrategy,
 					TendermintRPCUrl:         service.tendermintHTTPRPCURL,
 					InsecureTendermintClient: service.insecureTendermintClient,
 					AccountAddressPrefix:     service.accountAddressPrefix,
 					StakingDenom:             service.bondingDenom,
+					ContinuityCheck:          service.continuityCheck,
+					OnUndecodableTx:          service.onUndecodableTx,
+					BlockSubscription:        service.blockSubscription,
+					TendermintWebSocketUrl:   service.tendermintWebSocketURL,
 				},
-			}, eventhandler_interface.NewProjectionHandler(service.logger, projection))
-			if err := syncManager.Run(); err != nil {
+			}, projectionHandler)
+			if err := syncManager.Run(ctx); err != nil {
 				panic(fmt.Sprintf("error running sync manager %v", err))
 			}
 		}(service.projections[i])
 	}
-	select {}
+	wg.Wait()
+
+	return nil
+}
+
+// newTendermintClient creates the Tendermint client used by sync managers. Block and block results
+// are cached on disk when the block cache is enabled.
+func (service *IndexService) newTendermintClient() (tendermint_interface.Client, error) {
+	httpClient := NewTendermintHTTPClient(
+		service.tendermintHTTPRPCURL,
+		service.insecureTendermintClient,
+		service.strictGenesisParsing,
+	)
+	if service.blockCacheDir == "" {
+		return httpClient, nil
+	}
+
+	cachedClient, err := NewBlockCachedTendermintClient(
+		service.logger, httpClient, service.blockCacheDir, service.blockCacheMaxSizeMB,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating block cache: %v", err)
+	}
+	return cachedClient, nil
 }
@@ -1,10 +1,13 @@
 package main
 
 import (
+	"context"
+	"strconv"
 	"time"
 
 	"github.com/crypto-com/chain-indexing/appinterface/polling"
 	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/metrics"
 	"github.com/crypto-com/chain-indexing/infrastructure/tendermint"
 	applogger "github.com/crypto-com/chain-indexing/internal/logger"
 )
@@ -52,28 +55,36 @@ func NewInfoManager(
 
 }
 
-func (manager *InfoManager) Run() {
+// Run polls the Tendermint status until the context is done
+func (manager *InfoManager) Run(ctx context.Context) {
 	manager.logger.Infof("InfoManager started")
-	go func() {
-		for {
-			status, err := manager.client.Status()
-			if err != nil {
-				manager.logger.Errorf("error querying Tendermint status: %v", err)
-				time.Sleep(manager.pollingInterval)
-				continue
-			}
-			result := (*status)["result"]
-			syncInfo := result.(map[string]interface{})["sync_info"]
-			latestHeight := syncInfo.(map[string]interface{})["latest_block_height"].(string)
+	for {
+		manager.updateLatestHeight()
 
-			err = manager.viewStatus.Upsert("LatestHeight", latestHeight)
-			if err != nil {
-				manager.logger.Errorf("error upserting latest height: %v", err)
-				time.Sleep(manager.pollingInterval)
-				continue
-			}
-
-			time.Sleep(manager.pollingInterval)
+		select {
+		case <-ctx.Done():
+			manager.logger.Infof("InfoManager stopped")
+			return
+		case <-time.After(manager.pollingInterval):
 		}
-	}()
+	}
+}
+
+func (manager *InfoManager) updateLatestHeight() {
+	status, err := manager.client.Status()
+	if err != nil {
+		manager.logger.Errorf("error querying Tendermint status: %v", err)
+		return
+	}
+	result := (*status)["result"]
+	syncInfo := result.(map[string]interface{})["sync_info"]
+	latestHeight := syncInfo.(map[string]interface{})["latest_block_height"].(string)
+	if parsedLatestHeight, parseErr := strconv.ParseInt(latestHeight, 10, 64); parseErr == nil {
+		metrics.ChainLatestHeight.Set(float64(parsedLatestHeight))
+	}
+
+	err = manager.viewStatus.Upsert("LatestHeight", latestHeight)
+	if err != nil {
+		manager.logger.Errorf("error upserting latest height: %v", err)
+	}
 }
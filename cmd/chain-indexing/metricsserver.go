@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	projection_entity "github.com/crypto-com/chain-indexing/entity/projection"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
+	"github.com/crypto-com/chain-indexing/infrastructure/metrics"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+)
+
+const METRICS_PATH = "/metrics"
+
+// RunMetricsServer serves Prometheus metrics including the last handled height of projections until
+// the context is done
+func RunMetricsServer(
+	ctx context.Context,
+	shutdownTimeout time.Duration,
+	logger applogger.Logger,
+	config *Config,
+	projections []projection_entity.Projection,
+) error {
+	if err := metrics.Registry.Register(metrics.NewProjectionHeightCollector(projections)); err != nil {
+		return fmt.Errorf("error registering projection metrics: %v", err)
+	}
+
+	metricsServer := httpapi.NewServer(
+		config.Metrics.ListeningAddress,
+	).GET(METRICS_PATH, metrics.Handler())
+
+	logger.Infof("metrics server start listening on: %s%s", config.Metrics.ListeningAddress, METRICS_PATH)
+	if err := metricsServer.ListenAndServeWithContext(ctx, shutdownTimeout); err != nil {
+		return fmt.Errorf("error listening and serving metrics server: %v", err)
+	}
+
+	return nil
+}
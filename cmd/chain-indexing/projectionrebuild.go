@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+
+	event_interface "github.com/crypto-com/chain-indexing/appinterface/event"
+	"github.com/crypto-com/chain-indexing/appinterface/projection/rdbprojectionbase"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	projection_entity "github.com/crypto-com/chain-indexing/entity/projection"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	"github.com/crypto-com/chain-indexing/projection"
+)
+
+// RebuildProjection truncates the view tables of the projection and replays the events from the
+// event store up to the latest indexed height. Other projections are not affected and can keep
+// running in the indexing server, but the rebuilding projection must not be enabled there until
+// the rebuild completes, otherwise both processes would write to the same tables.
+func RebuildProjection(
+	logger applogger.Logger,
+	rdbConn rdb.Conn,
+	config *Config,
+	projectionName string,
+	fromHeight int64,
+) error {
+	if config.System.Mode != SYSTEM_MODE_EVENT_STORE {
+		return fmt.Errorf("projection rebuild is only supported in %s mode", SYSTEM_MODE_EVENT_STORE)
+	}
+
+	targetProjection := projection.InitProjection(
+		projectionName, newProjectionInitParams(logger, rdbConn, config),
+	)
+	rebuildableProjection, ok := targetProjection.(rdbprojectionbase.Rebuildable)
+	if !ok {
+		return fmt.Errorf("projection `%s` does not support rebuild", targetProjection.Id())
+	}
+
+	rebuildLogger := logger.WithFields(applogger.LogFields{
+		"module":     "ProjectionRebuild",
+		"projection": targetProjection.Id(),
+	})
+
+	if err := rdbprojectionbase.Truncate(rdbConn, rebuildableProjection, fromHeight); err != nil {
+		return fmt.Errorf("error truncating projection `%s`: %v", targetProjection.Id(), err)
+	}
+	rebuildLogger.Infof(
+		"truncated view tables %v, replaying events from height %d",
+		rebuildableProjection.GetViewTables(), fromHeight,
+	)
+
+	if err := targetProjection.OnInit(); err != nil {
+		return fmt.Errorf("error initializing projection `%s`: %v", targetProjection.Id(), err)
+	}
+
+	eventRegistry := newEventRegistry()
+	eventStore := event_interface.NewRDbStore(rdbConn.ToHandle(), eventRegistry)
+
+	projectionManager := projection_entity.NewStoreBasedManagerWithBatchSize(
+		logger, eventStore, config.Projection.ReplayBatchSize,
+	)
+	maybeLastHandledEventHeight, err := projectionManager.CatchUp(targetProjection)
+	if err != nil {
+		return fmt.Errorf("error replaying events to projection `%s`: %v", targetProjection.Id(), err)
+	}
+
+	if maybeLastHandledEventHeight == nil {
+		rebuildLogger.Infof("no event to replay, projection rebuild completed")
+	} else {
+		rebuildLogger.Infof(
+			"projection rebuild completed up to height %d, enable the projection in the indexing server to keep it updated",
+			*maybeLastHandledEventHeight,
+		)
+	}
+
+	return nil
+}
@@ -17,26 +17,8 @@ func initProjections(
 	rdbConn rdb.Conn,
 	config *Config,
 ) []projection_entity.Projection {
-	var cosmosAppClient cosmosapp.Client
-	if config.CosmosApp.Insecure {
-		cosmosAppClient = cosmosapp_infrastructure.NewInsecureHTTPClient(
-			config.CosmosApp.HTTPRPCUrl, config.Blockchain.BondingDenom,
-		)
-	} else {
-		cosmosAppClient = cosmosapp_infrastructure.NewHTTPClient(
-			config.CosmosApp.HTTPRPCUrl, config.Blockchain.BondingDenom,
-		)
-	}
-
 	projections := make([]projection_entity.Projection, 0, len(config.Projection.Enables))
-	initParams := projection.InitParams{
-		Logger:  logger,
-		RdbConn: rdbConn,
-
-		CosmosAppClient:       cosmosAppClient,
-		AccountAddressPrefix:  config.Blockchain.AccountAddressPrefix,
-		ConsNodeAddressPrefix: config.Blockchain.ConNodeAddressPrefix,
-	}
+	initParams := newProjectionInitParams(logger, rdbConn, config)
 	for _, projectionName := range config.Projection.Enables {
 		projection := projection.InitProjection(
 			projectionName, initParams,
@@ -55,3 +37,29 @@ func initProjections(
 
 	return projections
 }
+
+func newProjectionInitParams(
+	logger applogger.Logger,
+	rdbConn rdb.Conn,
+	config *Config,
+) projection.InitParams {
+	var cosmosAppClient cosmosapp.Client
+	if config.CosmosApp.Insecure {
+		cosmosAppClient = cosmosapp_infrastructure.NewInsecureHTTPClient(
+			config.CosmosApp.HTTPRPCUrl, config.Blockchain.BondingDenom,
+		)
+	} else {
+		cosmosAppClient = cosmosapp_infrastructure.NewHTTPClient(
+			config.CosmosApp.HTTPRPCUrl, config.Blockchain.BondingDenom,
+		)
+	}
+
+	return projection.InitParams{
+		Logger:  logger,
+		RdbConn: rdbConn,
+
+		CosmosAppClient:       cosmosAppClient,
+		AccountAddressPrefix:  config.Blockchain.AccountAddressPrefix,
+		ConsNodeAddressPrefix: config.Blockchain.ConNodeAddressPrefix,
+	}
+}
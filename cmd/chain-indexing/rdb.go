@@ -4,12 +4,11 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/crypto-com/chain-indexing/appinterface/rdb"
 	"github.com/crypto-com/chain-indexing/infrastructure/pg"
 	applogger "github.com/crypto-com/chain-indexing/internal/logger"
 )
 
-func SetupRDbConn(config *Config, logger applogger.Logger) (rdb.Conn, error) {
+func SetupRDbConn(config *Config, logger applogger.Logger) (*pg.PgxConn, error) {
 	var pgxConnPool *pg.PgxConn
 	var err error
 
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"github.com/crypto-com/chain-indexing/entity/event"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/parser"
+)
+
+// newMsgParserRegistry creates the registry of transaction message parsers by message type URL.
+// Parsers of custom modules should be registered here, with their events registered in
+// newEventRegistry.
+func newMsgParserRegistry() *parser.MsgParserRegistry {
+	registry := parser.NewMsgParserRegistry()
+	parser.RegisterBuiltinMsgParsers(registry)
+
+	return registry
+}
+
+// newEventRegistry creates the registry of event decoders used by the event store
+func newEventRegistry() *event.Registry {
+	registry := event.NewRegistry()
+	event_usecase.RegisterEvents(registry)
+
+	return registry
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+
+	eventhandler_interface "github.com/crypto-com/chain-indexing/appinterface/eventhandler"
+	"github.com/crypto-com/chain-indexing/appinterface/projection/rdbprojectionbase"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	"github.com/crypto-com/chain-indexing/projection"
+)
+
+// RollbackEventStore deletes the events after toHeight from the event store. The enabled
+// projections which have handled the deleted events are truncated in the same transaction and
+// replay all the events when the indexing server starts again. The block cache is invalidated
+// after toHeight so that the heights are fetched from the node again. The indexing server must not
+// be running during rollback, otherwise its projections keep handling the deleted events.
+func RollbackEventStore(
+	logger applogger.Logger,
+	rdbConn rdb.Conn,
+	config *Config,
+	toHeight int64,
+) error {
+	if config.System.Mode != SYSTEM_MODE_EVENT_STORE {
+		return fmt.Errorf("rollback is only supported in %s mode", SYSTEM_MODE_EVENT_STORE)
+	}
+	if toHeight < 0 {
+		return fmt.Errorf("invalid to height: %d", toHeight)
+	}
+
+	rollbackLogger := logger.WithFields(applogger.LogFields{
+		"module":   "Rollback",
+		"toHeight": toHeight,
+	})
+
+	rebuildableProjections := make(map[string]rdbprojectionbase.Rebuildable)
+	initParams := newProjectionInitParams(logger, rdbConn, config)
+	for _, projectionName := range config.Projection.Enables {
+		targetProjection := projection.InitProjection(projectionName, initParams)
+		if rebuildableProjection, ok := targetProjection.(rdbprojectionbase.Rebuildable); ok {
+			rebuildableProjections[targetProjection.Id()] = rebuildableProjection
+		}
+	}
+
+	eventStoreHandler := eventhandler_interface.NewRDbEventStoreHandler(logger, rdbConn, newEventRegistry())
+	projectionStore := rdbprojectionbase.NewStore(rdbprojectionbase.DEFAULT_TABLE)
+
+	rdbTx, err := rdbConn.Begin()
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %v", err)
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = rdbTx.Rollback()
+		}
+	}()
+	rdbTxHandle := rdbTx.ToHandle()
+
+	projectionIds, err := projectionStore.FindAllIdsHandledAfterHeight(rdbTxHandle, toHeight)
+	if err != nil {
+		return fmt.Errorf("error finding projections handled events after height %d: %v", toHeight, err)
+	}
+	for _, projectionId := range projectionIds {
+		rebuildableProjection, ok := rebuildableProjections[projectionId]
+		if !ok {
+			return fmt.Errorf(
+				"projection `%s` has handled events after height %d but is not enabled or does not support rebuild",
+				projectionId, toHeight,
+			)
+		}
+		if err = rdbprojectionbase.TruncateWithRDbHandle(rdbTxHandle, rebuildableProjection, 0); err != nil {
+			return fmt.Errorf("error truncating projection `%s`: %v", projectionId, err)
+		}
+	}
+
+	deletedCount, err := eventStoreHandler.RollbackToHeightWithRDbHandle(rdbTxHandle, toHeight)
+	if err != nil {
+		return err
+	}
+
+	if err = rdbTx.Commit(); err != nil {
+		return fmt.Errorf("error committing rollback: %v", err)
+	}
+	committed = true
+
+	rollbackLogger.WithFields(applogger.LogFields{
+		"deletedCount":           deletedCount,
+		"truncatedProjectionIds": projectionIds,
+	}).Info("rolled back event store, truncated projections replay all events on next start")
+
+	if config.Tendermint.BlockCacheDir != "" {
+		cachedClient, err := NewBlockCachedTendermintClient(
+			logger,
+			NewTendermintHTTPClient(
+				config.Tendermint.HTTPRPCUrl, config.Tendermint.Insecure, config.Tendermint.StrictGenesisParsing,
+			),
+			config.Tendermint.BlockCacheDir,
+			config.Tendermint.BlockCacheMaxSizeMB,
+		)
+		if err != nil {
+			return fmt.Errorf("error creating block cache: %v", err)
+		}
+		invalidatedCount, err := cachedClient.InvalidateFromHeight(toHeight + 1)
+		if err != nil {
+			return fmt.Errorf("error invalidating block cache after height %d: %v", toHeight, err)
+		}
+		rollbackLogger.Infof("invalidated %d cached responses after height %d", invalidatedCount, toHeight)
+	}
+
+	return nil
+}
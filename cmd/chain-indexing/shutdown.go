@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+)
+
+const DEFAULT_SHUTDOWN_TIMEOUT = 30 * time.Second
+
+// newShutdownContext returns a context which is done on SIGINT or SIGTERM. A second signal exits
+// the process immediately.
+func newShutdownContext(logger applogger.Logger) context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	signalCh := make(chan os.Signal, 2)
+	signal.Notify(signalCh, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		receivedSignal := <-signalCh
+		logger.Infof("received %v, shutting down gracefully. Send again to exit immediately", receivedSignal)
+		cancel()
+
+		<-signalCh
+		logger.Error("exiting immediately")
+		os.Exit(1)
+	}()
+
+	return ctx
+}
+
+func parseShutdownTimeout(shutdownTimeout string) (time.Duration, error) {
+	if shutdownTimeout == "" {
+		return DEFAULT_SHUTDOWN_TIMEOUT, nil
+	}
+
+	duration, err := time.ParseDuration(shutdownTimeout)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing ShutdownTimeout string to duration %v", err)
+	}
+	return duration, nil
+}
+
+// runUntilShutdown runs the service in background and tracks it with the wait group. Errors before
+// shutdown are fatal while errors during shutdown are logged.
+func runUntilShutdown(ctx context.Context, logger applogger.Logger, wg *sync.WaitGroup, run func() error) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		if err := run(); err != nil {
+			if ctx.Err() == nil {
+				logger.Panicf("%v", err)
+			}
+			logger.Errorf("error shutting down: %v", err)
+		}
+	}()
+}
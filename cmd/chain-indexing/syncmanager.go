@@ -1,17 +1,25 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/crypto-com/chain-indexing/appinterface/polling"
+	block_view "github.com/crypto-com/chain-indexing/projection/block/view"
+	"github.com/crypto-com/chain-indexing/usecase/continuity"
+	jsoniter "github.com/json-iterator/go"
+
 	"github.com/crypto-com/chain-indexing/usecase/parser/utils"
 
 	eventhandler_interface "github.com/crypto-com/chain-indexing/appinterface/eventhandler"
 	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	tendermint_interface "github.com/crypto-com/chain-indexing/appinterface/tendermint"
 	command_entity "github.com/crypto-com/chain-indexing/entity/command"
 	"github.com/crypto-com/chain-indexing/entity/event"
 	chainfeed "github.com/crypto-com/chain-indexing/infrastructure/feed/chain"
-	"github.com/crypto-com/chain-indexing/infrastructure/tendermint"
+	"github.com/crypto-com/chain-indexing/infrastructure/metrics"
 	applogger "github.com/crypto-com/chain-indexing/internal/logger"
 	"github.com/crypto-com/chain-indexing/usecase/parser"
 	"github.com/crypto-com/chain-indexing/usecase/syncstrategy"
@@ -19,42 +27,81 @@ import (
 
 const DEFAULT_POLLING_INTERVAL = 5 * time.Second
 
+const SYNC_STRATEGY_WINDOW = "window"
+const SYNC_STRATEGY_STREAMING = "streaming"
+
+const BLOCK_SUBSCRIPTION_POLLING = "polling"
+const BLOCK_SUBSCRIPTION_WEBSOCKET = "websocket"
+
 type SyncManager struct {
 	rdbConn              rdb.Conn
-	client               *tendermint.HTTPClient
+	client               tendermint_interface.Client
 	logger               applogger.Logger
 	pollingInterval      time.Duration
 	strictGenesisParsing bool
 
+	blockSubscription        string
+	tendermintWebSocketURL   string
+	insecureTendermintClient bool
+
 	accountAddressPrefix string
 	stakingDenom         string
 
-	txDecoder          *utils.TxDecoder
-	windowSyncStrategy *syncstrategy.Window
+	txDecoder         *utils.TxDecoder
+	msgParserRegistry *parser.MsgParserRegistry
+	syncStrategy      syncstrategy.Strategy
+
+	eventHandler     eventhandler_interface.Handler
+	eventHandlerName string
+
+	maybeContinuityVerifier *continuity.Verifier
+	viewStatus              *polling.Status
 
-	eventHandler eventhandler_interface.Handler
+	undecodableTxPolicy string
 
 	// SyncManager state
 	latestBlockHeight *int64
 	shouldSyncCh      chan bool
+
+	maybeDivergence     *continuity.DivergenceError
+	isDivergenceVisible bool
 }
 
 type SyncManagerParams struct {
 	Logger    applogger.Logger
 	RDbConn   rdb.Conn
 	TxDecoder *utils.TxDecoder
+	// Optional registry of message parsers. Only the built-in message parsers are used when nil
+	MsgParserRegistry *parser.MsgParserRegistry
+	// Optional Tendermint client shared between sync managers. Created from config when nil
+	TendermintClient tendermint_interface.Client
+	// Name of the event handler reported in metrics, e.g. the projection id
+	EventHandlerName string
 
 	Config SyncManagerConfig
 }
 
 type SyncManagerConfig struct {
-	WindowSize               int
+	WindowSize int
+	// SyncStrategy is either SYNC_STRATEGY_WINDOW or SYNC_STRATEGY_STREAMING
+	SyncStrategy             string
 	TendermintRPCUrl         string
 	InsecureTendermintClient bool
 	StrictGenesisParsing     bool
 
 	AccountAddressPrefix string
 	StakingDenom         string
+
+	ContinuityCheck bool
+
+	// OnUndecodableTx is either parser.UNDECODABLE_TX_POLICY_RECORD (default) or
+	// parser.UNDECODABLE_TX_POLICY_PANIC
+	OnUndecodableTx string
+
+	// BlockSubscription is either BLOCK_SUBSCRIPTION_POLLING or BLOCK_SUBSCRIPTION_WEBSOCKET
+	BlockSubscription string
+	// TendermintWebSocketUrl is derived from TendermintRPCUrl when empty
+	TendermintWebSocketUrl string
 }
 
 // NewSyncManager creates a new feed with polling for latest block starts at a specific height
@@ -62,19 +109,56 @@ func NewSyncManager(
 	params SyncManagerParams,
 	eventHandler eventhandler_interface.Handler,
 ) *SyncManager {
-	var tendermintClient *tendermint.HTTPClient
-	if params.Config.InsecureTendermintClient {
-		tendermintClient = tendermint.NewInsecureHTTPClient(
-			params.Config.TendermintRPCUrl,
-			params.Config.StrictGenesisParsing,
-		)
-	} else {
-		tendermintClient = tendermint.NewHTTPClient(
+	tendermintClient := params.TendermintClient
+	if tendermintClient == nil {
+		tendermintClient = NewTendermintHTTPClient(
 			params.Config.TendermintRPCUrl,
+			params.Config.InsecureTendermintClient,
 			params.Config.StrictGenesisParsing,
 		)
 	}
 
+	var syncStrategy syncstrategy.Strategy
+	switch params.Config.SyncStrategy {
+	case "", SYNC_STRATEGY_WINDOW:
+		syncStrategy = syncstrategy.NewWindow(params.Logger, params.Config.WindowSize)
+	case SYNC_STRATEGY_STREAMING:
+		syncStrategy = syncstrategy.NewStreaming(params.Logger, syncstrategy.StreamingConfig{
+			Size: params.Config.WindowSize,
+		})
+	default:
+		params.Logger.Panicf("unsupported sync strategy: %s", params.Config.SyncStrategy)
+	}
+
+	msgParserRegistry := params.MsgParserRegistry
+	if msgParserRegistry == nil {
+		msgParserRegistry = parser.NewMsgParserRegistry()
+		parser.RegisterBuiltinMsgParsers(msgParserRegistry)
+	}
+
+	var maybeContinuityVerifier *continuity.Verifier
+	if params.Config.ContinuityCheck {
+		maybeContinuityVerifier = continuity.NewVerifier()
+	}
+	blockSubscription := params.Config.BlockSubscription
+	if blockSubscription == "" {
+		blockSubscription = BLOCK_SUBSCRIPTION_POLLING
+	}
+	tendermintWebSocketURL := params.Config.TendermintWebSocketUrl
+	if tendermintWebSocketURL == "" {
+		tendermintWebSocketURL = chainfeed.WebSocketURLFromHTTPRPCUrl(params.Config.TendermintRPCUrl)
+	}
+
+	var undecodableTxPolicy string
+	switch params.Config.OnUndecodableTx {
+	case parser.UNDECODABLE_TX_POLICY_PANIC:
+		undecodableTxPolicy = parser.UNDECODABLE_TX_POLICY_PANIC
+	case "", parser.UNDECODABLE_TX_POLICY_RECORD:
+		undecodableTxPolicy = parser.UNDECODABLE_TX_POLICY_RECORD
+	default:
+		params.Logger.Panicf("unsupported undecodable transaction policy: %s", params.Config.OnUndecodableTx)
+	}
+
 	return &SyncManager{
 		rdbConn: params.RDbConn,
 		client:  tendermintClient,
@@ -84,20 +168,32 @@ func NewSyncManager(
 		pollingInterval:      DEFAULT_POLLING_INTERVAL,
 		strictGenesisParsing: params.Config.StrictGenesisParsing,
 
+		blockSubscription:        blockSubscription,
+		tendermintWebSocketURL:   tendermintWebSocketURL,
+		insecureTendermintClient: params.Config.InsecureTendermintClient,
+
 		accountAddressPrefix: params.Config.AccountAddressPrefix,
 		stakingDenom:         params.Config.StakingDenom,
 
 		shouldSyncCh: make(chan bool, 1),
 
-		txDecoder:          params.TxDecoder,
-		windowSyncStrategy: syncstrategy.NewWindow(params.Logger, params.Config.WindowSize),
+		txDecoder:         params.TxDecoder,
+		msgParserRegistry: msgParserRegistry,
+		syncStrategy:      syncStrategy,
 
-		eventHandler: eventHandler,
+		eventHandler:     eventHandler,
+		eventHandlerName: params.EventHandlerName,
+
+		maybeContinuityVerifier: maybeContinuityVerifier,
+		viewStatus:              polling.NewStatus(params.RDbConn.ToHandle()),
+
+		undecodableTxPolicy: undecodableTxPolicy,
 	}
 }
 
-// SyncBlocks makes request to tendermint, create and dispatch notifications
-func (manager *SyncManager) SyncBlocks(latestHeight int64) error {
+// SyncBlocks makes request to tendermint, create and dispatch notifications. Once the context is
+// done, it returns after the in-flight height is handled so that no height is partially handled.
+func (manager *SyncManager) SyncBlocks(ctx context.Context, latestHeight int64) error {
 	maybeLastIndexedHeight, err := manager.eventHandler.GetLastHandledEventHeight()
 	if err != nil {
 		return fmt.Errorf("error running GetLastIndexedBlockHeight %v", err)
@@ -109,13 +205,26 @@ func (manager *SyncManager) SyncBlocks(latestHeight int64) error {
 		currentIndexingHeight = *maybeLastIndexedHeight + 1
 	}
 
+	if manager.maybeContinuityVerifier != nil && maybeLastIndexedHeight != nil {
+		if seedErr := manager.seedContinuityVerifier(*maybeLastIndexedHeight); seedErr != nil {
+			return fmt.Errorf("error seeding continuity verifier: %v", seedErr)
+		}
+	}
+
 	manager.logger.Infof("going to synchronized blocks from %d to %d", currentIndexingHeight, latestHeight)
 	for currentIndexingHeight <= latestHeight {
-		blocksCommands, syncedHeight, err := manager.windowSyncStrategy.Sync(
-			currentIndexingHeight, latestHeight, manager.syncBlockWorker,
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		blocksCommands, syncedHeight, err := manager.syncStrategy.Sync(
+			ctx, currentIndexingHeight, latestHeight, manager.syncBlockWorker,
 		)
 		if err != nil {
-			return fmt.Errorf("error when synchronizing block with window strategy: %v", err)
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("error when synchronizing block with sync strategy: %v", err)
 		}
 
 		if err != nil {
@@ -123,6 +232,17 @@ func (manager *SyncManager) SyncBlocks(latestHeight int64) error {
 		}
 		for i, commands := range blocksCommands {
 			blockHeight := currentIndexingHeight + int64(i)
+			if ctx.Err() != nil {
+				manager.logger.Infof("stopped synchronizing blocks after height %d", blockHeight-1)
+				return ctx.Err()
+			}
+
+			// Genesis is not a block and has no hash to verify
+			if manager.maybeContinuityVerifier != nil && blockHeight > 0 {
+				if verifyErr := manager.maybeContinuityVerifier.Verify(blockHeight); verifyErr != nil {
+					return manager.handleDivergence(verifyErr)
+				}
+			}
 
 			events := make([]event.Event, 0, len(commands))
 			for _, command := range commands {
@@ -133,17 +253,32 @@ func (manager *SyncManager) SyncBlocks(latestHeight int64) error {
 				events = append(events, event)
 			}
 
+			handleStartTime := time.Now()
 			err := manager.eventHandler.HandleEvents(blockHeight, events)
+			metrics.HandleEventsDuration.WithLabelValues(
+				manager.eventHandlerName,
+			).Observe(time.Since(handleStartTime).Seconds())
 			if err != nil {
+				if manager.maybeContinuityVerifier != nil {
+					// Handled events are rolled back, verified hash is no longer indexed
+					manager.maybeContinuityVerifier.Reset()
+				}
 				return fmt.Errorf("error handling events: %v", err)
 			}
 		}
+		if manager.isDivergenceVisible {
+			manager.clearDivergenceStatus()
+		}
 
 		// If there is any error before, short-circuit return in the error handling
 		// while the local currentIndexingHeight won't be incremented and will be retried later
 		manager.logger.Infof("successfully synced to block height %d", syncedHeight)
 		currentIndexingHeight = syncedHeight + 1
 	}
+
+	if catchUpAwareHandler, ok := manager.eventHandler.(eventhandler_interface.CatchUpAware); ok {
+		catchUpAwareHandler.OnCaughtUp()
+	}
 	return nil
 }
 
@@ -170,12 +305,20 @@ func (manager *SyncManager) syncBlockWorker(blockHeight int64) ([]command_entity
 		return nil, fmt.Errorf("error requesting chain block at height %d: %v", blockHeight, err)
 	}
 
+	if manager.maybeContinuityVerifier != nil {
+		manager.maybeContinuityVerifier.Record(
+			blockHeight, block.Hash, rawBlock.Block.Header.LastBlockID.Hash,
+		)
+	}
+
 	blockResults, err := manager.client.BlockResults(blockHeight)
 	if err != nil {
 		return nil, fmt.Errorf("error requesting chain block_results at height %d: %v", blockHeight, err)
 	}
 
 	commands, err := parser.ParseBlockToCommands(
+		manager.msgParserRegistry,
+		manager.undecodableTxPolicy,
 		manager.txDecoder,
 		block,
 		rawBlock,
@@ -190,38 +333,152 @@ func (manager *SyncManager) syncBlockWorker(blockHeight int64) ([]command_entity
 	return commands, nil
 }
 
-// Run starts the polling service for blocks
-func (manager *SyncManager) Run() error {
-	tracker := chainfeed.NewBlockHeightTracker(manager.logger, manager.client)
+// Run starts the polling service for blocks until the context is done
+func (manager *SyncManager) Run(ctx context.Context) error {
+	tracker, err := manager.newBlockHeightFeed(ctx)
+	if err != nil {
+		return err
+	}
 	manager.latestBlockHeight = tracker.GetLatestBlockHeight()
 	blockHeightCh := make(chan int64, 1)
 	go func() {
 		for {
-			latestBlockHeight := <-blockHeightCh
-			manager.latestBlockHeight = &latestBlockHeight
-			manager.drainShouldSyncCh()
-			manager.shouldSyncCh <- true
+			select {
+			case <-ctx.Done():
+				return
+			case latestBlockHeight := <-blockHeightCh:
+				manager.latestBlockHeight = &latestBlockHeight
+				manager.drainShouldSyncCh()
+				manager.shouldSyncCh <- true
+			}
 		}
 	}()
 	tracker.Subscribe(blockHeightCh)
 
+	manager.loadDivergenceStatus()
+
 	for {
-		if manager.latestBlockHeight == nil {
+		if manager.maybeDivergence != nil {
+			manager.logger.Errorf(
+				"synchronization halted, verify the Tendermint node is serving the indexed chain and restart, "+
+					"or stop the service and run `rollback --to-height %d` to discard the diverged heights: %v",
+				manager.maybeDivergence.Height-1, manager.maybeDivergence,
+			)
+		} else if manager.latestBlockHeight == nil {
 			manager.logger.Info("the chain has no block yet")
 		} else {
-			if err := manager.SyncBlocks(*manager.latestBlockHeight); err != nil {
+			err := manager.SyncBlocks(ctx, *manager.latestBlockHeight)
+			if err != nil && ctx.Err() == nil {
 				manager.logger.Errorf("error synchronizing blocks to latest height %d: %v", *manager.latestBlockHeight, err)
-				<-time.After(5 * time.Second)
+				select {
+				case <-ctx.Done():
+				case <-time.After(5 * time.Second):
+				}
 			}
 		}
 
 		select {
+		case <-ctx.Done():
+			manager.logger.Info("synchronization stopped")
+			return nil
 		case <-manager.shouldSyncCh:
 		case <-time.After(manager.pollingInterval):
 		}
 	}
 }
 
+func (manager *SyncManager) newBlockHeightFeed(ctx context.Context) (chainfeed.BlockHeightFeed, error) {
+	switch manager.blockSubscription {
+	case BLOCK_SUBSCRIPTION_POLLING:
+		return chainfeed.NewBlockHeightTracker(ctx, manager.logger, manager.client), nil
+	case BLOCK_SUBSCRIPTION_WEBSOCKET:
+		return chainfeed.NewWebSocketBlockHeightTracker(
+			ctx,
+			manager.logger,
+			manager.client,
+			chainfeed.WebSocketBlockHeightTrackerConfig{
+				URL:               manager.tendermintWebSocketURL,
+				Insecure:          manager.insecureTendermintClient,
+				ReconnectInterval: manager.pollingInterval,
+			},
+		), nil
+	default:
+		return nil, fmt.Errorf("unsupported block subscription: %s", manager.blockSubscription)
+	}
+}
+
+// seedContinuityVerifier provides the hash of the last indexed block to the continuity verifier
+// from the blocks view. Verification of the next block is skipped when the hash is unavailable.
+func (manager *SyncManager) seedContinuityVerifier(lastIndexedHeight int64) error {
+	if manager.maybeContinuityVerifier.IsSeeded(lastIndexedHeight) {
+		return nil
+	}
+
+	blocksView := block_view.NewBlocks(manager.rdbConn.ToHandle())
+	block, err := blocksView.FindBy(&block_view.BlockIdentity{
+		MaybeHeight: &lastIndexedHeight,
+	})
+	if err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			manager.logger.Infof(
+				"block %d is not found in blocks view, skipping continuity check of next block", lastIndexedHeight,
+			)
+			return nil
+		}
+		return fmt.Errorf("error finding last indexed block: %v", err)
+	}
+
+	manager.maybeContinuityVerifier.Seed(lastIndexedHeight, block.Hash)
+	return nil
+}
+
+// handleDivergence records the divergence to status view and halts the synchronization
+func (manager *SyncManager) handleDivergence(err error) error {
+	var divergenceErr *continuity.DivergenceError
+	if !errors.As(err, &divergenceErr) {
+		return err
+	}
+
+	manager.logger.Errorf("%v", divergenceErr)
+	manager.reportDivergenceStatus(divergenceErr)
+	manager.maybeContinuityVerifier.Reset()
+
+	manager.maybeDivergence = divergenceErr
+	return divergenceErr
+}
+
+func (manager *SyncManager) reportDivergenceStatus(divergenceErr *continuity.DivergenceError) {
+	divergenceJSON, err := jsoniter.MarshalToString(divergenceErr)
+	if err != nil {
+		manager.logger.Errorf("error encoding divergence to JSON: %v", err)
+		return
+	}
+	if err := manager.viewStatus.Upsert(continuity.DivergenceStatusKey(manager.eventHandlerName), divergenceJSON); err != nil {
+		manager.logger.Errorf("error upserting divergence status: %v", err)
+		return
+	}
+	manager.isDivergenceVisible = true
+}
+
+func (manager *SyncManager) loadDivergenceStatus() {
+	divergenceJSON, err := manager.viewStatus.FindBy(continuity.DivergenceStatusKey(manager.eventHandlerName))
+	if err != nil {
+		if !errors.Is(err, rdb.ErrNoRows) {
+			manager.logger.Errorf("error finding divergence status: %v", err)
+		}
+		return
+	}
+	manager.isDivergenceVisible = divergenceJSON != ""
+}
+
+func (manager *SyncManager) clearDivergenceStatus() {
+	if err := manager.viewStatus.Upsert(continuity.DivergenceStatusKey(manager.eventHandlerName), ""); err != nil {
+		manager.logger.Errorf("error clearing divergence status: %v", err)
+		return
+	}
+	manager.isDivergenceVisible = false
+}
+
 func (manager *SyncManager) drainShouldSyncCh() {
 	select {
 	case <-manager.shouldSyncCh:
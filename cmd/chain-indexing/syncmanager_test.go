@@ -0,0 +1,353 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crypto-com/chain-indexing/appinterface/eventhandler"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	rdb_test "github.com/crypto-com/chain-indexing/appinterface/rdb/test"
+	"github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/infrastructure/pg"
+	"github.com/crypto-com/chain-indexing/infrastructure/tendermint"
+	tendermint_test "github.com/crypto-com/chain-indexing/infrastructure/tendermint/test"
+	logger_test "github.com/crypto-com/chain-indexing/internal/logger/test"
+	"github.com/crypto-com/chain-indexing/internal/primptr"
+	usecase_model "github.com/crypto-com/chain-indexing/usecase/model"
+	"github.com/crypto-com/chain-indexing/usecase/model/genesis"
+	"github.com/crypto-com/chain-indexing/usecase/parser/utils"
+)
+
+var _ = Describe("SyncManager", func() {
+	Describe("SyncBlocks", func() {
+		It("should finish handling the in-flight height and not start next height when context is done", func() {
+			handler := newBlockingEventHandler(10, 11)
+			syncManager := NewSyncManager(SyncManagerParams{
+				Logger:           logger_test.NewFakeLogger(),
+				RDbConn:          rdb_test.NewFakeRDbConn(),
+				TxDecoder:        utils.NewTxDecoder(),
+				TendermintClient: &fixtureTendermintClient{},
+				Config: SyncManagerConfig{
+					WindowSize:           5,
+					AccountAddressPrefix: "tcro",
+					StakingDenom:         "basetcro",
+				},
+			}, handler)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			syncErrCh := make(chan error, 1)
+			go func() {
+				syncErrCh <- syncManager.SyncBlocks(ctx, 15)
+			}()
+
+			Eventually(handler.handlingCh).Should(BeClosed())
+			cancel()
+			Consistently(syncErrCh).ShouldNot(Receive())
+			close(handler.releaseCh)
+
+			Eventually(syncErrCh).Should(Receive(Equal(context.Canceled)))
+			Expect(handler.HandledHeights()).To(Equal([]int64{11}))
+			lastHandledHeight, err := handler.GetLastHandledEventHeight()
+			Expect(err).To(BeNil())
+			Expect(*lastHandledHeight).To(Equal(int64(11)))
+		})
+
+		Describe("with RDbEventStoreHandler", func() {
+			var rdbConn *recordingRDbConn
+			var syncManager *SyncManager
+
+			BeforeEach(func() {
+				rdbConn = newRecordingRDbConn(10)
+				syncManager = NewSyncManager(SyncManagerParams{
+					Logger:           logger_test.NewFakeLogger(),
+					RDbConn:          rdbConn,
+					TxDecoder:        utils.NewTxDecoder(),
+					TendermintClient: &fixtureTendermintClient{},
+					Config: SyncManagerConfig{
+						WindowSize:           5,
+						AccountAddressPrefix: "tcro",
+						StakingDenom:         "basetcro",
+					},
+				}, eventhandler.NewRDbEventStoreHandler(
+					logger_test.NewFakeLogger(), rdbConn, event.NewRegistry(),
+				))
+			})
+
+			It("should commit the events and the height of the in-flight height together when context is done", func() {
+				// Blocks storing the events of height 11, the first height to sync
+				handlingCh := make(chan struct{})
+				releaseCh := make(chan struct{})
+				var blockOnce sync.Once
+				rdbConn.onExec = func(sql string, _ []interface{}) error {
+					if strings.HasPrefix(sql, "INSERT INTO events") {
+						blockOnce.Do(func() {
+							close(handlingCh)
+							<-releaseCh
+						})
+					}
+					return nil
+				}
+
+				ctx, cancel := context.WithCancel(context.Background())
+				syncErrCh := make(chan error, 1)
+				go func() {
+					syncErrCh <- syncManager.SyncBlocks(ctx, 15)
+				}()
+
+				Eventually(handlingCh).Should(BeClosed())
+				cancel()
+				Consistently(syncErrCh).ShouldNot(Receive())
+				close(releaseCh)
+
+				Eventually(syncErrCh).Should(Receive(Equal(context.Canceled)))
+				txs := rdbConn.Txs()
+				Expect(txs).To(HaveLen(1))
+				Expect(txs[0].committed).To(BeTrue())
+				Expect(txs[0].statements).To(Equal([]string{
+					"INSERT INTO events",
+					"UPDATE service_status SET last_indexed_block_height = $1",
+				}))
+				Expect(rdbConn.LastIndexedHeight()).To(Equal(int64(11)))
+			})
+
+			It("should roll back the events of the height when updating its height fails", func() {
+				rdbConn.onExec = func(sql string, args []interface{}) error {
+					if strings.HasPrefix(sql, "UPDATE service_status") {
+						return errors.New("any error")
+					}
+					return nil
+				}
+
+				err := syncManager.SyncBlocks(context.Background(), 15)
+				Expect(err).NotTo(BeNil())
+
+				txs := rdbConn.Txs()
+				Expect(txs).To(HaveLen(1))
+				Expect(txs[0].committed).To(BeFalse())
+				Expect(txs[0].rolledBack).To(BeTrue())
+				Expect(rdbConn.LastIndexedHeight()).To(Equal(int64(10)))
+			})
+		})
+	})
+})
+
+// recordingRDbConn keeps the last indexed block height of service_status and records the
+// statements of each transaction. The height is only updated when the transaction is committed.
+type recordingRDbConn struct {
+	mutex             sync.Mutex
+	lastIndexedHeight int64
+	txs               []*recordingRDbTx
+
+	onExec func(sql string, args []interface{}) error
+}
+
+func newRecordingRDbConn(lastIndexedHeight int64) *recordingRDbConn {
+	return &recordingRDbConn{
+		lastIndexedHeight: lastIndexedHeight,
+		txs:               make([]*recordingRDbTx, 0),
+
+		onExec: func(_ string, _ []interface{}) error { return nil },
+	}
+}
+
+func (conn *recordingRDbConn) Begin() (rdb.Tx, error) {
+	conn.mutex.Lock()
+	defer conn.mutex.Unlock()
+
+	tx := &recordingRDbTx{conn: conn}
+	conn.txs = append(conn.txs, tx)
+	return tx, nil
+}
+
+func (conn *recordingRDbConn) Exec(sql string, _ ...interface{}) (rdb.ExecResult, error) {
+	return nil, fmt.Errorf("unexpected statement outside transaction: %s", sql)
+}
+
+func (conn *recordingRDbConn) Query(sql string, _ ...interface{}) (rdb.RowsResult, error) {
+	return nil, fmt.Errorf("unexpected query: %s", sql)
+}
+
+func (conn *recordingRDbConn) QueryRow(sql string, _ ...interface{}) rdb.RowResult {
+	conn.mutex.Lock()
+	defer conn.mutex.Unlock()
+
+	row := &rdb_test.MockRDbRowResult{}
+	switch sql {
+	case "SELECT COUNT(*) FROM service_status":
+		row.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+			*args.Get(0).(*int64) = 1
+		}).Return(nil)
+	case "SELECT last_indexed_block_height FROM service_status":
+		lastIndexedHeight := conn.lastIndexedHeight
+		row.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+			*args.Get(0).(**int64) = &lastIndexedHeight
+		}).Return(nil)
+	default:
+		row.On("Scan", mock.Anything).Return(fmt.Errorf("unexpected query: %s", sql))
+	}
+	return row
+}
+
+func (conn *recordingRDbConn) ToHandle() *rdb.Handle {
+	return &rdb.Handle{
+		Runner:      conn,
+		TypeConv:    &pg.PgxTypeConv{},
+		StmtBuilder: pg.PostgresStmtBuilder,
+	}
+}
+
+func (conn *recordingRDbConn) Txs() []*recordingRDbTx {
+	conn.mutex.Lock()
+	defer conn.mutex.Unlock()
+
+	return append([]*recordingRDbTx{}, conn.txs...)
+}
+
+func (conn *recordingRDbConn) LastIndexedHeight() int64 {
+	conn.mutex.Lock()
+	defer conn.mutex.Unlock()
+
+	return conn.lastIndexedHeight
+}
+
+type recordingRDbTx struct {
+	conn *recordingRDbConn
+
+	statements             []string
+	maybeLastIndexedHeight *int64
+	committed              bool
+	rolledBack             bool
+}
+
+func (tx *recordingRDbTx) Exec(sql string, args ...interface{}) (rdb.ExecResult, error) {
+	if err := tx.conn.onExec(sql, args); err != nil {
+		return nil, err
+	}
+
+	rowsAffected := int64(1)
+	if strings.HasPrefix(sql, "INSERT INTO events") {
+		// Statements are recorded without the values
+		sql = "INSERT INTO events"
+		// uuid, height, name, version and payload of each event
+		rowsAffected = int64(len(args) / 5)
+	} else if strings.HasPrefix(sql, "UPDATE service_status") {
+		height := args[0].(int64)
+		tx.maybeLastIndexedHeight = &height
+	}
+	tx.statements = append(tx.statements, sql)
+
+	result := &rdb_test.MockRDbExecResult{}
+	result.On("RowsAffected").Return(rowsAffected)
+	return result, nil
+}
+
+func (tx *recordingRDbTx) Query(sql string, _ ...interface{}) (rdb.RowsResult, error) {
+	return nil, fmt.Errorf("unexpected query: %s", sql)
+}
+
+func (tx *recordingRDbTx) QueryRow(sql string, args ...interface{}) rdb.RowResult {
+	return tx.conn.QueryRow(sql, args...)
+}
+
+func (tx *recordingRDbTx) Commit() error {
+	tx.conn.mutex.Lock()
+	defer tx.conn.mutex.Unlock()
+
+	tx.committed = true
+	if tx.maybeLastIndexedHeight != nil {
+		tx.conn.lastIndexedHeight = *tx.maybeLastIndexedHeight
+	}
+	return nil
+}
+
+func (tx *recordingRDbTx) Rollback() error {
+	tx.conn.mutex.Lock()
+	defer tx.conn.mutex.Unlock()
+
+	tx.rolledBack = true
+	return nil
+}
+
+func (tx *recordingRDbTx) ToHandle() *rdb.Handle {
+	return &rdb.Handle{
+		Runner:      tx,
+		TypeConv:    &pg.PgxTypeConv{},
+		StmtBuilder: pg.PostgresStmtBuilder,
+	}
+}
+
+// blockingEventHandler blocks on handling the specified height until released
+type blockingEventHandler struct {
+	mutex             sync.Mutex
+	lastHandledHeight int64
+	handledHeights    []int64
+
+	blockingHeight int64
+	handlingCh     chan struct{}
+	releaseCh      chan struct{}
+}
+
+func newBlockingEventHandler(lastHandledHeight int64, blockingHeight int64) *blockingEventHandler {
+	return &blockingEventHandler{
+		lastHandledHeight: lastHandledHeight,
+		handledHeights:    make([]int64, 0),
+
+		blockingHeight: blockingHeight,
+		handlingCh:     make(chan struct{}),
+		releaseCh:      make(chan struct{}),
+	}
+}
+
+func (handler *blockingEventHandler) GetLastHandledEventHeight() (*int64, error) {
+	handler.mutex.Lock()
+	defer handler.mutex.Unlock()
+
+	return primptr.Int64(handler.lastHandledHeight), nil
+}
+
+func (handler *blockingEventHandler) HandleEvents(blockHeight int64, _ []event.Event) error {
+	if blockHeight == handler.blockingHeight {
+		close(handler.handlingCh)
+		<-handler.releaseCh
+	}
+
+	handler.mutex.Lock()
+	defer handler.mutex.Unlock()
+
+	handler.lastHandledHeight = blockHeight
+	handler.handledHeights = append(handler.handledHeights, blockHeight)
+	return nil
+}
+
+func (handler *blockingEventHandler) HandledHeights() []int64 {
+	handler.mutex.Lock()
+	defer handler.mutex.Unlock()
+
+	return handler.handledHeights
+}
+
+// fixtureTendermintClient returns the same block fixture for every height
+type fixtureTendermintClient struct{}
+
+func (client *fixtureTendermintClient) Genesis() (*genesis.Genesis, error) {
+	return nil, nil
+}
+
+func (client *fixtureTendermintClient) Block(_ int64) (*usecase_model.Block, *usecase_model.RawBlock, error) {
+	return tendermint.ParseBlockResp(strings.NewReader(tendermint_test.BLOCK_JSON))
+}
+
+func (client *fixtureTendermintClient) BlockResults(_ int64) (*usecase_model.BlockResults, error) {
+	return tendermint.ParseBlockResultsResp(strings.NewReader(tendermint_test.BLOCK_RESULTS_JSON))
+}
+
+func (client *fixtureTendermintClient) LatestBlockHeight() (int64, error) {
+	return 100, nil
+}
@@ -0,0 +1,387 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	pagination_interface "github.com/crypto-com/chain-indexing/appinterface/pagination"
+	"github.com/crypto-com/chain-indexing/appinterface/projection/rdbprojectionbase"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/internal/primptr"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/projection/webhook"
+	webhook_view "github.com/crypto-com/chain-indexing/projection/webhook/view"
+)
+
+const WEBHOOK_PROJECTION_ID = "Webhook"
+
+func newWebhookDispatcherConfig(config *Config) (webhook.DispatcherConfig, error) {
+	var dispatcherConfig webhook.DispatcherConfig
+	var err error
+
+	if dispatcherConfig.PollInterval, err = parseOptionalDuration(config.Webhook.PollInterval); err != nil {
+		return dispatcherConfig, fmt.Errorf("error parsing webhook poll_interval: %v", err)
+	}
+	if dispatcherConfig.InitialBackoff, err = parseOptionalDuration(config.Webhook.InitialBackoff); err != nil {
+		return dispatcherConfig, fmt.Errorf("error parsing webhook initial_backoff: %v", err)
+	}
+	if dispatcherConfig.MaxBackoff, err = parseOptionalDuration(config.Webhook.MaxBackoff); err != nil {
+		return dispatcherConfig, fmt.Errorf("error parsing webhook max_backoff: %v", err)
+	}
+	if dispatcherConfig.RequestTimeout, err = parseOptionalDuration(config.Webhook.RequestTimeout); err != nil {
+		return dispatcherConfig, fmt.Errorf("error parsing webhook request_timeout: %v", err)
+	}
+	dispatcherConfig.BatchSize = config.Webhook.BatchSize
+	dispatcherConfig.MaxAttempts = config.Webhook.MaxAttempts
+
+	return dispatcherConfig, nil
+}
+
+// parseOptionalDuration returns 0 when the duration is empty
+func parseOptionalDuration(duration string) (time.Duration, error) {
+	if duration == "" {
+		return 0, nil
+	}
+	return time.ParseDuration(duration)
+}
+
+type AddWebhookWatchParams struct {
+	URL            string
+	Secret         string
+	MaybeAddress   *string
+	MaybeEventName *string
+	// Height to start matching events from. Default to the height after the last height handled by
+	// the Webhook projection, so that past events are not notified
+	MaybeFromBlockHeight *int64
+}
+
+// newWebhookCommand returns the command managing the watches and dead letters of the Webhook projection
+func newWebhookCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "webhook",
+		Usage: "Manage webhook watches and dead letters. Watches are matched by the Webhook projection",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "add",
+				Usage: "Register a watch notifying the URL of the events involving the address, of the event name, or both",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "url",
+						Usage:    "Receiver `URL` the payloads are posted to",
+						Required: true,
+					},
+					&cli.StringFlag{
+						Name:     "secret",
+						Usage:    "`SECRET` signing the payloads with HMAC-SHA256",
+						EnvVars:  []string{"WEBHOOK_SECRET"},
+						Required: true,
+					},
+					&cli.StringFlag{
+						Name:  "address",
+						Usage: "Match the events sending funds from or to the `ADDRESS`",
+					},
+					&cli.StringFlag{
+						Name: "event",
+						Usage: fmt.Sprintf(
+							"Match the events of the `NAME`. One of %v", webhook.WATCHABLE_EVENTS,
+						),
+					},
+					&cli.Int64Flag{
+						Name: "from-height",
+						Usage: "Match the events starting from `HEIGHT`. Default to the height after the last " +
+							"height handled by the Webhook projection",
+					},
+				},
+				Action: func(ctx *cli.Context) error {
+					config, err := loadConfig(ctx)
+					if err != nil {
+						return err
+					}
+					logger := newLogger(config)
+
+					rdbConn, err := SetupRDbConn(config, logger)
+					if err != nil {
+						return fmt.Errorf("error setting up RDb connection: %v", err)
+					}
+					defer rdbConn.Close()
+
+					secretCipher, err := webhook.NewSecretCipher(config.Webhook.SecretKey)
+					if err != nil {
+						return err
+					}
+
+					params := AddWebhookWatchParams{
+						URL:    ctx.String("url"),
+						Secret: ctx.String("secret"),
+					}
+					if ctx.IsSet("address") {
+						params.MaybeAddress = primptr.String(ctx.String("address"))
+					}
+					if ctx.IsSet("event") {
+						params.MaybeEventName = primptr.String(ctx.String("event"))
+					}
+					if ctx.IsSet("from-height") {
+						params.MaybeFromBlockHeight = primptr.Int64(ctx.Int64("from-height"))
+					}
+					watch, err := AddWebhookWatch(rdbConn, secretCipher, params)
+					if err != nil {
+						return err
+					}
+
+					logger.Infof(
+						"added webhook watch %d matching events from height %d",
+						watch.Id, watch.FromBlockHeight,
+					)
+					return nil
+				},
+			},
+			{
+				Name:  "list",
+				Usage: "List the watches",
+				Action: func(ctx *cli.Context) error {
+					config, err := loadConfig(ctx)
+					if err != nil {
+						return err
+					}
+					logger := newLogger(config)
+
+					rdbConn, err := SetupRDbConn(config, logger)
+					if err != nil {
+						return fmt.Errorf("error setting up RDb connection: %v", err)
+					}
+					defer rdbConn.Close()
+
+					return ListWebhookWatches(rdbConn, os.Stdout)
+				},
+			},
+			{
+				Name:  "remove",
+				Usage: "Remove a watch together with its pending deliveries and dead letters",
+				Flags: []cli.Flag{
+					&cli.Int64Flag{
+						Name:     "id",
+						Usage:    "Watch `ID` to remove",
+						Required: true,
+					},
+				},
+				Action: func(ctx *cli.Context) error {
+					config, err := loadConfig(ctx)
+					if err != nil {
+						return err
+					}
+					logger := newLogger(config)
+
+					rdbConn, err := SetupRDbConn(config, logger)
+					if err != nil {
+						return fmt.Errorf("error setting up RDb connection: %v", err)
+					}
+					defer rdbConn.Close()
+
+					return RemoveWebhookWatch(rdbConn, ctx.Int64("id"))
+				},
+			},
+			{
+				Name:  "dead-letters",
+				Usage: "List the deliveries which have failed all the attempts",
+				Flags: []cli.Flag{
+					&cli.Int64Flag{
+						Name:  "page",
+						Value: 1,
+						Usage: "`PAGE` to list",
+					},
+					&cli.Int64Flag{
+						Name:  "limit",
+						Value: 20,
+						Usage: "Number of dead letters per page",
+					},
+				},
+				Action: func(ctx *cli.Context) error {
+					config, err := loadConfig(ctx)
+					if err != nil {
+						return err
+					}
+					logger := newLogger(config)
+
+					rdbConn, err := SetupRDbConn(config, logger)
+					if err != nil {
+						return fmt.Errorf("error setting up RDb connection: %v", err)
+					}
+					defer rdbConn.Close()
+
+					return ListWebhookDeadLetters(rdbConn, ctx.Int64("page"), ctx.Int64("limit"), os.Stdout)
+				},
+			},
+			{
+				Name:  "redeliver",
+				Usage: "Move a dead letter back to the outbox to be attempted again",
+				Flags: []cli.Flag{
+					&cli.Int64Flag{
+						Name:     "id",
+						Usage:    "Delivery `ID` of the dead letter",
+						Required: true,
+					},
+				},
+				Action: func(ctx *cli.Context) error {
+					config, err := loadConfig(ctx)
+					if err != nil {
+						return err
+					}
+					logger := newLogger(config)
+
+					rdbConn, err := SetupRDbConn(config, logger)
+					if err != nil {
+						return fmt.Errorf("error setting up RDb connection: %v", err)
+					}
+					defer rdbConn.Close()
+
+					return RedeliverWebhook(rdbConn, ctx.Int64("id"))
+				},
+			},
+		},
+	}
+}
+
+// AddWebhookWatch registers the watch with its secret encrypted by the secretCipher and returns it
+func AddWebhookWatch(
+	rdbConn rdb.Conn,
+	secretCipher *webhook.SecretCipher,
+	params AddWebhookWatchParams,
+) (*webhook_view.WatchRow, error) {
+	parsedURL, err := url.Parse(params.URL)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing webhook URL: %v", err)
+	}
+	if (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" {
+		return nil, errors.New("webhook URL must be an absolute http or https URL")
+	}
+	if params.Secret == "" {
+		return nil, errors.New("webhook secret is required to sign the payloads")
+	}
+	if params.MaybeAddress == nil && params.MaybeEventName == nil {
+		return nil, errors.New("webhook watch requires an address, an event name or both")
+	}
+	if params.MaybeEventName != nil && !webhook.IsWatchableEvent(*params.MaybeEventName) {
+		return nil, fmt.Errorf(
+			"event `%s` cannot be watched, watchable events are %v", *params.MaybeEventName, webhook.WATCHABLE_EVENTS,
+		)
+	}
+
+	var fromBlockHeight int64
+	if params.MaybeFromBlockHeight != nil {
+		fromBlockHeight = *params.MaybeFromBlockHeight
+	} else {
+		projectionBase := rdbprojectionbase.NewRDbBase(rdbConn.ToHandle(), WEBHOOK_PROJECTION_ID)
+		maybeLastHandledHeight, lastHandledHeightErr := projectionBase.GetLastHandledEventHeight()
+		if lastHandledHeightErr != nil {
+			return nil, fmt.Errorf("error getting last handled event height of Webhook projection: %v", lastHandledHeightErr)
+		}
+		if maybeLastHandledHeight != nil {
+			fromBlockHeight = *maybeLastHandledHeight + 1
+		}
+	}
+
+	encryptedSecret, err := secretCipher.Encrypt(params.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("error encrypting webhook secret: %v", err)
+	}
+
+	watch := webhook_view.WatchRow{
+		URL:             params.URL,
+		EncryptedSecret: encryptedSecret,
+		MaybeAddress:    params.MaybeAddress,
+		MaybeEventName:  params.MaybeEventName,
+		FromBlockHeight: fromBlockHeight,
+		CreatedAt:       utctime.Now(),
+	}
+	if err = webhook_view.NewWatches(rdbConn.ToHandle()).Insert(&watch); err != nil {
+		return nil, fmt.Errorf("error inserting webhook watch: %v", err)
+	}
+
+	return &watch, nil
+}
+
+// RemoveWebhookWatch deletes the watch and all its deliveries
+func RemoveWebhookWatch(rdbConn rdb.Conn, id int64) error {
+	if err := webhook_view.NewWatches(rdbConn.ToHandle()).Delete(id); err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			return fmt.Errorf("webhook watch %d does not exist", id)
+		}
+		return fmt.Errorf("error deleting webhook watch: %v", err)
+	}
+	return nil
+}
+
+// ListWebhookWatches writes the watches to out as a table. Secrets are not written.
+func ListWebhookWatches(rdbConn rdb.Conn, out io.Writer) error {
+	watches, err := webhook_view.NewWatches(rdbConn.ToHandle()).ListAll()
+	if err != nil {
+		return fmt.Errorf("error listing webhook watches: %v", err)
+	}
+
+	writer := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(writer, "ID\tURL\tADDRESS\tEVENT\tFROM HEIGHT\tCREATED AT")
+	for _, watch := range watches {
+		fmt.Fprintf(
+			writer, "%d\t%s\t%s\t%s\t%d\t%s\n",
+			watch.Id, watch.URL, stringOrDash(watch.MaybeAddress), stringOrDash(watch.MaybeEventName),
+			watch.FromBlockHeight, watch.CreatedAt,
+		)
+	}
+	return writer.Flush()
+}
+
+// ListWebhookDeadLetters writes a page of the deliveries which have failed all the attempts to out
+// as a table
+func ListWebhookDeadLetters(rdbConn rdb.Conn, page int64, limit int64, out io.Writer) error {
+	deliveries, paginationResult, err := webhook_view.NewDeliveries(rdbConn.ToHandle()).ListDead(
+		pagination_interface.NewOffsetPagination(page, limit),
+	)
+	if err != nil {
+		return fmt.Errorf("error listing webhook dead letters: %v", err)
+	}
+
+	writer := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(writer, "ID\tWATCH ID\tEVENT\tHEIGHT\tEVENT UUID\tATTEMPTS\tCREATED AT\tLAST ERROR")
+	for _, delivery := range deliveries {
+		fmt.Fprintf(
+			writer, "%d\t%d\t%s\t%d\t%s\t%d\t%s\t%s\n",
+			delivery.Id, delivery.WatchId, delivery.EventName, delivery.BlockHeight, delivery.EventUUID,
+			delivery.Attempts, delivery.CreatedAt, stringOrDash(delivery.MaybeLastError),
+		)
+	}
+	if err = writer.Flush(); err != nil {
+		return err
+	}
+
+	offsetResult := paginationResult.OffsetResult()
+	_, err = fmt.Fprintf(
+		out, "page %d of %d, %d dead letters in total\n",
+		offsetResult.CurrentPage, offsetResult.TotalPage(), offsetResult.TotalRecord,
+	)
+	return err
+}
+
+// RedeliverWebhook moves the dead letter back to the outbox to be attempted again
+func RedeliverWebhook(rdbConn rdb.Conn, deliveryId int64) error {
+	if err := webhook_view.NewDeliveries(rdbConn.ToHandle()).Redeliver(deliveryId, utctime.Now()); err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			return fmt.Errorf("webhook delivery %d is not a dead letter", deliveryId)
+		}
+		return fmt.Errorf("error redelivering webhook: %v", err)
+	}
+	return nil
+}
+
+func stringOrDash(maybeValue *string) string {
+	if maybeValue == nil {
+		return "-"
+	}
+	return *maybeValue
+}
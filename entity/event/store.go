@@ -6,6 +6,11 @@ type Store interface {
 
 	GetAllByHeight(height int64) ([]Event, error)
 
+	// GetAllByHeightRange returns all events with height between fromHeight and toHeight
+	// (inclusive) ordered by height and insertion order. When names is not empty, only events
+	// with one of the names are returned.
+	GetAllByHeightRange(fromHeight int64, toHeight int64, names []string) ([]Event, error)
+
 	Insert(evt Event) error
 
 	// InsertAll insert all events into store. It will rollback when the insert fails at any point.
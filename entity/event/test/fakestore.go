@@ -18,6 +18,14 @@ func (manager *FakeEventStore) GetAllByHeight(seq int64) ([]entity_event.Event,
 	return []entity_event.Event{NewFakeEvent()}, nil
 }
 
+func (manager *FakeEventStore) GetAllByHeightRange(
+	fromHeight int64,
+	toHeight int64,
+	names []string,
+) ([]entity_event.Event, error) {
+	return []entity_event.Event{NewFakeEvent()}, nil
+}
+
 func (manager *FakeEventStore) Insert(evt entity_event.Event) error {
 	return nil
 }
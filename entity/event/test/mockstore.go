@@ -25,6 +25,16 @@ func (manager *MockEventStore) GetAllByHeight(height int64) ([]entity_event.Even
 	return mockArgs.Get(0).([]entity_event.Event), mockArgs.Error(1)
 }
 
+func (manager *MockEventStore) GetAllByHeightRange(
+	fromHeight int64,
+	toHeight int64,
+	names []string,
+) ([]entity_event.Event, error) {
+	mockArgs := manager.Called(fromHeight, toHeight, names)
+
+	return mockArgs.Get(0).([]entity_event.Event), mockArgs.Error(1)
+}
+
 func (manager *MockEventStore) Insert(evt entity_event.Event) error {
 	mockArgs := manager.Called(evt)
 
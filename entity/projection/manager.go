@@ -1,32 +1,63 @@
 package projection
 
 import (
+	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	entity_event "github.com/crypto-com/chain-indexing/entity/event"
 	applogger "github.com/crypto-com/chain-indexing/internal/logger"
 )
 
+// DEFAULT_BATCH_SIZE is the default maximum number of heights replayed in one batch
+const DEFAULT_BATCH_SIZE = 100
+
 // StoreBasedManager is a projection manager relies on replaying events from EventStore
 type StoreBasedManager struct {
 	logger     applogger.Logger
 	eventStore entity_event.Store
+	batchSize  int64
 
 	projections []Projection
+	hooks       []HandledEventsHook
 }
 
 func NewStoreBasedManager(logger applogger.Logger, eventStore entity_event.Store) *StoreBasedManager {
+	return NewStoreBasedManagerWithBatchSize(logger, eventStore, DEFAULT_BATCH_SIZE)
+}
+
+// NewStoreBasedManagerWithBatchSize creates a manager which replays at most batchSize heights of
+// events per event store query. Projections implementing BatchProjection handle the whole batch
+// at once.
+func NewStoreBasedManagerWithBatchSize(
+	logger applogger.Logger,
+	eventStore entity_event.Store,
+	batchSize int64,
+) *StoreBasedManager {
+	if batchSize <= 0 {
+		batchSize = DEFAULT_BATCH_SIZE
+	}
+
 	return &StoreBasedManager{
 		logger: logger.WithFields(applogger.LogFields{
 			"module": "projectionManager",
 		}),
 		eventStore: eventStore,
+		batchSize:  batchSize,
 
 		projections: make([]Projection, 0),
+		hooks:       make([]HandledEventsHook, 0),
 	}
 }
 
+// AddHandledEventsHook adds a hook notified after every height handled by the projections once they
+// have caught up with the latest event height. Heights replayed before that, including by CatchUp,
+// are not notified.
+func (manager *StoreBasedManager) AddHandledEventsHook(hook HandledEventsHook) {
+	manager.hooks = append(manager.hooks, hook)
+}
+
 func (manager *StoreBasedManager) RegisterProjection(projection Projection) error {
 	if manager.IsProjectionRegistered(projection) {
 		return fmt.Errorf("projection `%s` already registered", projection.Id())
@@ -47,11 +78,25 @@ func (manager *StoreBasedManager) IsProjectionRegistered(projection Projection)
 // Starts projectionManager by running all registered projection.
 func (manager *StoreBasedManager) RunInBackground() {
 	for _, projection := range manager.projections {
-		go manager.projectionRunner(projection)
+		go manager.projectionRunner(context.Background(), projection)
 	}
 }
 
-func (manager *StoreBasedManager) projectionRunner(projection Projection) {
+// Run runs all registered projections until the context is done. It returns after every projection
+// has finished handling its in-flight height, so no height is left partially handled.
+func (manager *StoreBasedManager) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, projection := range manager.projections {
+		wg.Add(1)
+		go func(projection Projection) {
+			defer wg.Done()
+			manager.projectionRunner(ctx, projection)
+		}(projection)
+	}
+	wg.Wait()
+}
+
+func (manager *StoreBasedManager) projectionRunner(ctx context.Context, projection Projection) {
 	eventsToListen := projection.GetEventsToListen()
 	logger := manager.logger.WithFields(applogger.LogFields{
 		"projection": projection.Id(),
@@ -70,7 +115,9 @@ func (manager *StoreBasedManager) projectionRunner(projection Projection) {
 		}
 
 		logger.Infof("error getting last handled event height from projection")
-		<-waitFor(5 * time.Second)
+		if !waitOrDone(ctx, 5*time.Second) {
+			return
+		}
 	}
 
 	var nextEventHeight int64
@@ -80,53 +127,191 @@ func (manager *StoreBasedManager) projectionRunner(projection Projection) {
 		nextEventHeight = *lastHandledEventHeight + 1
 	}
 
+	// Hooks are notified only after the projection has caught up with the latest event height, so
+	// that the replay on start is not pushed
+	caughtUp := false
 	for {
 		latestEventHeight, _ := manager.eventStore.GetLatestHeight()
 		if latestEventHeight == nil {
 			logger.Debugf("no event in in the system yet")
-			<-waitFor(5 * time.Second)
+			if !waitOrDone(ctx, 5*time.Second) {
+				break
+			}
 			continue
 		}
-		for nextEventHeight <= *latestEventHeight {
-			var err error
+		for nextEventHeight <= *latestEventHeight && ctx.Err() == nil {
+			toHeight := manager.batchEndHeight(nextEventHeight, *latestEventHeight)
+			maybeLastHandledHeight, err := manager.handleEventsInRange(
+				ctx, logger, projection, eventsToListen, nextEventHeight, toHeight, caughtUp,
+			)
+			if maybeLastHandledHeight != nil {
+				nextEventHeight = *maybeLastHandledHeight + 1
+			}
+			if err != nil {
+				waitOrDone(ctx, 5*time.Second)
+			}
+		}
+		if nextEventHeight > *latestEventHeight {
+			caughtUp = true
+		}
+		if !waitOrDone(ctx, 5*time.Second) {
+			break
+		}
+	}
 
-			eventLogger := logger.WithFields(applogger.LogFields{
-				"height": nextEventHeight,
-			})
+	logger.Infof("projection stopped at height %d", nextEventHeight-1)
+}
 
-			var eventsAtHeight []entity_event.Event
-			if eventsAtHeight, err = manager.eventStore.GetAllByHeight(nextEventHeight); err != nil {
-				eventLogger.Errorf("error getting all events by height: %v", err)
-				<-waitFor(time.Second)
-				continue
-			}
+// CatchUp replays events to the projection from its last handled event height up to the latest
+// event height in the store and then returns. Unlike the background runner, it does not retry on
+// error and does not notify the hooks. Returns the last handled event height, nil when there is no
+// event in the store.
+func (manager *StoreBasedManager) CatchUp(projection Projection) (*int64, error) {
+	eventsToListen := projection.GetEventsToListen()
+	logger := manager.logger.WithFields(applogger.LogFields{
+		"projection": projection.Id(),
+	})
+
+	lastHandledEventHeight, err := projection.GetLastHandledEventHeight()
+	if err != nil {
+		return nil, fmt.Errorf("error getting last handled event height from projection: %v", err)
+	}
+
+	var nextEventHeight int64
+	if lastHandledEventHeight == nil {
+		nextEventHeight = 0
+	} else {
+		nextEventHeight = *lastHandledEventHeight + 1
+	}
+
+	for {
+		latestEventHeight, err := manager.eventStore.GetLatestHeight()
+		if err != nil {
+			return nil, fmt.Errorf("error getting latest event height: %v", err)
+		}
+		if latestEventHeight == nil || nextEventHeight > *latestEventHeight {
+			return lastHandledEventHeight, nil
+		}
 
-			var events = make([]entity_event.Event, 0)
-			for _, event := range eventsAtHeight {
-				if !isListeningEvent(event, eventsToListen) {
-					//eventLogger.WithFields(applogger.LogFields{
-					//	"eventName": event.Name(),
-					//}).Debugf("skipping because event is not one of the listening events")
-					continue
-				}
-				events = append(events, event)
+		for nextEventHeight <= *latestEventHeight {
+			toHeight := manager.batchEndHeight(nextEventHeight, *latestEventHeight)
+			maybeLastHandledHeight, err := manager.handleEventsInRange(
+				context.Background(), logger, projection, eventsToListen, nextEventHeight, toHeight, false,
+			)
+			if maybeLastHandledHeight != nil {
+				lastHandledEventHeight = maybeLastHandledHeight
+				nextEventHeight = *maybeLastHandledHeight + 1
+			}
+			if err != nil {
+				return lastHandledEventHeight, err
 			}
+		}
+	}
+}
 
-			eventLogger = eventLogger.WithFields(applogger.LogFields{
-				"eventCount": len(events),
-			})
-			if err = projection.HandleEvents(nextEventHeight, events); err != nil {
-				eventLogger.WithFields(applogger.LogFields{
-					"events": events,
-				}).Errorf("error handling events: %v", err)
-				<-waitFor(5 * time.Second)
-				continue
+func (manager *StoreBasedManager) batchEndHeight(fromHeight int64, latestHeight int64) int64 {
+	toHeight := fromHeight + manager.batchSize - 1
+	if toHeight > latestHeight {
+		return latestHeight
+	}
+	return toHeight
+}
+
+// handleEventsInRange replays the listening events between fromHeight and toHeight (inclusive)
+// to the projection. Returns the last successfully handled height, nil if no height is handled.
+// Heights after the in-flight one are skipped once the context is done. The hooks are notified of
+// the handled heights when notifyHooks is true.
+func (manager *StoreBasedManager) handleEventsInRange(
+	ctx context.Context,
+	logger applogger.Logger,
+	projection Projection,
+	eventsToListen []string,
+	fromHeight int64,
+	toHeight int64,
+	notifyHooks bool,
+) (*int64, error) {
+	rangeLogger := logger.WithFields(applogger.LogFields{
+		"fromHeight": fromHeight,
+		"toHeight":   toHeight,
+	})
+
+	eventsInRange, err := manager.eventStore.GetAllByHeightRange(fromHeight, toHeight, eventsToListen)
+	if err != nil {
+		rangeLogger.Errorf("error getting all events by height range: %v", err)
+		return nil, fmt.Errorf("error getting all events by height range %d-%d: %v", fromHeight, toHeight, err)
+	}
+
+	batch := make([]EventsAtHeight, 0, toHeight-fromHeight+1)
+	for height := fromHeight; height <= toHeight; height++ {
+		batch = append(batch, EventsAtHeight{
+			Height: height,
+			Events: make([]entity_event.Event, 0),
+		})
+	}
+	for _, event := range eventsInRange {
+		if !isListeningEvent(event, eventsToListen) {
+			continue
+		}
+		if event.Height() < fromHeight || event.Height() > toHeight {
+			return nil, fmt.Errorf(
+				"event store returned event at height %d outside of range %d-%d", event.Height(), fromHeight, toHeight,
+			)
+		}
+		eventsAtHeight := &batch[event.Height()-fromHeight]
+		eventsAtHeight.Events = append(eventsAtHeight.Events, event)
+	}
+
+	if batchProjection, ok := projection.(BatchProjection); ok {
+		rangeLogger = rangeLogger.WithFields(applogger.LogFields{
+			"eventCount": len(eventsInRange),
+		})
+		if err = batchProjection.HandleEventsBatch(batch); err != nil {
+			rangeLogger.Errorf("error handling events batch: %v", err)
+			return nil, fmt.Errorf("error handling events batch at height %d-%d: %v", fromHeight, toHeight, err)
+		}
+
+		rangeLogger.Infof("successfully handled events batch")
+		if notifyHooks {
+			for _, eventsAtHeight := range batch {
+				manager.notifyHooks(projection, eventsAtHeight.Height, eventsAtHeight.Events)
 			}
+		}
+		return &toHeight, nil
+	}
+
+	var maybeLastHandledHeight *int64
+	for i := range batch {
+		if ctx.Err() != nil {
+			return maybeLastHandledHeight, ctx.Err()
+		}
+
+		height := batch[i].Height
+		events := batch[i].Events
 
-			eventLogger.Infof("successfully handled events")
-			nextEventHeight += 1
+		eventLogger := logger.WithFields(applogger.LogFields{
+			"height":     height,
+			"eventCount": len(events),
+		})
+		if err = projection.HandleEvents(height, events); err != nil {
+			eventLogger.WithFields(applogger.LogFields{
+				"events": events,
+			}).Errorf("error handling events: %v", err)
+			return maybeLastHandledHeight, fmt.Errorf("error handling events at height %d: %v", height, err)
 		}
-		<-waitFor(5 * time.Second)
+
+		eventLogger.Infof("successfully handled events")
+		if notifyHooks {
+			manager.notifyHooks(projection, height, events)
+		}
+		maybeLastHandledHeight = &height
+	}
+
+	return maybeLastHandledHeight, nil
+}
+
+func (manager *StoreBasedManager) notifyHooks(projection Projection, height int64, events []entity_event.Event) {
+	for _, hook := range manager.hooks {
+		hook.OnEventsHandled(projection, height, events)
 	}
 }
 
@@ -144,3 +329,13 @@ func isListeningEvent(event entity_event.Event, eventsToListen []string) bool {
 func waitFor(wait time.Duration) <-chan time.Time {
 	return time.After(wait)
 }
+
+// waitOrDone waits for the duration and returns false if the context is done before that
+func waitOrDone(ctx context.Context, wait time.Duration) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-waitFor(wait):
+		return true
+	}
+}
@@ -1,6 +1,9 @@
 package projection_test
 
 import (
+	"context"
+	"errors"
+	"sync"
 	"time"
 
 	. "github.com/crypto-com/chain-indexing/entity/event/test"
@@ -44,8 +47,8 @@ var _ = Describe("StoreBasedManager", func() {
 			mockProjection := NewMockProjection()
 
 			// BlockEvent setup
-			anyEvent := newAnyEvent()
-			anyOtherEvent := newAnyOtherEvent()
+			anyEvent := newAnyEvent(1)
+			anyOtherEvent := newAnyOtherEvent(1)
 
 			// Projection setup
 			anyProjectionId := "ANY_PROJECTION_ID"
@@ -61,7 +64,7 @@ var _ = Describe("StoreBasedManager", func() {
 
 			// Produce event to the event store
 			nextHeight := int64(1)
-			mockEventStore.On("GetAllByHeight", nextHeight).Return(
+			mockEventStore.On("GetAllByHeightRange", nextHeight, nextHeight, []string{anyEvent.Name()}).Return(
 				[]entity_event.Event{anyEvent, anyOtherEvent}, nil,
 			)
 			mockEventStore.On("GetLatestHeight").Return(primptr.Int64(int64(1)), nil)
@@ -90,8 +93,8 @@ var _ = Describe("StoreBasedManager", func() {
 			mockProjection := NewMockProjection()
 
 			// BlockEvent setup
-			anyEvent := newAnyEvent()
-			anyOtherEvent := newAnyOtherEvent()
+			anyEvent := newAnyEvent(1)
+			anyOtherEvent := newAnyOtherEvent(2)
 
 			// Projection setup
 			anyProjectionId := "ANY_PROJECTION_ID"
@@ -107,12 +110,11 @@ var _ = Describe("StoreBasedManager", func() {
 
 			// Produce event to the event store
 			anyEventHeight := int64(1)
-			mockEventStore.On("GetAllByHeight", anyEventHeight).Return(
-				[]entity_event.Event{anyEvent}, nil,
-			)
 			anyOtherEventHeight := int64(2)
-			mockEventStore.On("GetAllByHeight", anyOtherEventHeight).Return(
-				[]entity_event.Event{anyOtherEvent}, nil,
+			mockEventStore.On(
+				"GetAllByHeightRange", anyEventHeight, anyOtherEventHeight, []string{anyEvent.Name(), anyOtherEvent.Name()},
+			).Return(
+				[]entity_event.Event{anyEvent, anyOtherEvent}, nil,
 			)
 			mockEventStore.On("GetLatestHeight").Return(primptr.Int64(int64(2)), nil)
 
@@ -146,8 +148,8 @@ var _ = Describe("StoreBasedManager", func() {
 			anyOtherProjection := NewMockProjection()
 
 			// BlockEvent setup
-			anyEvent := newAnyEvent()
-			anyOtherEvent := newAnyOtherEvent()
+			anyEvent := newAnyEvent(1)
+			anyOtherEvent := newAnyOtherEvent(1)
 
 			// Projection setup
 			anyProjectionId := "ANY_PROJECTION_ID"
@@ -172,7 +174,7 @@ var _ = Describe("StoreBasedManager", func() {
 
 			// Produce event to the event store
 			nextHeight := int64(1)
-			mockEventStore.On("GetAllByHeight", nextHeight).Return(
+			mockEventStore.On("GetAllByHeightRange", nextHeight, nextHeight, mock.Anything).Return(
 				[]entity_event.Event{anyEvent, anyOtherEvent}, nil,
 			)
 			mockEventStore.On("GetLatestHeight").Return(primptr.Int64(nextHeight), nil)
@@ -205,7 +207,7 @@ var _ = Describe("StoreBasedManager", func() {
 			mockProjection := NewMockProjection()
 
 			// BlockEvent setup
-			anyEvent := newAnyEvent()
+			anyEvent := newAnyEvent(2)
 
 			// Projection setup
 			anyProjectionId := "ANY_PROJECTION_ID"
@@ -221,7 +223,7 @@ var _ = Describe("StoreBasedManager", func() {
 
 			// Produce event to the event store
 			nextHeight := int64(2)
-			mockEventStore.On("GetAllByHeight", nextHeight).Return(
+			mockEventStore.On("GetAllByHeightRange", nextHeight, nextHeight, []string{anyEvent.Name()}).Return(
 				[]entity_event.Event{anyEvent}, nil,
 			)
 			mockEventStore.On("GetLatestHeight").Return(primptr.Int64(nextHeight), nil)
@@ -242,20 +244,256 @@ var _ = Describe("StoreBasedManager", func() {
 			mockProjection.AssertExpectations(GinkgoT())
 		})
 	})
+
+	Describe("Run", func() {
+		It("should finish the in-flight height and stop before the next height when context is done", func() {
+			mockEventStore := NewMockEventStore()
+			manager := projection.NewStoreBasedManager(NewFakeLogger(), mockEventStore)
+			mockProjection := NewMockProjection()
+
+			anyEvent := newAnyEvent(1)
+			anyOtherEvent := newAnyEvent(2)
+
+			mockProjection.On("Id").Return("ANY_PROJECTION_ID")
+			mockProjection.On("GetEventsToListen").Return([]string{anyEvent.Name()})
+			mockProjection.On("GetLastHandledEventHeight").Return(primptr.Int64(0), nil)
+
+			mockEventStore.On("GetLatestHeight").Return(primptr.Int64(int64(2)), nil)
+			mockEventStore.On("GetAllByHeightRange", int64(1), int64(2), []string{anyEvent.Name()}).Return(
+				[]entity_event.Event{anyEvent, anyOtherEvent}, nil,
+			)
+
+			handlingCh := make(chan struct{})
+			releaseCh := make(chan struct{})
+			mockProjection.On("HandleEvents", int64(1), []entity_event.Event{anyEvent}).Run(func(_ mock.Arguments) {
+				close(handlingCh)
+				<-releaseCh
+			}).Once().Return(nil)
+
+			Expect(manager.RegisterProjection(mockProjection)).To(Succeed())
+
+			ctx, cancel := context.WithCancel(context.Background())
+			doneCh := make(chan struct{})
+			go func() {
+				manager.Run(ctx)
+				close(doneCh)
+			}()
+
+			Eventually(handlingCh).Should(BeClosed())
+			cancel()
+			Consistently(doneCh, 100*time.Millisecond).ShouldNot(BeClosed())
+			close(releaseCh)
+			Eventually(doneCh).Should(BeClosed())
+
+			mockProjection.AssertExpectations(GinkgoT())
+			mockProjection.AssertNotCalled(GinkgoT(), "HandleEvents", int64(2), mock.Anything)
+		})
+	})
+
+	Describe("HandledEventsHook", func() {
+		It("should notify the hooks only of the heights handled after catching up", func() {
+			mockEventStore := NewMockEventStore()
+			manager := projection.NewStoreBasedManager(NewFakeLogger(), mockEventStore)
+			mockProjection := NewMockProjection()
+			hook := &recordingHook{}
+			manager.AddHandledEventsHook(hook)
+
+			anyEvent := newAnyEvent(1)
+			anyOtherEvent := newAnyEvent(2)
+
+			mockProjection.On("Id").Return("ANY_PROJECTION_ID")
+			mockProjection.On("GetEventsToListen").Return([]string{anyEvent.Name()})
+			mockProjection.On("GetLastHandledEventHeight").Return(primptr.Int64(0), nil)
+
+			mockEventStore.On("GetLatestHeight").Return(primptr.Int64(int64(1)), nil).Once()
+			mockEventStore.On("GetLatestHeight").Return(primptr.Int64(int64(2)), nil)
+			mockEventStore.On("GetAllByHeightRange", int64(1), int64(1), []string{anyEvent.Name()}).Return(
+				[]entity_event.Event{anyEvent}, nil,
+			)
+			mockEventStore.On("GetAllByHeightRange", int64(2), int64(2), []string{anyEvent.Name()}).Return(
+				[]entity_event.Event{anyOtherEvent}, nil,
+			)
+
+			mockProjection.On("HandleEvents", int64(1), mock.Anything).Once().Return(nil)
+			mockProjection.On("HandleEvents", int64(2), mock.Anything).Once().Return(nil)
+
+			Expect(manager.RegisterProjection(mockProjection)).To(Succeed())
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go manager.Run(ctx)
+
+			// The next height is polled after 5 seconds
+			Eventually(hook.Heights, 10*time.Second, 100*time.Millisecond).Should(Equal([]int64{2}))
+			Expect(hook.Events()).To(Equal([][]entity_event.Event{{anyOtherEvent}}))
+		})
+	})
+
+	Describe("CatchUp", func() {
+		It("should replay events from the next height up to the latest event height and return", func() {
+			mockEventStore := NewMockEventStore()
+			manager := projection.NewStoreBasedManager(NewFakeLogger(), mockEventStore)
+			mockProjection := NewMockProjection()
+
+			anyEvent := newAnyEvent(2)
+
+			mockProjection.On("Id").Return("ANY_PROJECTION_ID")
+			mockProjection.On("GetEventsToListen").Return([]string{anyEvent.Name()})
+			mockProjection.On("GetLastHandledEventHeight").Return(primptr.Int64(int64(1)), nil)
+
+			mockEventStore.On("GetLatestHeight").Return(primptr.Int64(int64(3)), nil)
+			mockEventStore.On("GetAllByHeightRange", int64(2), int64(3), []string{anyEvent.Name()}).Return(
+				[]entity_event.Event{anyEvent}, nil,
+			)
+
+			mockProjection.On("HandleEvents", int64(2), mock.MatchedBy(func(events interface{}) bool {
+				typedEvents, _ := events.([]entity_event.Event)
+				return len(typedEvents) == 1
+			})).Once().Return(nil)
+			mockProjection.On("HandleEvents", int64(3), mock.MatchedBy(func(events interface{}) bool {
+				typedEvents, _ := events.([]entity_event.Event)
+				return len(typedEvents) == 0
+			})).Once().Return(nil)
+
+			lastHandledEventHeight, err := manager.CatchUp(mockProjection)
+			Expect(err).To(BeNil())
+			Expect(lastHandledEventHeight).To(Equal(primptr.Int64(int64(3))))
+
+			mockProjection.AssertExpectations(GinkgoT())
+		})
+
+		It("should return error when the projection fails to handle events", func() {
+			mockEventStore := NewMockEventStore()
+			manager := projection.NewStoreBasedManager(NewFakeLogger(), mockEventStore)
+			mockProjection := NewMockProjection()
+
+			anyEvent := newAnyEvent(0)
+			anyOtherEvent := newAnyEvent(1)
+
+			mockProjection.On("Id").Return("ANY_PROJECTION_ID")
+			mockProjection.On("GetEventsToListen").Return([]string{anyEvent.Name()})
+			mockProjection.On("GetLastHandledEventHeight").Return(primptr.Int64Nil(), nil)
+
+			mockEventStore.On("GetLatestHeight").Return(primptr.Int64(int64(1)), nil)
+			mockEventStore.On("GetAllByHeightRange", int64(0), int64(1), []string{anyEvent.Name()}).Return(
+				[]entity_event.Event{anyEvent, anyOtherEvent}, nil,
+			)
+
+			mockProjection.On("HandleEvents", int64(0), mock.Anything).Once().Return(nil)
+			mockProjection.On("HandleEvents", int64(1), mock.Anything).Once().Return(errors.New("any error"))
+
+			lastHandledEventHeight, err := manager.CatchUp(mockProjection)
+			Expect(err).NotTo(BeNil())
+			Expect(lastHandledEventHeight).To(Equal(primptr.Int64(int64(0))))
+		})
+
+		It("should not notify the handled events hooks", func() {
+			mockEventStore := NewMockEventStore()
+			manager := projection.NewStoreBasedManager(NewFakeLogger(), mockEventStore)
+			mockProjection := NewMockProjection()
+			hook := &recordingHook{}
+			manager.AddHandledEventsHook(hook)
+
+			anyEvent := newAnyEvent(1)
+
+			mockProjection.On("Id").Return("ANY_PROJECTION_ID")
+			mockProjection.On("GetEventsToListen").Return([]string{anyEvent.Name()})
+			mockProjection.On("GetLastHandledEventHeight").Return(primptr.Int64(int64(0)), nil)
+
+			mockEventStore.On("GetLatestHeight").Return(primptr.Int64(int64(2)), nil)
+			mockEventStore.On("GetAllByHeightRange", int64(1), int64(2), []string{anyEvent.Name()}).Return(
+				[]entity_event.Event{anyEvent}, nil,
+			)
+
+			mockProjection.On("HandleEvents", int64(1), mock.Anything).Once().Return(nil)
+			mockProjection.On("HandleEvents", int64(2), mock.Anything).Once().Return(nil)
+
+			_, err := manager.CatchUp(mockProjection)
+			Expect(err).To(BeNil())
+
+			Expect(hook.Heights()).To(BeEmpty())
+		})
+
+		It("should pass events of multiple heights at once to batch projection", func() {
+			mockEventStore := NewMockEventStore()
+			manager := projection.NewStoreBasedManagerWithBatchSize(NewFakeLogger(), mockEventStore, 2)
+			mockProjection := NewMockBatchProjection()
+
+			anyEvent := newAnyEvent(1)
+			anyOtherEvent := newAnyEvent(3)
+
+			mockProjection.On("Id").Return("ANY_PROJECTION_ID")
+			mockProjection.On("GetEventsToListen").Return([]string{anyEvent.Name()})
+			mockProjection.On("GetLastHandledEventHeight").Return(primptr.Int64Nil(), nil)
+
+			mockEventStore.On("GetLatestHeight").Return(primptr.Int64(int64(3)), nil)
+			mockEventStore.On("GetAllByHeightRange", int64(0), int64(1), []string{anyEvent.Name()}).Return(
+				[]entity_event.Event{anyEvent}, nil,
+			)
+			mockEventStore.On("GetAllByHeightRange", int64(2), int64(3), []string{anyEvent.Name()}).Return(
+				[]entity_event.Event{anyOtherEvent}, nil,
+			)
+
+			mockProjection.On("HandleEventsBatch", []projection.EventsAtHeight{
+				{Height: 0, Events: []entity_event.Event{}},
+				{Height: 1, Events: []entity_event.Event{anyEvent}},
+			}).Once().Return(nil)
+			mockProjection.On("HandleEventsBatch", []projection.EventsAtHeight{
+				{Height: 2, Events: []entity_event.Event{}},
+				{Height: 3, Events: []entity_event.Event{anyOtherEvent}},
+			}).Once().Return(nil)
+
+			lastHandledEventHeight, err := manager.CatchUp(mockProjection)
+			Expect(err).To(BeNil())
+			Expect(lastHandledEventHeight).To(Equal(primptr.Int64(int64(3))))
+
+			mockProjection.AssertExpectations(GinkgoT())
+		})
+	})
 })
 
-func newAnyEvent() entity_event.Event {
+func newAnyEvent(height int64) entity_event.Event {
 	anyEventName := "ANY_EVENT"
 	anyEvent := NewMockEvent()
 	anyEvent.On("Name").Return(anyEventName)
+	anyEvent.On("Height").Return(height)
 
 	return anyEvent
 }
 
-func newAnyOtherEvent() entity_event.Event {
+func newAnyOtherEvent(height int64) entity_event.Event {
 	anyOtherEventName := "ANY_OTHER_EVENT"
 	anyOtherEvent := NewMockEvent()
 	anyOtherEvent.On("Name").Return(anyOtherEventName)
+	anyOtherEvent.On("Height").Return(height)
 
 	return anyOtherEvent
 }
+
+type recordingHook struct {
+	mutex   sync.Mutex
+	heights []int64
+	events  [][]entity_event.Event
+}
+
+func (hook *recordingHook) OnEventsHandled(_ projection.Projection, height int64, events []entity_event.Event) {
+	hook.mutex.Lock()
+	defer hook.mutex.Unlock()
+
+	hook.heights = append(hook.heights, height)
+	hook.events = append(hook.events, events)
+}
+
+func (hook *recordingHook) Heights() []int64 {
+	hook.mutex.Lock()
+	defer hook.mutex.Unlock()
+
+	return append([]int64{}, hook.heights...)
+}
+
+func (hook *recordingHook) Events() [][]entity_event.Event {
+	hook.mutex.Lock()
+	defer hook.mutex.Unlock()
+
+	return append([][]entity_event.Event{}, hook.events...)
+}
@@ -26,3 +26,28 @@ type Projection interface {
 	// projection. It is also responsible to update the last handled event height.
 	HandleEvents(height int64, events []entity_event.Event) error
 }
+
+// BatchProjection is an optional interface of Projection. During replay, the manager passes
+// events of consecutive heights at once to projections implementing it, so that they can be
+// handled in a single database transaction.
+type BatchProjection interface {
+	Projection
+
+	// Handle events of consecutive heights in ascending order. Every height in the batch is present
+	// even when it has no listening event. It is also responsible to update the last handled event
+	// height to the last height of the batch.
+	HandleEventsBatch(batch []EventsAtHeight) error
+}
+
+// HandledEventsHook is notified after a projection has handled and committed the events of a
+// height, e.g. to push the changes to the clients. It is called on the goroutine running the
+// projection, so it must not block.
+type HandledEventsHook interface {
+	OnEventsHandled(projection Projection, height int64, events []entity_event.Event)
+}
+
+// EventsAtHeight are all the listening events of a projection at the same height
+type EventsAtHeight struct {
+	Height int64
+	Events []entity_event.Event
+}
@@ -0,0 +1,19 @@
+package test
+
+import (
+	entity_projection "github.com/crypto-com/chain-indexing/entity/projection"
+)
+
+type MockBatchProjection struct {
+	MockProjection
+}
+
+func NewMockBatchProjection() *MockBatchProjection {
+	return &MockBatchProjection{}
+}
+
+func (projection *MockBatchProjection) HandleEventsBatch(batch []entity_projection.EventsAtHeight) error {
+	mockArgs := projection.Called(batch)
+
+	return mockArgs.Error(0)
+}
@@ -17,10 +17,13 @@ import (
 	jsoniter "github.com/json-iterator/go"
 
 	cosmosapp_interface "github.com/crypto-com/chain-indexing/appinterface/cosmosapp"
+	"github.com/crypto-com/chain-indexing/infrastructure/metrics"
 )
 
 var _ cosmosapp_interface.Client = &HTTPClient{}
 
+const METRICS_CLIENT_LABEL = "cosmosapp"
+
 const ERR_CODE_ACCOUNT_NOT_FOUND = 2
 const ERR_CODE_ACCOUNT_NO_DELEGATION = 5
 
@@ -705,6 +708,10 @@ func (client *HTTPClient) request(method string, queryString ...string) (io.Read
 	if err != nil {
 		return nil, fmt.Errorf("error creating HTTP request with context: %v", err)
 	}
+	startTime := time.Now()
+	defer func() {
+		metrics.ObserveRPCRequest(METRICS_CLIENT_LABEL, metricsMethodLabel(method), startTime, err)
+	}()
 	rawResp, err := client.httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("error requesting Cosmos %s endpoint: %v", queryUrl, err)
@@ -712,7 +719,8 @@ func (client *HTTPClient) request(method string, queryString ...string) (io.Read
 
 	if rawResp.StatusCode != 200 {
 		rawResp.Body.Close()
-		return nil, fmt.Errorf("error requesting Cosmos %s endpoint: %s", method, rawResp.Status)
+		err = fmt.Errorf("error requesting Cosmos %s endpoint: %s", method, rawResp.Status)
+		return nil, err
 	}
 
 	return rawResp.Body, nil
@@ -732,6 +740,10 @@ func (client *HTTPClient) rawRequest(method string, queryString ...string) (io.R
 	if err != nil {
 		return nil, 0, fmt.Errorf("error creating HTTP request with context: %v", err)
 	}
+	startTime := time.Now()
+	defer func() {
+		metrics.ObserveRPCRequest(METRICS_CLIENT_LABEL, metricsMethodLabel(method), startTime, err)
+	}()
 	// nolint:bodyclose
 	rawResp, err := client.httpClient.Do(req)
 	if err != nil {
@@ -741,6 +753,16 @@ func (client *HTTPClient) rawRequest(method string, queryString ...string) (io.R
 	return rawResp.Body, rawResp.StatusCode, nil
 }
 
+// metricsMethodLabel keeps the module and resource of the method path, e.g.
+// cosmos/bank/v1beta1/balances, so that addresses in the path do not become metric labels
+func metricsMethodLabel(method string) string {
+	segments := strings.SplitN(method, "/", 5)
+	if len(segments) > 4 {
+		segments = segments[:4]
+	}
+	return strings.Join(segments, "/")
+}
+
 type Pagination struct {
 	MaybeNextKey *string `json:"next_key"`
 	Total        string  `json:"total"`
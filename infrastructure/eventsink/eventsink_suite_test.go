@@ -0,0 +1,13 @@
+package eventsink_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestEventSink(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Event Sink Suite")
+}
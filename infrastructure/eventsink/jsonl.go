@@ -0,0 +1,125 @@
+package eventsink
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	jsoniter "github.com/json-iterator/go"
+
+	event_interface "github.com/crypto-com/chain-indexing/appinterface/event"
+	"github.com/crypto-com/chain-indexing/appinterface/eventpublisher"
+)
+
+var _ eventpublisher.Sink = &JSONLSink{}
+
+// JSONLSink writes every event as a line of JSON, i.e. JSON Lines, to a writer such as stdout or an
+// appended file
+type JSONLSink struct {
+	out       io.Writer
+	writer    *bufio.Writer
+	maybeFile *os.File
+}
+
+// NewJSONLSink creates a sink writing to the writer, e.g. os.Stdout. The writer is not closed.
+func NewJSONLSink(out io.Writer) *JSONLSink {
+	return &JSONLSink{
+		out:       out,
+		writer:    bufio.NewWriter(out),
+		maybeFile: nil,
+	}
+}
+
+// NewJSONLFileSink creates a sink appending to the file. Every flush is synced to the disk.
+func NewJSONLFileSink(path string) (*JSONLSink, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("error opening JSONL file: %v", err)
+	}
+
+	return &JSONLSink{
+		out:       file,
+		writer:    bufio.NewWriter(file),
+		maybeFile: file,
+	}, nil
+}
+
+func (sink *JSONLSink) Publish(event event_interface.RawEvent) error {
+	return sink.writeLine(JSONLEvent{
+		Id:      event.Id,
+		UUID:    event.UUID,
+		Height:  event.Height,
+		Name:    event.Name,
+		Version: event.Version,
+		Payload: json.RawMessage(event.Payload),
+	})
+}
+
+// PublishRollback writes a line with the name Rollback, id 0 and the height rolled back to
+func (sink *JSONLSink) PublishRollback(height int64) error {
+	return sink.writeLine(JSONLEvent{
+		Id:      0,
+		UUID:    "",
+		Height:  height,
+		Name:    eventpublisher.ROLLBACK_MARKER_NAME,
+		Version: 1,
+		Payload: json.RawMessage(fmt.Sprintf(`{"height":%d}`, height)),
+	})
+}
+
+func (sink *JSONLSink) writeLine(event JSONLEvent) error {
+	line, err := jsoniter.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("error encoding event line: %v", err)
+	}
+
+	if _, err = sink.writer.Write(line); err != nil {
+		return sink.reset(err)
+	}
+	if err = sink.writer.WriteByte('\n'); err != nil {
+		return sink.reset(err)
+	}
+	return nil
+}
+
+func (sink *JSONLSink) Flush() error {
+	if err := sink.writer.Flush(); err != nil {
+		return sink.reset(err)
+	}
+	if sink.maybeFile != nil {
+		if err := sink.maybeFile.Sync(); err != nil {
+			return sink.reset(err)
+		}
+	}
+	return nil
+}
+
+func (sink *JSONLSink) Close() error {
+	if err := sink.Flush(); err != nil {
+		return err
+	}
+	if sink.maybeFile != nil {
+		return sink.maybeFile.Close()
+	}
+	return nil
+}
+
+// reset discards the buffered lines after a write error, so that the events since the last flush
+// can be published again
+func (sink *JSONLSink) reset(err error) error {
+	sink.writer.Reset(sink.out)
+	return fmt.Errorf("error writing JSONL: %v", err)
+}
+
+// JSONLEvent is a line written by JSONLSink
+type JSONLEvent struct {
+	Id      int64  `json:"id"`
+	UUID    string `json:"uuid"`
+	Height  int64  `json:"height"`
+	Name    string `json:"name"`
+	Version int    `json:"version"`
+	// Payload is the event JSON encoded by the event's ToJSON()
+	Payload json.RawMessage `json:"payload"`
+}
@@ -0,0 +1,67 @@
+package eventsink_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	event_interface "github.com/crypto-com/chain-indexing/appinterface/event"
+	"github.com/crypto-com/chain-indexing/infrastructure/eventsink"
+)
+
+var _ = Describe("JSONLSink", func() {
+	anyEvent := event_interface.RawEvent{
+		Id:      1,
+		UUID:    "2a1e3a5c-7d83-4b5e-9d0b-8f1a6c3f0e11",
+		Height:  10,
+		Name:    "BlockCreated",
+		Version: 1,
+		Payload: `{"name":"BlockCreated","height":10}`,
+	}
+	anyEventLine := `{"id":1,"uuid":"2a1e3a5c-7d83-4b5e-9d0b-8f1a6c3f0e11","height":10,"name":"BlockCreated",` +
+		`"version":1,"payload":{"name":"BlockCreated","height":10}}` + "\n"
+
+	It("should write the events as JSON lines on flush", func() {
+		var out bytes.Buffer
+		sink := eventsink.NewJSONLSink(&out)
+
+		Expect(sink.Publish(anyEvent)).To(Succeed())
+		Expect(out.String()).To(BeEmpty())
+
+		Expect(sink.Flush()).To(Succeed())
+		Expect(out.String()).To(Equal(anyEventLine))
+	})
+
+	It("should write the rollback marker as a JSON line", func() {
+		var out bytes.Buffer
+		sink := eventsink.NewJSONLSink(&out)
+
+		Expect(sink.PublishRollback(int64(9))).To(Succeed())
+		Expect(sink.Flush()).To(Succeed())
+		Expect(out.String()).To(Equal(
+			`{"id":0,"uuid":"","height":9,"name":"Rollback","version":1,"payload":{"height":9}}` + "\n",
+		))
+	})
+
+	It("should append the events to the file", func() {
+		dir, err := ioutil.TempDir("", "jsonlsink")
+		Expect(err).To(BeNil())
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "events.jsonl")
+
+		for i := 0; i < 2; i++ {
+			sink, err := eventsink.NewJSONLFileSink(path)
+			Expect(err).To(BeNil())
+			Expect(sink.Publish(anyEvent)).To(Succeed())
+			Expect(sink.Close()).To(Succeed())
+		}
+
+		content, err := ioutil.ReadFile(path)
+		Expect(err).To(BeNil())
+		Expect(string(content)).To(Equal(anyEventLine + anyEventLine))
+	})
+})
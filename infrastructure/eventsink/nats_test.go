@@ -0,0 +1,301 @@
+package eventsink_test
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	natsserver_test "github.com/nats-io/nats-server/v2/test"
+	"github.com/nats-io/nats.go"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	event_interface "github.com/crypto-com/chain-indexing/appinterface/event"
+	"github.com/crypto-com/chain-indexing/appinterface/eventpublisher"
+	"github.com/crypto-com/chain-indexing/infrastructure/eventsink"
+	. "github.com/crypto-com/chain-indexing/internal/logger/test"
+	"github.com/crypto-com/chain-indexing/internal/primptr"
+)
+
+var _ = Describe("NATSSink", func() {
+	var natsServer *server.Server
+	var natsServerOptions server.Options
+
+	BeforeEach(func() {
+		natsServerOptions = server.Options{
+			Host:       "127.0.0.1",
+			Port:       server.RANDOM_PORT,
+			NoLog:      true,
+			NoSigs:     true,
+			MaxPayload: 1024,
+		}
+		natsServer = runNATSServer(natsServerOptions)
+	})
+
+	AfterEach(func() {
+		natsServer.Shutdown()
+	})
+
+	newEvent := func(id int64, height int64) event_interface.RawEvent {
+		return event_interface.RawEvent{
+			Id:      id,
+			UUID:    fmt.Sprintf("uuid-%d", id),
+			Height:  height,
+			Name:    "BlockCreated",
+			Version: 1,
+			Payload: fmt.Sprintf(`{"height":%d}`, height),
+		}
+	}
+
+	newSink := func(url string) *eventsink.NATSSink {
+		sink, err := eventsink.NewNATSSink(eventsink.NATSSinkConfig{
+			URL:     url,
+			Timeout: time.Second,
+		})
+		Expect(err).To(BeNil())
+		return sink
+	}
+
+	// subscribe subscribes to the subject with a client reconnecting to the restarted server
+	subscribe := func(subject string, options ...nats.Option) (*nats.Conn, *nats.Subscription) {
+		options = append(options, nats.ReconnectWait(10*time.Millisecond), nats.MaxReconnects(-1))
+		conn, err := nats.Connect(natsServer.ClientURL(), options...)
+		Expect(err).To(BeNil())
+		subscription, err := conn.SubscribeSync(subject)
+		Expect(err).To(BeNil())
+		Expect(conn.Flush()).To(Succeed())
+		return conn, subscription
+	}
+
+	// stopNATSServer shuts down the server and returns the function starting it again on the same port
+	stopNATSServer := func() (restart func()) {
+		port := natsServer.Addr().(*net.TCPAddr).Port
+		natsServer.Shutdown()
+		return func() {
+			natsServerOptions.Port = port
+			natsServer = runNATSServer(natsServerOptions)
+		}
+	}
+
+	waitForReconnect := func(conn *nats.Conn) {
+		Eventually(conn.IsConnected, 5*time.Second).Should(BeTrue())
+		Expect(conn.Flush()).To(Succeed())
+	}
+
+	It("should publish the events with headers to the subject of the event name", func() {
+		conn, subscription := subscribe("chainindex.events.>")
+		defer conn.Close()
+		sink := newSink(natsServer.ClientURL())
+
+		Expect(sink.Publish(newEvent(1, 10))).To(Succeed())
+		Expect(sink.Publish(newEvent(2, 11))).To(Succeed())
+		Expect(sink.Flush()).To(Succeed())
+
+		message, err := subscription.NextMsg(time.Second)
+		Expect(err).To(BeNil())
+		Expect(message.Subject).To(Equal("chainindex.events.BlockCreated"))
+		Expect(message.Header).To(Equal(nats.Header{
+			"Nats-Msg-Id":   []string{"uuid-1"},
+			"Event-Id":      []string{"1"},
+			"Event-Name":    []string{"BlockCreated"},
+			"Event-Version": []string{"1"},
+			"Event-Height":  []string{"10"},
+		}))
+		Expect(string(message.Data)).To(Equal(`{"height":10}`))
+
+		message, err = subscription.NextMsg(time.Second)
+		Expect(err).To(BeNil())
+		Expect(string(message.Data)).To(Equal(`{"height":11}`))
+
+		Expect(sink.Close()).To(Succeed())
+	})
+
+	It("should publish the rollback marker with its height", func() {
+		conn, subscription := subscribe("chainindex.events.>")
+		defer conn.Close()
+		sink := newSink(natsServer.ClientURL())
+
+		Expect(sink.PublishRollback(int64(9))).To(Succeed())
+		Expect(sink.Flush()).To(Succeed())
+
+		message, err := subscription.NextMsg(time.Second)
+		Expect(err).To(BeNil())
+		Expect(message.Subject).To(Equal("chainindex.events.Rollback"))
+		Expect(message.Header).To(Equal(nats.Header{
+			"Event-Name":   []string{"Rollback"},
+			"Event-Height": []string{"9"},
+		}))
+		Expect(string(message.Data)).To(Equal(`{"height":9}`))
+	})
+
+	It("should connect with the credentials in the URL", func() {
+		natsServer.Shutdown()
+		natsServerOptions.Username = "alice"
+		natsServerOptions.Password = "secret"
+		natsServer = runNATSServer(natsServerOptions)
+		address := natsServer.Addr().String()
+
+		conn, subscription := subscribe("indexer.>", nats.UserInfo("alice", "secret"))
+		defer conn.Close()
+
+		sink, err := eventsink.NewNATSSink(eventsink.NATSSinkConfig{
+			URL:           "nats://alice:secret@" + address,
+			SubjectPrefix: "indexer",
+		})
+		Expect(err).To(BeNil())
+		Expect(sink.Publish(newEvent(1, 10))).To(Succeed())
+		Expect(sink.Flush()).To(Succeed())
+
+		message, err := subscription.NextMsg(time.Second)
+		Expect(err).To(BeNil())
+		Expect(message.Subject).To(Equal("indexer.BlockCreated"))
+
+		unauthorizedSink := newSink("nats://alice:wrong@" + address)
+		Expect(unauthorizedSink.Publish(newEvent(1, 10))).NotTo(Succeed())
+	})
+
+	It("should reconnect after the server restarts", func() {
+		conn, subscription := subscribe("chainindex.events.>")
+		defer conn.Close()
+		sink := newSink(natsServer.ClientURL())
+
+		Expect(sink.Publish(newEvent(1, 10))).To(Succeed())
+		Expect(sink.Flush()).To(Succeed())
+
+		restartNATSServer := stopNATSServer()
+		err := sink.Publish(newEvent(2, 11))
+		if err == nil {
+			err = sink.Flush()
+		}
+		Expect(err).NotTo(BeNil())
+
+		restartNATSServer()
+		waitForReconnect(conn)
+		Expect(sink.Publish(newEvent(2, 11))).To(Succeed())
+		Expect(sink.Flush()).To(Succeed())
+
+		for _, expectedId := range []string{"1", "2"} {
+			message, err := subscription.NextMsg(time.Second)
+			Expect(err).To(BeNil())
+			Expect(message.Header.Get("Event-Id")).To(Equal(expectedId))
+		}
+	})
+
+	It("should reject the event exceeding the max payload of the server with ErrEventTooLarge", func() {
+		conn, subscription := subscribe("chainindex.events.>")
+		defer conn.Close()
+		sink := newSink(natsServer.ClientURL())
+
+		largeEvent := newEvent(1, 10)
+		largeEvent.Payload = `"` + strings.Repeat("a", 1024) + `"`
+		err := sink.Publish(largeEvent)
+		Expect(errors.Is(err, eventpublisher.ErrEventTooLarge)).To(BeTrue())
+
+		// The connection is kept for the following events
+		Expect(sink.Publish(newEvent(2, 10))).To(Succeed())
+		Expect(sink.Flush()).To(Succeed())
+		message, err := subscription.NextMsg(time.Second)
+		Expect(err).To(BeNil())
+		Expect(message.Header.Get("Event-Id")).To(Equal("2"))
+	})
+
+	It("should return error when the server does not support headers", func() {
+		legacyServerOptions := natsServerOptions
+		legacyServerOptions.NoHeaderSupport = true
+		legacyServer := runNATSServer(legacyServerOptions)
+		defer legacyServer.Shutdown()
+
+		sink := newSink(legacyServer.ClientURL())
+		Expect(sink.Publish(newEvent(1, 10))).NotTo(Succeed())
+	})
+
+	It("should return error when the URL is not a NATS URL", func() {
+		_, err := eventsink.NewNATSSink(eventsink.NATSSinkConfig{
+			URL: "http://localhost:4222",
+		})
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("should deliver all the events in height order with the publisher across a server restart", func() {
+		conn, subscription := subscribe("chainindex.events.>")
+		defer conn.Close()
+		sink := newSink(natsServer.ClientURL())
+
+		events := make([]event_interface.RawEvent, 0)
+		for id := int64(1); id <= 5; id++ {
+			events = append(events, newEvent(id, 10+(id-1)/2))
+		}
+		cursorStore := &inMemoryCursorStore{}
+		publisher := eventpublisher.NewPublisher(
+			NewFakeLogger(), "nats", &inMemoryEventSource{events}, cursorStore, sink,
+			eventpublisher.PublisherConfig{
+				BatchSize: 2,
+			},
+		)
+
+		_, err := publisher.PublishNext()
+		Expect(err).To(BeNil())
+
+		restartNATSServer := stopNATSServer()
+		_, err = publisher.PublishNext()
+		Expect(err).NotTo(BeNil())
+		Expect(cursorStore.maybeLastPublishedEventId).To(Equal(primptr.Int64(2)))
+
+		restartNATSServer()
+		waitForReconnect(conn)
+		for i := 0; i < 10 && *cursorStore.maybeLastPublishedEventId != 5; i++ {
+			_, _ = publisher.PublishNext()
+		}
+		Expect(cursorStore.maybeLastPublishedEventId).To(Equal(primptr.Int64(5)))
+
+		receivedIds := make([]string, 0)
+		for range events {
+			message, err := subscription.NextMsg(time.Second)
+			Expect(err).To(BeNil())
+			receivedIds = append(receivedIds, message.Header.Get("Event-Id"))
+		}
+		Expect(receivedIds).To(Equal([]string{"1", "2", "3", "4", "5"}))
+	})
+})
+
+func runNATSServer(options server.Options) *server.Server {
+	return natsserver_test.RunServer(&options)
+}
+
+type inMemoryEventSource struct {
+	events []event_interface.RawEvent
+}
+
+func (source *inMemoryEventSource) GetAllRawAfterId(afterId int64, limit uint64) ([]event_interface.RawEvent, error) {
+	events := make([]event_interface.RawEvent, 0)
+	for _, event := range source.events {
+		if event.Id > afterId && uint64(len(events)) < limit {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+type inMemoryCursorStore struct {
+	maybeLastPublishedEventId *int64
+}
+
+func (store *inMemoryCursorStore) GetLastPublishedEventId(_ string) (*int64, error) {
+	return store.maybeLastPublishedEventId, nil
+}
+
+func (store *inMemoryCursorStore) UpdateLastPublishedEventId(_ string, eventId int64) error {
+	store.maybeLastPublishedEventId = &eventId
+	return nil
+}
+
+func (store *inMemoryCursorStore) GetPendingRollbackHeight(_ string) (*int64, error) {
+	return nil, nil
+}
+
+func (store *inMemoryCursorStore) ClearPendingRollbackHeight(_ string, _ int64) error {
+	return nil
+}
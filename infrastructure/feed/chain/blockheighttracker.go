@@ -1,81 +1,66 @@
 package chain
 
 import (
-	"sync"
+	"context"
 	"time"
 
-	"github.com/crypto-com/chain-indexing/internal/primptr"
-
 	"github.com/crypto-com/chain-indexing/appinterface/tendermint"
 	applogger "github.com/crypto-com/chain-indexing/internal/logger"
 )
 
 const DEFAULT_POLLING_INTERVAL = 5 * time.Second
 
+var _ BlockHeightFeed = &BlockHeightTracker{}
+
 type BlockHeightTracker struct {
+	*blockHeightPublisher
+
 	logger applogger.Logger
 	client tendermint.Client
 
 	pollingInterval time.Duration
-
-	subscriptions []chan<- int64
-
-	latestBlockHeight *int64
-	rwMutex           sync.RWMutex
 }
 
-func NewBlockHeightTracker(logger applogger.Logger, client tendermint.Client) *BlockHeightTracker {
+// NewBlockHeightTracker creates a tracker polling the latest block height until the context is done
+func NewBlockHeightTracker(
+	ctx context.Context,
+	logger applogger.Logger,
+	client tendermint.Client,
+) *BlockHeightTracker {
+	trackerLogger := logger.WithFields(applogger.LogFields{
+		"module": "BlockHeightTracker",
+	})
 	tracker := &BlockHeightTracker{
-		logger: logger.WithFields(applogger.LogFields{
-			"module": "BlockHeightTracker",
-		}),
+		blockHeightPublisher: newBlockHeightPublisher(trackerLogger),
+
+		logger: trackerLogger,
 		client: client,
 
 		pollingInterval: DEFAULT_POLLING_INTERVAL,
-
-		subscriptions: make([]chan<- int64, 0),
-
-		latestBlockHeight: primptr.Int64Nil(),
 	}
 
-	go tracker.Run()
+	go tracker.Run(ctx)
 
 	return tracker
 }
 
-func (tracker *BlockHeightTracker) Run() {
+// Run polls the latest block height until the context is done
+func (tracker *BlockHeightTracker) Run(ctx context.Context) {
 	for {
+		interval := tracker.pollingInterval
 		height, err := tracker.client.LatestBlockHeight()
 		if err != nil {
 			tracker.logger.Errorf("error getting chain latest block height: %v", err)
-			<-time.After(1 * time.Second)
-			continue
+			interval = 1 * time.Second
+		} else {
+			tracker.Publish(height)
+			tracker.logger.Infof("updated chain latest block height: %d", height)
 		}
 
-		for _, subscription := range tracker.subscriptions {
-			select {
-			case subscription <- height:
-			default:
-				tracker.logger.Info("block subscription channel is blocked, maybe busy?")
-			}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
 		}
-
-		tracker.rwMutex.Lock()
-		tracker.latestBlockHeight = &height
-		tracker.rwMutex.Unlock()
-
-		tracker.logger.Infof("updated chain latest block height: %d", height)
-		<-time.After(tracker.pollingInterval)
 	}
 }
-
-func (tracker *BlockHeightTracker) Subscribe(ch chan<- int64) {
-	tracker.subscriptions = append(tracker.subscriptions, ch)
-}
-
-func (tracker *BlockHeightTracker) GetLatestBlockHeight() *int64 {
-	tracker.rwMutex.RLock()
-	defer tracker.rwMutex.RUnlock()
-
-	return tracker.latestBlockHeight
-}
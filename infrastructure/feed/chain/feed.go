@@ -0,0 +1,66 @@
+package chain
+
+import (
+	"sync"
+
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	"github.com/crypto-com/chain-indexing/internal/primptr"
+)
+
+// BlockHeightFeed keeps track of chain latest block height and publishes new heights to the
+// subscribed channels
+type BlockHeightFeed interface {
+	Subscribe(ch chan<- int64)
+
+	// GetLatestBlockHeight returns the last known chain latest block height, nil when unknown
+	GetLatestBlockHeight() *int64
+}
+
+// blockHeightPublisher keeps the latest block height and notifies subscriptions without blocking
+type blockHeightPublisher struct {
+	logger applogger.Logger
+
+	subscriptions []chan<- int64
+
+	latestBlockHeight *int64
+	rwMutex           sync.RWMutex
+}
+
+func newBlockHeightPublisher(logger applogger.Logger) *blockHeightPublisher {
+	return &blockHeightPublisher{
+		logger: logger,
+
+		subscriptions: make([]chan<- int64, 0),
+
+		latestBlockHeight: primptr.Int64Nil(),
+	}
+}
+
+func (publisher *blockHeightPublisher) Publish(height int64) {
+	publisher.rwMutex.Lock()
+	subscriptions := publisher.subscriptions
+	publisher.latestBlockHeight = &height
+	publisher.rwMutex.Unlock()
+
+	for _, subscription := range subscriptions {
+		select {
+		case subscription <- height:
+		default:
+			publisher.logger.Info("block subscription channel is blocked, maybe busy?")
+		}
+	}
+}
+
+func (publisher *blockHeightPublisher) Subscribe(ch chan<- int64) {
+	publisher.rwMutex.Lock()
+	defer publisher.rwMutex.Unlock()
+
+	publisher.subscriptions = append(publisher.subscriptions, ch)
+}
+
+func (publisher *blockHeightPublisher) GetLatestBlockHeight() *int64 {
+	publisher.rwMutex.RLock()
+	defer publisher.rwMutex.RUnlock()
+
+	return publisher.latestBlockHeight
+}
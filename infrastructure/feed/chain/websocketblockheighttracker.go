@@ -0,0 +1,269 @@
+package chain
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/crypto-com/chain-indexing/appinterface/tendermint"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+)
+
+const NEW_BLOCK_SUBSCRIPTION_QUERY = "tm.event='NewBlock'"
+
+const DEFAULT_WEBSOCKET_PING_INTERVAL = 20 * time.Second
+const DEFAULT_WEBSOCKET_READ_TIMEOUT = 60 * time.Second
+
+var _ BlockHeightFeed = &WebSocketBlockHeightTracker{}
+
+// WebSocketBlockHeightTracker subscribes to Tendermint `NewBlock` events through the `/websocket`
+// endpoint. When the connection cannot be established or is lost, it falls back to polling the
+// latest block height in between reconnection attempts. It stops when the context is done.
+type WebSocketBlockHeightTracker struct {
+	*blockHeightPublisher
+
+	logger applogger.Logger
+	client tendermint.Client
+	dialer *websocket.Dialer
+
+	url               string
+	reconnectInterval time.Duration
+	pingInterval      time.Duration
+	readTimeout       time.Duration
+}
+
+type WebSocketBlockHeightTrackerConfig struct {
+	// Tendermint websocket endpoint. e.g. ws://127.0.0.1:26657/websocket
+	URL      string
+	Insecure bool
+
+	// Interval between reconnection attempts, the latest block height is polled once on every
+	// attempt. Default to DEFAULT_POLLING_INTERVAL.
+	ReconnectInterval time.Duration
+}
+
+func NewWebSocketBlockHeightTracker(
+	ctx context.Context,
+	logger applogger.Logger,
+	client tendermint.Client,
+	config WebSocketBlockHeightTrackerConfig,
+) *WebSocketBlockHeightTracker {
+	trackerLogger := logger.WithFields(applogger.LogFields{
+		"module": "WebSocketBlockHeightTracker",
+	})
+
+	dialer := &websocket.Dialer{
+		HandshakeTimeout: 30 * time.Second,
+	}
+	if config.Insecure {
+		// nolint:gosec
+		dialer.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
+	reconnectInterval := config.ReconnectInterval
+	if reconnectInterval == 0 {
+		reconnectInterval = DEFAULT_POLLING_INTERVAL
+	}
+
+	tracker := &WebSocketBlockHeightTracker{
+		blockHeightPublisher: newBlockHeightPublisher(trackerLogger),
+
+		logger: trackerLogger,
+		client: client,
+		dialer: dialer,
+
+		url:               config.URL,
+		reconnectInterval: reconnectInterval,
+		pingInterval:      DEFAULT_WEBSOCKET_PING_INTERVAL,
+		readTimeout:       DEFAULT_WEBSOCKET_READ_TIMEOUT,
+	}
+
+	go tracker.Run(ctx)
+
+	return tracker
+}
+
+// Run subscribes to new blocks and reconnects until the context is done
+func (tracker *WebSocketBlockHeightTracker) Run(ctx context.Context) {
+	for {
+		if err := tracker.subscribe(ctx); err != nil && ctx.Err() == nil {
+			tracker.logger.Errorf(
+				"error subscribing to new blocks, falling back to polling until reconnected: %v", err,
+			)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+
+		tracker.poll()
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(tracker.reconnectInterval):
+		}
+	}
+}
+
+// subscribe connects to websocket endpoint and publishes heights of new blocks. It blocks until
+// the connection is broken or the context is done.
+func (tracker *WebSocketBlockHeightTracker) subscribe(ctx context.Context) error {
+	conn, _, err := tracker.dialer.DialContext(ctx, tracker.url, nil)
+	if err != nil {
+		return fmt.Errorf("error connecting to %s: %v", tracker.url, err)
+	}
+	defer conn.Close()
+
+	if err := conn.WriteJSON(newSubscribeRequest(NEW_BLOCK_SUBSCRIPTION_QUERY)); err != nil {
+		return fmt.Errorf("error sending subscribe request: %v", err)
+	}
+	tracker.logger.Infof("subscribed to new blocks from %s", tracker.url)
+
+	_ = conn.SetReadDeadline(time.Now().Add(tracker.readTimeout))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(tracker.readTimeout))
+	})
+
+	closeCh := make(chan struct{})
+	defer close(closeCh)
+	go tracker.keepAlive(ctx, conn, closeCh)
+
+	for {
+		_, message, readErr := conn.ReadMessage()
+		if readErr != nil {
+			return fmt.Errorf("error reading websocket message: %v", readErr)
+		}
+		_ = conn.SetReadDeadline(time.Now().Add(tracker.readTimeout))
+
+		maybeHeight, parseErr := ParseNewBlockEventHeight(message)
+		if parseErr != nil {
+			return parseErr
+		}
+		if maybeHeight == nil {
+			continue
+		}
+
+		tracker.Publish(*maybeHeight)
+		tracker.logger.Infof("updated chain latest block height: %d", *maybeHeight)
+	}
+}
+
+// keepAlive pings the connection until it is closed. The connection is closed when the context is
+// done to unblock the reading.
+func (tracker *WebSocketBlockHeightTracker) keepAlive(
+	ctx context.Context,
+	conn *websocket.Conn,
+	closeCh <-chan struct{},
+) {
+	ticker := time.NewTicker(tracker.pingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-closeCh:
+			return
+		case <-ctx.Done():
+			_ = conn.Close()
+			return
+		case <-ticker.C:
+			deadline := time.Now().Add(tracker.pingInterval)
+			if err := conn.WriteControl(websocket.PingMessage, nil, deadline); err != nil {
+				tracker.logger.Errorf("error sending websocket ping: %v", err)
+				return
+			}
+		}
+	}
+}
+
+func (tracker *WebSocketBlockHeightTracker) poll() {
+	height, err := tracker.client.LatestBlockHeight()
+	if err != nil {
+		tracker.logger.Errorf("error getting chain latest block height: %v", err)
+		return
+	}
+
+	tracker.Publish(height)
+	tracker.logger.Infof("updated chain latest block height by polling: %d", height)
+}
+
+// ParseNewBlockEventHeight parses the block height from a Tendermint websocket JSON-RPC message.
+// Returns nil height when the message is not a `NewBlock` event, e.g. subscription confirmation.
+func ParseNewBlockEventHeight(message []byte) (*int64, error) {
+	var resp newBlockEventResp
+	if err := jsoniter.Unmarshal(message, &resp); err != nil {
+		return nil, fmt.Errorf("error decoding websocket message: %v", err)
+	}
+	if resp.MaybeError != nil {
+		return nil, fmt.Errorf(
+			"error returned from websocket subscription: %s %s", resp.MaybeError.Message, resp.MaybeError.Data,
+		)
+	}
+	if !strings.HasSuffix(resp.Result.Data.Type, "NewBlock") {
+		return nil, nil
+	}
+
+	height, err := strconv.ParseInt(resp.Result.Data.Value.Block.Header.Height, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing new block height: %v", err)
+	}
+	return &height, nil
+}
+
+// WebSocketURLFromHTTPRPCUrl derives the Tendermint websocket endpoint from the HTTP RPC URL
+func WebSocketURLFromHTTPRPCUrl(httpRPCUrl string) string {
+	url := strings.TrimSuffix(httpRPCUrl, "/")
+	if strings.HasPrefix(url, "https://") {
+		url = "wss://" + strings.TrimPrefix(url, "https://")
+	} else if strings.HasPrefix(url, "http://") {
+		url = "ws://" + strings.TrimPrefix(url, "http://")
+	}
+
+	return url + "/websocket"
+}
+
+type subscribeRequest struct {
+	Jsonrpc string                `json:"jsonrpc"`
+	Method  string                `json:"method"`
+	ID      int                   `json:"id"`
+	Params  subscribeRequestParam `json:"params"`
+}
+
+type subscribeRequestParam struct {
+	Query string `json:"query"`
+}
+
+func newSubscribeRequest(query string) subscribeRequest {
+	return subscribeRequest{
+		Jsonrpc: "2.0",
+		Method:  "subscribe",
+		ID:      1,
+		Params: subscribeRequestParam{
+			Query: query,
+		},
+	}
+}
+
+type newBlockEventResp struct {
+	Result struct {
+		Data struct {
+			Type  string `json:"type"`
+			Value struct {
+				Block struct {
+					Header struct {
+						Height string `json:"height"`
+					} `json:"header"`
+				} `json:"block"`
+			} `json:"value"`
+		} `json:"data"`
+	} `json:"result"`
+	MaybeError *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Data    string `json:"data"`
+	} `json:"error"`
+}
@@ -0,0 +1,278 @@
+package chain_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	jsoniter "github.com/json-iterator/go"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/crypto-com/chain-indexing/infrastructure/feed/chain"
+	. "github.com/crypto-com/chain-indexing/internal/logger/test"
+	usecase_model "github.com/crypto-com/chain-indexing/usecase/model"
+	"github.com/crypto-com/chain-indexing/usecase/model/genesis"
+)
+
+var _ = Describe("WebSocketBlockHeightTracker", func() {
+	It("should implement BlockHeightFeed", func() {
+		var _ BlockHeightFeed = &WebSocketBlockHeightTracker{}
+	})
+
+	Describe("ParseNewBlockEventHeight", func() {
+		It("should return nil height for subscription confirmation", func() {
+			height, err := ParseNewBlockEventHeight([]byte(`{"jsonrpc":"2.0","id":1,"result":{}}`))
+
+			Expect(err).To(BeNil())
+			Expect(height).To(BeNil())
+		})
+
+		It("should return error when subscription returns error", func() {
+			_, err := ParseNewBlockEventHeight([]byte(
+				`{"jsonrpc":"2.0","id":1,"error":{"code":-32603,"message":"Internal error","data":"already subscribed"}}`,
+			))
+
+			Expect(err).NotTo(BeNil())
+		})
+
+		It("should return the height of NewBlock event", func() {
+			height, err := ParseNewBlockEventHeight([]byte(newBlockEventJSON(12345)))
+
+			Expect(err).To(BeNil())
+			Expect(*height).To(Equal(int64(12345)))
+		})
+	})
+
+	Describe("WebSocketURLFromHTTPRPCUrl", func() {
+		It("should derive websocket endpoint from HTTP RPC URL", func() {
+			Expect(WebSocketURLFromHTTPRPCUrl("http://127.0.0.1:26657")).To(
+				Equal("ws://127.0.0.1:26657/websocket"),
+			)
+			Expect(WebSocketURLFromHTTPRPCUrl("https://mainnet.crypto.org:26657/")).To(
+				Equal("wss://mainnet.crypto.org:26657/websocket"),
+			)
+		})
+	})
+
+	It("should subscribe to NewBlock events and publish block heights", func() {
+		server := newFakeTendermintWebSocketServer()
+		defer server.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		tracker := NewWebSocketBlockHeightTracker(
+			ctx,
+			NewFakeLogger(),
+			&fakeTendermintClient{err: errors.New("unavailable")},
+			WebSocketBlockHeightTrackerConfig{
+				URL:               server.URL(),
+				ReconnectInterval: 10 * time.Millisecond,
+			},
+		)
+		blockHeightCh := make(chan int64, 10)
+		tracker.Subscribe(blockHeightCh)
+
+		Eventually(server.SubscribedQueries).Should(ConsistOf("tm.event='NewBlock'"))
+
+		server.Push(newBlockEventJSON(10))
+		Eventually(blockHeightCh).Should(Receive(Equal(int64(10))))
+		server.Push(newBlockEventJSON(11))
+		Eventually(blockHeightCh).Should(Receive(Equal(int64(11))))
+		Expect(*tracker.GetLatestBlockHeight()).To(Equal(int64(11)))
+	})
+
+	It("should reconnect after the connection is closed", func() {
+		server := newFakeTendermintWebSocketServer()
+		defer server.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		tracker := NewWebSocketBlockHeightTracker(
+			ctx,
+			NewFakeLogger(),
+			&fakeTendermintClient{err: errors.New("unavailable")},
+			WebSocketBlockHeightTrackerConfig{
+				URL:               server.URL(),
+				ReconnectInterval: 10 * time.Millisecond,
+			},
+		)
+		blockHeightCh := make(chan int64, 10)
+		tracker.Subscribe(blockHeightCh)
+
+		Eventually(server.SubscribedQueries).Should(HaveLen(1))
+		server.DisconnectAll()
+
+		Eventually(server.SubscribedQueries).Should(HaveLen(2))
+		server.Push(newBlockEventJSON(20))
+		Eventually(blockHeightCh).Should(Receive(Equal(int64(20))))
+	})
+
+	It("should fall back to polling when websocket endpoint is unavailable", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		tracker := NewWebSocketBlockHeightTracker(
+			ctx,
+			NewFakeLogger(),
+			&fakeTendermintClient{height: 30},
+			WebSocketBlockHeightTrackerConfig{
+				URL:               "ws://127.0.0.1:1/websocket",
+				ReconnectInterval: 10 * time.Millisecond,
+			},
+		)
+
+		Eventually(tracker.GetLatestBlockHeight).ShouldNot(BeNil())
+		Expect(*tracker.GetLatestBlockHeight()).To(Equal(int64(30)))
+	})
+
+	It("should stop subscribing and reconnecting when the context is done", func() {
+		server := newFakeTendermintWebSocketServer()
+		defer server.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		tracker := NewWebSocketBlockHeightTracker(
+			ctx,
+			NewFakeLogger(),
+			&fakeTendermintClient{err: errors.New("unavailable")},
+			WebSocketBlockHeightTrackerConfig{
+				URL:               server.URL(),
+				ReconnectInterval: 10 * time.Millisecond,
+			},
+		)
+		blockHeightCh := make(chan int64, 10)
+		tracker.Subscribe(blockHeightCh)
+
+		Eventually(server.SubscribedQueries).Should(HaveLen(1))
+		cancel()
+		server.DisconnectAll()
+
+		Consistently(server.SubscribedQueries, 100*time.Millisecond).Should(HaveLen(1))
+		server.Push(newBlockEventJSON(40))
+		Consistently(blockHeightCh).ShouldNot(Receive())
+	})
+})
+
+func newBlockEventJSON(height int64) string {
+	return fmt.Sprintf(`{
+  "jsonrpc": "2.0",
+  "id": 1,
+  "result": {
+    "query": "tm.event='NewBlock'",
+    "data": {
+      "type": "tendermint/event/NewBlock",
+      "value": {
+        "block": {
+          "header": {
+            "chain_id": "testnet",
+            "height": "%d"
+          }
+        }
+      }
+    }
+  }
+}`, height)
+}
+
+type fakeTendermintWebSocketServer struct {
+	server *httptest.Server
+
+	mutex             sync.Mutex
+	conns             []*websocket.Conn
+	subscribedQueries []string
+}
+
+func newFakeTendermintWebSocketServer() *fakeTendermintWebSocketServer {
+	fakeServer := &fakeTendermintWebSocketServer{}
+	upgrader := websocket.Upgrader{}
+	fakeServer.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+
+		var request struct {
+			Method string `json:"method"`
+			Params struct {
+				Query string `json:"query"`
+			} `json:"params"`
+		}
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			_ = conn.Close()
+			return
+		}
+		if err := jsoniter.Unmarshal(message, &request); err != nil || request.Method != "subscribe" {
+			_ = conn.Close()
+			return
+		}
+		fakeServer.mutex.Lock()
+		defer fakeServer.mutex.Unlock()
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":1,"result":{}}`))
+		fakeServer.conns = append(fakeServer.conns, conn)
+		fakeServer.subscribedQueries = append(fakeServer.subscribedQueries, request.Params.Query)
+	}))
+
+	return fakeServer
+}
+
+func (fakeServer *fakeTendermintWebSocketServer) URL() string {
+	return strings.Replace(fakeServer.server.URL, "http://", "ws://", 1) + "/websocket"
+}
+
+func (fakeServer *fakeTendermintWebSocketServer) SubscribedQueries() []string {
+	fakeServer.mutex.Lock()
+	defer fakeServer.mutex.Unlock()
+
+	return append([]string{}, fakeServer.subscribedQueries...)
+}
+
+func (fakeServer *fakeTendermintWebSocketServer) Push(message string) {
+	fakeServer.mutex.Lock()
+	defer fakeServer.mutex.Unlock()
+
+	for _, conn := range fakeServer.conns {
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(message))
+	}
+}
+
+func (fakeServer *fakeTendermintWebSocketServer) DisconnectAll() {
+	fakeServer.mutex.Lock()
+	defer fakeServer.mutex.Unlock()
+
+	for _, conn := range fakeServer.conns {
+		_ = conn.Close()
+	}
+	fakeServer.conns = nil
+}
+
+func (fakeServer *fakeTendermintWebSocketServer) Close() {
+	fakeServer.DisconnectAll()
+	fakeServer.server.Close()
+}
+
+type fakeTendermintClient struct {
+	height int64
+	err    error
+}
+
+func (client *fakeTendermintClient) Genesis() (*genesis.Genesis, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (client *fakeTendermintClient) Block(_ int64) (*usecase_model.Block, *usecase_model.RawBlock, error) {
+	return nil, nil, errors.New("not implemented")
+}
+
+func (client *fakeTendermintClient) BlockResults(_ int64) (*usecase_model.BlockResults, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (client *fakeTendermintClient) LatestBlockHeight() (int64, error) {
+	return client.height, client.err
+}
@@ -8,6 +8,7 @@ var (
 	ErrInvalidPagination = errors.New("invalid pagination type")
 	ErrInvalidPage       = errors.New("invalid page number")
 	ErrInvalidLimit      = errors.New("invalid page limit")
+	ErrInvalidCursor     = errors.New("invalid pagination cursor")
 
 	ErrInvalidQuery = errors.New("invalid query parameter")
 )
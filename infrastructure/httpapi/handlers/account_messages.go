@@ -31,7 +31,7 @@ func NewAccountMessages(logger applogger.Logger, rdbHandle *rdb.Handle) *Account
 func (handler *AccountMessages) ListByAccount(ctx *fasthttp.RequestCtx) {
 	var err error
 
-	pagination, err := httpapi.ParsePagination(ctx)
+	pagination, err := httpapi.ParsePaginationWithCursor(ctx)
 	if err != nil {
 		ctx.SetStatusCode(fasthttp.StatusBadRequest)
 		return
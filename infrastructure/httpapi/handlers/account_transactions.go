@@ -14,6 +14,7 @@ type AccountTransactions struct {
 	logger applogger.Logger
 
 	accountTransactionsView *account_transaction_view.AccountTransactions
+	denomTraces             *denomTracesResolver
 }
 
 func NewAccountTransactions(logger applogger.Logger, rdbHandle *rdb.Handle) *AccountTransactions {
@@ -23,13 +24,14 @@ func NewAccountTransactions(logger applogger.Logger, rdbHandle *rdb.Handle) *Acc
 		}),
 
 		account_transaction_view.NewAccountTransactions(rdbHandle),
+		newDenomTracesResolver(logger, rdbHandle),
 	}
 }
 
 func (handler *AccountTransactions) ListByAccount(ctx *fasthttp.RequestCtx) {
 	var err error
 
-	pagination, err := httpapi.ParsePagination(ctx)
+	pagination, err := httpapi.ParsePaginationWithCursor(ctx)
 	if err != nil {
 		ctx.SetStatusCode(fasthttp.StatusBadRequest)
 		return
@@ -54,7 +56,7 @@ func (handler *AccountTransactions) ListByAccount(ctx *fasthttp.RequestCtx) {
 		Memo:    memo,
 	}
 
-	blocks, paginationResult, err := handler.accountTransactionsView.List(
+	transactions, paginationResult, err := handler.accountTransactionsView.List(
 		filter, account_transaction_view.AccountTransactionsListOrder{Id: idOrder}, pagination,
 	)
 	if err != nil {
@@ -63,5 +65,26 @@ func (handler *AccountTransactions) ListByAccount(ctx *fasthttp.RequestCtx) {
 		return
 	}
 
-	httpapi.SuccessWithPagination(ctx, blocks, paginationResult)
+	results := make([]interface{}, 0, len(transactions))
+	for _, transaction := range transactions {
+		results = append(results, transaction)
+	}
+	denomTraces := handler.denomTraces.Resolve(results...)
+
+	transactionsWithDenomTraces := make([]AccountTransactionWithDenomTraces, 0, len(transactions))
+	for i, transaction := range transactions {
+		transactionsWithDenomTraces = append(transactionsWithDenomTraces, AccountTransactionWithDenomTraces{
+			AccountTransactionReadRow: transaction,
+			DenomTraces:               denomTraces[i],
+		})
+	}
+
+	httpapi.SuccessWithPagination(ctx, transactionsWithDenomTraces, paginationResult)
+}
+
+type AccountTransactionWithDenomTraces struct {
+	account_transaction_view.AccountTransactionReadRow
+
+	// Traces of the IBC denominations in the transaction
+	DenomTraces DenomTraces `json:"denomTraces"`
 }
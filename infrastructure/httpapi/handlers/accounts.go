@@ -2,10 +2,12 @@ package handlers
 
 import (
 	"errors"
+	"time"
 
 	"github.com/crypto-com/chain-indexing/appinterface/cosmosapp"
 	"github.com/crypto-com/chain-indexing/internal/primptr"
 	"github.com/crypto-com/chain-indexing/internal/tmcosmosutils"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
 	validator_view "github.com/crypto-com/chain-indexing/projection/validator/view"
 
 	"github.com/crypto-com/chain-indexing/usecase/coin"
@@ -22,9 +24,11 @@ import (
 type Accounts struct {
 	logger applogger.Logger
 
-	accountsView   *account_view.Accounts
-	validatorsView *validator_view.Validators
-	cosmosClient   cosmosapp.Client
+	accountsView        *account_view.Accounts
+	vestingAccountsView *account_view.VestingAccounts
+	validatorsView      *validator_view.Validators
+	denomTraces         *denomTracesResolver
+	cosmosClient        cosmosapp.Client
 
 	validatorAddressPrefix string
 }
@@ -41,16 +45,31 @@ func NewAccounts(
 		}),
 
 		account_view.NewAccounts(rdbHandle),
+		account_view.NewVestingAccounts(rdbHandle),
 		validator_view.NewValidators(rdbHandle),
+		newDenomTracesResolver(logger, rdbHandle),
 		cosmosClient,
 
 		validatorAddressPrefix,
 	}
 }
 
+// FindBy returns the account information. Vested and vesting coins of vesting account are
+// calculated from the indexed vesting schedule at the time of query argument `vestingTime` in
+// RFC3339 format, or at current time when absent
 func (handler *Accounts) FindBy(ctx *fasthttp.RequestCtx) {
 	accountParam, _ := ctx.UserValue("account").(string)
 
+	vestingTime := utctime.Now()
+	if queryArgs := ctx.QueryArgs(); queryArgs.Has("vestingTime") {
+		var parseErr error
+		vestingTime, parseErr = utctime.Parse(time.RFC3339, string(queryArgs.Peek("vestingTime")))
+		if parseErr != nil {
+			httpapi.BadRequest(ctx, errors.New("invalid vestingTime"))
+			return
+		}
+	}
+
 	info := AccountInfo{
 		Balance:             coin.NewEmptyCoins(),
 		BondedBalance:       coin.NewEmptyCoins(),
@@ -138,6 +157,22 @@ func (handler *Accounts) FindBy(ctx *fasthttp.RequestCtx) {
 		info.Commissions = commissions
 	}
 
+	vestingAccount, err := handler.vestingAccountsView.FindBy(accountParam)
+	if err != nil {
+		if !errors.Is(err, rdb.ErrNoRows) {
+			handler.logger.Errorf("error fetching vesting account: %v", err)
+			httpapi.InternalServerError(ctx)
+			return
+		}
+	} else {
+		info.MaybeVesting = &AccountVesting{
+			VestingAccountRow: *vestingAccount,
+			Time:              vestingTime,
+			VestedCoins:       vestingAccount.VestedCoins(vestingTime),
+			VestingCoins:      vestingAccount.VestingCoins(vestingTime),
+		}
+	}
+
 	totalBalance := coin.NewEmptyDecCoins()
 	totalBalance = totalBalance.Add(coin.NewDecCoinsFromCoins(info.Balance...)...)
 	totalBalance = totalBalance.Add(coin.NewDecCoinsFromCoins(info.BondedBalance...)...)
@@ -146,6 +181,7 @@ func (handler *Accounts) FindBy(ctx *fasthttp.RequestCtx) {
 	totalBalance = totalBalance.Add(info.TotalRewards...)
 	totalBalance = totalBalance.Add(info.Commissions...)
 	info.TotalBalance = totalBalance
+	info.DenomTraces = handler.denomTraces.Resolve(info)[0]
 
 	httpapi.Success(ctx, info)
 }
@@ -190,4 +226,16 @@ type AccountInfo struct {
 	TotalRewards        coin.DecCoins `json:"totalRewards"`
 	Commissions         coin.DecCoins `json:"commissions"`
 	TotalBalance        coin.DecCoins `json:"totalBalance"`
+	// Only available in vesting account
+	MaybeVesting *AccountVesting `json:"vesting"`
+	// Traces of the IBC denominations in the account information
+	DenomTraces DenomTraces `json:"denomTraces"`
+}
+
+type AccountVesting struct {
+	account_view.VestingAccountRow
+
+	Time         utctime.UTCTime `json:"time"`
+	VestedCoins  coin.Coins      `json:"vestedCoins"`
+	VestingCoins coin.Coins      `json:"vestingCoins"`
 }
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"github.com/valyala/fasthttp"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	authzgrant_view "github.com/crypto-com/chain-indexing/projection/authzgrant/view"
+)
+
+type AuthzGrants struct {
+	logger applogger.Logger
+
+	authzGrantsView *authzgrant_view.AuthzGrants
+}
+
+func NewAuthzGrants(logger applogger.Logger, rdbHandle *rdb.Handle) *AuthzGrants {
+	return &AuthzGrants{
+		logger.WithFields(applogger.LogFields{
+			"module": "AuthzGrantsHandler",
+		}),
+
+		authzgrant_view.NewAuthzGrants(rdbHandle),
+	}
+}
+
+// ListByGranter lists the grants given by the account
+func (handler *AuthzGrants) ListByGranter(ctx *fasthttp.RequestCtx) {
+	account := ctx.UserValue("account").(string)
+	handler.list(ctx, authzgrant_view.AuthzGrantsListFilter{
+		MaybeGranter: &account,
+	})
+}
+
+// ListByGrantee lists the grants received by the account
+func (handler *AuthzGrants) ListByGrantee(ctx *fasthttp.RequestCtx) {
+	account := ctx.UserValue("account").(string)
+	handler.list(ctx, authzgrant_view.AuthzGrantsListFilter{
+		MaybeGrantee: &account,
+	})
+}
+
+func (handler *AuthzGrants) list(ctx *fasthttp.RequestCtx, filter authzgrant_view.AuthzGrantsListFilter) {
+	pagination, err := httpapi.ParsePagination(ctx)
+	if err != nil {
+		ctx.SetStatusCode(fasthttp.StatusBadRequest)
+		return
+	}
+
+	grants, paginationResult, err := handler.authzGrantsView.List(filter, pagination)
+	if err != nil {
+		handler.logger.Errorf("error listing authz grants: %v", err)
+		httpapi.InternalServerError(ctx)
+		return
+	}
+
+	httpapi.SuccessWithPagination(ctx, grants, paginationResult)
+}
@@ -54,7 +54,7 @@ func (handler *BlockEvents) FindById(ctx *fasthttp.RequestCtx) {
 func (handler *BlockEvents) List(ctx *fasthttp.RequestCtx) {
 	var err error
 
-	pagination, err := httpapi.ParsePagination(ctx)
+	pagination, err := httpapi.ParsePaginationWithCursor(ctx)
 	if err != nil {
 		ctx.SetStatusCode(fasthttp.StatusBadRequest)
 		return
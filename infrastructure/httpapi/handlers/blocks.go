@@ -92,7 +92,7 @@ func (handler *Blocks) List(ctx *fasthttp.RequestCtx) {
 }
 
 func (handler *Blocks) ListTransactionsByHeight(ctx *fasthttp.RequestCtx) {
-	pagination, err := httpapi.ParsePagination(ctx)
+	pagination, err := httpapi.ParsePaginationWithCursor(ctx)
 	if err != nil {
 		httpapi.BadRequest(ctx, err)
 		return
@@ -128,7 +128,7 @@ func (handler *Blocks) ListTransactionsByHeight(ctx *fasthttp.RequestCtx) {
 }
 
 func (handler *Blocks) ListEventsByHeight(ctx *fasthttp.RequestCtx) {
-	pagination, err := httpapi.ParsePagination(ctx)
+	pagination, err := httpapi.ParsePaginationWithCursor(ctx)
 	if err != nil {
 		httpapi.BadRequest(ctx, err)
 		return
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/valyala/fasthttp"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	"github.com/crypto-com/chain-indexing/internal/tmcosmosutils"
+	ethereum_transaction_view "github.com/crypto-com/chain-indexing/projection/ethereum_transaction/view"
+)
+
+type EthereumTransactions struct {
+	logger applogger.Logger
+
+	transactionsView *ethereum_transaction_view.EthereumTransactions
+}
+
+func NewEthereumTransactions(logger applogger.Logger, rdbHandle *rdb.Handle) *EthereumTransactions {
+	return &EthereumTransactions{
+		logger.WithFields(applogger.LogFields{
+			"module": "EthereumTransactionsHandler",
+		}),
+
+		ethereum_transaction_view.NewEthereumTransactions(rdbHandle),
+	}
+}
+
+// FindByHash finds the Ethereum transaction by its 0x-prefixed Ethereum transaction hash
+func (handler *EthereumTransactions) FindByHash(ctx *fasthttp.RequestCtx) {
+	var err error
+
+	hash := strings.ToLower(ctx.UserValue("hash").(string))
+	transaction, err := handler.transactionsView.FindByHash(hash)
+	if err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			httpapi.NotFound(ctx)
+			return
+		}
+		handler.logger.Errorf("error finding Ethereum transaction by hash: %v", err)
+		httpapi.InternalServerError(ctx)
+		return
+	}
+
+	httpapi.Success(ctx, transaction)
+}
+
+// List lists the Ethereum transactions. They can be filtered by the hex or bech32 address the
+// transaction is sent from, sent to, or created, and by the hash of the Cosmos transaction.
+func (handler *EthereumTransactions) List(ctx *fasthttp.RequestCtx) {
+	pagination, err := httpapi.ParsePagination(ctx)
+	if err != nil {
+		ctx.SetStatusCode(fasthttp.StatusBadRequest)
+		return
+	}
+
+	filter := ethereum_transaction_view.EthereumTransactionsListFilter{
+		MaybeAddress:         nil,
+		MaybeTransactionHash: nil,
+	}
+	queryArgs := ctx.QueryArgs()
+	if queryArgs.Has("filter.address") {
+		rawAddress := string(queryArgs.Peek("filter.address"))
+		var address string
+		if tmcosmosutils.IsValidHexAddress(rawAddress) {
+			address = tmcosmosutils.MustChecksumHexAddress(rawAddress)
+		} else {
+			address, err = tmcosmosutils.HexAddressFromAccountAddress(rawAddress)
+			if err != nil {
+				httpapi.BadRequest(ctx, errors.New("invalid address"))
+				return
+			}
+		}
+		filter.MaybeAddress = &address
+	}
+	if queryArgs.Has("filter.transactionHash") {
+		transactionHash := strings.ToUpper(string(queryArgs.Peek("filter.transactionHash")))
+		filter.MaybeTransactionHash = &transactionHash
+	}
+
+	transactions, paginationResult, err := handler.transactionsView.List(filter, pagination)
+	if err != nil {
+		handler.logger.Errorf("error listing Ethereum transactions: %v", err)
+		httpapi.InternalServerError(ctx)
+		return
+	}
+
+	httpapi.SuccessWithPagination(ctx, transactions, paginationResult)
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"github.com/valyala/fasthttp"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	feegrant_view "github.com/crypto-com/chain-indexing/projection/feegrant/view"
+)
+
+type FeeGrants struct {
+	logger applogger.Logger
+
+	feeGrantsView *feegrant_view.FeeGrants
+}
+
+func NewFeeGrants(logger applogger.Logger, rdbHandle *rdb.Handle) *FeeGrants {
+	return &FeeGrants{
+		logger.WithFields(applogger.LogFields{
+			"module": "FeeGrantsHandler",
+		}),
+
+		feegrant_view.NewFeeGrants(rdbHandle),
+	}
+}
+
+// ListByGranter lists the fee allowances given by the account
+func (handler *FeeGrants) ListByGranter(ctx *fasthttp.RequestCtx) {
+	account := ctx.UserValue("account").(string)
+	handler.list(ctx, feegrant_view.FeeGrantsListFilter{
+		MaybeGranter: &account,
+	})
+}
+
+// ListByGrantee lists the fee allowances received by the account
+func (handler *FeeGrants) ListByGrantee(ctx *fasthttp.RequestCtx) {
+	account := ctx.UserValue("account").(string)
+	handler.list(ctx, feegrant_view.FeeGrantsListFilter{
+		MaybeGrantee: &account,
+	})
+}
+
+func (handler *FeeGrants) list(ctx *fasthttp.RequestCtx, filter feegrant_view.FeeGrantsListFilter) {
+	pagination, err := httpapi.ParsePagination(ctx)
+	if err != nil {
+		ctx.SetStatusCode(fasthttp.StatusBadRequest)
+		return
+	}
+
+	grants, paginationResult, err := handler.feeGrantsView.List(filter, pagination)
+	if err != nil {
+		handler.logger.Errorf("error listing fee grants: %v", err)
+		httpapi.InternalServerError(ctx)
+		return
+	}
+
+	httpapi.SuccessWithPagination(ctx, grants, paginationResult)
+}
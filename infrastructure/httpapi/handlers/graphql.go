@@ -0,0 +1,173 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/valyala/fasthttp"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
+	"github.com/crypto-com/chain-indexing/internal/graphqllimit"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	account_message_view "github.com/crypto-com/chain-indexing/projection/account_message/view"
+	block_view "github.com/crypto-com/chain-indexing/projection/block/view"
+	nft_view "github.com/crypto-com/chain-indexing/projection/nft/view"
+	proposal_view "github.com/crypto-com/chain-indexing/projection/proposal/view"
+	transaction_view "github.com/crypto-com/chain-indexing/projection/transaction/view"
+	validator_view "github.com/crypto-com/chain-indexing/projection/validator/view"
+)
+
+// GRAPHQL_MAX_REQUEST_BODY_SIZE is the maximum size of the JSON body of POST request in bytes
+const GRAPHQL_MAX_REQUEST_BODY_SIZE = 64 * 1024
+
+var ErrInvalidGraphQLRequest = errors.New("invalid GraphQL request")
+var ErrGraphQLRequestTooLarge = errors.New("GraphQL request too large")
+
+type GraphQL struct {
+	logger applogger.Logger
+
+	schema        graphql.Schema
+	complexities  graphqllimit.FieldComplexities
+	maxDepth      int
+	maxComplexity int
+}
+
+func NewGraphQL(
+	logger applogger.Logger,
+	rdbHandle *rdb.Handle,
+	validatorAddressPrefix string,
+	consNodeAddressPrefix string,
+	maxDepth int,
+	maxComplexity int,
+) (*GraphQL, error) {
+	handler := &GraphQL{
+		logger.WithFields(applogger.LogFields{
+			"module": "GraphQLHandler",
+		}),
+
+		graphql.Schema{},
+		nil,
+		maxDepth,
+		maxComplexity,
+	}
+
+	schema, complexities, err := (&graphqlSchemaBuilder{
+		handler: handler,
+
+		blocksView:          block_view.NewBlocks(rdbHandle),
+		transactionsView:    transaction_view.NewTransactions(rdbHandle),
+		validatorsView:      validator_view.NewValidators(rdbHandle),
+		accountMessagesView: account_message_view.NewAccountMessages(rdbHandle),
+		proposalsView:       proposal_view.NewProposals(rdbHandle),
+		nftDenomsView:       nft_view.NewDenoms(rdbHandle),
+		nftTokensView:       nft_view.NewTokens(rdbHandle),
+
+		validatorAddressPrefix: validatorAddressPrefix,
+		consNodeAddressPrefix:  consNodeAddressPrefix,
+	}).Build()
+	if err != nil {
+		return nil, fmt.Errorf("error building GraphQL schema: %v", err)
+	}
+	handler.schema = schema
+	handler.complexities = complexities
+
+	return handler, nil
+}
+
+type GraphQLRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Query executes the GraphQL query from the query string of GET request or the JSON body of POST
+// request. The result is always responded as the GraphQL response, with field errors in its errors.
+// The request body is limited by LimitGraphQLRequestBodySize in the routes.
+func (handler *GraphQL) Query(ctx *fasthttp.RequestCtx) {
+	var request GraphQLRequest
+	if ctx.IsPost() {
+		if err := jsoniter.Unmarshal(ctx.PostBody(), &request); err != nil {
+			httpapi.BadRequest(ctx, ErrInvalidGraphQLRequest)
+			return
+		}
+	} else {
+		queryArgs := ctx.QueryArgs()
+		request.Query = string(queryArgs.Peek("query"))
+		request.OperationName = string(queryArgs.Peek("operationName"))
+		if queryArgs.Has("variables") {
+			if err := jsoniter.Unmarshal(queryArgs.Peek("variables"), &request.Variables); err != nil {
+				httpapi.BadRequest(ctx, ErrInvalidGraphQLRequest)
+				return
+			}
+		}
+	}
+	if request.Query == "" {
+		httpapi.BadRequest(ctx, ErrInvalidGraphQLRequest)
+		return
+	}
+
+	result := handler.execute(&request)
+
+	ctx.Response.Header.Set("Content-Type", "application/json")
+	if err := jsoniter.NewEncoder(ctx.Response.BodyWriter()).Encode(result); err != nil {
+		handler.logger.Errorf("error encoding GraphQL result: %v", err)
+		httpapi.InternalServerError(ctx)
+	}
+}
+
+// GraphQLRequestErrors is the response of the request failed before execution, which has no data
+type GraphQLRequestErrors struct {
+	Errors []gqlerrors.FormattedError `json:"errors"`
+}
+
+// execute validates the document and executes the operation. The depth and complexity limits are
+// checked before the rules of the specification, which are costly on large documents, and no field
+// is resolved unless the document passes both.
+func (handler *GraphQL) execute(request *GraphQLRequest) interface{} {
+	document, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{
+			Body: []byte(request.Query),
+			Name: "GraphQL request",
+		}),
+	})
+	if err != nil {
+		return &GraphQLRequestErrors{gqlerrors.FormatErrors(err)}
+	}
+
+	limitRules := graphqllimit.Rules(handler.maxDepth, handler.maxComplexity, handler.complexities, request.Variables)
+	for _, rules := range [][]graphql.ValidationRuleFn{limitRules, graphql.SpecifiedRules} {
+		validationResult := graphql.ValidateDocument(&handler.schema, document, rules)
+		if !validationResult.IsValid {
+			return &GraphQLRequestErrors{validationResult.Errors}
+		}
+	}
+
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:        handler.schema,
+		AST:           document,
+		OperationName: request.OperationName,
+		Args:          request.Variables,
+		Context:       context.Background(),
+	})
+}
+
+// LimitGraphQLRequestBodySize is the middleware rejecting the request body larger than
+// GRAPHQL_MAX_REQUEST_BODY_SIZE
+func LimitGraphQLRequestBodySize(handler fasthttp.RequestHandler) fasthttp.RequestHandler {
+	return func(ctx *fasthttp.RequestCtx) {
+		if ctx.Request.Header.ContentLength() > GRAPHQL_MAX_REQUEST_BODY_SIZE ||
+			len(ctx.PostBody()) > GRAPHQL_MAX_REQUEST_BODY_SIZE {
+			httpapi.BadRequest(ctx, ErrGraphQLRequestTooLarge)
+			return
+		}
+
+		handler(ctx)
+	}
+}
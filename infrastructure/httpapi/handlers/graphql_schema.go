@@ -0,0 +1,882 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	jsoniter "github.com/json-iterator/go"
+
+	pagination_interface "github.com/crypto-com/chain-indexing/appinterface/pagination"
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
+	"github.com/crypto-com/chain-indexing/internal/graphqllimit"
+	"github.com/crypto-com/chain-indexing/internal/primptr"
+	account_message_view "github.com/crypto-com/chain-indexing/projection/account_message/view"
+	block_view "github.com/crypto-com/chain-indexing/projection/block/view"
+	nft_view "github.com/crypto-com/chain-indexing/projection/nft/view"
+	proposal_view "github.com/crypto-com/chain-indexing/projection/proposal/view"
+	transaction_view "github.com/crypto-com/chain-indexing/projection/transaction/view"
+	validator_view "github.com/crypto-com/chain-indexing/projection/validator/view"
+)
+
+const GRAPHQL_DEFAULT_LIMIT = int64(20)
+
+// GRAPHQL_MAX_LIMIT is the maximum page size of the lists. Larger limit is clamped to it.
+const GRAPHQL_MAX_LIMIT = int64(100)
+
+var graphqlBigInt = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "BigInt",
+	Description: "Arbitrary-precision integer",
+	Serialize:   graphqlSerializeAsIs,
+})
+
+var graphqlBigFloat = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "BigFloat",
+	Description: "Arbitrary-precision decimal",
+	Serialize:   graphqlSerializeAsIs,
+})
+
+// graphqlJSON is any JSON value, returned as it is without selecting subfields
+var graphqlJSON = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "JSON",
+	Description: "Any JSON value",
+	Serialize:   graphqlSerializeAsIs,
+})
+
+// graphqlSerializeAsIs leaves the value to be encoded by its JSON encoding in the response
+func graphqlSerializeAsIs(value interface{}) interface{} {
+	return value
+}
+
+// graphqlSchemaBuilder builds the GraphQL schema backed by the projection views
+type graphqlSchemaBuilder struct {
+	handler *GraphQL
+
+	blocksView          *block_view.Blocks
+	transactionsView    *transaction_view.BlockTransactions
+	validatorsView      *validator_view.Validators
+	accountMessagesView *account_message_view.AccountMessages
+	proposalsView       *proposal_view.Proposals
+	nftDenomsView       *nft_view.Denoms
+	nftTokensView       *nft_view.Tokens
+
+	validatorAddressPrefix string
+	consNodeAddressPrefix  string
+}
+
+// Build returns the schema together with the complexity functions of its list fields
+func (builder *graphqlSchemaBuilder) Build() (graphql.Schema, graphqllimit.FieldComplexities, error) {
+	offsetPaginationType := graphqlObject("OffsetPagination", graphql.Fields{
+		"totalRecord": {Type: graphql.NewNonNull(graphql.Int)},
+		"totalPage":   {Type: graphql.NewNonNull(graphql.Int)},
+		"currentPage": {Type: graphql.NewNonNull(graphql.Int)},
+		"limit":       {Type: graphql.NewNonNull(graphql.Int)},
+	})
+	cursorPaginationType := graphqlObject("CursorPagination", graphql.Fields{
+		"nextCursor": {Type: graphql.String, Description: "Null when there are no more records"},
+		"limit":      {Type: graphql.NewNonNull(graphql.Int)},
+	})
+	newConnectionType := func(name string, itemType graphql.Type) *graphql.Object {
+		return graphqlObject(name, graphql.Fields{
+			"items":            {Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(itemType)))},
+			"pagination":       {Type: offsetPaginationType, Description: "Only available in offset pagination"},
+			"cursorPagination": {Type: cursorPaginationType, Description: "Only available in cursor pagination"},
+		})
+	}
+
+	coinType := graphqlObject("Coin", graphql.Fields{
+		"denom":  {Type: graphql.NewNonNull(graphql.String)},
+		"amount": {Type: graphql.NewNonNull(graphql.String)},
+	})
+	coinsType := graphql.NewList(graphql.NewNonNull(coinType))
+
+	blockType := graphqlObject("Block", graphql.Fields{
+		"blockHeight":      {Type: graphql.NewNonNull(graphql.Int)},
+		"blockHash":        {Type: graphql.NewNonNull(graphql.String)},
+		"blockTime":        {Type: graphql.NewNonNull(graphql.String)},
+		"appHash":          {Type: graphql.NewNonNull(graphql.String)},
+		"transactionCount": {Type: graphql.NewNonNull(graphql.Int)},
+		"committedCouncilNodes": {Type: graphql.NewList(graphql.NewNonNull(graphqlObject(
+			"BlockCommittedCouncilNode", graphql.Fields{
+				"address":    {Type: graphql.NewNonNull(graphql.String)},
+				"time":       {Type: graphql.NewNonNull(graphql.String)},
+				"signature":  {Type: graphql.NewNonNull(graphql.String)},
+				"isProposer": {Type: graphql.NewNonNull(graphql.Boolean)},
+			},
+		)))},
+	})
+
+	transactionType := graphqlObject("Transaction", graphql.Fields{
+		"blockHeight":   {Type: graphql.NewNonNull(graphql.Int)},
+		"blockHash":     {Type: graphql.NewNonNull(graphql.String)},
+		"blockTime":     {Type: graphql.NewNonNull(graphql.String)},
+		"hash":          {Type: graphql.NewNonNull(graphql.String)},
+		"index":         {Type: graphql.NewNonNull(graphql.Int)},
+		"success":       {Type: graphql.NewNonNull(graphql.Boolean)},
+		"code":          {Type: graphql.NewNonNull(graphql.Int)},
+		"log":           {Type: graphql.NewNonNull(graphql.String)},
+		"fee":           {Type: coinsType},
+		"feePayer":      {Type: graphql.NewNonNull(graphql.String)},
+		"feeGranter":    {Type: graphql.NewNonNull(graphql.String)},
+		"gasWanted":     {Type: graphql.NewNonNull(graphqlBigInt)},
+		"gasUsed":       {Type: graphql.NewNonNull(graphqlBigInt)},
+		"memo":          {Type: graphql.NewNonNull(graphql.String)},
+		"timeoutHeight": {Type: graphql.NewNonNull(graphqlBigInt)},
+		"messages": {Type: graphql.NewList(graphql.NewNonNull(graphqlObject("TransactionMessage", graphql.Fields{
+			"type":    {Type: graphql.NewNonNull(graphql.String)},
+			"content": {Type: graphqlJSON},
+		})))},
+		"signers": {Type: graphql.NewList(graphql.NewNonNull(graphqlObject("TransactionSigner", graphql.Fields{
+			"type":            {Type: graphql.NewNonNull(graphql.String)},
+			"isMultiSig":      {Type: graphql.NewNonNull(graphql.Boolean)},
+			"pubkeys":         {Type: graphql.NewList(graphql.NewNonNull(graphql.String))},
+			"threshold":       {Type: graphql.Int},
+			"accountSequence": {Type: graphql.NewNonNull(graphqlBigInt)},
+			"address":         {Type: graphql.NewNonNull(graphql.String)},
+		})))},
+		"block": {
+			Type: blockType,
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				height := params.Source.(transaction_view.TransactionRow).BlockHeight
+				return builder.findBlock(block_view.BlockIdentity{MaybeHeight: &height})
+			},
+		},
+	})
+	transactionConnectionType := newConnectionType("TransactionConnection", transactionType)
+	transactionsOrderArg := &graphql.ArgumentConfig{
+		Type:        graphql.String,
+		Description: "height.desc for descending block height",
+	}
+	blockType.AddFieldConfig("transactions", &graphql.Field{
+		Type: graphql.NewNonNull(transactionConnectionType),
+		Args: graphqlPaginationArgs(true, graphql.FieldConfigArgument{
+			"order": transactionsOrderArg,
+		}),
+		Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			height := params.Source.(block_view.Block).Height
+			return builder.listTransactions(params.Args, &height)
+		},
+	})
+
+	validatorType := graphqlObject("Validator", graphql.Fields{
+		"operatorAddress":         {Type: graphql.NewNonNull(graphql.String)},
+		"consensusNodeAddress":    {Type: graphql.NewNonNull(graphql.String)},
+		"initialDelegatorAddress": {Type: graphql.NewNonNull(graphql.String)},
+		"tendermintPubkey":        {Type: graphql.NewNonNull(graphql.String)},
+		"tendermintAddress":       {Type: graphql.NewNonNull(graphql.String)},
+		"status":                  {Type: graphql.NewNonNull(graphql.String)},
+		"jailed":                  {Type: graphql.NewNonNull(graphql.Boolean)},
+		"joinedAtBlockHeight":     {Type: graphql.NewNonNull(graphql.Int)},
+		"power":                   {Type: graphql.NewNonNull(graphql.String)},
+		"moniker":                 {Type: graphql.NewNonNull(graphql.String)},
+		"identity":                {Type: graphql.NewNonNull(graphql.String)},
+		"website":                 {Type: graphql.NewNonNull(graphql.String)},
+		"securityContact":         {Type: graphql.NewNonNull(graphql.String)},
+		"details":                 {Type: graphql.NewNonNull(graphql.String)},
+		"commissionRate":          {Type: graphql.NewNonNull(graphql.String)},
+		"commissionMaxRate":       {Type: graphql.NewNonNull(graphql.String)},
+		"commissionMaxChangeRate": {Type: graphql.NewNonNull(graphql.String)},
+		"minSelfDelegation":       {Type: graphql.NewNonNull(graphql.String)},
+		"totalSignedBlock":        {Type: graphql.NewNonNull(graphql.Int)},
+		"totalActiveBlock":        {Type: graphql.NewNonNull(graphql.Int)},
+		"impreciseUpTime":         {Type: graphqlBigFloat},
+		"votedGovProposal":        {Type: graphqlBigInt},
+		"powerPercentage": {
+			Type:        graphql.String,
+			Description: "Only available in validators list",
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				if validator, ok := params.Source.(validator_view.ListValidatorRow); ok {
+					return validator.PowerPercentage, nil
+				}
+				return nil, nil
+			},
+		},
+		"cumulativePowerPercentage": {
+			Type:        graphql.String,
+			Description: "Only available in validators list",
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				if validator, ok := params.Source.(validator_view.ListValidatorRow); ok {
+					return validator.CumulativePowerPercentage, nil
+				}
+				return nil, nil
+			},
+		},
+	})
+
+	accountMessageType := graphqlObject("AccountMessage", graphql.Fields{
+		"account":         {Type: graphql.String},
+		"blockHeight":     {Type: graphql.NewNonNull(graphql.Int)},
+		"blockHash":       {Type: graphql.NewNonNull(graphql.String)},
+		"blockTime":       {Type: graphql.NewNonNull(graphql.String)},
+		"transactionHash": {Type: graphql.NewNonNull(graphql.String)},
+		"success":         {Type: graphql.NewNonNull(graphql.Boolean)},
+		"messageIndex":    {Type: graphql.NewNonNull(graphql.Int)},
+		"messageType":     {Type: graphql.NewNonNull(graphql.String)},
+		"data":            {Type: graphqlJSON},
+		"transaction": {
+			Type: transactionType,
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				return builder.findTransaction(params.Source.(account_message_view.AccountMessageRow).TransactionHash)
+			},
+		},
+	})
+
+	proposalType := graphqlObject("Proposal", graphql.Fields{
+		"id":                           {Type: graphql.NewNonNull(graphql.String)},
+		"title":                        {Type: graphql.NewNonNull(graphql.String)},
+		"description":                  {Type: graphql.NewNonNull(graphql.String)},
+		"type":                         {Type: graphql.NewNonNull(graphql.String)},
+		"status":                       {Type: graphql.NewNonNull(graphql.String)},
+		"proposerAddress":              {Type: graphql.NewNonNull(graphql.String)},
+		"maybeProposerOperatorAddress": {Type: graphql.String},
+		"maybeProposerMoniker":         {Type: graphql.String},
+		"data":                         {Type: graphqlJSON},
+		"initialDeposit":               {Type: coinsType},
+		"totalDeposit":                 {Type: coinsType},
+		"totalVote":                    {Type: graphqlBigInt},
+		"transactionHash":              {Type: graphql.NewNonNull(graphql.String)},
+		"submitBlockHeight":            {Type: graphql.NewNonNull(graphql.Int)},
+		"submitTime":                   {Type: graphql.NewNonNull(graphql.String)},
+		"depositEndTime":               {Type: graphql.NewNonNull(graphql.String)},
+		"maybeVotingStartTime":         {Type: graphql.String},
+		"maybeVotingEndBlockHeight":    {Type: graphql.Int},
+		"maybeVotingEndTime":           {Type: graphql.String},
+	})
+
+	nftDenomType := graphqlObject("NFTDenom", graphql.Fields{
+		"denomId":                   {Type: graphql.NewNonNull(graphql.String)},
+		"denomName":                 {Type: graphql.NewNonNull(graphql.String)},
+		"denomSchema":               {Type: graphql.NewNonNull(graphql.String)},
+		"denomCreator":              {Type: graphql.NewNonNull(graphql.String)},
+		"denomCreatedAt":            {Type: graphql.NewNonNull(graphql.String)},
+		"denomCreatedAtBlockHeight": {Type: graphql.NewNonNull(graphql.Int)},
+	})
+	nftTokenType := graphqlObject("NFTToken", graphql.Fields{
+		"denomId":                           {Type: graphql.NewNonNull(graphql.String)},
+		"denomName":                         {Type: graphql.NewNonNull(graphql.String)},
+		"denomSchema":                       {Type: graphql.NewNonNull(graphql.String)},
+		"tokenId":                           {Type: graphql.NewNonNull(graphql.String)},
+		"drop":                              {Type: graphql.String},
+		"tokenName":                         {Type: graphql.NewNonNull(graphql.String)},
+		"tokenURI":                          {Type: graphql.NewNonNull(graphql.String)},
+		"tokenData":                         {Type: graphql.NewNonNull(graphql.String)},
+		"tokenMinter":                       {Type: graphql.NewNonNull(graphql.String)},
+		"tokenOwner":                        {Type: graphql.NewNonNull(graphql.String)},
+		"tokenMintedAt":                     {Type: graphql.NewNonNull(graphql.String)},
+		"tokenMintedAtBlockHeight":          {Type: graphql.NewNonNull(graphql.Int)},
+		"tokenLastEditedAt":                 {Type: graphql.NewNonNull(graphql.String)},
+		"tokenLastEditedAtBlockHeight":      {Type: graphql.NewNonNull(graphql.Int)},
+		"tokenLastTransferredAt":            {Type: graphql.NewNonNull(graphql.String)},
+		"tokenLastTransferredAtBlockHeight": {Type: graphql.NewNonNull(graphql.Int)},
+		"denom": {
+			Type: nftDenomType,
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				denomId := params.Source.(nft_view.TokenRowWithDenomname).DenomId
+				return builder.nullIfNotFound(builder.nftDenomsView.FindById(denomId))
+			},
+		},
+	})
+
+	queryType := graphqlObject("Query", graphql.Fields{
+		"block": {
+			Type: blockType,
+			Args: graphql.FieldConfigArgument{
+				"height": {Type: graphql.Int},
+				"hash":   {Type: graphql.String},
+			},
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				var identity block_view.BlockIdentity
+				if height, ok := params.Args["height"].(int); ok {
+					identity.MaybeHeight = primptr.Int64(int64(height))
+				} else if hash, ok := params.Args["hash"].(string); ok {
+					hash = strings.ToUpper(hash)
+					identity.MaybeHash = &hash
+				} else {
+					return nil, errors.New("either height or hash is required")
+				}
+				return builder.findBlock(identity)
+			},
+		},
+		"blocks": {
+			Type: graphql.NewNonNull(newConnectionType("BlockConnection", blockType)),
+			Args: graphqlPaginationArgs(false, graphql.FieldConfigArgument{
+				"order": {Type: graphql.String, Description: "height.desc for descending block height"},
+			}),
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				pagination, err := graphqlParsePagination(params.Args, false)
+				if err != nil {
+					return nil, err
+				}
+				blocks, paginationResult, err := builder.blocksView.List(block_view.BlocksListOrder{
+					Height: graphqlParseOrder(params.Args, "height.desc"),
+				}, pagination)
+				if err != nil {
+					return nil, builder.internalError("error listing blocks", err)
+				}
+				return graphqlConnection(blocks, paginationResult), nil
+			},
+		},
+		"transaction": {
+			Type: transactionType,
+			Args: graphql.FieldConfigArgument{
+				"hash": {Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				return builder.findTransaction(strings.ToUpper(params.Args["hash"].(string)))
+			},
+		},
+		"transactions": {
+			Type: graphql.NewNonNull(transactionConnectionType),
+			Args: graphqlPaginationArgs(true, graphql.FieldConfigArgument{
+				"order": transactionsOrderArg,
+			}),
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				return builder.listTransactions(params.Args, nil)
+			},
+		},
+		"validator": {
+			Type: validatorType,
+			Args: graphql.FieldConfigArgument{
+				"address": {
+					Type:        graphql.NewNonNull(graphql.String),
+					Description: "Operator address or consensus node address",
+				},
+			},
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				address := params.Args["address"].(string)
+				var identity validator_view.ValidatorIdentity
+				if strings.HasPrefix(address, builder.validatorAddressPrefix) {
+					identity.MaybeOperatorAddress = &address
+				} else if strings.HasPrefix(address, builder.consNodeAddressPrefix) {
+					identity.MaybeConsensusNodeAddress = &address
+				} else {
+					return nil, errors.New("invalid address")
+				}
+
+				validator, err := builder.validatorsView.FindBy(identity)
+				if err != nil {
+					if errors.Is(err, rdb.ErrNoRows) {
+						return nil, nil
+					}
+					return nil, builder.internalError("error finding validator", err)
+				}
+				return *validator, nil
+			},
+		},
+		"validators": {
+			Type: graphql.NewNonNull(newConnectionType("ValidatorConnection", validatorType)),
+			Args: graphqlPaginationArgs(false, graphql.FieldConfigArgument{
+				"order": {
+					Type:        graphql.NewList(graphql.NewNonNull(graphql.String)),
+					Description: "Any of power, power.desc, commission and commission.desc",
+				},
+			}),
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				pagination, err := graphqlParsePagination(params.Args, false)
+				if err != nil {
+					return nil, err
+				}
+				order := validator_view.ValidatorsListOrder{
+					MaybeStatus:              primptr.String(view.ORDER_ASC),
+					MaybeJoinedAtBlockHeight: primptr.String(view.ORDER_ASC),
+				}
+				orderArgs, _ := params.Args["order"].([]interface{})
+				for _, orderArg := range orderArgs {
+					switch orderArg.(string) {
+					case "power":
+						order.MaybePower = primptr.String(view.ORDER_ASC)
+					case "power.desc":
+						order.MaybePower = primptr.String(view.ORDER_DESC)
+					case "commission":
+						order.MaybeCommission = primptr.String(view.ORDER_ASC)
+					case "commission.desc":
+						order.MaybeCommission = primptr.String(view.ORDER_DESC)
+					default:
+						return nil, errors.New("invalid order")
+					}
+				}
+
+				validators, paginationResult, err := builder.validatorsView.List(
+					validator_view.ValidatorsListFilter{}, order, pagination,
+				)
+				if err != nil {
+					return nil, builder.internalError("error listing validators", err)
+				}
+				return graphqlConnection(validators, paginationResult), nil
+			},
+		},
+		"accountMessages": {
+			Type: graphql.NewNonNull(newConnectionType("AccountMessageConnection", accountMessageType)),
+			Args: graphqlPaginationArgs(true, graphql.FieldConfigArgument{
+				"account": {Type: graphql.NewNonNull(graphql.String)},
+				"filter": {Type: graphql.NewInputObject(graphql.InputObjectConfig{
+					Name: "AccountMessagesFilter",
+					Fields: graphql.InputObjectConfigFieldMap{
+						"msgType": {Type: graphql.NewList(graphql.NewNonNull(graphql.String))},
+					},
+				})},
+				"order": {Type: graphql.String, Description: "height.desc for descending block height"},
+			}),
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				pagination, err := graphqlParsePagination(params.Args, true)
+				if err != nil {
+					return nil, err
+				}
+				filter := account_message_view.AccountMessagesListFilter{
+					Account:       params.Args["account"].(string),
+					MaybeMsgTypes: nil,
+				}
+				if msgTypes, ok := graphqlFilterArg(params.Args, "msgType").([]interface{}); ok {
+					filter.MaybeMsgTypes = make([]string, 0, len(msgTypes))
+					for _, msgType := range msgTypes {
+						filter.MaybeMsgTypes = append(filter.MaybeMsgTypes, msgType.(string))
+					}
+				}
+
+				messages, paginationResult, err := builder.accountMessagesView.List(
+					filter,
+					account_message_view.AccountMessagesListOrder{
+						Id: graphqlParseOrder(params.Args, "height.desc"),
+					},
+					pagination,
+				)
+				if err != nil {
+					return nil, builder.internalError("error listing account messages", err)
+				}
+				return graphqlConnection(messages, paginationResult), nil
+			},
+		},
+		"proposal": {
+			Type: proposalType,
+			Args: graphql.FieldConfigArgument{
+				"id": {Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				return builder.nullIfNotFound(builder.proposalsView.FindById(params.Args["id"].(string)))
+			},
+		},
+		"proposals": {
+			Type: graphql.NewNonNull(newConnectionType("ProposalConnection", proposalType)),
+			Args: graphqlPaginationArgs(false, graphql.FieldConfigArgument{
+				"filter": {Type: graphql.NewInputObject(graphql.InputObjectConfig{
+					Name: "ProposalsFilter",
+					Fields: graphql.InputObjectConfigFieldMap{
+						"status": {Type: graphql.String},
+					},
+				})},
+				"order": {Type: graphql.String, Description: "id.desc for descending proposal id"},
+			}),
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				pagination, err := graphqlParsePagination(params.Args, false)
+				if err != nil {
+					return nil, err
+				}
+				filter := proposal_view.ProposalListFilter{
+					MaybeStatus: nil,
+				}
+				if status, ok := graphqlFilterArg(params.Args, "status").(string); ok {
+					filter.MaybeStatus = &status
+				}
+
+				proposals, paginationResult, err := builder.proposalsView.List(filter, proposal_view.ProposalListOrder{
+					Id: graphqlParseOrder(params.Args, "id.desc"),
+				}, pagination)
+				if err != nil {
+					return nil, builder.internalError("error listing proposals", err)
+				}
+				return graphqlConnection(proposals, paginationResult), nil
+			},
+		},
+		"nftDenom": {
+			Type: nftDenomType,
+			Args: graphql.FieldConfigArgument{
+				"id": {Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				return builder.nullIfNotFound(builder.nftDenomsView.FindById(params.Args["id"].(string)))
+			},
+		},
+		"nftDenoms": {
+			Type: graphql.NewNonNull(newConnectionType("NFTDenomConnection", nftDenomType)),
+			Args: graphqlPaginationArgs(false, graphql.FieldConfigArgument{
+				"filter": {Type: graphql.NewInputObject(graphql.InputObjectConfig{
+					Name: "NFTDenomsFilter",
+					Fields: graphql.InputObjectConfigFieldMap{
+						"creator": {Type: graphql.String},
+					},
+				})},
+				"order": {Type: graphql.String, Description: "createdAt.desc for descending creation time"},
+			}),
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				pagination, err := graphqlParsePagination(params.Args, false)
+				if err != nil {
+					return nil, err
+				}
+				filter := nft_view.DenomListFilter{
+					MaybeCreator: nil,
+				}
+				if creator, ok := graphqlFilterArg(params.Args, "creator").(string); ok {
+					filter.MaybeCreator = &creator
+				}
+
+				denoms, paginationResult, err := builder.nftDenomsView.List(filter, nft_view.DenomListOrder{
+					CreatedAt: graphqlParseOrder(params.Args, "createdAt.desc"),
+				}, pagination)
+				if err != nil {
+					return nil, builder.internalError("error listing NFT denoms", err)
+				}
+				return graphqlConnection(denoms, paginationResult), nil
+			},
+		},
+		"nftToken": {
+			Type: nftTokenType,
+			Args: graphql.FieldConfigArgument{
+				"denomId": {Type: graphql.NewNonNull(graphql.String)},
+				"tokenId": {Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				token, err := builder.nftTokensView.FindById(
+					params.Args["denomId"].(string), params.Args["tokenId"].(string),
+				)
+				if err != nil {
+					if errors.Is(err, rdb.ErrNoRows) {
+						return nil, nil
+					}
+					return nil, builder.internalError("error finding NFT token", err)
+				}
+				return *token, nil
+			},
+		},
+		"nftTokens": {
+			Type: graphql.NewNonNull(newConnectionType("NFTTokenConnection", nftTokenType)),
+			Args: graphqlPaginationArgs(false, graphql.FieldConfigArgument{
+				"filter": {Type: graphql.NewInputObject(graphql.InputObjectConfig{
+					Name: "NFTTokensFilter",
+					Fields: graphql.InputObjectConfigFieldMap{
+						"denomId": {Type: graphql.String},
+						"drop":    {Type: graphql.String},
+						"minter":  {Type: graphql.String},
+						"owner":   {Type: graphql.String},
+					},
+				})},
+				"order": {Type: graphql.String, Description: "mintedAt.desc for descending mint time"},
+			}),
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				pagination, err := graphqlParsePagination(params.Args, false)
+				if err != nil {
+					return nil, err
+				}
+				filter := nft_view.TokenListFilter{
+					MaybeDenomId: nil,
+					MaybeDrop:    nil,
+					MaybeMinter:  nil,
+					MaybeOwner:   nil,
+				}
+				if denomId, ok := graphqlFilterArg(params.Args, "denomId").(string); ok {
+					filter.MaybeDenomId = &denomId
+				}
+				if drop, ok := graphqlFilterArg(params.Args, "drop").(string); ok {
+					filter.MaybeDrop = &drop
+				}
+				if minter, ok := graphqlFilterArg(params.Args, "minter").(string); ok {
+					filter.MaybeMinter = &minter
+				}
+				if owner, ok := graphqlFilterArg(params.Args, "owner").(string); ok {
+					filter.MaybeOwner = &owner
+				}
+
+				tokens, paginationResult, err := builder.nftTokensView.List(filter, nft_view.TokenListOrder{
+					MintedAt: graphqlParseOrder(params.Args, "mintedAt.desc"),
+				}, pagination)
+				if err != nil {
+					return nil, builder.internalError("error listing NFT tokens", err)
+				}
+				return graphqlConnection(tokens, paginationResult), nil
+			},
+		},
+	})
+
+	complexities := graphqllimit.FieldComplexities{
+		"Block.transactions":    graphqlListComplexity,
+		"Query.blocks":          graphqlListComplexity,
+		"Query.transactions":    graphqlListComplexity,
+		"Query.validators":      graphqlListComplexity,
+		"Query.accountMessages": graphqlListComplexity,
+		"Query.proposals":       graphqlListComplexity,
+		"Query.nftDenoms":       graphqlListComplexity,
+		"Query.nftTokens":       graphqlListComplexity,
+	}
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: queryType,
+	})
+	if err != nil {
+		return graphql.Schema{}, nil, err
+	}
+	return schema, complexities, nil
+}
+
+func (builder *graphqlSchemaBuilder) findBlock(identity block_view.BlockIdentity) (interface{}, error) {
+	block, err := builder.blocksView.FindBy(&identity)
+	if err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, builder.internalError("error finding block", err)
+	}
+	return *block, nil
+}
+
+func (builder *graphqlSchemaBuilder) findTransaction(hash string) (interface{}, error) {
+	transaction, err := builder.transactionsView.FindByHash(hash)
+	if err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, builder.internalError("error finding transaction", err)
+	}
+	return *transaction, nil
+}
+
+func (builder *graphqlSchemaBuilder) listTransactions(
+	args map[string]interface{},
+	maybeBlockHeight *int64,
+) (interface{}, error) {
+	pagination, err := graphqlParsePagination(args, true)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, paginationResult, err := builder.transactionsView.List(transaction_view.TransactionsListFilter{
+		MaybeBlockHeight: maybeBlockHeight,
+	}, transaction_view.TransactionsListOrder{
+		Height: graphqlParseOrder(args, "height.desc"),
+	}, pagination)
+	if err != nil {
+		return nil, builder.internalError("error listing transactions", err)
+	}
+	return graphqlConnection(transactions, paginationResult), nil
+}
+
+// nullIfNotFound returns the row found by the view, or null when it is not found
+func (builder *graphqlSchemaBuilder) nullIfNotFound(row interface{}, err error) (interface{}, error) {
+	if err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, builder.internalError("error finding record", err)
+	}
+	return row, nil
+}
+
+// internalError logs the error and returns the generic internal server error
+func (builder *graphqlSchemaBuilder) internalError(message string, err error) error {
+	builder.handler.logger.Errorf("%s: %v", message, err)
+	return httpapi.ErrInternalServerError
+}
+
+// graphqlObject creates the object type whose fields are resolved from the view rows by default
+func graphqlObject(name string, fields graphql.Fields) *graphql.Object {
+	for _, field := range fields {
+		if field.Resolve == nil {
+			field.Resolve = graphqlResolveJSONField
+		}
+	}
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name:   name,
+		Fields: fields,
+	})
+}
+
+// graphqlResolveJSONField resolves the field from the source by the map key or the JSON name of the
+// struct field, including the fields of the embedded structs. Scalars with custom JSON encoding,
+// e.g. time and coin amount, are returned as they are encoded in the REST APIs.
+func graphqlResolveJSONField(params graphql.ResolveParams) (interface{}, error) {
+	value, found := graphqlJSONFieldValue(reflect.ValueOf(params.Source), params.Info.FieldName)
+	if !found {
+		return nil, nil
+	}
+
+	if _, isScalar := graphql.GetNamed(params.Info.ReturnType).(*graphql.Scalar); !isScalar {
+		return value, nil
+	}
+	if _, isJSONMarshaler := value.(json.Marshaler); !isJSONMarshaler {
+		return value, nil
+	}
+	encoded, err := jsoniter.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var decoded interface{}
+	if err := jsoniter.Unmarshal(encoded, &decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+func graphqlJSONFieldValue(source reflect.Value, name string) (interface{}, bool) {
+	for source.Kind() == reflect.Ptr || source.Kind() == reflect.Interface {
+		if source.IsNil() {
+			return nil, false
+		}
+		source = source.Elem()
+	}
+
+	switch source.Kind() {
+	case reflect.Map:
+		if source.Type().Key().Kind() != reflect.String {
+			return nil, false
+		}
+		value := source.MapIndex(reflect.ValueOf(name).Convert(source.Type().Key()))
+		if !value.IsValid() {
+			return nil, false
+		}
+		return value.Interface(), true
+	case reflect.Struct:
+		sourceType := source.Type()
+		// The fields of the struct take precedence over the fields of the embedded structs
+		for i := 0; i < sourceType.NumField(); i++ {
+			field := sourceType.Field(i)
+			if field.Anonymous || field.PkgPath != "" {
+				continue
+			}
+			jsonName := strings.Split(field.Tag.Get("json"), ",")[0]
+			if jsonName == "" {
+				jsonName = field.Name
+			}
+			if jsonName == name {
+				return source.Field(i).Interface(), true
+			}
+		}
+		for i := 0; i < sourceType.NumField(); i++ {
+			field := sourceType.Field(i)
+			if !field.Anonymous || field.Tag.Get("json") != "" {
+				continue
+			}
+			if value, found := graphqlJSONFieldValue(source.Field(i), name); found {
+				return value, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// graphqlPaginationArgs returns the arguments mirroring the REST pagination query, together with the
+// other arguments of the field
+func graphqlPaginationArgs(cursorSupported bool, args graphql.FieldConfigArgument) graphql.FieldConfigArgument {
+	args["page"] = &graphql.ArgumentConfig{
+		Type:         graphql.Int,
+		DefaultValue: 1,
+	}
+	args["limit"] = &graphql.ArgumentConfig{
+		Type:         graphql.Int,
+		DefaultValue: int(GRAPHQL_DEFAULT_LIMIT),
+	}
+	if cursorSupported {
+		args["pagination"] = &graphql.ArgumentConfig{
+			Type:         graphql.String,
+			Description:  "offset or cursor",
+			DefaultValue: pagination_interface.PAGINATION_OFFSET,
+		}
+		args["cursor"] = &graphql.ArgumentConfig{
+			Type:        graphql.String,
+			Description: "nextCursor of the previous page in cursor pagination",
+		}
+	}
+	return args
+}
+
+func graphqlParsePagination(
+	args map[string]interface{},
+	cursorSupported bool,
+) (*pagination_interface.Pagination, error) {
+	limit := graphqlLimit(args)
+
+	if cursorSupported && args["pagination"] == pagination_interface.PAGINATION_CURSOR {
+		var maybeCursor *pagination_interface.PaginationCursor
+		if encodedCursor, ok := args["cursor"].(string); ok && encodedCursor != "" {
+			cursor, err := pagination_interface.DecodePaginationCursor(encodedCursor)
+			if err != nil {
+				return nil, httpapi.ErrInvalidCursor
+			}
+			maybeCursor = cursor
+		}
+		return pagination_interface.NewCursorPagination(maybeCursor, limit), nil
+	}
+	if cursorSupported && args["pagination"] != pagination_interface.PAGINATION_OFFSET {
+		return nil, httpapi.ErrInvalidPagination
+	}
+
+	page, _ := args["page"].(int)
+	if page <= 0 {
+		return nil, httpapi.ErrInvalidPage
+	}
+	return pagination_interface.NewOffsetPagination(int64(page), limit), nil
+}
+
+func graphqlParseOrder(args map[string]interface{}, descOrder string) view.ORDER {
+	if args["order"] == descOrder {
+		return view.ORDER_DESC
+	}
+	return view.ORDER_ASC
+}
+
+// graphqlFilterArg returns the field of the filter argument. Nil when it is absent
+func graphqlFilterArg(args map[string]interface{}, name string) interface{} {
+	filter, ok := args["filter"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return filter[name]
+}
+
+func graphqlConnection(
+	items interface{},
+	paginationResult *pagination_interface.PaginationResult,
+) map[string]interface{} {
+	connection := map[string]interface{}{
+		"items":            items,
+		"pagination":       nil,
+		"cursorPagination": nil,
+	}
+	if offsetResult := paginationResult.OffsetResult(); offsetResult != nil {
+		connection["pagination"] = map[string]interface{}{
+			"totalRecord": offsetResult.TotalRecord,
+			"totalPage":   offsetResult.TotalPage(),
+			"currentPage": offsetResult.CurrentPage,
+			"limit":       offsetResult.Limit,
+		}
+	}
+	if cursorResult := paginationResult.CursorResult(); cursorResult != nil {
+		var nextCursor interface{}
+		if cursorResult.MaybeNextCursor != nil {
+			nextCursor = cursorResult.MaybeNextCursor.Encode()
+		}
+		connection["cursorPagination"] = map[string]interface{}{
+			"nextCursor": nextCursor,
+			"limit":      cursorResult.Limit,
+		}
+	}
+	return connection
+}
+
+// graphqlLimit returns the page size from the limit argument, clamped to GRAPHQL_MAX_LIMIT
+func graphqlLimit(args map[string]interface{}) int64 {
+	limit, _ := args["limit"].(int)
+	if limit <= 0 {
+		return GRAPHQL_DEFAULT_LIMIT
+	}
+	if int64(limit) > GRAPHQL_MAX_LIMIT {
+		return GRAPHQL_MAX_LIMIT
+	}
+	return int64(limit)
+}
+
+// graphqlListComplexity counts the selection of the items once per item of the page
+func graphqlListComplexity(args map[string]interface{}, childComplexity int) int {
+	return graphqllimit.SaturatingAdd(1, graphqllimit.SaturatingMul(int(graphqlLimit(args)), childComplexity))
+}
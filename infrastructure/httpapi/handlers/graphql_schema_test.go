@@ -0,0 +1,176 @@
+package handlers_test
+
+import (
+	"strings"
+
+	jsoniter "github.com/json-iterator/go"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	"github.com/valyala/fasthttp"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	. "github.com/crypto-com/chain-indexing/appinterface/rdb/test"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/handlers"
+	"github.com/crypto-com/chain-indexing/infrastructure/pg"
+	. "github.com/crypto-com/chain-indexing/internal/logger/test"
+)
+
+var _ = Describe("GraphQL", func() {
+	var mockRDbConn *MockRDbConn
+	var handler *handlers.GraphQL
+
+	BeforeEach(func() {
+		var err error
+
+		mockRDbConn = NewMockRDBbConn()
+		handler, err = handlers.NewGraphQL(
+			NewFakeLogger(),
+			&rdb.Handle{
+				Runner:      mockRDbConn,
+				TypeConv:    &pg.PgxTypeConv{},
+				StmtBuilder: pg.PostgresStmtBuilder,
+			},
+			"tcrocncl",
+			"tcrocnclcons",
+			10,
+			300,
+		)
+		Expect(err).To(BeNil())
+	})
+
+	query := func(body string) *fasthttp.RequestCtx {
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Request.Header.SetMethod(fasthttp.MethodPost)
+		ctx.Request.SetBody([]byte(body))
+
+		handlers.LimitGraphQLRequestBodySize(handler.Query)(ctx)
+		return ctx
+	}
+
+	mockEmptyBlocksList := func(limit int64, offset int64) {
+		mockRowsResult := &MockRDbRowsResult{}
+		mockRowsResult.On("Next").Return(false)
+		mockRowsResult.On("Close").Return()
+		mockRDbConn.On(
+			"Query",
+			"SELECT height, hash, time, app_hash, committed_council_nodes, transaction_count FROM view_blocks ORDER BY height LIMIT $1 OFFSET $2",
+			limit,
+			offset,
+		).Return(mockRowsResult, nil).Once()
+
+		mockRowResult := &MockRDbRowResult{}
+		mockRowResult.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+			*(args.Get(0).(*int64)) = int64(1000)
+		}).Return(nil)
+		mockRDbConn.On(
+			"QueryRow",
+			"SELECT height FROM view_blocks ORDER BY height DESC LIMIT 1",
+		).Return(mockRowResult).Once()
+	}
+
+	Describe("Pagination", func() {
+		It("should clamp the limit to the maximum page size", func() {
+			mockEmptyBlocksList(handlers.GRAPHQL_MAX_LIMIT, int64(0))
+
+			ctx := query(`{"query": "{ blocks(limit: 2147483647) { items { blockHeight } } }"}`)
+
+			Expect(ctx.Response.StatusCode()).To(Equal(fasthttp.StatusOK))
+			Expect(string(ctx.Response.Body())).To(MatchJSON(`{"data": {"blocks": {"items": []}}}`))
+			mockRDbConn.AssertExpectations(GinkgoT())
+		})
+
+		It("should use the default limit when the limit is not positive", func() {
+			mockEmptyBlocksList(handlers.GRAPHQL_DEFAULT_LIMIT, int64(20))
+
+			ctx := query(`{"query": "{ blocks(page: 2, limit: 0) { items { blockHeight } pagination { limit } } }"}`)
+
+			Expect(ctx.Response.StatusCode()).To(Equal(fasthttp.StatusOK))
+			Expect(string(ctx.Response.Body())).To(MatchJSON(
+				`{"data": {"blocks": {"items": [], "pagination": {"limit": 20}}}}`,
+			))
+			mockRDbConn.AssertExpectations(GinkgoT())
+		})
+
+		It("should return error when the page is invalid", func() {
+			ctx := query(`{"query": "{ blocks(page: 0) { items { blockHeight } } }"}`)
+
+			var result struct {
+				Errors []struct {
+					Message string `json:"message"`
+				} `json:"errors"`
+			}
+			Expect(jsoniter.Unmarshal(ctx.Response.Body(), &result)).To(BeNil())
+			Expect(result.Errors).To(HaveLen(1))
+			Expect(result.Errors[0].Message).To(Equal("invalid page number"))
+			mockRDbConn.AssertNotCalled(GinkgoT(), "Query", mock.Anything, mock.Anything, mock.Anything)
+		})
+
+		It("should return error when the cursor is invalid", func() {
+			ctx := query(
+				`{"query": "{ transactions(pagination: \"cursor\", cursor: \"invalid\") { items { hash } } }"}`,
+			)
+
+			var result struct {
+				Errors []struct {
+					Message string `json:"message"`
+				} `json:"errors"`
+			}
+			Expect(jsoniter.Unmarshal(ctx.Response.Body(), &result)).To(BeNil())
+			Expect(result.Errors).To(HaveLen(1))
+			Expect(result.Errors[0].Message).To(Equal("invalid pagination cursor"))
+		})
+	})
+
+	Describe("Complexity", func() {
+		It("should count the list selection by the clamped limit", func() {
+			ctx := query(`{"query": "{ blocks(limit: 2147483647) { items { blockHeight blockHash } } }"}`)
+
+			Expect(string(ctx.Response.Body())).To(MatchJSON(
+				`{"errors": [{"message": "query complexity 301 exceeds the limit of 300", "locations": [{"line": 1, "column": 1}]}]}`,
+			))
+			mockRDbConn.AssertNotCalled(GinkgoT(), "Query", mock.Anything, mock.Anything, mock.Anything)
+		})
+	})
+
+	Describe("Introspection", func() {
+		It("should introspect the schema regardless of the depth and complexity limits", func() {
+			ctx := query(`{"query": "{ __schema { queryType { name } types { name fields { name type { ofType { ` +
+				`ofType { ofType { ofType { ofType { ofType { ofType { name } } } } } } } } } } } }"}`)
+
+			var result struct {
+				Data struct {
+					Schema struct {
+						QueryType struct {
+							Name string `json:"name"`
+						} `json:"queryType"`
+						Types []struct {
+							Name string `json:"name"`
+						} `json:"types"`
+					} `json:"__schema"`
+				} `json:"data"`
+				Errors []interface{} `json:"errors"`
+			}
+			Expect(jsoniter.Unmarshal(ctx.Response.Body(), &result)).To(BeNil())
+			Expect(result.Errors).To(BeEmpty())
+			Expect(result.Data.Schema.QueryType.Name).To(Equal("Query"))
+			typeNames := make([]string, 0)
+			for _, schemaType := range result.Data.Schema.Types {
+				typeNames = append(typeNames, schemaType.Name)
+			}
+			Expect(typeNames).To(ContainElement("TransactionConnection"))
+		})
+	})
+
+	Describe("Request", func() {
+		It("should reject request body larger than the limit", func() {
+			ctx := query(`{"query": "{ blocks { items { blockHeight } } }", "operationName": "` +
+				strings.Repeat("a", handlers.GRAPHQL_MAX_REQUEST_BODY_SIZE) + `"}`)
+
+			Expect(ctx.Response.StatusCode()).To(Equal(fasthttp.StatusBadRequest))
+			Expect(string(ctx.Response.Body())).To(MatchJSON(
+				`{"result": null, "error": "GraphQL request too large"}`,
+			))
+		})
+	})
+})
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"github.com/valyala/fasthttp"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	ibc_channel_view "github.com/crypto-com/chain-indexing/projection/ibc_channel/view"
+	ibc_denom_trace_view "github.com/crypto-com/chain-indexing/projection/ibc_denom_trace/view"
+)
+
+type IBC struct {
+	logger applogger.Logger
+
+	clientsView  *ibc_channel_view.IBCClients
+	channelsView *ibc_channel_view.IBCChannels
+	packetsView  *ibc_channel_view.IBCPackets
+
+	denomTracesView *ibc_denom_trace_view.IBCDenomTraces
+}
+
+func NewIBC(logger applogger.Logger, rdbHandle *rdb.Handle) *IBC {
+	return &IBC{
+		logger.WithFields(applogger.LogFields{
+			"module": "IBCHandler",
+		}),
+
+		ibc_channel_view.NewIBCClients(rdbHandle),
+		ibc_channel_view.NewIBCChannels(rdbHandle),
+		ibc_channel_view.NewIBCPackets(rdbHandle),
+
+		ibc_denom_trace_view.NewIBCDenomTraces(rdbHandle),
+	}
+}
+
+func (handler *IBC) ListClients(ctx *fasthttp.RequestCtx) {
+	pagination, err := httpapi.ParsePagination(ctx)
+	if err != nil {
+		ctx.SetStatusCode(fasthttp.StatusBadRequest)
+		return
+	}
+
+	clients, paginationResult, err := handler.clientsView.List(pagination)
+	if err != nil {
+		handler.logger.Errorf("error listing IBC clients: %v", err)
+		httpapi.InternalServerError(ctx)
+		return
+	}
+
+	httpapi.SuccessWithPagination(ctx, clients, paginationResult)
+}
+
+func (handler *IBC) ListChannels(ctx *fasthttp.RequestCtx) {
+	pagination, err := httpapi.ParsePagination(ctx)
+	if err != nil {
+		ctx.SetStatusCode(fasthttp.StatusBadRequest)
+		return
+	}
+
+	filter := ibc_channel_view.IBCChannelsListFilter{
+		MaybeState: nil,
+	}
+	queryArgs := ctx.QueryArgs()
+	if queryArgs.Has("filter.state") {
+		state := string(queryArgs.Peek("filter.state"))
+		filter.MaybeState = &state
+	}
+
+	channels, paginationResult, err := handler.channelsView.List(filter, pagination)
+	if err != nil {
+		handler.logger.Errorf("error listing IBC channels: %v", err)
+		httpapi.InternalServerError(ctx)
+		return
+	}
+
+	httpapi.SuccessWithPagination(ctx, channels, paginationResult)
+}
+
+// ListPacketsByChannel lists the packets sent and received through the channel
+func (handler *IBC) ListPacketsByChannel(ctx *fasthttp.RequestCtx) {
+	pagination, err := httpapi.ParsePagination(ctx)
+	if err != nil {
+		ctx.SetStatusCode(fasthttp.StatusBadRequest)
+		return
+	}
+
+	filter := ibc_channel_view.IBCPacketsListFilter{
+		ChannelID:      ctx.UserValue("channel-id").(string),
+		MaybeDirection: nil,
+		MaybeStatus:    nil,
+	}
+	queryArgs := ctx.QueryArgs()
+	if queryArgs.Has("filter.direction") {
+		direction := string(queryArgs.Peek("filter.direction"))
+		filter.MaybeDirection = &direction
+	}
+	if queryArgs.Has("filter.status") {
+		status := string(queryArgs.Peek("filter.status"))
+		filter.MaybeStatus = &status
+	}
+
+	packets, paginationResult, err := handler.packetsView.List(filter, pagination)
+	if err != nil {
+		handler.logger.Errorf("error listing IBC packets: %v", err)
+		httpapi.InternalServerError(ctx)
+		return
+	}
+
+	httpapi.SuccessWithPagination(ctx, packets, paginationResult)
+}
+
+// ListDenomTraces lists the traces of the IBC voucher denominations seen on the chain
+func (handler *IBC) ListDenomTraces(ctx *fasthttp.RequestCtx) {
+	pagination, err := httpapi.ParsePagination(ctx)
+	if err != nil {
+		ctx.SetStatusCode(fasthttp.StatusBadRequest)
+		return
+	}
+
+	traces, paginationResult, err := handler.denomTracesView.List(pagination)
+	if err != nil {
+		handler.logger.Errorf("error listing IBC denom traces: %v", err)
+		httpapi.InternalServerError(ctx)
+		return
+	}
+
+	httpapi.SuccessWithPagination(ctx, traces, paginationResult)
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	ibc_denom_trace_view "github.com/crypto-com/chain-indexing/projection/ibc_denom_trace/view"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+)
+
+// DenomTraces maps IBC voucher denominations, i.e. ibc/{hash}, to their traces
+type DenomTraces map[string]coin.DenomTrace
+
+// denomTracesResolver annotates API results with the traces of the IBC voucher denominations in
+// them
+type denomTracesResolver struct {
+	logger applogger.Logger
+
+	denomTracesView *ibc_denom_trace_view.IBCDenomTraces
+}
+
+func newDenomTracesResolver(logger applogger.Logger, rdbHandle *rdb.Handle) *denomTracesResolver {
+	return &denomTracesResolver{
+		logger.WithFields(applogger.LogFields{
+			"module": "DenomTracesResolver",
+		}),
+
+		ibc_denom_trace_view.NewIBCDenomTraces(rdbHandle),
+	}
+}
+
+// Resolve returns the traces of the IBC denominations found in each of the results, in the same
+// order as the results. Denominations with unknown trace are left out. Annotation is best effort and
+// the results are not annotated when the traces cannot be queried, e.g. when the IBCDenomTrace
+// projection is not enabled.
+func (resolver *denomTracesResolver) Resolve(results ...interface{}) []DenomTraces {
+	resultsDenoms := make([][]string, 0, len(results))
+	hashes := make([]string, 0)
+	for _, result := range results {
+		denoms := coin.CollectIBCDenoms(result)
+		for _, denom := range denoms {
+			hash, _ := coin.IBCDenomHash(denom)
+			hashes = append(hashes, hash)
+		}
+		resultsDenoms = append(resultsDenoms, denoms)
+	}
+
+	traceRows, err := resolver.denomTracesView.FindAllByHashes(hashes)
+	if err != nil {
+		resolver.logger.Errorf("error finding IBC denom traces: %v", err)
+		traceRows = make(map[string]ibc_denom_trace_view.IBCDenomTraceRow)
+	}
+
+	resultsTraces := make([]DenomTraces, 0, len(results))
+	for _, denoms := range resultsDenoms {
+		traces := make(DenomTraces)
+		for _, denom := range denoms {
+			hash, _ := coin.IBCDenomHash(denom)
+			if row, ok := traceRows[hash]; ok {
+				traces[denom] = row.DenomTrace()
+			}
+		}
+		resultsTraces = append(resultsTraces, traces)
+	}
+
+	return resultsTraces
+}
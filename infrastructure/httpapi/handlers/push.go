@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/valyala/fasthttp"
+
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
+	"github.com/crypto-com/chain-indexing/infrastructure/push"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+)
+
+// Interval of the comment lines sent to keep idle streams open through proxies
+const PUSH_HEARTBEAT_INTERVAL = 15 * time.Second
+
+type Push struct {
+	logger applogger.Logger
+
+	broker *push.Broker
+}
+
+func NewPush(logger applogger.Logger, broker *push.Broker) *Push {
+	return &Push{
+		logger.WithFields(applogger.LogFields{
+			"module": "PushHandler",
+		}),
+
+		broker,
+	}
+}
+
+// Subscribe streams the messages of the topics in the `topic` query parameters as Server-Sent
+// Events. The event name is the topic and the data is the JSON message. The stream ends with an
+// `error` event when the client cannot keep up with the messages or the server is shutting down.
+func (handler *Push) Subscribe(ctx *fasthttp.RequestCtx) {
+	topics := make([]string, 0)
+	for _, topic := range ctx.QueryArgs().PeekMulti("topic") {
+		topics = append(topics, string(topic))
+	}
+
+	subscription, err := handler.broker.Subscribe(topics)
+	if err != nil {
+		if errors.Is(err, push.ErrTooManySubscriptions) || errors.Is(err, push.ErrBrokerClosed) {
+			httpapi.ServiceUnavailable(ctx, err)
+			return
+		}
+		httpapi.BadRequest(ctx, err)
+		return
+	}
+
+	ctx.Response.Header.Set("Content-Type", "text/event-stream")
+	ctx.Response.Header.Set("Cache-Control", "no-cache")
+	ctx.Response.Header.Set("Connection", "keep-alive")
+	ctx.Response.Header.Set("X-Accel-Buffering", "no")
+	ctx.SetBodyStreamWriter(func(writer *bufio.Writer) {
+		defer handler.broker.Unsubscribe(subscription)
+
+		heartbeat := time.NewTicker(PUSH_HEARTBEAT_INTERVAL)
+		defer heartbeat.Stop()
+
+		if err := writeServerSentEvent(writer, "subscribed", PushSubscribed{
+			Topics: subscription.Topics(),
+		}); err != nil {
+			return
+		}
+		for {
+			select {
+			case message := <-subscription.Messages():
+				if err := writeServerSentEvent(writer, message.Topic, message.Data); err != nil {
+					handler.logger.Debugf("error writing pushed message, client is likely gone: %v", err)
+					return
+				}
+			case <-subscription.Done():
+				if subscription.Err() != nil {
+					_ = writeServerSentEvent(writer, "error", httpapi.Response{
+						Err: subscription.Err().Error(),
+					})
+				}
+				return
+			case <-heartbeat.C:
+				if _, err := writer.WriteString(": heartbeat\n\n"); err != nil {
+					return
+				}
+				if err := writer.Flush(); err != nil {
+					return
+				}
+			}
+		}
+	})
+}
+
+// PushSubscribed is the data of the first event of the stream
+type PushSubscribed struct {
+	Topics []string `json:"topics"`
+}
+
+func writeServerSentEvent(writer *bufio.Writer, event string, data interface{}) error {
+	encodedData, err := jsoniter.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("error encoding server-sent event data: %v", err)
+	}
+	if _, err := fmt.Fprintf(writer, "event: %s\ndata: %s\n\n", event, encodedData); err != nil {
+		return err
+	}
+	return writer.Flush()
+}
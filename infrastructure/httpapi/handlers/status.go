@@ -23,6 +23,7 @@ import (
 	validator_view "github.com/crypto-com/chain-indexing/projection/validator/view"
 	"github.com/crypto-com/chain-indexing/projection/validatorstats"
 	validatorstats_view "github.com/crypto-com/chain-indexing/projection/validatorstats/view"
+	"github.com/crypto-com/chain-indexing/usecase/continuity"
 	"github.com/valyala/fasthttp"
 )
 
@@ -33,6 +34,7 @@ type StatusHandler struct {
 	blocksView            *block_view.Blocks
 	chainStatsView        *chainstats_view.ChainStats
 	transactionsTotalView *transaction_view.TransactionsTotal
+	unknownMsgsTotalView  *transaction_view.UnknownMsgsTotal
 	validatorsView        *validator_view.Validators
 	validatorStatsView    *validatorstats_view.ValidatorStats
 	statusView            *status_polling.Status
@@ -51,6 +53,7 @@ func NewStatusHandler(logger applogger.Logger, cosmosAppClient cosmosapp.Client,
 		block_view.NewBlocks(rdbHandle),
 		chainstats_view.NewChainStats(rdbHandle),
 		transaction_view.NewTransactionsTotal(rdbHandle),
+		transaction_view.NewUnknownMsgsTotal(rdbHandle),
 		validator_view.NewValidators(rdbHandle),
 		validatorstats_view.NewValidatorStats(rdbHandle),
 		status_polling.NewStatus(rdbHandle),
@@ -75,6 +78,19 @@ func (handler *StatusHandler) GetStatus(ctx *fasthttp.RequestCtx) {
 		return
 	}
 
+	unknownMsgCount, err := handler.unknownMsgsTotalView.FindBy("-")
+	if err != nil {
+		handler.logger.Errorf("error fetching unknown message count: %v", err)
+		httpapi.InternalServerError(ctx)
+		return
+	}
+	unknownMsgTypeCounts, err := handler.unknownMsgsTotalView.ListByType()
+	if err != nil {
+		handler.logger.Errorf("error fetching unknown message count by type: %v", err)
+		httpapi.InternalServerError(ctx)
+		return
+	}
+
 	// TODO: https://github.com/crypto-com/chain-indexing/issues/386
 	//rawTotalDelegated, err := handler.validatorStatsView.FindBy(validatorstats.TOTAL_DELEGATE)
 	//if err != nil {
@@ -177,6 +193,25 @@ func (handler *StatusHandler) GetStatus(ctx *fasthttp.RequestCtx) {
 		}
 	}
 
+	// Each event handler reports its own divergence, the earliest one is reported
+	var maybeDivergence *continuity.DivergenceError
+	rawDivergences, err := handler.statusView.FindAllByKeyPrefix(continuity.DIVERGENCE_STATUS_KEY_PREFIX)
+	if err != nil {
+		handler.logger.Errorf("error fetching chain divergences: %v", err)
+		httpapi.InternalServerError(ctx)
+		return
+	}
+	for _, rawDivergence := range rawDivergences {
+		if rawDivergence == "" {
+			continue
+		}
+		var divergence continuity.DivergenceError
+		json.MustUnmarshalFromString(rawDivergence, &divergence)
+		if maybeDivergence == nil || divergence.Height < maybeDivergence.Height {
+			maybeDivergence = &divergence
+		}
+	}
+
 	totalBlockTimeMilliSecond := new(big.Float).Quo(
 		new(big.Float).SetInt(totalBlockTime),
 		new(big.Float).SetInt64(int64(1000000)),
@@ -189,12 +224,15 @@ func (handler *StatusHandler) GetStatus(ctx *fasthttp.RequestCtx) {
 	status := Status{
 		BlockCount:                  blockCount,
 		TransactionCount:            transactionCount,
+		UnknownMsgCount:             unknownMsgCount,
+		UnknownMsgTypeCounts:        unknownMsgTypeCounts,
 		TotalDelegated:              handler.totalDelegated,
 		TotalReward:                 totalReward,
 		ValidatorCount:              validatorCount,
 		ActiveValidatorCount:        activeValidatorCount,
 		LatestHeight:                latestHeight,
 		AverageBlockTimeMillisecond: averageBlockTime.Text('f', 0),
+		MaybeChainDivergence:        maybeDivergence,
 	}
 
 	httpapi.Success(ctx, status)
@@ -203,10 +241,15 @@ func (handler *StatusHandler) GetStatus(ctx *fasthttp.RequestCtx) {
 type Status struct {
 	BlockCount                  int64         `json:"blockCount"`
 	TransactionCount            int64         `json:"transactionCount"`
+	UnknownMsgCount             int64         `json:"unknownMsgCount"`
 	TotalDelegated              coin.Coins    `json:"totalDelegated"`
 	TotalReward                 coin.DecCoins `json:"totalReward"`
 	ValidatorCount              int64         `json:"validatorCount"`
 	ActiveValidatorCount        int64         `json:"activeValidatorCount"`
 	LatestHeight                int64         `json:"latestHeight"`
 	AverageBlockTimeMillisecond string        `json:"averageBlockTimeMillisecond"`
+	// Count of messages without registered parser by message type URL
+	UnknownMsgTypeCounts map[string]int64 `json:"unknownMsgTypeCounts"`
+
+	MaybeChainDivergence *continuity.DivergenceError `json:"chainDivergence"`
 }
@@ -15,7 +15,9 @@ import (
 type Transactions struct {
 	logger applogger.Logger
 
-	transactionsView *transaction_view.BlockTransactions
+	transactionsView            *transaction_view.BlockTransactions
+	undecodableTransactionsView *transaction_view.UndecodableTransactions
+	denomTraces                 *denomTracesResolver
 }
 
 func NewTransactions(logger applogger.Logger, rdbHandle *rdb.Handle) *Transactions {
@@ -25,6 +27,8 @@ func NewTransactions(logger applogger.Logger, rdbHandle *rdb.Handle) *Transactio
 		}),
 
 		transaction_view.NewTransactions(rdbHandle),
+		transaction_view.NewUndecodableTransactions(rdbHandle),
+		newDenomTracesResolver(logger, rdbHandle),
 	}
 }
 
@@ -44,13 +48,16 @@ func (handler *Transactions) FindByHash(ctx *fasthttp.RequestCtx) {
 		return
 	}
 
-	httpapi.Success(ctx, transaction)
+	httpapi.Success(ctx, TransactionWithDenomTraces{
+		TransactionRow: *transaction,
+		DenomTraces:    handler.denomTraces.Resolve(transaction)[0],
+	})
 }
 
 func (handler *Transactions) List(ctx *fasthttp.RequestCtx) {
 	var err error
 
-	pagination, err := httpapi.ParsePagination(ctx)
+	pagination, err := httpapi.ParsePaginationWithCursor(ctx)
 	if err != nil {
 		ctx.SetStatusCode(fasthttp.StatusBadRequest)
 		return
@@ -64,7 +71,7 @@ func (handler *Transactions) List(ctx *fasthttp.RequestCtx) {
 		}
 	}
 
-	blocks, paginationResult, err := handler.transactionsView.List(transaction_view.TransactionsListFilter{
+	transactions, paginationResult, err := handler.transactionsView.List(transaction_view.TransactionsListFilter{
 		MaybeBlockHeight: nil,
 	}, transaction_view.TransactionsListOrder{
 		Height: heightOrder,
@@ -75,5 +82,63 @@ func (handler *Transactions) List(ctx *fasthttp.RequestCtx) {
 		return
 	}
 
-	httpapi.SuccessWithPagination(ctx, blocks, paginationResult)
+	httpapi.SuccessWithPagination(ctx, handler.withDenomTraces(transactions), paginationResult)
+}
+
+// ListUndecodable lists the transactions recorded as undecodable for backfilling
+func (handler *Transactions) ListUndecodable(ctx *fasthttp.RequestCtx) {
+	var err error
+
+	pagination, err := httpapi.ParsePagination(ctx)
+	if err != nil {
+		ctx.SetStatusCode(fasthttp.StatusBadRequest)
+		return
+	}
+
+	heightOrder := view.ORDER_ASC
+	queryArgs := ctx.QueryArgs()
+	if queryArgs.Has("order") {
+		if string(queryArgs.Peek("order")) == "height.desc" {
+			heightOrder = view.ORDER_DESC
+		}
+	}
+
+	transactions, paginationResult, err := handler.undecodableTransactionsView.List(
+		transaction_view.TransactionsListOrder{
+			Height: heightOrder,
+		}, pagination,
+	)
+	if err != nil {
+		handler.logger.Errorf("error listing undecodable transactions: %v", err)
+		httpapi.InternalServerError(ctx)
+		return
+	}
+
+	httpapi.SuccessWithPagination(ctx, transactions, paginationResult)
+}
+
+func (handler *Transactions) withDenomTraces(
+	transactions []transaction_view.TransactionRow,
+) []TransactionWithDenomTraces {
+	results := make([]interface{}, 0, len(transactions))
+	for _, transaction := range transactions {
+		results = append(results, transaction)
+	}
+	denomTraces := handler.denomTraces.Resolve(results...)
+
+	transactionsWithDenomTraces := make([]TransactionWithDenomTraces, 0, len(transactions))
+	for i, transaction := range transactions {
+		transactionsWithDenomTraces = append(transactionsWithDenomTraces, TransactionWithDenomTraces{
+			TransactionRow: transaction,
+			DenomTraces:    denomTraces[i],
+		})
+	}
+	return transactionsWithDenomTraces
+}
+
+type TransactionWithDenomTraces struct {
+	transaction_view.TransactionRow
+
+	// Traces of the IBC denominations in the transaction
+	DenomTraces DenomTraces `json:"denomTraces"`
 }
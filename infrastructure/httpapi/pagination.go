@@ -37,20 +37,57 @@ func ParsePagination(ctx *fasthttp.RequestCtx) (*pagination_interface.Pagination
 		}
 	}
 
+	limit, err = parsePaginationLimit(queryArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return pagination_interface.NewOffsetPagination(page, limit), nil
+}
+
+// ParsePaginationWithCursor parses offset pagination as ParsePagination does, and cursor pagination
+// with `pagination=cursor`, the optional `cursor` returned from the previous page and `limit`. It is
+// only for the lists supporting cursor pagination.
+func ParsePaginationWithCursor(ctx *fasthttp.RequestCtx) (*pagination_interface.Pagination, error) {
+	queryArgs := NewQueryArgs(ctx.QueryArgs())
+
+	if queryArgs.Get("pagination") != pagination_interface.PAGINATION_CURSOR {
+		return ParsePagination(ctx)
+	}
+
+	var maybeCursor *pagination_interface.PaginationCursor
+	cursorQuery := queryArgs.Get("cursor")
+	if cursorQuery != "" {
+		cursor, err := pagination_interface.DecodePaginationCursor(cursorQuery)
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+		maybeCursor = cursor
+	}
+
+	limit, err := parsePaginationLimit(queryArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return pagination_interface.NewCursorPagination(maybeCursor, limit), nil
+}
+
+func parsePaginationLimit(queryArgs *QueryArgs) (int64, error) {
 	var defaultLimit int64 = int64(20)
 
 	limitQuery := queryArgs.Get("limit")
 	if limitQuery == "" {
-		limit = defaultLimit
-	} else {
-		limit, err = strconv.ParseInt(limitQuery, 10, 64)
-		if err != nil {
-			return nil, ErrInvalidPage
-		}
-		if limit <= 0 {
-			limit = defaultLimit
-		}
+		return defaultLimit, nil
 	}
 
-	return pagination_interface.NewOffsetPagination(page, limit), nil
+	limit, err := strconv.ParseInt(limitQuery, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidPage
+	}
+	if limit <= 0 {
+		return defaultLimit, nil
+	}
+
+	return limit, nil
 }
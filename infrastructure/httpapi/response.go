@@ -30,6 +30,7 @@ func SuccessWithPagination(
 			Err:    "",
 		},
 		OffsetPagination: OptPaginationOffsetResponseFromResult(paginationResult.OffsetResult()),
+		CursorPagination: OptPaginationCursorResponseFromResult(paginationResult.CursorResult()),
 	})
 	if err != nil {
 		InternalServerError(ctx)
@@ -64,6 +65,20 @@ func BadRequest(ctx *fasthttp.RequestCtx, errResp error) {
 	ctx.SetBody(message)
 }
 
+func ServiceUnavailable(ctx *fasthttp.RequestCtx, errResp error) {
+	ctx.Response.Header.Set("Content-Type", "application/json")
+	message, err := jsoniter.Marshal(Response{
+		Err: errResp.Error(),
+	})
+	if err != nil {
+		InternalServerError(ctx)
+		return
+	}
+
+	ctx.SetStatusCode(fasthttp.StatusServiceUnavailable)
+	ctx.SetBody(message)
+}
+
 func InternalServerError(ctx *fasthttp.RequestCtx) {
 	ctx.Response.Header.Set("Content-Type", "application/json")
 	message, _ := jsoniter.Marshal(Response{
@@ -78,6 +93,7 @@ type PagedResponse struct {
 	Response
 
 	OffsetPagination *PaginationOffsetResponse `json:"pagination,omitempty"`
+	CursorPagination *PaginationCursorResponse `json:"cursor_pagination,omitempty"`
 }
 
 type Response struct {
@@ -106,3 +122,28 @@ func OptPaginationOffsetResponseFromResult(
 		Limit:       offsetResult.Limit,
 	}
 }
+
+type PaginationCursorResponse struct {
+	// Null when there are no more records
+	NextCursor *string `json:"next_cursor"`
+	Limit      int64   `json:"limit"`
+}
+
+func OptPaginationCursorResponseFromResult(
+	cursorResult *pagination_interface.PaginationCursorResult,
+) *PaginationCursorResponse {
+	if cursorResult == nil {
+		return nil
+	}
+
+	var nextCursor *string
+	if cursorResult.MaybeNextCursor != nil {
+		encodedCursor := cursorResult.MaybeNextCursor.Encode()
+		nextCursor = &encodedCursor
+	}
+
+	return &PaginationCursorResponse{
+		NextCursor: nextCursor,
+		Limit:      cursorResult.Limit,
+	}
+}
@@ -18,8 +18,15 @@ type RouteRegistry struct {
 	accountTransactionsHandler *handlers.AccountTransactions
 	accountMessagesHandler     *handlers.AccountMessages
 	accountsHandler            *handlers.Accounts
+	authzGrantsHandler         *handlers.AuthzGrants
+	ethereumTransactionHandler *handlers.EthereumTransactions
+	feeGrantsHandler           *handlers.FeeGrants
+	ibcHandler                 *handlers.IBC
 	proposalsHandler           *handlers.Proposals
 	nftsHandler                *handlers.NFTs
+	graphqlHandler             *handlers.GraphQL
+	// Optional. Nil when push API is disabled
+	pushHandler *handlers.Push
 }
 
 func NewRoutesRegistry(
@@ -32,8 +39,14 @@ func NewRoutesRegistry(
 	accountTransactionsHandler *handlers.AccountTransactions,
 	accountMessagesHandler *handlers.AccountMessages,
 	accountsHandler *handlers.Accounts,
+	authzGrantsHandler *handlers.AuthzGrants,
+	ethereumTransactionHandler *handlers.EthereumTransactions,
+	feeGrantsHandler *handlers.FeeGrants,
+	ibcHandler *handlers.IBC,
 	proposalsHandler *handlers.Proposals,
 	nftsHandler *handlers.NFTs,
+	graphqlHandler *handlers.GraphQL,
+	pushHandler *handlers.Push,
 ) *RouteRegistry {
 	return &RouteRegistry{
 		searchHandler,
@@ -45,8 +58,14 @@ func NewRoutesRegistry(
 		accountTransactionsHandler,
 		accountMessagesHandler,
 		accountsHandler,
+		authzGrantsHandler,
+		ethereumTransactionHandler,
+		feeGrantsHandler,
+		ibcHandler,
 		proposalsHandler,
 		nftsHandler,
+		graphqlHandler,
+		pushHandler,
 	}
 }
 
@@ -64,19 +83,30 @@ func (registry *RouteRegistry) Register(server *httpapi.Server, routePrefix stri
 	server.GET(fmt.Sprintf("%s/api/v1/accounts/{account}", routePrefix), registry.accountsHandler.FindBy)
 	server.GET(fmt.Sprintf("%s/api/v1/accounts/{account}/transactions", routePrefix), registry.accountTransactionsHandler.ListByAccount)
 	server.GET(fmt.Sprintf("%s/api/v1/accounts/{account}/messages", routePrefix), registry.accountMessagesHandler.ListByAccount)
+	server.GET(fmt.Sprintf("%s/api/v1/accounts/{account}/grants", routePrefix), registry.authzGrantsHandler.ListByGranter)
+	server.GET(fmt.Sprintf("%s/api/v1/accounts/{account}/grants/received", routePrefix), registry.authzGrantsHandler.ListByGrantee)
+	server.GET(fmt.Sprintf("%s/api/v1/accounts/{account}/feegrants", routePrefix), registry.feeGrantsHandler.ListByGranter)
+	server.GET(fmt.Sprintf("%s/api/v1/accounts/{account}/feegrants/received", routePrefix), registry.feeGrantsHandler.ListByGrantee)
 	server.GET(fmt.Sprintf("%s/api/v1/blocks", routePrefix), registry.blocksHandler.List)
 	server.GET(fmt.Sprintf("%s/api/v1/blocks/{height-or-hash}", routePrefix), registry.blocksHandler.FindBy)
 	server.GET(fmt.Sprintf("%s/api/v1/blocks/{height}/transactions", routePrefix), registry.blocksHandler.ListTransactionsByHeight)
 	server.GET(fmt.Sprintf("%s/api/v1/blocks/{height}/events", routePrefix), registry.blocksHandler.ListEventsByHeight)
 	server.GET(fmt.Sprintf("%s/api/v1/blocks/{height}/commitments", routePrefix), registry.blocksHandler.ListCommitmentsByHeight)
+	server.GET(fmt.Sprintf("%s/api/v1/ethereum/transactions", routePrefix), registry.ethereumTransactionHandler.List)
+	server.GET(fmt.Sprintf("%s/api/v1/ethereum/transactions/{hash}", routePrefix), registry.ethereumTransactionHandler.FindByHash)
 	server.GET(fmt.Sprintf("%s/api/v1/events", routePrefix), registry.blockEventHandler.List)
 	server.GET(fmt.Sprintf("%s/api/v1/events/{id}", routePrefix), registry.blockEventHandler.FindById)
+	server.GET(fmt.Sprintf("%s/api/v1/ibc/channels", routePrefix), registry.ibcHandler.ListChannels)
+	server.GET(fmt.Sprintf("%s/api/v1/ibc/channels/{channel-id}/packets", routePrefix), registry.ibcHandler.ListPacketsByChannel)
+	server.GET(fmt.Sprintf("%s/api/v1/ibc/clients", routePrefix), registry.ibcHandler.ListClients)
+	server.GET(fmt.Sprintf("%s/api/v1/ibc/denomtraces", routePrefix), registry.ibcHandler.ListDenomTraces)
 	server.GET(fmt.Sprintf("%s/api/v1/proposals", routePrefix), registry.proposalsHandler.List)
 	server.GET(fmt.Sprintf("%s/api/v1/proposals/{id}", routePrefix), registry.proposalsHandler.FindById)
 	server.GET(fmt.Sprintf("%s/api/v1/proposals/{id}/votes", routePrefix), registry.proposalsHandler.ListVotesById)
 	server.GET(fmt.Sprintf("%s/api/v1/proposals/{id}/depositors", routePrefix), registry.proposalsHandler.ListDepositorsById)
 	server.GET(fmt.Sprintf("%s/api/v1/status", routePrefix), registry.statusHandler.GetStatus)
 	server.GET(fmt.Sprintf("%s/api/v1/transactions", routePrefix), registry.transactionHandler.List)
+	server.GET(fmt.Sprintf("%s/api/v1/transactions/undecodable", routePrefix), registry.transactionHandler.ListUndecodable)
 	server.GET(fmt.Sprintf("%s/api/v1/transactions/{hash}", routePrefix), registry.transactionHandler.FindByHash)
 	server.GET(fmt.Sprintf("%s/api/v1/validators", routePrefix), registry.validatorsHandler.List)
 	server.GET(fmt.Sprintf("%s/api/v1/validators/active", routePrefix), registry.validatorsHandler.ListActive)
@@ -96,4 +126,10 @@ func (registry *RouteRegistry) Register(server *httpapi.Server, routePrefix stri
 	server.GET(fmt.Sprintf("%s/api/v1/nfts/drops", routePrefix), registry.nftsHandler.ListDrops)
 	server.GET(fmt.Sprintf("%s/api/v1/nfts/drops/{drop}/tokens", routePrefix), registry.nftsHandler.ListTokensByDrop)
 	server.GET(fmt.Sprintf("%s/api/v1/nfts/accounts/{account}/tokens", routePrefix), registry.nftsHandler.ListTokensByAccount)
+	graphqlQuery := handlers.LimitGraphQLRequestBodySize(registry.graphqlHandler.Query)
+	server.GET(fmt.Sprintf("%s/api/graphql", routePrefix), graphqlQuery)
+	server.POST(fmt.Sprintf("%s/api/graphql", routePrefix), graphqlQuery)
+	if registry.pushHandler != nil {
+		server.GET(fmt.Sprintf("%s/api/v1/subscribe", routePrefix), registry.pushHandler.Subscribe)
+	}
 }
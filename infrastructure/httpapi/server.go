@@ -1,9 +1,13 @@
 package httpapi
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/crypto-com/chain-indexing/infrastructure/metrics"
 	applogger "github.com/crypto-com/chain-indexing/internal/logger"
 	"github.com/fasthttp/router"
 	"github.com/lab259/cors"
@@ -38,6 +42,11 @@ func (server *Server) GET(path string, handler fasthttp.RequestHandler) *Server
 	return server
 }
 
+func (server *Server) POST(path string, handler fasthttp.RequestHandler) *Server {
+	server.router.POST(path, handler)
+	return server
+}
+
 func (server *Server) Use(middleware Middleware) *Server {
 	server.middlewares = append(server.middlewares, middleware)
 	return server
@@ -72,12 +81,64 @@ func (server *Server) WithPanicHandler(handler func(ctx *fasthttp.RequestCtx, er
 	return server
 }
 
+// WithMetrics records the latency of every request by its matched route
+func (server *Server) WithMetrics() *Server {
+	server.router.SaveMatchedRoutePath = true
+	return server.Use(func(handler fasthttp.RequestHandler) fasthttp.RequestHandler {
+		return func(ctx *fasthttp.RequestCtx) {
+			startTime := time.Now()
+
+			handler(ctx)
+
+			route, ok := ctx.UserValue(router.MatchedRoutePathParam).(string)
+			if !ok {
+				route = "unmatched"
+			}
+			metrics.ObserveHTTPRequest(string(ctx.Method()), route, ctx.Response.StatusCode(), startTime)
+		}
+	})
+}
+
 func (server *Server) WithPprof(path string) *Server {
 	server.router.ANY(fmt.Sprintf("%s/{path:*}", strings.TrimRight(path, "/")), pprofhandler.PprofHandler)
 	return server
 }
 
 func (server *Server) ListenAndServe() error {
+	return fasthttp.ListenAndServe(server.listeningAddress, server.handler())
+}
+
+// ListenAndServeWithContext serves until the context is done and then shuts the server down
+// gracefully. In-flight requests are given shutdownTimeout to complete.
+func (server *Server) ListenAndServeWithContext(ctx context.Context, shutdownTimeout time.Duration) error {
+	httpServer := &fasthttp.Server{
+		Handler: server.handler(),
+	}
+
+	serveErrCh := make(chan error, 1)
+	go func() {
+		serveErrCh <- httpServer.ListenAndServe(server.listeningAddress)
+	}()
+
+	select {
+	case err := <-serveErrCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownErrCh := make(chan error, 1)
+	go func() {
+		shutdownErrCh <- httpServer.Shutdown()
+	}()
+	select {
+	case err := <-shutdownErrCh:
+		return err
+	case <-time.After(shutdownTimeout):
+		return errors.New("timeout waiting for in-flight requests to complete on shutdown")
+	}
+}
+
+func (server *Server) handler() fasthttp.RequestHandler {
 	handler := server.router.Handler
 	if server.corsMiddleware != nil {
 		handler = server.corsMiddleware(handler)
@@ -88,7 +149,7 @@ func (server *Server) ListenAndServe() error {
 	for _, middleware := range server.middlewares {
 		handler = middleware(handler)
 	}
-	return fasthttp.ListenAndServe(server.listeningAddress, handler)
+	return handler
 }
 
 type Middleware = func(fasthttp.RequestHandler) fasthttp.RequestHandler
@@ -0,0 +1,91 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/valyala/fasthttp"
+	"github.com/valyala/fasthttp/fasthttpadaptor"
+)
+
+const NAMESPACE = "chain_indexing"
+
+// Registry holds all the metrics of chain-indexing. A dedicated registry is used instead of the
+// Prometheus default one so that metrics registered by dependencies are not exposed.
+var Registry = prometheus.NewRegistry()
+
+var ChainLatestHeight = prometheus.NewGauge(prometheus.GaugeOpts{
+	Namespace: NAMESPACE,
+	Name:      "chain_latest_height",
+	Help:      "Latest block height of the chain reported by Tendermint",
+})
+
+var HandleEventsDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: NAMESPACE,
+	Name:      "handle_events_duration_seconds",
+	Help:      "Time taken to handle the events of a block height",
+	Buckets:   prometheus.ExponentialBuckets(0.001, 2, 16),
+}, []string{"handler"})
+
+var RPCRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: NAMESPACE,
+	Name:      "rpc_requests_total",
+	Help:      "Number of requests made to Tendermint and Cosmos RPC",
+}, []string{"client", "method"})
+
+var RPCRequestErrorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: NAMESPACE,
+	Name:      "rpc_request_errors_total",
+	Help:      "Number of failed requests made to Tendermint and Cosmos RPC",
+}, []string{"client", "method"})
+
+var RPCRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: NAMESPACE,
+	Name:      "rpc_request_duration_seconds",
+	Help:      "Latency of requests made to Tendermint and Cosmos RPC",
+	Buckets:   prometheus.DefBuckets,
+}, []string{"client", "method"})
+
+var HTTPRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: NAMESPACE,
+	Name:      "http_request_duration_seconds",
+	Help:      "Latency of HTTP API requests",
+	Buckets:   prometheus.DefBuckets,
+}, []string{"method", "route", "status"})
+
+func init() {
+	Registry.MustRegister(
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+
+		ChainLatestHeight,
+		HandleEventsDuration,
+		RPCRequestsTotal,
+		RPCRequestErrorsTotal,
+		RPCRequestDuration,
+		HTTPRequestDuration,
+	)
+}
+
+// ObserveRPCRequest records a request made to RPC client
+func ObserveRPCRequest(client string, method string, startTime time.Time, err error) {
+	RPCRequestsTotal.WithLabelValues(client, method).Inc()
+	if err != nil {
+		RPCRequestErrorsTotal.WithLabelValues(client, method).Inc()
+	}
+	RPCRequestDuration.WithLabelValues(client, method).Observe(time.Since(startTime).Seconds())
+}
+
+// ObserveHTTPRequest records a request served by HTTP API
+func ObserveHTTPRequest(method string, route string, statusCode int, startTime time.Time) {
+	HTTPRequestDuration.WithLabelValues(
+		method, route, strconv.Itoa(statusCode),
+	).Observe(time.Since(startTime).Seconds())
+}
+
+// Handler serves the metrics in Prometheus exposition format
+func Handler() fasthttp.RequestHandler {
+	return fasthttpadaptor.NewFastHTTPHandler(promhttp.HandlerFor(Registry, promhttp.HandlerOpts{}))
+}
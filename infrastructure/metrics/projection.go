@@ -0,0 +1,49 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	projection_entity "github.com/crypto-com/chain-indexing/entity/projection"
+)
+
+var _ prometheus.Collector = &ProjectionHeightCollector{}
+
+var projectionLastHandledHeightDesc = prometheus.NewDesc(
+	prometheus.BuildFQName(NAMESPACE, "", "projection_last_handled_height"),
+	"Last handled event height of the projection",
+	[]string{"projection"},
+	nil,
+)
+
+// ProjectionHeightCollector reports the last handled event height of projections on every scrape.
+// Projections without any handled height are not reported.
+type ProjectionHeightCollector struct {
+	projections []projection_entity.Projection
+}
+
+func NewProjectionHeightCollector(projections []projection_entity.Projection) *ProjectionHeightCollector {
+	return &ProjectionHeightCollector{
+		projections,
+	}
+}
+
+func (collector *ProjectionHeightCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- projectionLastHandledHeightDesc
+}
+
+func (collector *ProjectionHeightCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, projection := range collector.projections {
+		maybeHeight, err := projection.GetLastHandledEventHeight()
+		if err != nil {
+			ch <- prometheus.NewInvalidMetric(projectionLastHandledHeightDesc, err)
+			continue
+		}
+		if maybeHeight == nil {
+			continue
+		}
+
+		ch <- prometheus.MustNewConstMetric(
+			projectionLastHandledHeightDesc, prometheus.GaugeValue, float64(*maybeHeight), projection.Id(),
+		)
+	}
+}
@@ -0,0 +1,53 @@
+package metrics_test
+
+import (
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	projection_entity "github.com/crypto-com/chain-indexing/entity/projection"
+	projection_entity_test "github.com/crypto-com/chain-indexing/entity/projection/test"
+	. "github.com/crypto-com/chain-indexing/infrastructure/metrics"
+	"github.com/crypto-com/chain-indexing/internal/primptr"
+)
+
+var _ = Describe("ProjectionHeightCollector", func() {
+	It("should report last handled event height of every projection with handled height", func() {
+		validatorProjection := projection_entity_test.NewMockProjection()
+		validatorProjection.On("Id").Return("Validator")
+		validatorProjection.On("GetLastHandledEventHeight").Return(primptr.Int64(100), nil)
+
+		blockProjection := projection_entity_test.NewMockProjection()
+		blockProjection.On("Id").Return("Block")
+		blockProjection.On("GetLastHandledEventHeight").Return((*int64)(nil), nil)
+
+		collector := NewProjectionHeightCollector([]projection_entity.Projection{
+			validatorProjection, blockProjection,
+		})
+
+		expected := `
+# HELP chain_indexing_projection_last_handled_height Last handled event height of the projection
+# TYPE chain_indexing_projection_last_handled_height gauge
+chain_indexing_projection_last_handled_height{projection="Validator"} 100
+`
+		Expect(testutil.CollectAndCompare(collector, strings.NewReader(expected))).To(Succeed())
+	})
+
+	It("should fail the scrape when last handled event height cannot be read", func() {
+		projection := projection_entity_test.NewMockProjection()
+		projection.On("Id").Return("Validator")
+		projection.On("GetLastHandledEventHeight").Return((*int64)(nil), fmt.Errorf("connection refused"))
+
+		registry := prometheus.NewPedanticRegistry()
+		Expect(registry.Register(
+			NewProjectionHeightCollector([]projection_entity.Projection{projection}),
+		)).To(Succeed())
+
+		_, err := registry.Gather()
+		Expect(err).NotTo(BeNil())
+	})
+})
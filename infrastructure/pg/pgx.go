@@ -146,6 +146,18 @@ func (conn *PgxConn) QueryRow(sql string, args ...interface{}) rdb.RowResult {
 		row: conn.pgxConn.QueryRow(context.Background(), sql, args...),
 	}
 }
+
+// Close closes the connection. For connection pool, it waits until all acquired connections are
+// released before closing.
+func (conn *PgxConn) Close() {
+	switch pgxConn := conn.pgxConn.(type) {
+	case *pgxpool.Pool:
+		pgxConn.Close()
+	case *pgx.Conn:
+		_ = pgxConn.Close(context.Background())
+	}
+}
+
 func (conn *PgxConn) ToHandle() *rdb.Handle {
 	return &rdb.Handle{
 		Runner:   conn,
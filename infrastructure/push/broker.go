@@ -0,0 +1,230 @@
+package push
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+const (
+	DEFAULT_MAX_SUBSCRIPTIONS           = 1000
+	DEFAULT_MAX_TOPICS_PER_SUBSCRIPTION = 10
+	DEFAULT_SUBSCRIPTION_BUFFER_SIZE    = 256
+)
+
+var (
+	ErrNoTopic              = errors.New("no topic to subscribe")
+	ErrDuplicatedTopic      = errors.New("duplicated topic")
+	ErrTooManyTopics        = errors.New("too many topics")
+	ErrTooManySubscriptions = errors.New("too many subscriptions")
+	ErrSlowSubscription     = errors.New("subscription is too slow to keep up with the messages")
+	ErrBrokerClosed         = errors.New("broker is closed")
+)
+
+// Message is published to the subscriptions of its topic
+type Message struct {
+	Topic string
+	Data  interface{}
+}
+
+// Broker delivers the published messages to the subscriptions of their topics in memory. Publishing
+// never blocks: every subscription has a bounded buffer, and a subscription whose buffer is full is
+// closed with ErrSlowSubscription, so that its client can catch up from the REST APIs and subscribe
+// again.
+type Broker struct {
+	mutex sync.RWMutex
+	// Subscriptions by topic
+	topics map[string]map[*Subscription]bool
+	// Subscriptions which are not closed
+	subscriptions map[*Subscription]bool
+	closed        bool
+
+	maxSubscriptions         int
+	maxTopicsPerSubscription int
+	bufferSize               int
+}
+
+// NewBroker creates a broker. Default values are used for the non-positive arguments.
+func NewBroker(maxSubscriptions int, maxTopicsPerSubscription int, bufferSize int) *Broker {
+	if maxSubscriptions <= 0 {
+		maxSubscriptions = DEFAULT_MAX_SUBSCRIPTIONS
+	}
+	if maxTopicsPerSubscription <= 0 {
+		maxTopicsPerSubscription = DEFAULT_MAX_TOPICS_PER_SUBSCRIPTION
+	}
+	if bufferSize <= 0 {
+		bufferSize = DEFAULT_SUBSCRIPTION_BUFFER_SIZE
+	}
+
+	return &Broker{
+		topics:        make(map[string]map[*Subscription]bool),
+		subscriptions: make(map[*Subscription]bool),
+		closed:        false,
+
+		maxSubscriptions:         maxSubscriptions,
+		maxTopicsPerSubscription: maxTopicsPerSubscription,
+		bufferSize:               bufferSize,
+	}
+}
+
+// Subscribe creates a subscription to the topics. The subscription must be unsubscribed when the
+// client is gone.
+func (broker *Broker) Subscribe(topics []string) (*Subscription, error) {
+	if len(topics) == 0 {
+		return nil, ErrNoTopic
+	}
+	if len(topics) > broker.maxTopicsPerSubscription {
+		return nil, fmt.Errorf("%w: at most %d topics are allowed", ErrTooManyTopics, broker.maxTopicsPerSubscription)
+	}
+	subscribedTopics := make(map[string]bool, len(topics))
+	for _, topic := range topics {
+		if err := ValidateTopic(topic); err != nil {
+			return nil, err
+		}
+		if subscribedTopics[topic] {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicatedTopic, topic)
+		}
+		subscribedTopics[topic] = true
+	}
+
+	broker.mutex.Lock()
+	defer broker.mutex.Unlock()
+
+	if broker.closed {
+		return nil, ErrBrokerClosed
+	}
+	if len(broker.subscriptions) >= broker.maxSubscriptions {
+		return nil, ErrTooManySubscriptions
+	}
+
+	subscription := &Subscription{
+		topics:   topics,
+		messages: make(chan Message, broker.bufferSize),
+		done:     make(chan struct{}),
+		err:      nil,
+	}
+	broker.subscriptions[subscription] = true
+	for _, topic := range topics {
+		if _, exist := broker.topics[topic]; !exist {
+			broker.topics[topic] = make(map[*Subscription]bool)
+		}
+		broker.topics[topic][subscription] = true
+	}
+
+	return subscription, nil
+}
+
+// Unsubscribe closes the subscription without error. It is no-op when the subscription is closed
+// already.
+func (broker *Broker) Unsubscribe(subscription *Subscription) {
+	broker.mutex.Lock()
+	defer broker.mutex.Unlock()
+
+	broker.closeSubscription(subscription, nil)
+}
+
+// Publish delivers the message to the subscriptions of the topic without blocking
+func (broker *Broker) Publish(topic string, data interface{}) {
+	message := Message{
+		Topic: topic,
+		Data:  data,
+	}
+
+	var slowSubscriptions []*Subscription
+	broker.mutex.RLock()
+	for subscription := range broker.topics[topic] {
+		select {
+		case subscription.messages <- message:
+		default:
+			slowSubscriptions = append(slowSubscriptions, subscription)
+		}
+	}
+	broker.mutex.RUnlock()
+
+	if len(slowSubscriptions) == 0 {
+		return
+	}
+	broker.mutex.Lock()
+	defer broker.mutex.Unlock()
+	for _, subscription := range slowSubscriptions {
+		broker.closeSubscription(subscription, ErrSlowSubscription)
+	}
+}
+
+// HasSubscriptions returns true when the topic has any subscription
+func (broker *Broker) HasSubscriptions(topic string) bool {
+	broker.mutex.RLock()
+	defer broker.mutex.RUnlock()
+
+	return len(broker.topics[topic]) > 0
+}
+
+// HasSubscriptionsWithPrefix returns true when any topic starting with the prefix has subscription
+func (broker *Broker) HasSubscriptionsWithPrefix(prefix string) bool {
+	broker.mutex.RLock()
+	defer broker.mutex.RUnlock()
+
+	for topic := range broker.topics {
+		if strings.HasPrefix(topic, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Close closes all the subscriptions with ErrBrokerClosed and rejects new subscriptions
+func (broker *Broker) Close() {
+	broker.mutex.Lock()
+	defer broker.mutex.Unlock()
+
+	broker.closed = true
+	for subscription := range broker.subscriptions {
+		broker.closeSubscription(subscription, ErrBrokerClosed)
+	}
+}
+
+// closeSubscription must be called with the write lock held
+func (broker *Broker) closeSubscription(subscription *Subscription, err error) {
+	if !broker.subscriptions[subscription] {
+		return
+	}
+
+	delete(broker.subscriptions, subscription)
+	for _, topic := range subscription.topics {
+		delete(broker.topics[topic], subscription)
+		if len(broker.topics[topic]) == 0 {
+			delete(broker.topics, topic)
+		}
+	}
+	subscription.err = err
+	close(subscription.done)
+}
+
+// Subscription receives the messages of its topics until it is done
+type Subscription struct {
+	topics   []string
+	messages chan Message
+	done     chan struct{}
+	err      error
+}
+
+func (subscription *Subscription) Topics() []string {
+	return subscription.topics
+}
+
+// Messages returns the channel of the delivered messages. It is never closed, wait for Done() as well
+func (subscription *Subscription) Messages() <-chan Message {
+	return subscription.messages
+}
+
+// Done returns the channel closed when the subscription is closed
+func (subscription *Subscription) Done() <-chan struct{} {
+	return subscription.done
+}
+
+// Err returns the reason of closing the subscription after it is done. Nil when it is unsubscribed.
+func (subscription *Subscription) Err() error {
+	<-subscription.done
+	return subscription.err
+}
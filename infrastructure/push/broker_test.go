@@ -0,0 +1,98 @@
+package push_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/infrastructure/push"
+)
+
+var _ = Describe("Broker", func() {
+	Describe("Subscribe", func() {
+		It("should reject invalid, duplicated and too many topics", func() {
+			broker := push.NewBroker(10, 2, 10)
+
+			_, err := broker.Subscribe([]string{})
+			Expect(err).To(MatchError(push.ErrNoTopic))
+
+			for _, topic := range []string{"unknown", "account:", "transactions:", "account:cro1 abc"} {
+				_, err = broker.Subscribe([]string{topic})
+				Expect(err).To(MatchError(push.ErrInvalidTopic), topic)
+			}
+
+			_, err = broker.Subscribe([]string{"blocks", "blocks"})
+			Expect(err).To(MatchError(push.ErrDuplicatedTopic))
+
+			_, err = broker.Subscribe([]string{"blocks", "transactions", "account:cro1abc"})
+			Expect(err).To(MatchError(push.ErrTooManyTopics))
+		})
+
+		It("should reject subscription exceeding the limit until a subscription is unsubscribed", func() {
+			broker := push.NewBroker(1, 10, 10)
+
+			subscription, err := broker.Subscribe([]string{"blocks"})
+			Expect(err).To(BeNil())
+
+			_, err = broker.Subscribe([]string{"blocks"})
+			Expect(err).To(MatchError(push.ErrTooManySubscriptions))
+
+			broker.Unsubscribe(subscription)
+			Eventually(subscription.Done()).Should(BeClosed())
+			Expect(subscription.Err()).To(BeNil())
+
+			_, err = broker.Subscribe([]string{"blocks"})
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Describe("Publish", func() {
+		It("should deliver the message to the subscriptions of the topic only", func() {
+			broker := push.NewBroker(10, 10, 10)
+
+			blocksSubscription, _ := broker.Subscribe([]string{"blocks"})
+			accountSubscription, _ := broker.Subscribe([]string{"account:cro1abc", "transactions"})
+
+			Expect(broker.HasSubscriptions("blocks")).To(BeTrue())
+			Expect(broker.HasSubscriptions("account:cro1xyz")).To(BeFalse())
+			Expect(broker.HasSubscriptionsWithPrefix("account:")).To(BeTrue())
+			Expect(broker.HasSubscriptionsWithPrefix("transactions:")).To(BeFalse())
+
+			broker.Publish("blocks", 1)
+			broker.Publish("account:cro1abc", 2)
+			broker.Publish("account:cro1xyz", 3)
+
+			Expect(blocksSubscription.Messages()).To(Receive(Equal(push.Message{Topic: "blocks", Data: 1})))
+			Expect(blocksSubscription.Messages()).NotTo(Receive())
+			Expect(accountSubscription.Messages()).To(Receive(Equal(push.Message{Topic: "account:cro1abc", Data: 2})))
+			Expect(accountSubscription.Messages()).NotTo(Receive())
+		})
+
+		It("should close the subscription falling behind without blocking", func() {
+			broker := push.NewBroker(10, 10, 2)
+
+			slowSubscription, _ := broker.Subscribe([]string{"blocks"})
+			for i := 0; i < 3; i++ {
+				broker.Publish("blocks", i)
+			}
+
+			Expect(slowSubscription.Done()).To(BeClosed())
+			Expect(slowSubscription.Err()).To(Equal(push.ErrSlowSubscription))
+			Expect(broker.HasSubscriptions("blocks")).To(BeFalse())
+		})
+	})
+
+	Describe("Close", func() {
+		It("should close all subscriptions and reject new subscription", func() {
+			broker := push.NewBroker(10, 10, 10)
+
+			subscription, _ := broker.Subscribe([]string{"blocks"})
+			broker.Close()
+
+			Expect(subscription.Done()).To(BeClosed())
+			Expect(subscription.Err()).To(Equal(push.ErrBrokerClosed))
+
+			_, err := broker.Subscribe([]string{"blocks"})
+			Expect(err).To(MatchError(push.ErrBrokerClosed))
+		})
+	})
+})
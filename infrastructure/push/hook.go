@@ -0,0 +1,184 @@
+package push
+
+import (
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	projection_entity "github.com/crypto-com/chain-indexing/entity/projection"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	account_message_view "github.com/crypto-com/chain-indexing/projection/account_message/view"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+)
+
+// Projections whose handled events are pushed. The topics are published only after the projection
+// has committed, so that the clients can query the pushed records from the REST APIs right away.
+const (
+	BLOCK_PROJECTION_ID           = "Block"
+	TRANSACTION_PROJECTION_ID     = "Transaction"
+	ACCOUNT_MESSAGE_PROJECTION_ID = "AccountMessage"
+)
+
+var _ projection_entity.HandledEventsHook = &ProjectionHook{}
+
+// AccountMessagesParser resolves the accounts involved in the message events. It is implemented by
+// the AccountMessage projection.
+type AccountMessagesParser interface {
+	ParseAccountMessages(height int64, events []entity_event.Event) []account_message_view.AccountMessageRecord
+}
+
+// ProjectionHook publishes the events handled by the projections to the broker
+type ProjectionHook struct {
+	broker *Broker
+}
+
+func NewProjectionHook(broker *Broker) *ProjectionHook {
+	return &ProjectionHook{
+		broker,
+	}
+}
+
+func (hook *ProjectionHook) OnEventsHandled(
+	projection projection_entity.Projection,
+	height int64,
+	events []entity_event.Event,
+) {
+	switch projection.Id() {
+	case BLOCK_PROJECTION_ID:
+		hook.publishBlocks(events)
+	case TRANSACTION_PROJECTION_ID:
+		hook.publishTransactions(height, events)
+	case ACCOUNT_MESSAGE_PROJECTION_ID:
+		if parser, ok := projection.(AccountMessagesParser); ok {
+			hook.publishAccountMessages(parser, height, events)
+		}
+	}
+}
+
+func (hook *ProjectionHook) publishBlocks(events []entity_event.Event) {
+	if !hook.broker.HasSubscriptions(TOPIC_BLOCKS) {
+		return
+	}
+
+	for _, event := range events {
+		if blockCreatedEvent, ok := event.(*event_usecase.BlockCreated); ok {
+			hook.broker.Publish(TOPIC_BLOCKS, BlockMessage{
+				Height:           blockCreatedEvent.Block.Height,
+				Hash:             blockCreatedEvent.Block.Hash,
+				Time:             blockCreatedEvent.Block.Time,
+				AppHash:          blockCreatedEvent.Block.AppHash,
+				ProposerAddress:  blockCreatedEvent.Block.ProposerAddress,
+				TransactionCount: len(blockCreatedEvent.Block.Txs),
+			})
+		}
+	}
+}
+
+func (hook *ProjectionHook) publishTransactions(height int64, events []entity_event.Event) {
+	if !hook.broker.HasSubscriptionsWithPrefix(TOPIC_TRANSACTIONS) {
+		return
+	}
+
+	var blockTime utctime.UTCTime
+	var blockHash string
+	txs := make([]TransactionMessage, 0)
+	txMsgTypes := make(map[string][]string)
+	for _, event := range events {
+		if blockCreatedEvent, ok := event.(*event_usecase.BlockCreated); ok {
+			blockTime = blockCreatedEvent.Block.Time
+			blockHash = blockCreatedEvent.Block.Hash
+		} else if transactionCreatedEvent, ok := event.(*event_usecase.TransactionCreated); ok {
+			txs = append(txs, TransactionMessage{
+				BlockHeight: height,
+				Hash:        transactionCreatedEvent.TxHash,
+				Index:       transactionCreatedEvent.Index,
+				Success:     true,
+				Code:        transactionCreatedEvent.Code,
+				Fee:         transactionCreatedEvent.Fee,
+				FeePayer:    transactionCreatedEvent.FeePayer,
+				Memo:        transactionCreatedEvent.Memo,
+			})
+		} else if transactionFailedEvent, ok := event.(*event_usecase.TransactionFailed); ok {
+			txs = append(txs, TransactionMessage{
+				BlockHeight: height,
+				Hash:        transactionFailedEvent.TxHash,
+				Index:       transactionFailedEvent.Index,
+				Success:     false,
+				Code:        transactionFailedEvent.Code,
+				Fee:         transactionFailedEvent.Fee,
+				FeePayer:    transactionFailedEvent.FeePayer,
+				Memo:        transactionFailedEvent.Memo,
+			})
+		} else if msgEvent, ok := event.(event_usecase.MsgEvent); ok {
+			txMsgTypes[msgEvent.TxHash()] = append(txMsgTypes[msgEvent.TxHash()], msgEvent.MsgType())
+		}
+	}
+
+	for _, tx := range txs {
+		tx.BlockHash = blockHash
+		tx.BlockTime = blockTime
+		tx.MessageTypes = make([]string, 0)
+		publishedMsgTypes := make(map[string]bool)
+		for _, msgType := range txMsgTypes[tx.Hash] {
+			tx.MessageTypes = append(tx.MessageTypes, msgType)
+			publishedMsgTypes[msgType] = true
+		}
+
+		hook.broker.Publish(TOPIC_TRANSACTIONS, tx)
+		for msgType := range publishedMsgTypes {
+			hook.broker.Publish(TransactionsByMsgTypeTopic(msgType), tx)
+		}
+	}
+}
+
+func (hook *ProjectionHook) publishAccountMessages(
+	parser AccountMessagesParser,
+	height int64,
+	events []entity_event.Event,
+) {
+	if !hook.broker.HasSubscriptionsWithPrefix(TOPIC_ACCOUNT_PREFIX) {
+		return
+	}
+
+	for _, accountMessage := range parser.ParseAccountMessages(height, events) {
+		publishedAccounts := make(map[string]bool)
+		for i := range accountMessage.Accounts {
+			account := accountMessage.Accounts[i]
+			if publishedAccounts[account] {
+				continue
+			}
+			publishedAccounts[account] = true
+
+			topic := AccountTopic(account)
+			if !hook.broker.HasSubscriptions(topic) {
+				continue
+			}
+			row := accountMessage.Row
+			row.MaybeAccount = &account
+			hook.broker.Publish(topic, row)
+		}
+	}
+}
+
+// BlockMessage is published to TOPIC_BLOCKS
+type BlockMessage struct {
+	Height           int64           `json:"blockHeight"`
+	Hash             string          `json:"blockHash"`
+	Time             utctime.UTCTime `json:"blockTime"`
+	AppHash          string          `json:"appHash"`
+	ProposerAddress  string          `json:"proposerAddress"`
+	TransactionCount int             `json:"transactionCount"`
+}
+
+// TransactionMessage is published to TOPIC_TRANSACTIONS and the topics of its message types
+type TransactionMessage struct {
+	BlockHeight  int64           `json:"blockHeight"`
+	BlockHash    string          `json:"blockHash"`
+	BlockTime    utctime.UTCTime `json:"blockTime"`
+	Hash         string          `json:"hash"`
+	Index        int             `json:"index"`
+	Success      bool            `json:"success"`
+	Code         int             `json:"code"`
+	Fee          coin.Coins      `json:"fee"`
+	FeePayer     string          `json:"feePayer"`
+	Memo         string          `json:"memo"`
+	MessageTypes []string        `json:"messageTypes"`
+}
@@ -0,0 +1,159 @@
+package push_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	. "github.com/crypto-com/chain-indexing/entity/projection/test"
+	"github.com/crypto-com/chain-indexing/infrastructure/push"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	account_message_view "github.com/crypto-com/chain-indexing/projection/account_message/view"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+)
+
+type fakeAccountMessageProjection struct {
+	*MockProjection
+
+	accountMessages []account_message_view.AccountMessageRecord
+}
+
+func (projection *fakeAccountMessageProjection) ParseAccountMessages(
+	_ int64,
+	_ []entity_event.Event,
+) []account_message_view.AccountMessageRecord {
+	return projection.accountMessages
+}
+
+var _ = Describe("ProjectionHook", func() {
+	anyBlockTime := utctime.FromUnixNano(int64(1000000000))
+	anyBlockCreated := event_usecase.NewBlockCreated(&model.Block{
+		Height:          2,
+		Hash:            "B2",
+		Time:            anyBlockTime,
+		AppHash:         "A2",
+		ProposerAddress: "P",
+		Txs:             []string{"T1", "T2"},
+	})
+
+	newProjection := func(id string) *MockProjection {
+		projection := NewMockProjection()
+		projection.On("Id").Return(id)
+		return projection
+	}
+
+	It("should publish new blocks handled by the Block projection", func() {
+		broker := push.NewBroker(10, 10, 10)
+		hook := push.NewProjectionHook(broker)
+		subscription, _ := broker.Subscribe([]string{"blocks"})
+
+		hook.OnEventsHandled(newProjection("Transaction"), 2, []entity_event.Event{anyBlockCreated})
+		Expect(subscription.Messages()).NotTo(Receive())
+
+		hook.OnEventsHandled(newProjection("Block"), 2, []entity_event.Event{anyBlockCreated})
+		Expect(subscription.Messages()).To(Receive(Equal(push.Message{
+			Topic: "blocks",
+			Data: push.BlockMessage{
+				Height:           2,
+				Hash:             "B2",
+				Time:             anyBlockTime,
+				AppHash:          "A2",
+				ProposerAddress:  "P",
+				TransactionCount: 2,
+			},
+		})))
+	})
+
+	It("should publish new transactions to the topics of their message types", func() {
+		broker := push.NewBroker(10, 10, 10)
+		hook := push.NewProjectionHook(broker)
+		allSubscription, _ := broker.Subscribe([]string{"transactions"})
+		msgSendSubscription, _ := broker.Subscribe([]string{"transactions:MsgSend"})
+
+		anyFee := coin.NewCoins(coin.NewInt64Coin("basecro", 1))
+		hook.OnEventsHandled(newProjection("Transaction"), 2, []entity_event.Event{
+			anyBlockCreated,
+			event_usecase.NewTransactionCreated(2, model.CreateTransactionParams{
+				TxHash: "T1",
+				Index:  0,
+				Fee:    anyFee,
+				Memo:   "memo",
+			}),
+			event_usecase.NewMsgSend(event_usecase.MsgCommonParams{
+				BlockHeight: 2,
+				TxHash:      "T1",
+				TxSuccess:   true,
+				MsgIndex:    0,
+			}, event_usecase.MsgSendCreatedParams{}),
+			event_usecase.NewTransactionFailed(2, model.CreateTransactionParams{
+				TxHash: "T2",
+				Index:  1,
+				Code:   5,
+			}),
+		})
+
+		expectedSendTx := push.TransactionMessage{
+			BlockHeight:  2,
+			BlockHash:    "B2",
+			BlockTime:    anyBlockTime,
+			Hash:         "T1",
+			Index:        0,
+			Success:      true,
+			Code:         0,
+			Fee:          anyFee,
+			Memo:         "memo",
+			MessageTypes: []string{"MsgSend"},
+		}
+		Expect(allSubscription.Messages()).To(Receive(Equal(push.Message{
+			Topic: "transactions",
+			Data:  expectedSendTx,
+		})))
+		Expect(allSubscription.Messages()).To(Receive(Equal(push.Message{
+			Topic: "transactions",
+			Data: push.TransactionMessage{
+				BlockHeight:  2,
+				BlockHash:    "B2",
+				BlockTime:    anyBlockTime,
+				Hash:         "T2",
+				Index:        1,
+				Success:      false,
+				Code:         5,
+				MessageTypes: []string{},
+			},
+		})))
+		Expect(msgSendSubscription.Messages()).To(Receive(Equal(push.Message{
+			Topic: "transactions:MsgSend",
+			Data:  expectedSendTx,
+		})))
+		Expect(msgSendSubscription.Messages()).NotTo(Receive())
+	})
+
+	It("should publish account messages to each involved account once", func() {
+		broker := push.NewBroker(10, 10, 10)
+		hook := push.NewProjectionHook(broker)
+		subscription, _ := broker.Subscribe([]string{"account:cro1from"})
+
+		anyRow := account_message_view.AccountMessageRow{
+			BlockHeight:     2,
+			TransactionHash: "T1",
+			MessageType:     "MsgSend",
+		}
+		hook.OnEventsHandled(&fakeAccountMessageProjection{
+			newProjection("AccountMessage"),
+			[]account_message_view.AccountMessageRecord{{
+				Row:      anyRow,
+				Accounts: []string{"cro1from", "cro1to", "cro1from"},
+			}},
+		}, 2, []entity_event.Event{})
+
+		var message push.Message
+		Expect(subscription.Messages()).To(Receive(&message))
+		Expect(message.Topic).To(Equal("account:cro1from"))
+		row := message.Data.(account_message_view.AccountMessageRow)
+		Expect(*row.MaybeAccount).To(Equal("cro1from"))
+		Expect(row.TransactionHash).To(Equal("T1"))
+		Expect(subscription.Messages()).NotTo(Receive())
+	})
+})
@@ -0,0 +1,46 @@
+package push
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// New blocks
+	TOPIC_BLOCKS = "blocks"
+	// New transactions
+	TOPIC_TRANSACTIONS = "transactions"
+	// New transactions having message of the type, e.g. transactions:MsgSend
+	TOPIC_TRANSACTIONS_BY_MSG_TYPE_PREFIX = "transactions:"
+	// New messages involving the account, e.g. account:cro1...
+	TOPIC_ACCOUNT_PREFIX = "account:"
+
+	MAX_TOPIC_LENGTH = 256
+)
+
+var ErrInvalidTopic = errors.New("invalid topic")
+
+func TransactionsByMsgTypeTopic(msgType string) string {
+	return TOPIC_TRANSACTIONS_BY_MSG_TYPE_PREFIX + msgType
+}
+
+func AccountTopic(account string) string {
+	return TOPIC_ACCOUNT_PREFIX + account
+}
+
+// ValidateTopic returns ErrInvalidTopic when the topic is not one of the supported topics
+func ValidateTopic(topic string) error {
+	if topic == TOPIC_BLOCKS || topic == TOPIC_TRANSACTIONS {
+		return nil
+	}
+	if len(topic) <= MAX_TOPIC_LENGTH && !strings.ContainsAny(topic, " \t\r\n") {
+		for _, prefix := range []string{TOPIC_TRANSACTIONS_BY_MSG_TYPE_PREFIX, TOPIC_ACCOUNT_PREFIX} {
+			if strings.HasPrefix(topic, prefix) && len(topic) > len(prefix) {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("%w: %s", ErrInvalidTopic, topic)
+}
@@ -0,0 +1,286 @@
+package tendermint
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/crypto-com/chain-indexing/appinterface/tendermint"
+	usecase_model "github.com/crypto-com/chain-indexing/usecase/model"
+	"github.com/crypto-com/chain-indexing/usecase/model/genesis"
+)
+
+var _ tendermint.Client = &ArchiveClient{}
+
+const ARCHIVE_GENESIS_FILE = "genesis.json"
+const ARCHIVE_BLOCK_DIR = "block"
+const ARCHIVE_BLOCK_RESULTS_DIR = "block_results"
+
+const archiveFileExt = ".json"
+
+// ArchiveClient serves blocks from an archive of Tendermint RPC responses instead of a live node.
+// The archive is a directory, or a tarball of it, with the following layout:
+//
+//	manifest.json                Optional ArchiveManifest
+//	genesis.json                 Genesis document or `/genesis` response
+//	block/<height>.json          `/block?height=<height>` response
+//	block_results/<height>.json  `/block_results?height=<height>` response
+type ArchiveClient struct {
+	dir                  string
+	maybeExtractedDir    *string
+	strictGenesisParsing bool
+
+	maybeManifest     *ArchiveManifest
+	maybeLatestHeight *int64
+}
+
+// NewArchiveClient opens the archive at path. Tarballs (.tar, .tar.gz, .tgz) are extracted to a
+// temporary directory, which is removed on Close. Archives with a manifest of unsupported version
+// are rejected.
+func NewArchiveClient(path string, strictGenesisParsing bool) (*ArchiveClient, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening archive: %v", err)
+	}
+
+	client := &ArchiveClient{
+		dir:                  path,
+		strictGenesisParsing: strictGenesisParsing,
+	}
+	if !info.IsDir() {
+		extractedDir, extractErr := extractTarball(path)
+		if extractErr != nil {
+			return nil, fmt.Errorf("error extracting archive: %v", extractErr)
+		}
+		client.maybeExtractedDir = &extractedDir
+		client.dir = archiveRoot(extractedDir)
+	}
+
+	if client.maybeManifest, err = ReadArchiveManifest(client.dir); err != nil {
+		_ = client.Close()
+		return nil, err
+	}
+	if err = client.scanLatestHeight(); err != nil {
+		_ = client.Close()
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// Manifest returns the archive manifest. Returns nil when the archive has no manifest.
+func (client *ArchiveClient) Manifest() *ArchiveManifest {
+	return client.maybeManifest
+}
+
+// Verify checks the archived files against the checksums in manifest. Archives without manifest
+// are not verified.
+func (client *ArchiveClient) Verify() error {
+	if client.maybeManifest == nil {
+		return nil
+	}
+	return client.maybeManifest.Verify(client.dir)
+}
+
+// Dir returns the directory the archive is served from
+func (client *ArchiveClient) Dir() string {
+	return client.dir
+}
+
+// Close removes the temporary directory when the archive is extracted from a tarball
+func (client *ArchiveClient) Close() error {
+	if client.maybeExtractedDir == nil {
+		return nil
+	}
+	return os.RemoveAll(*client.maybeExtractedDir)
+}
+
+func (client *ArchiveClient) Genesis() (*genesis.Genesis, error) {
+	data, err := ioutil.ReadFile(filepath.Join(client.dir, ARCHIVE_GENESIS_FILE))
+	if err != nil {
+		return nil, fmt.Errorf("error reading archive genesis: %v", err)
+	}
+
+	// Archive genesis may either be the genesis document or the `/genesis` RPC response
+	if jsoniter.Get(data, "result", "genesis").ValueType() == jsoniter.ObjectValue {
+		return ParseGenesisResp(strings.NewReader(string(data)), client.strictGenesisParsing)
+	}
+	return ParseGenesis(strings.NewReader(string(data)), client.strictGenesisParsing)
+}
+
+func (client *ArchiveClient) Block(height int64) (*usecase_model.Block, *usecase_model.RawBlock, error) {
+	file, err := client.open(ARCHIVE_BLOCK_DIR, height)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer file.Close()
+
+	return ParseBlockResp(file)
+}
+
+func (client *ArchiveClient) BlockResults(height int64) (*usecase_model.BlockResults, error) {
+	file, err := client.open(ARCHIVE_BLOCK_RESULTS_DIR, height)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return ParseBlockResultsResp(file)
+}
+
+// LatestBlockHeight returns the highest block height available in the archive
+func (client *ArchiveClient) LatestBlockHeight() (int64, error) {
+	if client.maybeLatestHeight == nil {
+		return int64(0), fmt.Errorf("archive has no block")
+	}
+	return *client.maybeLatestHeight, nil
+}
+
+func (client *ArchiveClient) open(dir string, height int64) (*os.File, error) {
+	path := filepath.Join(client.dir, dir, strconv.FormatInt(height, 10)+archiveFileExt)
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("height %d is not available in archive %s", height, dir)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading archive %s: %v", dir, err)
+	}
+	return file, nil
+}
+
+func (client *ArchiveClient) scanLatestHeight() error {
+	heights, err := ArchiveHeights(client.dir, ARCHIVE_BLOCK_DIR)
+	if err != nil {
+		return err
+	}
+	for i := range heights {
+		if client.maybeLatestHeight == nil || heights[i] > *client.maybeLatestHeight {
+			client.maybeLatestHeight = &heights[i]
+		}
+	}
+
+	return nil
+}
+
+// ArchiveHeights returns the heights of the response files under the archive sub-directory
+func ArchiveHeights(archiveDir string, dir string) ([]int64, error) {
+	files, err := ioutil.ReadDir(filepath.Join(archiveDir, dir))
+	if os.IsNotExist(err) {
+		return []int64{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error listing archive %s: %v", dir, err)
+	}
+
+	heights := make([]int64, 0, len(files))
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() || !strings.HasSuffix(name, archiveFileExt) {
+			continue
+		}
+		height, parseErr := strconv.ParseInt(strings.TrimSuffix(name, archiveFileExt), 10, 64)
+		if parseErr != nil {
+			continue
+		}
+		heights = append(heights, height)
+	}
+
+	return heights, nil
+}
+
+// archiveRoot returns the directory containing the archive content. Tarballs created from a
+// directory usually have the directory itself as the only top level entry.
+func archiveRoot(dir string) string {
+	for _, name := range []string{
+		ARCHIVE_MANIFEST_FILE, ARCHIVE_GENESIS_FILE, ARCHIVE_BLOCK_DIR, ARCHIVE_BLOCK_RESULTS_DIR,
+	} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			return dir
+		}
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil || len(files) != 1 || !files[0].IsDir() {
+		return dir
+	}
+	return filepath.Join(dir, files[0].Name())
+}
+
+func extractTarball(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	var reader io.Reader = file
+	if strings.HasSuffix(path, ".gz") || strings.HasSuffix(path, ".tgz") {
+		gzipReader, gzipErr := gzip.NewReader(file)
+		if gzipErr != nil {
+			return "", gzipErr
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	}
+
+	dir, err := ioutil.TempDir("", "chain-indexing-archive")
+	if err != nil {
+		return "", err
+	}
+
+	tarReader := tar.NewReader(reader)
+	for {
+		header, nextErr := tarReader.Next()
+		if nextErr == io.EOF {
+			break
+		}
+		if nextErr != nil {
+			_ = os.RemoveAll(dir)
+			return "", nextErr
+		}
+
+		if extractErr := extractTarEntry(dir, header, tarReader); extractErr != nil {
+			_ = os.RemoveAll(dir)
+			return "", extractErr
+		}
+	}
+
+	return dir, nil
+}
+
+func extractTarEntry(dir string, header *tar.Header, reader io.Reader) error {
+	target := filepath.Join(dir, filepath.Clean("/"+header.Name))
+	if !strings.HasPrefix(target, filepath.Clean(dir)+string(os.PathSeparator)) {
+		return fmt.Errorf("invalid archive entry: %s", header.Name)
+	}
+
+	switch header.Typeflag {
+	case tar.TypeDir:
+		return os.MkdirAll(target, 0755)
+	case tar.TypeReg:
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return err
+		}
+		file, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+		if err != nil {
+			return err
+		}
+		// nolint:gosec
+		if _, err = io.Copy(file, reader); err != nil {
+			file.Close()
+			return err
+		}
+		return file.Close()
+	default:
+		// Links and special files are not part of the archive format
+		return nil
+	}
+}
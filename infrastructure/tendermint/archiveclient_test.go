@@ -0,0 +1,149 @@
+package tendermint_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	jsoniter "github.com/json-iterator/go"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/appinterface/tendermint"
+	. "github.com/crypto-com/chain-indexing/infrastructure/tendermint"
+	infrastructure_tendermint_test "github.com/crypto-com/chain-indexing/infrastructure/tendermint/test"
+)
+
+var _ = Describe("ArchiveClient", func() {
+	var archiveDir string
+
+	BeforeEach(func() {
+		var err error
+
+		archiveDir, err = ioutil.TempDir("", "tendermint-archive")
+		Expect(err).To(BeNil())
+
+		writeArchiveFile(archiveDir, "block/100.json", infrastructure_tendermint_test.BLOCK_JSON)
+		writeArchiveFile(archiveDir, "block/99.json", infrastructure_tendermint_test.BLOCK_JSON)
+		writeArchiveFile(archiveDir, "block_results/100.json", infrastructure_tendermint_test.BLOCK_RESULTS_JSON)
+		writeArchiveFile(
+			archiveDir, "genesis.json", infrastructure_tendermint_test.GENESIS_MIXED_NUMBER_AND_STRING_JSON,
+		)
+	})
+
+	AfterEach(func() {
+		_ = os.RemoveAll(archiveDir)
+	})
+
+	It("should implement Client", func() {
+		client, err := NewArchiveClient(archiveDir, true)
+		Expect(err).To(BeNil())
+
+		var _ tendermint.Client = client
+	})
+
+	It("should return the same responses as parsing the archived RPC responses", func() {
+		client, err := NewArchiveClient(archiveDir, true)
+		Expect(err).To(BeNil())
+
+		block, rawBlock, err := client.Block(100)
+		Expect(err).To(BeNil())
+		expectedBlock, expectedRawBlock, _ := ParseBlockResp(
+			mustOpen(filepath.Join(archiveDir, "block/100.json")),
+		)
+		Expect(block).To(Equal(expectedBlock))
+		Expect(rawBlock).To(Equal(expectedRawBlock))
+
+		blockResults, err := client.BlockResults(100)
+		Expect(err).To(BeNil())
+		expectedBlockResults, _ := ParseBlockResultsResp(
+			mustOpen(filepath.Join(archiveDir, "block_results/100.json")),
+		)
+		Expect(blockResults).To(Equal(expectedBlockResults))
+
+		latestHeight, err := client.LatestBlockHeight()
+		Expect(err).To(BeNil())
+		Expect(latestHeight).To(Equal(int64(100)))
+	})
+
+	It("should return error when the height is not in the archive", func() {
+		client, err := NewArchiveClient(archiveDir, true)
+		Expect(err).To(BeNil())
+
+		_, err = client.BlockResults(99)
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("should parse genesis document as well as genesis response", func() {
+		client, err := NewArchiveClient(archiveDir, true)
+		Expect(err).To(BeNil())
+		expectedGenesis, err := client.Genesis()
+		Expect(err).To(BeNil())
+
+		genesisDoc := jsoniter.Get(
+			[]byte(infrastructure_tendermint_test.GENESIS_MIXED_NUMBER_AND_STRING_JSON), "result", "genesis",
+		).ToString()
+		writeArchiveFile(archiveDir, "genesis.json", genesisDoc)
+
+		actualGenesis, err := client.Genesis()
+		Expect(err).To(BeNil())
+		Expect(actualGenesis).To(Equal(expectedGenesis))
+	})
+
+	It("should read archive from tarball with a top level directory", func() {
+		tarballPath := filepath.Join(archiveDir, "archive.tar.gz")
+		writeTarball(tarballPath, map[string]string{
+			"archive/block/100.json":         infrastructure_tendermint_test.BLOCK_JSON,
+			"archive/block_results/100.json": infrastructure_tendermint_test.BLOCK_RESULTS_JSON,
+		})
+
+		client, err := NewArchiveClient(tarballPath, true)
+		Expect(err).To(BeNil())
+		extractedDir := client.Dir()
+
+		block, _, err := client.Block(100)
+		Expect(err).To(BeNil())
+		Expect(block.Height).To(Equal(int64(100)))
+		_, err = client.BlockResults(100)
+		Expect(err).To(BeNil())
+
+		Expect(client.Close()).To(BeNil())
+		_, err = os.Stat(extractedDir)
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+})
+
+func writeArchiveFile(archiveDir string, name string, content string) {
+	path := filepath.Join(archiveDir, name)
+	Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+	Expect(ioutil.WriteFile(path, []byte(content), 0600)).To(Succeed())
+}
+
+func writeTarball(path string, files map[string]string) {
+	file, err := os.Create(path)
+	Expect(err).To(BeNil())
+	defer file.Close()
+
+	gzipWriter := gzip.NewWriter(file)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, content := range files {
+		Expect(tarWriter.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0600,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		})).To(Succeed())
+		_, err = tarWriter.Write([]byte(content))
+		Expect(err).To(BeNil())
+	}
+	Expect(tarWriter.Close()).To(Succeed())
+	Expect(gzipWriter.Close()).To(Succeed())
+}
+
+func mustOpen(path string) *os.File {
+	file, err := os.Open(path)
+	Expect(err).To(BeNil())
+	return file
+}
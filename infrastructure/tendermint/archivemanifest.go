@@ -0,0 +1,178 @@
+package tendermint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+const ARCHIVE_MANIFEST_FILE = "manifest.json"
+const ARCHIVE_FORMAT = "chain-indexing-archive"
+const ARCHIVE_VERSION = 1
+
+// ArchiveManifest describes the content of an exported archive. Every archived file is listed
+// with its SHA-256 checksum, keyed by its slash separated path relative to the archive root.
+type ArchiveManifest struct {
+	Format     string            `json:"format"`
+	Version    int               `json:"version"`
+	ChainID    string            `json:"chainId"`
+	FromHeight int64             `json:"fromHeight"`
+	ToHeight   int64             `json:"toHeight"`
+	Files      map[string]string `json:"files"`
+}
+
+// ReadArchiveManifest reads the manifest of the archive directory. Returns nil when the archive has
+// no manifest, e.g. archives captured by hand.
+func ReadArchiveManifest(dir string) (*ArchiveManifest, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, ARCHIVE_MANIFEST_FILE))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading archive manifest: %v", err)
+	}
+
+	var manifest ArchiveManifest
+	if err = json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("error decoding archive manifest: %v", err)
+	}
+	if manifest.Format != ARCHIVE_FORMAT {
+		return nil, fmt.Errorf("unrecognized archive format: %s", manifest.Format)
+	}
+	if manifest.Version != ARCHIVE_VERSION {
+		return nil, fmt.Errorf(
+			"unsupported archive version %d, expected version %d", manifest.Version, ARCHIVE_VERSION,
+		)
+	}
+
+	return &manifest, nil
+}
+
+// Verify checks every file listed in the manifest exists in the archive directory with matching
+// checksum
+func (manifest *ArchiveManifest) Verify(dir string) error {
+	paths := make([]string, 0, len(manifest.Files))
+	for path := range manifest.Files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		checksum, err := fileChecksum(filepath.Join(dir, filepath.FromSlash(path)))
+		if err != nil {
+			return fmt.Errorf("error verifying archive file %s: %v", path, err)
+		}
+		if checksum != manifest.Files[path] {
+			return fmt.Errorf(
+				"checksum mismatch of archive file %s: expected %s, got %s", path, manifest.Files[path], checksum,
+			)
+		}
+	}
+
+	return nil
+}
+
+// ArchiveWriter writes Tendermint RPC responses into an archive directory in the layout read by
+// ArchiveClient, and records their checksums into the manifest on Close.
+type ArchiveWriter struct {
+	dir string
+
+	mutex    sync.Mutex
+	manifest ArchiveManifest
+}
+
+// NewArchiveWriter creates the archive directory. Returns error when the directory already
+// contains an archive.
+func NewArchiveWriter(dir string, fromHeight int64, toHeight int64) (*ArchiveWriter, error) {
+	if _, err := os.Stat(filepath.Join(dir, ARCHIVE_MANIFEST_FILE)); err == nil {
+		return nil, fmt.Errorf("archive already exists in %s", dir)
+	}
+	for _, subDir := range []string{ARCHIVE_BLOCK_DIR, ARCHIVE_BLOCK_RESULTS_DIR} {
+		if err := os.MkdirAll(filepath.Join(dir, subDir), 0755); err != nil {
+			return nil, fmt.Errorf("error creating archive directory: %v", err)
+		}
+	}
+
+	return &ArchiveWriter{
+		dir: dir,
+
+		manifest: ArchiveManifest{
+			Format:     ARCHIVE_FORMAT,
+			Version:    ARCHIVE_VERSION,
+			FromHeight: fromHeight,
+			ToHeight:   toHeight,
+			Files:      make(map[string]string),
+		},
+	}, nil
+}
+
+func (writer *ArchiveWriter) SetChainID(chainID string) {
+	writer.mutex.Lock()
+	defer writer.mutex.Unlock()
+
+	writer.manifest.ChainID = chainID
+}
+
+func (writer *ArchiveWriter) WriteGenesis(data []byte) error {
+	return writer.write(ARCHIVE_GENESIS_FILE, data)
+}
+
+func (writer *ArchiveWriter) WriteBlock(height int64, data []byte) error {
+	return writer.write(ARCHIVE_BLOCK_DIR+"/"+strconv.FormatInt(height, 10)+archiveFileExt, data)
+}
+
+func (writer *ArchiveWriter) WriteBlockResults(height int64, data []byte) error {
+	return writer.write(ARCHIVE_BLOCK_RESULTS_DIR+"/"+strconv.FormatInt(height, 10)+archiveFileExt, data)
+}
+
+// Close writes the manifest. The archive is incomplete without manifest.
+func (writer *ArchiveWriter) Close() error {
+	writer.mutex.Lock()
+	defer writer.mutex.Unlock()
+
+	// Files are sorted by path for a stable manifest
+	data, err := json.MarshalIndent(writer.manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding archive manifest: %v", err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(writer.dir, ARCHIVE_MANIFEST_FILE), data, 0644); err != nil {
+		return fmt.Errorf("error writing archive manifest: %v", err)
+	}
+
+	return nil
+}
+
+func (writer *ArchiveWriter) write(path string, data []byte) error {
+	if err := ioutil.WriteFile(filepath.Join(writer.dir, filepath.FromSlash(path)), data, 0644); err != nil {
+		return fmt.Errorf("error writing archive file %s: %v", path, err)
+	}
+
+	checksum := sha256.Sum256(data)
+	writer.mutex.Lock()
+	writer.manifest.Files[path] = hex.EncodeToString(checksum[:])
+	writer.mutex.Unlock()
+
+	return nil
+}
+
+func fileChecksum(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err = io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,115 @@
+package tendermint_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/crypto-com/chain-indexing/infrastructure/tendermint"
+	infrastructure_tendermint_test "github.com/crypto-com/chain-indexing/infrastructure/tendermint/test"
+)
+
+var _ = Describe("ArchiveWriter", func() {
+	var archiveDir string
+
+	BeforeEach(func() {
+		var err error
+
+		archiveDir, err = ioutil.TempDir("", "tendermint-archive")
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		_ = os.RemoveAll(archiveDir)
+	})
+
+	It("should write archive readable by ArchiveClient with verified manifest", func() {
+		anyHeight := int64(100)
+		server := ghttp.NewServer()
+		defer server.Close()
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/block", fmt.Sprintf("height=%d", anyHeight)),
+				ghttp.RespondWith(http.StatusOK, infrastructure_tendermint_test.BLOCK_JSON),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/block_results", fmt.Sprintf("height=%d", anyHeight)),
+				ghttp.RespondWith(http.StatusOK, infrastructure_tendermint_test.BLOCK_RESULTS_JSON),
+			),
+		)
+		httpClient := NewHTTPClient(server.URL(), true)
+
+		writer, err := NewArchiveWriter(archiveDir, anyHeight, anyHeight)
+		Expect(err).To(BeNil())
+		block, err := httpClient.RawResponse("block", fmt.Sprintf("height=%d", anyHeight))
+		Expect(err).To(BeNil())
+		Expect(writer.WriteBlock(anyHeight, block)).To(Succeed())
+		blockResults, err := httpClient.RawResponse("block_results", fmt.Sprintf("height=%d", anyHeight))
+		Expect(err).To(BeNil())
+		Expect(writer.WriteBlockResults(anyHeight, blockResults)).To(Succeed())
+		writer.SetChainID("testnet-croeseid-1")
+		Expect(writer.Close()).To(Succeed())
+
+		client, err := NewArchiveClient(archiveDir, true)
+		Expect(err).To(BeNil())
+		Expect(client.Verify()).To(Succeed())
+		Expect(*client.Manifest()).To(Equal(ArchiveManifest{
+			Format:     ARCHIVE_FORMAT,
+			Version:    ARCHIVE_VERSION,
+			ChainID:    "testnet-croeseid-1",
+			FromHeight: anyHeight,
+			ToHeight:   anyHeight,
+			Files: map[string]string{
+				"block/100.json":         checksumOf(infrastructure_tendermint_test.BLOCK_JSON),
+				"block_results/100.json": checksumOf(infrastructure_tendermint_test.BLOCK_RESULTS_JSON),
+			},
+		}))
+
+		actualBlock, _, err := client.Block(anyHeight)
+		Expect(err).To(BeNil())
+		Expect(actualBlock.Height).To(Equal(anyHeight))
+	})
+
+	It("should fail verification when an archived file is modified", func() {
+		writer, err := NewArchiveWriter(archiveDir, 100, 100)
+		Expect(err).To(BeNil())
+		Expect(writer.WriteBlock(100, []byte(infrastructure_tendermint_test.BLOCK_JSON))).To(Succeed())
+		Expect(writer.Close()).To(Succeed())
+
+		writeArchiveFile(archiveDir, "block/100.json", infrastructure_tendermint_test.BLOCK_WITH_DUPLICATED_VOTE_EVIDENCE)
+
+		client, err := NewArchiveClient(archiveDir, true)
+		Expect(err).To(BeNil())
+		Expect(client.Verify()).NotTo(Succeed())
+	})
+
+	It("should reject archive of unsupported version", func() {
+		writeArchiveFile(archiveDir, ARCHIVE_MANIFEST_FILE, `{"format":"chain-indexing-archive","version":999}`)
+
+		_, err := NewArchiveClient(archiveDir, true)
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("should refuse to overwrite an existing archive", func() {
+		writer, err := NewArchiveWriter(archiveDir, 100, 100)
+		Expect(err).To(BeNil())
+		Expect(writer.Close()).To(Succeed())
+
+		_, err = NewArchiveWriter(archiveDir, 100, 100)
+		Expect(err).NotTo(BeNil())
+		Expect(filepath.Join(archiveDir, ARCHIVE_MANIFEST_FILE)).To(BeAnExistingFile())
+	})
+})
+
+func checksumOf(content string) string {
+	checksum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(checksum[:])
+}
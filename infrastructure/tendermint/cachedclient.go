@@ -0,0 +1,386 @@
+package tendermint
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/crypto-com/chain-indexing/appinterface/tendermint"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	usecase_model "github.com/crypto-com/chain-indexing/usecase/model"
+	"github.com/crypto-com/chain-indexing/usecase/model/genesis"
+)
+
+var _ tendermint.Client = &CachedClient{}
+
+const CACHE_KIND_BLOCK = "block"
+const CACHE_KIND_BLOCK_RESULTS = "block_results"
+
+const cacheFileExt = ".json.gz"
+
+// CachedClient decorates a tendermint.Client and caches the block and block results responses
+// on disk as compressed JSON files keyed by height. Concurrent requests of the same height are
+// only forwarded to the underlying client once, which allows the cache to be shared across
+// multiple sync managers. When the cache grows beyond the size limit, the least recently used
+// heights are evicted.
+type CachedClient struct {
+	logger applogger.Logger
+	client tendermint.Client
+
+	dir          string
+	maxSizeBytes int64
+
+	mutex     sync.Mutex
+	entries   map[string]*cacheEntry
+	totalSize int64
+	inflight  map[string]*inflightRequest
+}
+
+type CachedClientConfig struct {
+	// Directory to store the cache files. Created when not exist.
+	Dir string
+	// Maximum total size of the cache files in bytes. 0 means unlimited.
+	MaxSizeBytes int64
+}
+
+type cacheEntry struct {
+	height     int64
+	path       string
+	size       int64
+	lastAccess int64
+}
+
+type inflightRequest struct {
+	wg   sync.WaitGroup
+	data []byte
+	err  error
+}
+
+type cachedBlock struct {
+	Block    *usecase_model.Block    `json:"block"`
+	RawBlock *usecase_model.RawBlock `json:"rawBlock"`
+}
+
+// NewCachedClient creates a CachedClient and loads the existing cache files under the directory
+func NewCachedClient(
+	logger applogger.Logger,
+	client tendermint.Client,
+	config CachedClientConfig,
+) (*CachedClient, error) {
+	cachedClient := &CachedClient{
+		logger: logger.WithFields(applogger.LogFields{
+			"module": "CachedTendermintClient",
+		}),
+		client: client,
+
+		dir:          config.Dir,
+		maxSizeBytes: config.MaxSizeBytes,
+
+		entries:  make(map[string]*cacheEntry),
+		inflight: make(map[string]*inflightRequest),
+	}
+
+	for _, kind := range []string{CACHE_KIND_BLOCK, CACHE_KIND_BLOCK_RESULTS} {
+		if err := os.MkdirAll(filepath.Join(config.Dir, kind), 0755); err != nil {
+			return nil, fmt.Errorf("error creating cache directory: %v", err)
+		}
+		if err := cachedClient.loadEntries(kind); err != nil {
+			return nil, fmt.Errorf("error loading cache entries: %v", err)
+		}
+	}
+	cachedClient.mutex.Lock()
+	cachedClient.evict()
+	cachedClient.mutex.Unlock()
+
+	cachedClient.logger.Infof(
+		"loaded %d cache entries of %d bytes from %s", len(cachedClient.entries), cachedClient.totalSize, config.Dir,
+	)
+
+	return cachedClient, nil
+}
+
+func (client *CachedClient) Genesis() (*genesis.Genesis, error) {
+	return client.client.Genesis()
+}
+
+func (client *CachedClient) Block(height int64) (*usecase_model.Block, *usecase_model.RawBlock, error) {
+	data, err := client.get(CACHE_KIND_BLOCK, height, func() (interface{}, error) {
+		block, rawBlock, fetchErr := client.client.Block(height)
+		if fetchErr != nil {
+			return nil, fetchErr
+		}
+		return cachedBlock{block, rawBlock}, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result cachedBlock
+	if unmarshalErr := jsoniter.Unmarshal(data, &result); unmarshalErr != nil {
+		return nil, nil, fmt.Errorf("error decoding cached block: %v", unmarshalErr)
+	}
+
+	return result.Block, result.RawBlock, nil
+}
+
+func (client *CachedClient) BlockResults(height int64) (*usecase_model.BlockResults, error) {
+	data, err := client.get(CACHE_KIND_BLOCK_RESULTS, height, func() (interface{}, error) {
+		return client.client.BlockResults(height)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result usecase_model.BlockResults
+	if unmarshalErr := jsoniter.Unmarshal(data, &result); unmarshalErr != nil {
+		return nil, fmt.Errorf("error decoding cached block results: %v", unmarshalErr)
+	}
+
+	return &result, nil
+}
+
+func (client *CachedClient) LatestBlockHeight() (int64, error) {
+	return client.client.LatestBlockHeight()
+}
+
+// Warm fetches block and block results of the height into the cache if not already cached
+func (client *CachedClient) Warm(height int64) error {
+	if _, _, err := client.Block(height); err != nil {
+		return err
+	}
+	if _, err := client.BlockResults(height); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InvalidateFromHeight deletes the cached responses of all kinds at and after height, e.g. after
+// rolling back the diverged heights, so that they are fetched from the node again. Returns the
+// number of deleted responses. Responses being fetched concurrently are not invalidated.
+func (client *CachedClient) InvalidateFromHeight(height int64) (int, error) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	invalidatedCount := 0
+	for key, entry := range client.entries {
+		if entry.height < height {
+			continue
+		}
+		path := entry.path
+		client.removeEntry(key)
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return invalidatedCount, fmt.Errorf("error removing cache file %s: %v", path, err)
+		}
+		invalidatedCount++
+	}
+
+	return invalidatedCount, nil
+}
+
+// get returns the cached JSON of the kind at height. On cache miss the value is fetched, encoded
+// and stored. Concurrent misses of the same key share a single fetch.
+func (client *CachedClient) get(kind string, height int64, fetch func() (interface{}, error)) ([]byte, error) {
+	key := cacheKey(kind, height)
+
+	client.mutex.Lock()
+	if entry, ok := client.entries[key]; ok {
+		entry.lastAccess = time.Now().UnixNano()
+		client.mutex.Unlock()
+
+		data, err := readCacheFile(entry.path)
+		if err == nil {
+			return data, nil
+		}
+		client.logger.Errorf("error reading cache file %s, fetching again: %v", entry.path, err)
+		client.mutex.Lock()
+		client.removeEntry(key)
+	}
+	if request, ok := client.inflight[key]; ok {
+		client.mutex.Unlock()
+		request.wg.Wait()
+		return request.data, request.err
+	}
+	request := &inflightRequest{}
+	request.wg.Add(1)
+	client.inflight[key] = request
+	client.mutex.Unlock()
+
+	request.data, request.err = client.fetchAndStore(kind, height, key, fetch)
+
+	client.mutex.Lock()
+	delete(client.inflight, key)
+	client.mutex.Unlock()
+	request.wg.Done()
+
+	return request.data, request.err
+}
+
+func (client *CachedClient) fetchAndStore(
+	kind string,
+	height int64,
+	key string,
+	fetch func() (interface{}, error),
+) ([]byte, error) {
+	value, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	data, err := jsoniter.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding %s at height %d for cache: %v", kind, height, err)
+	}
+
+	path := filepath.Join(client.dir, kind, strconv.FormatInt(height, 10)+cacheFileExt)
+	size, err := writeCacheFile(path, data)
+	if err != nil {
+		// Cache failure should not stop the response from being used
+		client.logger.Errorf("error writing cache file %s: %v", path, err)
+		return data, nil
+	}
+
+	client.mutex.Lock()
+	client.removeEntry(key)
+	client.entries[key] = &cacheEntry{
+		height:     height,
+		path:       path,
+		size:       size,
+		lastAccess: time.Now().UnixNano(),
+	}
+	client.totalSize += size
+	client.evict()
+	client.mutex.Unlock()
+
+	return data, nil
+}
+
+func (client *CachedClient) loadEntries(kind string) error {
+	files, err := ioutil.ReadDir(filepath.Join(client.dir, kind))
+	if err != nil {
+		return err
+	}
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() || !strings.HasSuffix(name, cacheFileExt) {
+			continue
+		}
+		height, parseErr := strconv.ParseInt(strings.TrimSuffix(name, cacheFileExt), 10, 64)
+		if parseErr != nil {
+			continue
+		}
+
+		client.entries[cacheKey(kind, height)] = &cacheEntry{
+			height:     height,
+			path:       filepath.Join(client.dir, kind, name),
+			size:       file.Size(),
+			lastAccess: file.ModTime().UnixNano(),
+		}
+		client.totalSize += file.Size()
+	}
+
+	return nil
+}
+
+// evict removes the least recently used entries until the cache fits into the size limit. Must be
+// called with mutex locked.
+func (client *CachedClient) evict() {
+	if client.maxSizeBytes <= 0 || client.totalSize <= client.maxSizeBytes {
+		return
+	}
+
+	keys := make([]string, 0, len(client.entries))
+	for key := range client.entries {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return client.entries[keys[i]].lastAccess < client.entries[keys[j]].lastAccess
+	})
+
+	for _, key := range keys {
+		if client.totalSize <= client.maxSizeBytes {
+			break
+		}
+		path := client.entries[key].path
+		client.removeEntry(key)
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			client.logger.Errorf("error removing cache file %s: %v", path, err)
+		}
+	}
+}
+
+// removeEntry removes the entry from the index without deleting the file. Must be called with
+// mutex locked.
+func (client *CachedClient) removeEntry(key string) {
+	entry, ok := client.entries[key]
+	if !ok {
+		return
+	}
+	client.totalSize -= entry.size
+	delete(client.entries, key)
+}
+
+func cacheKey(kind string, height int64) string {
+	return kind + "/" + strconv.FormatInt(height, 10)
+}
+
+func readCacheFile(path string) ([]byte, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return ioutil.ReadAll(reader)
+}
+
+// writeCacheFile writes the compressed data to a temporary file and renames it to the path, so
+// that a partially written file is never read. Returns the size of the written file.
+func writeCacheFile(path string, data []byte) (int64, error) {
+	tmpFile, err := ioutil.TempFile(filepath.Dir(path), ".tmp-")
+	if err != nil {
+		return 0, err
+	}
+	tmpPath := tmpFile.Name()
+	defer os.Remove(tmpPath)
+
+	writer := gzip.NewWriter(tmpFile)
+	if _, err = writer.Write(data); err != nil {
+		tmpFile.Close()
+		return 0, err
+	}
+	if err = writer.Close(); err != nil {
+		tmpFile.Close()
+		return 0, err
+	}
+	info, err := tmpFile.Stat()
+	if err != nil {
+		tmpFile.Close()
+		return 0, err
+	}
+	if err = tmpFile.Close(); err != nil {
+		return 0, err
+	}
+
+	if err = os.Rename(tmpPath, path); err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
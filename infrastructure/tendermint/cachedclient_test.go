@@ -0,0 +1,213 @@
+package tendermint_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/crypto-com/chain-indexing/appinterface/tendermint"
+	. "github.com/crypto-com/chain-indexing/infrastructure/tendermint"
+	infrastructure_tendermint_test "github.com/crypto-com/chain-indexing/infrastructure/tendermint/test"
+	. "github.com/crypto-com/chain-indexing/internal/logger/test"
+)
+
+var _ = Describe("CachedClient", func() {
+	var server *ghttp.Server
+	var cacheDir string
+
+	BeforeEach(func() {
+		var err error
+
+		server = ghttp.NewServer()
+		server.SetAllowUnhandledRequests(false)
+
+		cacheDir, err = ioutil.TempDir("", "tendermint-cache")
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		server.Close()
+		_ = os.RemoveAll(cacheDir)
+	})
+
+	appendBlockHandler := func(height int64) {
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", "/block", fmt.Sprintf("height=%d", height)),
+			ghttp.RespondWith(http.StatusOK, infrastructure_tendermint_test.BLOCK_JSON),
+		))
+	}
+	appendBlockResultsHandler := func(height int64) {
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", "/block_results", fmt.Sprintf("height=%d", height)),
+			ghttp.RespondWith(http.StatusOK, infrastructure_tendermint_test.BLOCK_RESULTS_JSON),
+		))
+	}
+
+	It("should implement Client", func() {
+		client, err := NewCachedClient(
+			NewFakeLogger(), NewHTTPClient(server.URL(), true), CachedClientConfig{Dir: cacheDir},
+		)
+		Expect(err).To(BeNil())
+
+		var _ tendermint.Client = client
+	})
+
+	It("should return the same block and block results as the underlying client from cache", func() {
+		anyHeight := int64(100)
+		appendBlockHandler(anyHeight)
+		appendBlockResultsHandler(anyHeight)
+		appendBlockHandler(anyHeight)
+		appendBlockResultsHandler(anyHeight)
+
+		httpClient := NewHTTPClient(server.URL(), true)
+		expectedBlock, expectedRawBlock, err := httpClient.Block(anyHeight)
+		Expect(err).To(BeNil())
+		expectedBlockResults, err := httpClient.BlockResults(anyHeight)
+		Expect(err).To(BeNil())
+
+		client, err := NewCachedClient(NewFakeLogger(), httpClient, CachedClientConfig{Dir: cacheDir})
+		Expect(err).To(BeNil())
+
+		for i := 0; i < 3; i += 1 {
+			block, rawBlock, blockErr := client.Block(anyHeight)
+			Expect(blockErr).To(BeNil())
+			Expect(block).To(Equal(expectedBlock))
+			Expect(rawBlock).To(Equal(expectedRawBlock))
+
+			blockResults, blockResultsErr := client.BlockResults(anyHeight)
+			Expect(blockResultsErr).To(BeNil())
+			Expect(blockResults).To(Equal(expectedBlockResults))
+		}
+		Expect(server.ReceivedRequests()).To(HaveLen(4))
+	})
+
+	It("should load cached heights from the directory", func() {
+		anyHeight := int64(100)
+		appendBlockHandler(anyHeight)
+
+		client, err := NewCachedClient(
+			NewFakeLogger(), NewHTTPClient(server.URL(), true), CachedClientConfig{Dir: cacheDir},
+		)
+		Expect(err).To(BeNil())
+		_, _, err = client.Block(anyHeight)
+		Expect(err).To(BeNil())
+
+		reloadedClient, err := NewCachedClient(
+			NewFakeLogger(), NewHTTPClient(server.URL(), true), CachedClientConfig{Dir: cacheDir},
+		)
+		Expect(err).To(BeNil())
+		block, _, err := reloadedClient.Block(anyHeight)
+		Expect(err).To(BeNil())
+		Expect(block.Height).To(Equal(anyHeight))
+
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("should fetch a height only once when requested concurrently", func() {
+		anyHeight := int64(100)
+		appendBlockHandler(anyHeight)
+
+		client, err := NewCachedClient(
+			NewFakeLogger(), NewHTTPClient(server.URL(), true), CachedClientConfig{Dir: cacheDir},
+		)
+		Expect(err).To(BeNil())
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i += 1 {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+
+				block, _, blockErr := client.Block(anyHeight)
+				Expect(blockErr).To(BeNil())
+				Expect(block.Height).To(Equal(anyHeight))
+			}()
+		}
+		wg.Wait()
+
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("should evict least recently used heights when exceeding size limit", func() {
+		appendBlockHandler(1)
+		appendBlockHandler(2)
+		appendBlockHandler(1)
+
+		client, err := NewCachedClient(
+			NewFakeLogger(), NewHTTPClient(server.URL(), true), CachedClientConfig{Dir: cacheDir},
+		)
+		Expect(err).To(BeNil())
+		_, _, err = client.Block(1)
+		Expect(err).To(BeNil())
+
+		files, err := ioutil.ReadDir(filepath.Join(cacheDir, CACHE_KIND_BLOCK))
+		Expect(err).To(BeNil())
+		Expect(files).To(HaveLen(1))
+		entrySize := files[0].Size()
+
+		limitedClient, err := NewCachedClient(
+			NewFakeLogger(), NewHTTPClient(server.URL(), true), CachedClientConfig{
+				Dir:          cacheDir,
+				MaxSizeBytes: entrySize + entrySize/2,
+			},
+		)
+		Expect(err).To(BeNil())
+		_, _, err = limitedClient.Block(2)
+		Expect(err).To(BeNil())
+
+		files, err = ioutil.ReadDir(filepath.Join(cacheDir, CACHE_KIND_BLOCK))
+		Expect(err).To(BeNil())
+		Expect(files).To(HaveLen(1))
+		Expect(files[0].Name()).To(Equal("2.json.gz"))
+
+		_, _, err = limitedClient.Block(1)
+		Expect(err).To(BeNil())
+		Expect(server.ReceivedRequests()).To(HaveLen(3))
+	})
+
+	It("should fetch the heights at and after the invalidated height again", func() {
+		for _, height := range []int64{1, 2, 3} {
+			appendBlockHandler(height)
+		}
+		appendBlockResultsHandler(3)
+		appendBlockHandler(2)
+		appendBlockHandler(3)
+
+		client, err := NewCachedClient(
+			NewFakeLogger(), NewHTTPClient(server.URL(), true), CachedClientConfig{Dir: cacheDir},
+		)
+		Expect(err).To(BeNil())
+		for _, height := range []int64{1, 2, 3} {
+			_, _, err = client.Block(height)
+			Expect(err).To(BeNil())
+		}
+		_, err = client.BlockResults(3)
+		Expect(err).To(BeNil())
+
+		invalidatedCount, err := client.InvalidateFromHeight(2)
+		Expect(err).To(BeNil())
+		Expect(invalidatedCount).To(Equal(3))
+
+		files, err := ioutil.ReadDir(filepath.Join(cacheDir, CACHE_KIND_BLOCK))
+		Expect(err).To(BeNil())
+		Expect(files).To(HaveLen(1))
+		Expect(files[0].Name()).To(Equal("1.json.gz"))
+		files, err = ioutil.ReadDir(filepath.Join(cacheDir, CACHE_KIND_BLOCK_RESULTS))
+		Expect(err).To(BeNil())
+		Expect(files).To(BeEmpty())
+
+		for _, height := range []int64{1, 2, 3} {
+			_, _, err = client.Block(height)
+			Expect(err).To(BeNil())
+		}
+		Expect(server.ReceivedRequests()).To(HaveLen(6))
+	})
+})
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/crypto-com/chain-indexing/appinterface/tendermint"
+	"github.com/crypto-com/chain-indexing/infrastructure/metrics"
 
 	"github.com/crypto-com/chain-indexing/usecase/model/genesis"
 
@@ -21,6 +22,8 @@ import (
 
 var _ tendermint.Client = &HTTPClient{}
 
+const METRICS_CLIENT_LABEL = "tendermint"
+
 type HTTPClient struct {
 	httpClient           *http.Client
 	tendermintRPCUrl     string
@@ -127,6 +130,22 @@ func (client *HTTPClient) LatestBlockHeight() (int64, error) {
 	return block.Height, nil
 }
 
+// RawResponse returns the unparsed response body of the Tendermint RPC method
+func (client *HTTPClient) RawResponse(method string, queryString ...string) ([]byte, error) {
+	rawRespBody, err := client.request(method, queryString...)
+	if err != nil {
+		return nil, err
+	}
+	defer rawRespBody.Close()
+
+	body, err := ioutil.ReadAll(rawRespBody)
+	if err != nil {
+		return nil, fmt.Errorf("error reading Tendermint %s response: %v", method, err)
+	}
+
+	return body, nil
+}
+
 // request construct tendermint url and issues an HTTP request
 // returns the success http Body
 func (client *HTTPClient) request(method string, queryString ...string) (io.ReadCloser, error) {
@@ -141,6 +160,10 @@ func (client *HTTPClient) request(method string, queryString ...string) (io.Read
 	if err != nil {
 		return nil, fmt.Errorf("error creating HTTP request with context: %v", err)
 	}
+	startTime := time.Now()
+	defer func() {
+		metrics.ObserveRPCRequest(METRICS_CLIENT_LABEL, method, startTime, err)
+	}()
 	rawResp, err := client.httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("error requesting Tendermint %s endpoint: %v", url, err)
@@ -148,7 +171,8 @@ func (client *HTTPClient) request(method string, queryString ...string) (io.Read
 
 	if rawResp.StatusCode != 200 {
 		rawResp.Body.Close()
-		return nil, fmt.Errorf("error requesting Tendermint %s endpoint: %s", method, rawResp.Status)
+		err = fmt.Errorf("error requesting Tendermint %s endpoint: %s", method, rawResp.Status)
+		return nil, err
 	}
 
 	return rawResp.Body, nil
@@ -30,6 +30,20 @@ func ParseGenesisResp(rawRespReader io.Reader, strictParsing bool) (*genesis.Gen
 	return &genesisResp.Result.Genesis, nil
 }
 
+// ParseGenesis parses a genesis document, e.g. genesis.json of a node
+func ParseGenesis(rawGenesisReader io.Reader, strictParsing bool) (*genesis.Genesis, error) {
+	var genesisDoc genesis.Genesis
+	jsonDecoder := jsoniter.NewDecoder(rawGenesisReader)
+	if strictParsing {
+		jsonDecoder.DisallowUnknownFields()
+	}
+	if err := jsonDecoder.Decode(&genesisDoc); err != nil {
+		return nil, fmt.Errorf("error decoding Tendermint genesis: %v", err)
+	}
+
+	return &genesisDoc, nil
+}
+
 func ParseBlockResp(rawRespReader io.Reader) (*model.Block, *model.RawBlock, error) {
 	var err error
 
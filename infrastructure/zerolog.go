@@ -130,7 +130,8 @@ func (logger *ZerologLogger) WithInterface(key string, value interface{}) applog
 }
 
 func (logger *ZerologLogger) WithFields(fields applogger.LogFields) applogger.Logger {
-	instance := logger.instance.With().Fields(fields).Logger()
+	// zerolog ignores the fields of any type other than map[string]interface{} and []interface{}
+	instance := logger.instance.With().Fields(map[string]interface{}(fields)).Logger()
 	return &ZerologLogger{
 		instance: &instance,
 	}
@@ -0,0 +1,13 @@
+package graphqllimit_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestGraphqllimit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Graphqllimit Suite")
+}
@@ -0,0 +1,333 @@
+package graphqllimit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/kinds"
+	"github.com/graphql-go/graphql/language/visitor"
+)
+
+// MAX_COMPLEXITY is the complexity saturated to when it overflows
+const MAX_COMPLEXITY = int(^uint(0) >> 1)
+
+// ComplexityFn returns the complexity of the field from its arguments and the complexity of its
+// selection set. It is for the list fields to count the selection once per item.
+type ComplexityFn = func(args map[string]interface{}, childComplexity int) int
+
+// FieldComplexities are the complexity functions of the fields keyed by "Type.field". The other
+// fields count 1 plus the complexity of their selection set.
+type FieldComplexities = map[string]ComplexityFn
+
+// Rules returns the validation rules rejecting the operations nested deeper than maxDepth or more
+// complex than maxComplexity. Zero means no limit. The variables are the raw variable values of the
+// request, used for the arguments of the complexity functions.
+//
+// The introspection fields are not counted, since they do not reach the views and are bounded by
+// the schema. The rules tolerate invalid documents, so that they can run before the specified rules.
+func Rules(
+	maxDepth int,
+	maxComplexity int,
+	complexities FieldComplexities,
+	variables map[string]interface{},
+) []graphql.ValidationRuleFn {
+	return []graphql.ValidationRuleFn{
+		func(context *graphql.ValidationContext) *graphql.ValidationRuleInstance {
+			return newOperationRule(context, func(walker *walker, operation *ast.OperationDefinition) error {
+				if maxDepth <= 0 {
+					return nil
+				}
+				if depth := walker.depth(operation.SelectionSet); depth > maxDepth {
+					return fmt.Errorf("query depth exceeds the limit of %d", maxDepth)
+				}
+				return nil
+			})
+		},
+		func(context *graphql.ValidationContext) *graphql.ValidationRuleInstance {
+			return newOperationRule(context, func(walker *walker, operation *ast.OperationDefinition) error {
+				if maxComplexity <= 0 {
+					return nil
+				}
+				walker.complexities = complexities
+				walker.variables = operationVariables(operation, variables)
+				rootType := operationRootType(context.Schema(), operation)
+				if rootType == nil {
+					return nil
+				}
+				complexity := walker.complexity(rootType, operation.SelectionSet)
+				if complexity > maxComplexity {
+					return fmt.Errorf("query complexity %d exceeds the limit of %d", complexity, maxComplexity)
+				}
+				return nil
+			})
+		},
+	}
+}
+
+func newOperationRule(
+	context *graphql.ValidationContext,
+	check func(walker *walker, operation *ast.OperationDefinition) error,
+) *graphql.ValidationRuleInstance {
+	return &graphql.ValidationRuleInstance{
+		VisitorOpts: &visitor.VisitorOptions{
+			KindFuncMap: map[string]visitor.NamedVisitFuncs{
+				kinds.OperationDefinition: {
+					Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+						operation, ok := p.Node.(*ast.OperationDefinition)
+						if !ok {
+							return visitor.ActionNoChange, nil
+						}
+						if err := check(newWalker(context), operation); err != nil {
+							context.ReportError(gqlerrors.NewError(
+								err.Error(), []ast.Node{operation}, "", nil, []int{}, nil,
+							))
+						}
+						return visitor.ActionSkip, nil
+					},
+				},
+			},
+		},
+	}
+}
+
+func operationRootType(schema *graphql.Schema, operation *ast.OperationDefinition) *graphql.Object {
+	switch operation.Operation {
+	case ast.OperationTypeQuery:
+		return schema.QueryType()
+	case ast.OperationTypeMutation:
+		return schema.MutationType()
+	case ast.OperationTypeSubscription:
+		return schema.SubscriptionType()
+	}
+	return nil
+}
+
+// operationVariables returns the request variables together with the default values of the absent
+// variables of the operation
+func operationVariables(operation *ast.OperationDefinition, values map[string]interface{}) map[string]interface{} {
+	variables := make(map[string]interface{})
+	for _, definition := range operation.VariableDefinitions {
+		if definition.Variable == nil || definition.Variable.Name == nil {
+			continue
+		}
+		name := definition.Variable.Name.Value
+		if value, exist := values[name]; exist {
+			variables[name] = value
+		} else if definition.DefaultValue != nil {
+			variables[name] = definition.DefaultValue
+		}
+	}
+	return variables
+}
+
+// walker walks the selection sets of an operation through the fragments. The results of the
+// fragments are memoized, so that fragments spread repeatedly are walked once.
+type walker struct {
+	context      *graphql.ValidationContext
+	complexities FieldComplexities
+	// Raw variable values or the default value AST
+	variables map[string]interface{}
+
+	fragmentDepths       map[string]int
+	fragmentComplexities map[string]int
+	// The fragments being walked, to stop at the fragment cycles
+	walkingFragments map[string]bool
+}
+
+func newWalker(context *graphql.ValidationContext) *walker {
+	return &walker{
+		context: context,
+
+		fragmentDepths:       make(map[string]int),
+		fragmentComplexities: make(map[string]int),
+		walkingFragments:     make(map[string]bool),
+	}
+}
+
+func (walker *walker) depth(selectionSet *ast.SelectionSet) int {
+	if selectionSet == nil {
+		return 0
+	}
+
+	maxDepth := 0
+	for _, selection := range selectionSet.Selections {
+		depth := 0
+		switch typedSelection := selection.(type) {
+		case *ast.Field:
+			if isIntrospectionField(typedSelection) {
+				continue
+			}
+			depth = 1 + walker.depth(typedSelection.SelectionSet)
+		case *ast.InlineFragment:
+			depth = walker.depth(typedSelection.SelectionSet)
+		case *ast.FragmentSpread:
+			fragment := walker.enterFragment(typedSelection)
+			if fragment == nil {
+				continue
+			}
+			name := fragment.Name.Value
+			if fragmentDepth, exist := walker.fragmentDepths[name]; exist {
+				depth = fragmentDepth
+			} else {
+				depth = walker.depth(fragment.SelectionSet)
+				walker.fragmentDepths[name] = depth
+			}
+			delete(walker.walkingFragments, name)
+		}
+		if depth > maxDepth {
+			maxDepth = depth
+		}
+	}
+	return maxDepth
+}
+
+func (walker *walker) complexity(parentType graphql.Type, selectionSet *ast.SelectionSet) int {
+	if selectionSet == nil {
+		return 0
+	}
+
+	complexity := 0
+	for _, selection := range selectionSet.Selections {
+		switch typedSelection := selection.(type) {
+		case *ast.Field:
+			if isIntrospectionField(typedSelection) {
+				continue
+			}
+			complexity = SaturatingAdd(complexity, walker.fieldComplexity(parentType, typedSelection))
+		case *ast.InlineFragment:
+			fragmentType := parentType
+			if typedSelection.TypeCondition != nil {
+				fragmentType = walker.namedType(typedSelection.TypeCondition)
+			}
+			complexity = SaturatingAdd(complexity, walker.complexity(fragmentType, typedSelection.SelectionSet))
+		case *ast.FragmentSpread:
+			fragment := walker.enterFragment(typedSelection)
+			if fragment == nil {
+				continue
+			}
+			name := fragment.Name.Value
+			fragmentComplexity, exist := walker.fragmentComplexities[name]
+			if !exist {
+				fragmentComplexity = walker.complexity(walker.namedType(fragment.TypeCondition), fragment.SelectionSet)
+				walker.fragmentComplexities[name] = fragmentComplexity
+			}
+			delete(walker.walkingFragments, name)
+			complexity = SaturatingAdd(complexity, fragmentComplexity)
+		}
+	}
+	return complexity
+}
+
+func (walker *walker) fieldComplexity(parentType graphql.Type, field *ast.Field) int {
+	object, ok := parentType.(*graphql.Object)
+	if !ok {
+		return SaturatingAdd(1, walker.complexity(nil, field.SelectionSet))
+	}
+	definition, exist := object.Fields()[field.Name.Value]
+	if !exist {
+		return SaturatingAdd(1, walker.complexity(nil, field.SelectionSet))
+	}
+
+	childComplexity := walker.complexity(unwrapType(definition.Type), field.SelectionSet)
+	complexityFn, exist := walker.complexities[fmt.Sprintf("%s.%s", object.Name(), definition.Name)]
+	if !exist {
+		return SaturatingAdd(1, childComplexity)
+	}
+	return complexityFn(walker.argumentValues(definition, field), childComplexity)
+}
+
+// argumentValues returns the values of the scalar arguments of the field, coerced by their type
+// from the literal, the variable or the default value. The other arguments are absent.
+func (walker *walker) argumentValues(definition *graphql.FieldDefinition, field *ast.Field) map[string]interface{} {
+	args := make(map[string]interface{})
+	for _, argDefinition := range definition.Args {
+		scalar, ok := graphql.GetNamed(argDefinition.Type).(*graphql.Scalar)
+		if !ok {
+			continue
+		}
+
+		var value interface{}
+		for _, arg := range field.Arguments {
+			if arg.Name == nil || arg.Name.Value != argDefinition.Name() {
+				continue
+			}
+			if variable, ok := arg.Value.(*ast.Variable); ok {
+				switch variableValue := walker.variables[variable.Name.Value].(type) {
+				case nil:
+				case ast.Value:
+					value = scalar.ParseLiteral(variableValue)
+				default:
+					value = scalar.ParseValue(variableValue)
+				}
+			} else {
+				value = scalar.ParseLiteral(arg.Value)
+			}
+		}
+		if value == nil {
+			value = argDefinition.DefaultValue
+		}
+		if value != nil {
+			args[argDefinition.Name()] = value
+		}
+	}
+	return args
+}
+
+// enterFragment returns the spread fragment and marks it as being walked. Nil when the fragment is
+// unknown or already being walked.
+func (walker *walker) enterFragment(spread *ast.FragmentSpread) *ast.FragmentDefinition {
+	if spread.Name == nil {
+		return nil
+	}
+	fragment := walker.context.Fragment(spread.Name.Value)
+	if fragment == nil || walker.walkingFragments[spread.Name.Value] {
+		return nil
+	}
+	walker.walkingFragments[spread.Name.Value] = true
+	return fragment
+}
+
+func (walker *walker) namedType(named *ast.Named) graphql.Type {
+	if named == nil || named.Name == nil {
+		return nil
+	}
+	return walker.context.Schema().Type(named.Name.Value)
+}
+
+// unwrapType returns the named type wrapped by the list and non-null types
+func unwrapType(t graphql.Type) graphql.Type {
+	for {
+		switch typedType := t.(type) {
+		case *graphql.List:
+			t = typedType.OfType
+		case *graphql.NonNull:
+			t = typedType.OfType
+		default:
+			return t
+		}
+	}
+}
+
+func isIntrospectionField(field *ast.Field) bool {
+	return field.Name == nil || strings.HasPrefix(field.Name.Value, "__")
+}
+
+// SaturatingAdd adds the non-negative complexities. Returns MAX_COMPLEXITY when the sum overflows.
+func SaturatingAdd(a int, b int) int {
+	if a > MAX_COMPLEXITY-b {
+		return MAX_COMPLEXITY
+	}
+	return a + b
+}
+
+// SaturatingMul multiplies the non-negative complexities. Returns MAX_COMPLEXITY when the product
+// overflows.
+func SaturatingMul(a int, b int) int {
+	if a != 0 && b > MAX_COMPLEXITY/a {
+		return MAX_COMPLEXITY
+	}
+	return a * b
+}
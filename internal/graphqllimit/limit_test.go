@@ -0,0 +1,141 @@
+package graphqllimit_test
+
+import (
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/parser"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/internal/graphqllimit"
+)
+
+var _ = Describe("Rules", func() {
+	blockType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Block",
+		Fields: graphql.Fields{
+			"blockHeight": {Type: graphql.NewNonNull(graphql.Int)},
+			"blockHash":   {Type: graphql.NewNonNull(graphql.String)},
+		},
+	})
+	blockType.AddFieldConfig("parent", &graphql.Field{Type: blockType})
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"block": {
+				Type: blockType,
+				Args: graphql.FieldConfigArgument{
+					"height": {Type: graphql.NewNonNull(graphql.Int)},
+				},
+			},
+			"blocks": {
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(blockType))),
+				Args: graphql.FieldConfigArgument{
+					"limit": {Type: graphql.Int, DefaultValue: 2},
+				},
+			},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{Query: queryType})
+	if err != nil {
+		panic(err)
+	}
+	complexities := graphqllimit.FieldComplexities{
+		"Query.blocks": func(args map[string]interface{}, childComplexity int) int {
+			return 1 + args["limit"].(int)*childComplexity
+		},
+	}
+
+	validate := func(
+		query string,
+		maxDepth int,
+		maxComplexity int,
+		variables map[string]interface{},
+	) []string {
+		document, err := parser.Parse(parser.ParseParams{Source: query})
+		Expect(err).To(BeNil())
+
+		result := graphql.ValidateDocument(
+			&schema, document, graphqllimit.Rules(maxDepth, maxComplexity, complexities, variables),
+		)
+		messages := make([]string, 0)
+		for _, err := range result.Errors {
+			messages = append(messages, err.Message)
+		}
+		return messages
+	}
+
+	It("should reject query exceeding the depth limit", func() {
+		query := `{ block(height: 3) { parent { parent { blockHeight } } } }`
+
+		Expect(validate(query, 4, 0, nil)).To(BeEmpty())
+		Expect(validate(query, 3, 0, nil)).To(Equal([]string{"query depth exceeds the limit of 3"}))
+	})
+
+	It("should count the depth through the fragments", func() {
+		query := `
+			{ block(height: 3) { ...Parent } }
+			fragment Parent on Block { parent { ... on Block { parent { blockHeight } } } }
+		`
+
+		Expect(validate(query, 4, 0, nil)).To(BeEmpty())
+		Expect(validate(query, 3, 0, nil)).To(Equal([]string{"query depth exceeds the limit of 3"}))
+	})
+
+	It("should reject query exceeding the complexity limit", func() {
+		query := `{ blocks(limit: 10) { blockHeight blockHash } }`
+
+		Expect(validate(query, 0, 21, nil)).To(BeEmpty())
+		Expect(validate(query, 0, 20, nil)).To(Equal([]string{"query complexity 21 exceeds the limit of 20"}))
+	})
+
+	It("should take the arguments of the complexity from the variables and the default values", func() {
+		query := `query Blocks($limit: Int = 5) { blocks(limit: $limit) { blockHeight } }`
+
+		Expect(validate(query, 0, 1, map[string]interface{}{"limit": float64(10)})).To(Equal(
+			[]string{"query complexity 11 exceeds the limit of 1"},
+		))
+		Expect(validate(query, 0, 1, nil)).To(Equal([]string{"query complexity 6 exceeds the limit of 1"}))
+		Expect(validate(`{ blocks { blockHeight } }`, 0, 1, nil)).To(Equal(
+			[]string{"query complexity 3 exceeds the limit of 1"},
+		))
+	})
+
+	It("should count the fragment spread repeatedly", func() {
+		query := `
+			{ blocks(limit: 10) { ...A ...A } }
+			fragment A on Block { ...B ...B }
+			fragment B on Block { blockHeight blockHash }
+		`
+
+		Expect(validate(query, 0, 1, nil)).To(Equal([]string{"query complexity 81 exceeds the limit of 1"}))
+	})
+
+	It("should stop at the fragment cycles", func() {
+		query := `
+			{ blocks { ...A } }
+			fragment A on Block { parent { ...B } }
+			fragment B on Block { parent { ...A } }
+		`
+
+		Expect(validate(query, 10, 100, nil)).To(BeEmpty())
+	})
+
+	It("should not count the introspection fields", func() {
+		query := `{
+			__schema { types { fields { type { ofType { ofType { ofType { name } } } } } } }
+			block(height: 1) { __typename blockHeight }
+		}`
+
+		Expect(validate(query, 2, 2, nil)).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Complexity", func() {
+	It("should saturate the complexity on overflow", func() {
+		Expect(graphqllimit.SaturatingAdd(1, 2)).To(Equal(3))
+		Expect(graphqllimit.SaturatingAdd(graphqllimit.MAX_COMPLEXITY, 1)).To(Equal(graphqllimit.MAX_COMPLEXITY))
+		Expect(graphqllimit.SaturatingMul(0, graphqllimit.MAX_COMPLEXITY)).To(Equal(0))
+		Expect(graphqllimit.SaturatingMul(3, 4)).To(Equal(12))
+		Expect(graphqllimit.SaturatingMul(graphqllimit.MAX_COMPLEXITY/2, 3)).To(Equal(graphqllimit.MAX_COMPLEXITY))
+	})
+})
@@ -0,0 +1,122 @@
+package tmcosmosutils
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcutil/bech32"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const evmAddressLength = 20
+
+func MustAccountAddressFromHexAddress(bech32Prefix string, hexAddress string) string {
+	address, err := AccountAddressFromHexAddress(bech32Prefix, hexAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	return address
+}
+
+// AccountAddressFromHexAddress converts the 0x-prefixed EVM hex address to the bech32 account
+// address. Both of them share the same address bytes on Ethermint-based chains.
+func AccountAddressFromHexAddress(bech32Prefix string, hexAddress string) (string, error) {
+	addressBytes, err := evmAddressBytesFromHex(hexAddress)
+	if err != nil {
+		return "", err
+	}
+
+	conv, err := bech32.ConvertBits(addressBytes, 8, 5, true)
+	if err != nil {
+		return "", fmt.Errorf("error converting EVM address to bech32 bits: %v", err)
+	}
+	address, err := bech32.Encode(bech32Prefix, conv)
+	if err != nil {
+		return "", fmt.Errorf("error encoding EVM address bits to account address: %v", err)
+	}
+
+	return address, nil
+}
+
+func MustHexAddressFromAccountAddress(accountAddress string) string {
+	address, err := HexAddressFromAccountAddress(accountAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	return address
+}
+
+// HexAddressFromAccountAddress converts the bech32 account address to the EIP-55 checksummed EVM
+// hex address
+func HexAddressFromAccountAddress(accountAddress string) (string, error) {
+	_, conv, err := bech32.Decode(accountAddress)
+	if err != nil {
+		return "", fmt.Errorf("error decoding account address: %v", err)
+	}
+	addressBytes, err := bech32.ConvertBits(conv, 5, 8, false)
+	if err != nil {
+		return "", fmt.Errorf("error converting bech32 bits to account address bytes: %v", err)
+	}
+	if len(addressBytes) != evmAddressLength {
+		return "", fmt.Errorf("account address of %d bytes cannot be converted to EVM address", len(addressBytes))
+	}
+
+	return checksumHexAddress(addressBytes), nil
+}
+
+func MustChecksumHexAddress(hexAddress string) string {
+	address, err := ChecksumHexAddress(hexAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	return address
+}
+
+// ChecksumHexAddress returns the EIP-55 checksummed form of the EVM hex address
+func ChecksumHexAddress(hexAddress string) (string, error) {
+	addressBytes, err := evmAddressBytesFromHex(hexAddress)
+	if err != nil {
+		return "", err
+	}
+
+	return checksumHexAddress(addressBytes), nil
+}
+
+func IsValidHexAddress(hexAddress string) bool {
+	_, err := evmAddressBytesFromHex(hexAddress)
+	return err == nil
+}
+
+// ContractAddressFromCreator returns the address of the contract deployed by the creator with the
+// nonce
+func ContractAddressFromCreator(creatorHexAddress string, nonce uint64) (string, error) {
+	if _, err := evmAddressBytesFromHex(creatorHexAddress); err != nil {
+		return "", err
+	}
+
+	return crypto.CreateAddress(common.HexToAddress(creatorHexAddress), nonce).Hex(), nil
+}
+
+func evmAddressBytesFromHex(hexAddress string) ([]byte, error) {
+	if !strings.HasPrefix(hexAddress, "0x") && !strings.HasPrefix(hexAddress, "0X") {
+		return nil, fmt.Errorf("EVM address must be 0x-prefixed: %s", hexAddress)
+	}
+	addressBytes, err := hex.DecodeString(hexAddress[2:])
+	if err != nil {
+		return nil, fmt.Errorf("error decoding EVM address hex: %v", err)
+	}
+	if len(addressBytes) != evmAddressLength {
+		return nil, fmt.Errorf("EVM address must be %d bytes: %s", evmAddressLength, hexAddress)
+	}
+
+	return addressBytes, nil
+}
+
+func checksumHexAddress(addressBytes []byte) string {
+	return common.BytesToAddress(addressBytes).Hex()
+}
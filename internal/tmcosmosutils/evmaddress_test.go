@@ -0,0 +1,69 @@
+package tmcosmosutils_test
+
+import (
+	"github.com/crypto-com/chain-indexing/internal/tmcosmosutils"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const evmHexAddress = "0xb5Ec6d86f8f418F480799447F5c21F1C17c6f8F8"
+
+var _ = Describe("evmaddress", func() {
+	Describe("AccountAddressFromHexAddress", func() {
+		It("should work", func() {
+			Expect(tmcosmosutils.AccountAddressFromHexAddress(
+				"tcro", evmHexAddress,
+			)).To(Equal(accountAddress))
+		})
+
+		It("should accept lower case hex address", func() {
+			Expect(tmcosmosutils.AccountAddressFromHexAddress(
+				"tcro", "0xb5ec6d86f8f418f480799447f5c21f1c17c6f8f8",
+			)).To(Equal(accountAddress))
+		})
+
+		It("should return error when the address is not 0x-prefixed", func() {
+			_, err := tmcosmosutils.AccountAddressFromHexAddress(
+				"tcro", "b5ec6d86f8f418f480799447f5c21f1c17c6f8f8",
+			)
+			Expect(err).NotTo(BeNil())
+		})
+
+		It("should return error when the address is not 20 bytes", func() {
+			_, err := tmcosmosutils.AccountAddressFromHexAddress("tcro", "0xb5ec6d86")
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Describe("HexAddressFromAccountAddress", func() {
+		It("should return checksummed hex address", func() {
+			Expect(tmcosmosutils.HexAddressFromAccountAddress(
+				accountAddress,
+			)).To(Equal(evmHexAddress))
+		})
+	})
+
+	Describe("ChecksumHexAddress", func() {
+		It("should return EIP-55 checksummed address", func() {
+			Expect(tmcosmosutils.ChecksumHexAddress(
+				"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+			)).To(Equal("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"))
+			Expect(tmcosmosutils.ChecksumHexAddress(
+				"0xFB6916095CA1DF60BB79CE92CE3EA74C37C5D359",
+			)).To(Equal("0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"))
+		})
+	})
+
+	Describe("ContractAddressFromCreator", func() {
+		It("should work", func() {
+			creator := "0x6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0"
+			Expect(tmcosmosutils.ContractAddressFromCreator(
+				creator, 0,
+			)).To(Equal("0xcd234A471b72ba2F1Ccf0A70FCABA648a5eeCD8d"))
+			Expect(tmcosmosutils.ContractAddressFromCreator(
+				creator, 1,
+			)).To(Equal("0x343c43A37D37dfF08AE8C4A11544c718AbB4fCF8"))
+		})
+	})
+})
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS view_transaction_unknown_msgs_total;
\ No newline at end of file
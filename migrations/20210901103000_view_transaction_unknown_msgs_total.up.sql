@@ -0,0 +1,5 @@
+CREATE TABLE view_transaction_unknown_msgs_total (
+    identity VARCHAR,
+    total BIGINT NOT NULL,
+    PRIMARY KEY (identity)
+)
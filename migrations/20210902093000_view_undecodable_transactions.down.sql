@@ -0,0 +1 @@
+DROP TABLE IF EXISTS view_undecodable_transactions;
@@ -0,0 +1,14 @@
+CREATE TABLE view_undecodable_transactions (
+    id BIGSERIAL,
+    block_height BIGINT NOT NULL,
+    block_hash VARCHAR NOT NULL,
+    block_time BIGINT NOT NULL,
+    hash VARCHAR NOT NULL,
+    index INT NOT NULL,
+    code INT NOT NULL,
+    log VARCHAR NOT NULL,
+    raw_tx VARCHAR NOT NULL,
+    decode_error VARCHAR NOT NULL,
+    PRIMARY KEY(id)
+);
+CREATE INDEX view_undecodable_transactions_block_height_index_btree_index ON view_undecodable_transactions USING btree (block_height, index);
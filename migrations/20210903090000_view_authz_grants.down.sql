@@ -0,0 +1 @@
+DROP TABLE IF EXISTS view_authz_grants;
@@ -0,0 +1,14 @@
+CREATE TABLE view_authz_grants (
+    granter VARCHAR NOT NULL,
+    grantee VARCHAR NOT NULL,
+    msg_type_url VARCHAR NOT NULL,
+    authorization_type VARCHAR NOT NULL,
+    spend_limit JSONB NOT NULL,
+    max_tokens JSONB,
+    expiration BIGINT,
+    granted_block_height BIGINT NOT NULL,
+    granted_block_time BIGINT NOT NULL,
+    granted_transaction_hash VARCHAR NOT NULL,
+    PRIMARY KEY(granter, grantee, msg_type_url)
+);
+CREATE INDEX view_authz_grants_grantee_btree_index ON view_authz_grants USING btree (grantee);
@@ -0,0 +1,15 @@
+CREATE TABLE view_fee_grants (
+    granter VARCHAR NOT NULL,
+    grantee VARCHAR NOT NULL,
+    allowance_type VARCHAR NOT NULL,
+    spend_limit JSONB NOT NULL,
+    expiration BIGINT,
+    period BIGINT,
+    period_spend_limit JSONB NOT NULL,
+    allowed_messages JSONB NOT NULL,
+    granted_block_height BIGINT NOT NULL,
+    granted_block_time BIGINT NOT NULL,
+    granted_transaction_hash VARCHAR NOT NULL,
+    PRIMARY KEY(granter, grantee)
+);
+CREATE INDEX view_fee_grants_grantee_btree_index ON view_fee_grants USING btree (grantee);
@@ -0,0 +1,10 @@
+CREATE TABLE view_vesting_accounts (
+    address VARCHAR NOT NULL,
+    type VARCHAR NOT NULL,
+    original_vesting JSONB NOT NULL,
+    start_time BIGINT,
+    end_time BIGINT NOT NULL,
+    vesting_periods JSONB NOT NULL,
+    created_block_height BIGINT NOT NULL,
+    PRIMARY KEY(address)
+);
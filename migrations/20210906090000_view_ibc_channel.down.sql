@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS view_ibc_packets;
+DROP TABLE IF EXISTS view_ibc_channels;
+DROP TABLE IF EXISTS view_ibc_connections;
+DROP TABLE IF EXISTS view_ibc_clients;
@@ -0,0 +1,64 @@
+CREATE TABLE view_ibc_clients (
+    client_id VARCHAR NOT NULL,
+    client_type VARCHAR NOT NULL,
+    counterparty_chain_id VARCHAR NOT NULL,
+    trusting_period BIGINT NOT NULL,
+    latest_height_revision_number BIGINT NOT NULL,
+    latest_height_revision_height BIGINT NOT NULL,
+    frozen BOOLEAN NOT NULL,
+    created_block_height BIGINT NOT NULL,
+    last_updated_block_height BIGINT NOT NULL,
+    last_updated_block_time BIGINT NOT NULL,
+    last_updated_transaction_hash VARCHAR NOT NULL,
+    PRIMARY KEY(client_id)
+);
+
+CREATE TABLE view_ibc_connections (
+    connection_id VARCHAR NOT NULL,
+    client_id VARCHAR NOT NULL,
+    counterparty_connection_id VARCHAR NOT NULL,
+    counterparty_client_id VARCHAR NOT NULL,
+    state VARCHAR NOT NULL,
+    created_block_height BIGINT NOT NULL,
+    last_updated_block_height BIGINT NOT NULL,
+    last_updated_block_time BIGINT NOT NULL,
+    last_updated_transaction_hash VARCHAR NOT NULL,
+    PRIMARY KEY(connection_id)
+);
+
+CREATE TABLE view_ibc_channels (
+    channel_id VARCHAR NOT NULL,
+    port_id VARCHAR NOT NULL,
+    connection_id VARCHAR NOT NULL,
+    counterparty_port_id VARCHAR NOT NULL,
+    counterparty_channel_id VARCHAR NOT NULL,
+    state VARCHAR NOT NULL,
+    ordering VARCHAR NOT NULL,
+    version VARCHAR NOT NULL,
+    created_block_height BIGINT NOT NULL,
+    last_updated_block_height BIGINT NOT NULL,
+    last_updated_block_time BIGINT NOT NULL,
+    last_updated_transaction_hash VARCHAR NOT NULL,
+    PRIMARY KEY(channel_id, port_id)
+);
+
+CREATE TABLE view_ibc_packets (
+    channel_id VARCHAR NOT NULL,
+    port_id VARCHAR NOT NULL,
+    direction VARCHAR NOT NULL,
+    sequence BIGINT NOT NULL,
+    counterparty_port_id VARCHAR NOT NULL,
+    counterparty_channel_id VARCHAR NOT NULL,
+    status VARCHAR NOT NULL,
+    fungible_token_packet_data JSONB,
+    sent_transaction_hash VARCHAR,
+    received_transaction_hash VARCHAR,
+    acknowledged_transaction_hash VARCHAR,
+    acknowledgement_success BOOLEAN,
+    timed_out_transaction_hash VARCHAR,
+    created_block_height BIGINT NOT NULL,
+    last_updated_block_height BIGINT NOT NULL,
+    last_updated_block_time BIGINT NOT NULL,
+    PRIMARY KEY(channel_id, port_id, direction, sequence)
+);
+CREATE INDEX view_ibc_packets_channel_id_created_block_height_btree_index ON view_ibc_packets USING btree (channel_id, created_block_height DESC, sequence DESC);
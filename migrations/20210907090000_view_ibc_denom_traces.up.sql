@@ -0,0 +1,7 @@
+CREATE TABLE view_ibc_denom_traces (
+    hash VARCHAR NOT NULL,
+    path VARCHAR NOT NULL,
+    base_denom VARCHAR NOT NULL,
+    created_block_height BIGINT NOT NULL,
+    PRIMARY KEY(hash)
+);
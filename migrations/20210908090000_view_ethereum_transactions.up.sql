@@ -0,0 +1,34 @@
+CREATE TABLE view_ethereum_transactions (
+    id BIGSERIAL,
+    hash VARCHAR NOT NULL,
+    transaction_hash VARCHAR NOT NULL,
+    msg_index INT NOT NULL,
+    block_height BIGINT NOT NULL,
+    block_time BIGINT NOT NULL,
+    success BOOLEAN NOT NULL,
+    tx_type VARCHAR NOT NULL,
+    from_address VARCHAR NOT NULL,
+    from_account VARCHAR NOT NULL,
+    to_address VARCHAR NULL,
+    to_account VARCHAR NULL,
+    is_contract_creation BOOLEAN NOT NULL,
+    contract_address VARCHAR NULL,
+    chain_id VARCHAR NULL,
+    nonce BIGINT NOT NULL,
+    value VARCHAR NOT NULL,
+    gas BIGINT NOT NULL,
+    gas_price VARCHAR NULL,
+    gas_tip_cap VARCHAR NULL,
+    gas_fee_cap VARCHAR NULL,
+    gas_used BIGINT NULL,
+    vm_error VARCHAR NULL,
+    data VARCHAR NOT NULL,
+    logs JSONB NOT NULL,
+    PRIMARY KEY(id),
+    UNIQUE(transaction_hash, msg_index)
+);
+
+CREATE INDEX view_ethereum_transactions_hash_btree_index ON view_ethereum_transactions USING btree (hash);
+CREATE INDEX view_ethereum_transactions_from_address_btree_index ON view_ethereum_transactions USING btree (from_address);
+CREATE INDEX view_ethereum_transactions_to_address_btree_index ON view_ethereum_transactions USING btree (to_address);
+CREATE INDEX view_ethereum_transactions_contract_address_btree_index ON view_ethereum_transactions USING btree (contract_address);
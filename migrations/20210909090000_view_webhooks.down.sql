@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS view_webhook_deliveries;
+DROP TABLE IF EXISTS view_webhook_watches;
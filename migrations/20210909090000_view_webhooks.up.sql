@@ -0,0 +1,31 @@
+CREATE TABLE view_webhook_watches (
+    id BIGSERIAL,
+    url VARCHAR NOT NULL,
+    -- AES-256-GCM encrypted by the [webhook] secret_key, never stored in plaintext
+    encrypted_secret VARCHAR NOT NULL,
+    address VARCHAR NULL,
+    event_name VARCHAR NULL,
+    from_block_height BIGINT NOT NULL,
+    created_at BIGINT NOT NULL,
+    PRIMARY KEY(id)
+);
+
+CREATE TABLE view_webhook_deliveries (
+    id BIGSERIAL,
+    watch_id BIGINT NOT NULL REFERENCES view_webhook_watches(id) ON DELETE CASCADE,
+    event_uuid VARCHAR NOT NULL,
+    event_name VARCHAR NOT NULL,
+    block_height BIGINT NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR NOT NULL,
+    attempts INT NOT NULL,
+    next_attempt_at BIGINT NOT NULL,
+    last_error VARCHAR NULL,
+    delivered_at BIGINT NULL,
+    created_at BIGINT NOT NULL,
+    UNIQUE(watch_id, event_uuid),
+    PRIMARY KEY(id)
+);
+
+CREATE INDEX view_webhook_deliveries_pending_btree_index ON view_webhook_deliveries USING btree (next_attempt_at, id) WHERE status = 'PENDING';
+CREATE INDEX view_webhook_deliveries_dead_btree_index ON view_webhook_deliveries USING btree (id) WHERE status = 'DEAD';
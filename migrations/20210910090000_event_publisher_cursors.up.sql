@@ -0,0 +1,6 @@
+CREATE TABLE event_publisher_cursors (
+    id VARCHAR NOT NULL,
+    last_published_event_id BIGINT NOT NULL,
+    pending_rollback_height BIGINT,
+    PRIMARY KEY(id)
+);
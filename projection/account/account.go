@@ -3,7 +3,9 @@ package account
 import (
 	"fmt"
 
+	"github.com/crypto-com/chain-indexing/internal/utctime"
 	"github.com/crypto-com/chain-indexing/usecase/coin"
+	"github.com/crypto-com/chain-indexing/usecase/model"
 
 	cosmosapp_interface "github.com/crypto-com/chain-indexing/appinterface/cosmosapp"
 
@@ -16,6 +18,8 @@ import (
 	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
 )
 
+var _ rdbprojectionbase.Rebuildable = &Account{}
+
 // Account number, sequence number, balances are fetched from the latest state (regardless of current replaying height)
 type Account struct {
 	*rdbprojectionbase.Base
@@ -42,6 +46,16 @@ func (_ *Account) GetEventsToListen() []string {
 	return []string{
 		// TODO: Genesis account
 		event_usecase.ACCOUNT_TRANSFERRED,
+		event_usecase.BLOCK_CREATED,
+		event_usecase.GENESIS_VESTING_ACCOUNT_CREATED,
+		event_usecase.MSG_CREATE_VESTING_ACCOUNT_CREATED,
+	}
+}
+
+func (_ *Account) GetViewTables() []string {
+	return []string{
+		"view_accounts",
+		account_view.VESTING_ACCOUNTS_TABLE,
 	}
 }
 
@@ -65,12 +79,38 @@ func (projection *Account) HandleEvents(height int64, events []event_entity.Even
 	rdbTxHandle := rdbTx.ToHandle()
 
 	accountsView := account_view.NewAccounts(rdbTxHandle)
+	vestingAccountsView := account_view.NewVestingAccounts(rdbTxHandle)
+
+	var blockTime utctime.UTCTime
+	for _, event := range events {
+		if blockCreatedEvent, ok := event.(*event_usecase.BlockCreated); ok {
+			blockTime = blockCreatedEvent.Block.Time
+		}
+	}
 
 	for _, event := range events {
 		if accountCreatedEvent, ok := event.(*event_usecase.AccountTransferred); ok {
 			if handleErr := projection.handleAccountCreatedEvent(accountsView, accountCreatedEvent); handleErr != nil {
 				return fmt.Errorf("error handling AccountCreatedEvent: %v", handleErr)
 			}
+		} else if genesisVestingAccountEvent, ok := event.(*event_usecase.CreateGenesisVestingAccount); ok {
+			if insertErr := vestingAccountsView.Insert(&account_view.VestingAccountRow{
+				Address:            genesisVestingAccountEvent.Address,
+				Type:               genesisVestingAccountEvent.Type,
+				OriginalVesting:    genesisVestingAccountEvent.OriginalVesting,
+				MaybeStartTime:     genesisVestingAccountEvent.MaybeStartTime,
+				EndTime:            genesisVestingAccountEvent.EndTime,
+				VestingPeriods:     genesisVestingAccountEvent.VestingPeriods,
+				CreatedBlockHeight: height,
+			}); insertErr != nil {
+				return fmt.Errorf("error inserting genesis vesting account: %v", insertErr)
+			}
+		} else if msgCreateVestingAccountEvent, ok := event.(*event_usecase.MsgCreateVestingAccount); ok {
+			if insertErr := vestingAccountsView.Insert(
+				newVestingAccountRowFromMsg(height, blockTime, msgCreateVestingAccountEvent),
+			); insertErr != nil {
+				return fmt.Errorf("error inserting vesting account: %v", insertErr)
+			}
 		}
 	}
 
@@ -86,6 +126,31 @@ func (projection *Account) HandleEvents(height int64, events []event_entity.Even
 	return nil
 }
 
+// newVestingAccountRowFromMsg returns the vesting account created by the message. Continuous vesting
+// account starts vesting at the block time of creation
+func newVestingAccountRowFromMsg(
+	height int64,
+	blockTime utctime.UTCTime,
+	event *event_usecase.MsgCreateVestingAccount,
+) *account_view.VestingAccountRow {
+	row := &account_view.VestingAccountRow{
+		Address:            event.Params.ToAddress,
+		Type:               model.VESTING_ACCOUNT_CONTINUOUS,
+		OriginalVesting:    event.Params.Amount,
+		EndTime:            event.Params.EndTime,
+		VestingPeriods:     []model.VestingPeriod{},
+		CreatedBlockHeight: height,
+	}
+	if event.Params.Delayed {
+		row.Type = model.VESTING_ACCOUNT_DELAYED
+	} else {
+		startTime := blockTime
+		row.MaybeStartTime = &startTime
+	}
+
+	return row
+}
+
 func (projection *Account) handleAccountCreatedEvent(accountsView *account_view.Accounts, event *event_usecase.AccountTransferred) error {
 
 	recipienterr := projection.writeAccountInfo(accountsView, event.Recipient)
@@ -0,0 +1,183 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/internal/json"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+)
+
+const VESTING_ACCOUNTS_TABLE = "view_vesting_accounts"
+
+// VestingAccounts projection view of the vesting schedules of vesting accounts
+type VestingAccounts struct {
+	rdb *rdb.Handle
+}
+
+func NewVestingAccounts(handle *rdb.Handle) *VestingAccounts {
+	return &VestingAccounts{
+		handle,
+	}
+}
+
+func (vestingAccountsView *VestingAccounts) Insert(vestingAccount *VestingAccountRow) error {
+	vestingPeriods := vestingAccount.VestingPeriods
+	if vestingPeriods == nil {
+		vestingPeriods = []model.VestingPeriod{}
+	}
+
+	sql, sqlArgs, err := vestingAccountsView.rdb.StmtBuilder.Insert(
+		VESTING_ACCOUNTS_TABLE,
+	).Columns(
+		"address",
+		"type",
+		"original_vesting",
+		"start_time",
+		"end_time",
+		"vesting_periods",
+		"created_block_height",
+	).Values(
+		vestingAccount.Address,
+		vestingAccount.Type,
+		json.MustMarshalToString(vestingAccount.OriginalVesting),
+		vestingAccountsView.rdb.Tton(vestingAccount.MaybeStartTime),
+		vestingAccountsView.rdb.Tton(&vestingAccount.EndTime),
+		json.MustMarshalToString(vestingPeriods),
+		vestingAccount.CreatedBlockHeight,
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building vesting account insertion sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	result, err := vestingAccountsView.rdb.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error inserting vesting account into the table: %v: %w", err, rdb.ErrWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return fmt.Errorf("error inserting vesting account into the table: no rows inserted: %w", rdb.ErrWrite)
+	}
+
+	return nil
+}
+
+func (vestingAccountsView *VestingAccounts) FindBy(address string) (*VestingAccountRow, error) {
+	sql, sqlArgs, err := vestingAccountsView.rdb.StmtBuilder.Select(
+		"address",
+		"type",
+		"original_vesting",
+		"start_time",
+		"end_time",
+		"vesting_periods",
+		"created_block_height",
+	).From(
+		VESTING_ACCOUNTS_TABLE,
+	).Where(
+		"address = ?", address,
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building vesting account selection sql: %v: %w", err, rdb.ErrPrepare)
+	}
+
+	var vestingAccount VestingAccountRow
+	var originalVesting string
+	var vestingPeriods string
+	startTimeReader := vestingAccountsView.rdb.NtotReader()
+	endTimeReader := vestingAccountsView.rdb.NtotReader()
+	if err = vestingAccountsView.rdb.QueryRow(sql, sqlArgs...).Scan(
+		&vestingAccount.Address,
+		&vestingAccount.Type,
+		&originalVesting,
+		startTimeReader.ScannableArg(),
+		endTimeReader.ScannableArg(),
+		&vestingPeriods,
+		&vestingAccount.CreatedBlockHeight,
+	); err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			return nil, rdb.ErrNoRows
+		}
+		return nil, fmt.Errorf("error scanning vesting account row: %v: %w", err, rdb.ErrQuery)
+	}
+
+	json.MustUnmarshalFromString(originalVesting, &vestingAccount.OriginalVesting)
+	json.MustUnmarshalFromString(vestingPeriods, &vestingAccount.VestingPeriods)
+	vestingAccount.MaybeStartTime, err = startTimeReader.Parse()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing vesting account start time: %v: %w", err, rdb.ErrQuery)
+	}
+	endTime, err := endTimeReader.Parse()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing vesting account end time: %v: %w", err, rdb.ErrQuery)
+	}
+	vestingAccount.EndTime = *endTime
+
+	return &vestingAccount, nil
+}
+
+type VestingAccountRow struct {
+	Address string `json:"address"`
+	// Type URL of the vesting account, e.g. /cosmos.vesting.v1beta1.DelayedVestingAccount
+	Type            string     `json:"type"`
+	OriginalVesting coin.Coins `json:"originalVesting"`
+	// Not available in DelayedVestingAccount
+	MaybeStartTime     *utctime.UTCTime      `json:"startTime"`
+	EndTime            utctime.UTCTime       `json:"endTime"`
+	VestingPeriods     []model.VestingPeriod `json:"vestingPeriods"`
+	CreatedBlockHeight int64                 `json:"createdBlockHeight"`
+}
+
+// VestedCoins returns the coins of the original vesting which are vested at the time, following
+// the vesting schedule of the vesting account type in Cosmos SDK
+func (row *VestingAccountRow) VestedCoins(at utctime.UTCTime) coin.Coins {
+	if at.UnixNano() >= row.EndTime.UnixNano() {
+		return row.OriginalVesting
+	}
+
+	switch row.Type {
+	case model.VESTING_ACCOUNT_CONTINUOUS:
+		if row.MaybeStartTime == nil || at.UnixNano() <= row.MaybeStartTime.UnixNano() {
+			return coin.NewEmptyCoins()
+		}
+		// Cosmos SDK vests continuously at the precision of seconds
+		elapsed := coin.NewDec(unixSeconds(at) - unixSeconds(*row.MaybeStartTime))
+		duration := coin.NewDec(unixSeconds(row.EndTime) - unixSeconds(*row.MaybeStartTime))
+		vestedRatio := elapsed.Quo(duration)
+
+		vestedCoins := coin.NewEmptyCoins()
+		for _, originalVestingCoin := range row.OriginalVesting {
+			vestedAmount := originalVestingCoin.Amount.ToDec().Mul(vestedRatio).RoundInt()
+			vestedCoins = vestedCoins.Add(coin.NewCoin(originalVestingCoin.Denom, vestedAmount))
+		}
+		return vestedCoins
+	case model.VESTING_ACCOUNT_PERIODIC:
+		if row.MaybeStartTime == nil {
+			return coin.NewEmptyCoins()
+		}
+		vestedCoins := coin.NewEmptyCoins()
+		periodEndTime := *row.MaybeStartTime
+		for _, period := range row.VestingPeriods {
+			periodEndTime = periodEndTime.Add(time.Duration(period.Length) * time.Second)
+			if at.UnixNano() < periodEndTime.UnixNano() {
+				break
+			}
+			vestedCoins = vestedCoins.Add(period.Amount...)
+		}
+		return vestedCoins
+	default:
+		// Delayed vesting account vests all coins at end time
+		return coin.NewEmptyCoins()
+	}
+}
+
+// VestingCoins returns the coins of the original vesting which are not yet vested at the time
+func (row *VestingAccountRow) VestingCoins(at utctime.UTCTime) coin.Coins {
+	return row.OriginalVesting.Sub(row.VestedCoins(at))
+}
+
+func unixSeconds(t utctime.UTCTime) int64 {
+	return t.UnixNano() / int64(time.Second)
+}
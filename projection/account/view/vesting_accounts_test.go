@@ -0,0 +1,69 @@
+package view_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/projection/account/view"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+)
+
+var _ = Describe("VestingAccountRow", func() {
+	startTime := utctime.FromTime(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+	endTime := startTime.Add(100 * time.Second)
+	originalVesting := coin.NewCoins(coin.MustNewCoinFromString("basetcro", "1000"))
+
+	It("should vest nothing of delayed vesting account before end time", func() {
+		row := view.VestingAccountRow{
+			Type:            model.VESTING_ACCOUNT_DELAYED,
+			OriginalVesting: originalVesting,
+			EndTime:         endTime,
+		}
+
+		at := endTime.Add(-1 * time.Second)
+		Expect(row.VestedCoins(at)).To(Equal(coin.NewEmptyCoins()))
+		Expect(row.VestingCoins(at)).To(Equal(originalVesting))
+		Expect(row.VestedCoins(endTime)).To(Equal(originalVesting))
+		Expect(row.VestingCoins(endTime).IsZero()).To(BeTrue())
+	})
+
+	It("should vest continuous vesting account linearly between start and end time", func() {
+		row := view.VestingAccountRow{
+			Type:            model.VESTING_ACCOUNT_CONTINUOUS,
+			OriginalVesting: originalVesting,
+			MaybeStartTime:  &startTime,
+			EndTime:         endTime,
+		}
+
+		Expect(row.VestedCoins(startTime)).To(Equal(coin.NewEmptyCoins()))
+		at := startTime.Add(25 * time.Second)
+		Expect(row.VestedCoins(at)).To(Equal(coin.NewCoins(coin.MustNewCoinFromString("basetcro", "250"))))
+		Expect(row.VestingCoins(at)).To(Equal(coin.NewCoins(coin.MustNewCoinFromString("basetcro", "750"))))
+		Expect(row.VestedCoins(endTime.Add(time.Second))).To(Equal(originalVesting))
+	})
+
+	It("should vest periodic vesting account at the end of each period", func() {
+		row := view.VestingAccountRow{
+			Type:            model.VESTING_ACCOUNT_PERIODIC,
+			OriginalVesting: originalVesting,
+			MaybeStartTime:  &startTime,
+			EndTime:         endTime,
+			VestingPeriods: []model.VestingPeriod{
+				{Length: 40, Amount: coin.NewCoins(coin.MustNewCoinFromString("basetcro", "400"))},
+				{Length: 60, Amount: coin.NewCoins(coin.MustNewCoinFromString("basetcro", "600"))},
+			},
+		}
+
+		Expect(row.VestedCoins(startTime.Add(39 * time.Second))).To(Equal(coin.NewEmptyCoins()))
+		Expect(row.VestedCoins(startTime.Add(40 * time.Second))).To(Equal(
+			coin.NewCoins(coin.MustNewCoinFromString("basetcro", "400")),
+		))
+		Expect(row.VestingCoins(startTime.Add(99 * time.Second))).To(Equal(
+			coin.NewCoins(coin.MustNewCoinFromString("basetcro", "600")),
+		))
+	})
+})
@@ -18,6 +18,7 @@ import (
 )
 
 var _ projection_entity.Projection = &AccountMessage{}
+var _ rdbprojectionbase.Rebuildable = &AccountMessage{}
 
 type AccountMessage struct {
 	*rdbprojectionbase.Base
@@ -49,6 +50,13 @@ func (_ *AccountMessage) GetEventsToListen() []string {
 	}, event_usecase.MSG_EVENTS...)
 }
 
+func (_ *AccountMessage) GetViewTables() []string {
+	return []string{
+		"view_account_messages",
+		"view_account_messages_total",
+	}
+}
+
 func (projection *AccountMessage) OnInit() error {
 	return nil
 }
@@ -84,6 +92,51 @@ func (projection *AccountMessage) HandleEvents(height int64, events []event_enti
 	accountMessagesView := view.NewAccountMessages(rdbTxHandle)
 	accountMessagesTotalView := view.NewAccountMessagesTotal(rdbTxHandle)
 
+	accountMessages := projection.ParseAccountMessages(height, events)
+	for i, accountMessage := range accountMessages {
+		// TODO: Change to use InsertAll
+		insertedAccounts := make(map[string]bool)
+		deduplicatedAccounts := make([]string, 0)
+		for _, involvedAccount := range accountMessage.Accounts {
+			// Deduplication
+			if _, exist := insertedAccounts[involvedAccount]; exist {
+				continue
+			}
+
+			if err := accountMessagesTotalView.Increment(fmt.Sprintf("%s:-", involvedAccount), 1); err != nil {
+				return fmt.Errorf("error incremnting total account message of account: %w", err)
+			}
+			if err := accountMessagesTotalView.Increment(
+				fmt.Sprintf("%s:%s", involvedAccount, accountMessage.Row.MessageType), 1,
+			); err != nil {
+				return fmt.Errorf("error incremnting total account message of account: %w", err)
+			}
+			deduplicatedAccounts = append(deduplicatedAccounts, involvedAccount)
+			insertedAccounts[involvedAccount] = true
+		}
+
+		if err := accountMessagesView.Insert(&accountMessages[i].Row, deduplicatedAccounts); err != nil {
+			return fmt.Errorf("error inserting account message: %w", err)
+		}
+	}
+
+	if err := projection.UpdateLastHandledEventHeight(rdbTxHandle, height); err != nil {
+		return fmt.Errorf("error updating last handled event height: %v", err)
+	}
+
+	if err := rdbTx.Commit(); err != nil {
+		return fmt.Errorf("error committing changes: %v", err)
+	}
+	committed = true
+	return nil
+}
+
+// ParseAccountMessages returns the account messages of the events at the height, together with the
+// accounts involved in each message. The accounts may be duplicated.
+func (projection *AccountMessage) ParseAccountMessages(
+	height int64,
+	events []event_entity.Event,
+) []view.AccountMessageRecord {
 	var blockTime utctime.UTCTime
 	var blockHash string
 	accountMessages := make([]view.AccountMessageRecord, 0)
@@ -740,46 +793,213 @@ func (projection *AccountMessage) HandleEvents(height int64, events []event_enti
 					typedEvent.Params.Signer,
 				},
 			})
-		}
-	}
-
-	for i, accountMessage := range accountMessages {
-		// TODO: Change to use InsertAll
-		accountMessages[i].Row.BlockHash = blockHash
-		accountMessages[i].Row.BlockTime = blockTime
-
-		insertedAccounts := make(map[string]bool)
-		deduplicatedAccounts := make([]string, 0)
-		for _, involvedAccount := range accountMessage.Accounts {
-			// Deduplication
-			if _, exist := insertedAccounts[involvedAccount]; exist {
-				continue
-			}
-
-			if err := accountMessagesTotalView.Increment(fmt.Sprintf("%s:-", involvedAccount), 1); err != nil {
-				return fmt.Errorf("error incremnting total account message of account: %w", err)
+		} else if typedEvent, ok := event.(*event_usecase.MsgIBCChannelCloseInit); ok {
+			accountMessages = append(accountMessages, view.AccountMessageRecord{
+				Row: view.AccountMessageRow{
+					BlockHeight:     height,
+					BlockHash:       "",
+					BlockTime:       utctime.UTCTime{},
+					TransactionHash: typedEvent.TxHash(),
+					Success:         typedEvent.TxSuccess(),
+					MessageIndex:    typedEvent.MsgIndex,
+					MessageType:     typedEvent.MsgType(),
+					Data:            typedEvent,
+				},
+				Accounts: []string{
+					typedEvent.Params.Signer,
+				},
+			})
+		} else if typedEvent, ok := event.(*event_usecase.MsgIBCChannelCloseConfirm); ok {
+			accountMessages = append(accountMessages, view.AccountMessageRecord{
+				Row: view.AccountMessageRow{
+					BlockHeight:     height,
+					BlockHash:       "",
+					BlockTime:       utctime.UTCTime{},
+					TransactionHash: typedEvent.TxHash(),
+					Success:         typedEvent.TxSuccess(),
+					MessageIndex:    typedEvent.MsgIndex,
+					MessageType:     typedEvent.MsgType(),
+					Data:            typedEvent,
+				},
+				Accounts: []string{
+					typedEvent.Params.Signer,
+				},
+			})
+		} else if typedEvent, ok := event.(*event_usecase.MsgIBCSubmitMisbehaviour); ok {
+			accountMessages = append(accountMessages, view.AccountMessageRecord{
+				Row: view.AccountMessageRow{
+					BlockHeight:     height,
+					BlockHash:       "",
+					BlockTime:       utctime.UTCTime{},
+					TransactionHash: typedEvent.TxHash(),
+					Success:         typedEvent.TxSuccess(),
+					MessageIndex:    typedEvent.MsgIndex,
+					MessageType:     typedEvent.MsgType(),
+					Data:            typedEvent,
+				},
+				Accounts: []string{
+					typedEvent.Params.Signer,
+				},
+			})
+		} else if typedEvent, ok := event.(*event_usecase.MsgIBCUpgradeClient); ok {
+			accountMessages = append(accountMessages, view.AccountMessageRecord{
+				Row: view.AccountMessageRow{
+					BlockHeight:     height,
+					BlockHash:       "",
+					BlockTime:       utctime.UTCTime{},
+					TransactionHash: typedEvent.TxHash(),
+					Success:         typedEvent.TxSuccess(),
+					MessageIndex:    typedEvent.MsgIndex,
+					MessageType:     typedEvent.MsgType(),
+					Data:            typedEvent,
+				},
+				Accounts: []string{
+					typedEvent.Params.Signer,
+				},
+			})
+		} else if typedEvent, ok := event.(*event_usecase.MsgCreateVestingAccount); ok {
+			accountMessages = append(accountMessages, view.AccountMessageRecord{
+				Row: view.AccountMessageRow{
+					BlockHeight:     height,
+					BlockHash:       "",
+					BlockTime:       utctime.UTCTime{},
+					TransactionHash: typedEvent.TxHash(),
+					Success:         typedEvent.TxSuccess(),
+					MessageIndex:    typedEvent.MsgIndex,
+					MessageType:     typedEvent.MsgType(),
+					Data:            typedEvent,
+				},
+				Accounts: []string{
+					typedEvent.Params.FromAddress,
+					typedEvent.Params.ToAddress,
+				},
+			})
+		} else if typedEvent, ok := event.(*event_usecase.MsgGrant); ok {
+			accountMessages = append(accountMessages, view.AccountMessageRecord{
+				Row: view.AccountMessageRow{
+					BlockHeight:     height,
+					BlockHash:       "",
+					BlockTime:       utctime.UTCTime{},
+					TransactionHash: typedEvent.TxHash(),
+					Success:         typedEvent.TxSuccess(),
+					MessageIndex:    typedEvent.MsgIndex,
+					MessageType:     typedEvent.MsgType(),
+					Data:            typedEvent,
+				},
+				Accounts: []string{
+					typedEvent.Params.Granter,
+					typedEvent.Params.Grantee,
+				},
+			})
+		} else if typedEvent, ok := event.(*event_usecase.MsgRevoke); ok {
+			accountMessages = append(accountMessages, view.AccountMessageRecord{
+				Row: view.AccountMessageRow{
+					BlockHeight:     height,
+					BlockHash:       "",
+					BlockTime:       utctime.UTCTime{},
+					TransactionHash: typedEvent.TxHash(),
+					Success:         typedEvent.TxSuccess(),
+					MessageIndex:    typedEvent.MsgIndex,
+					MessageType:     typedEvent.MsgType(),
+					Data:            typedEvent,
+				},
+				Accounts: []string{
+					typedEvent.Params.Granter,
+					typedEvent.Params.Grantee,
+				},
+			})
+		} else if typedEvent, ok := event.(*event_usecase.MsgExec); ok {
+			accountMessages = append(accountMessages, view.AccountMessageRecord{
+				Row: view.AccountMessageRow{
+					BlockHeight:     height,
+					BlockHash:       "",
+					BlockTime:       utctime.UTCTime{},
+					TransactionHash: typedEvent.TxHash(),
+					Success:         typedEvent.TxSuccess(),
+					MessageIndex:    typedEvent.MsgIndex,
+					MessageType:     typedEvent.MsgType(),
+					Data:            typedEvent,
+				},
+				Accounts: []string{
+					typedEvent.Params.Grantee,
+				},
+			})
+		} else if typedEvent, ok := event.(*event_usecase.MsgGrantAllowance); ok {
+			accountMessages = append(accountMessages, view.AccountMessageRecord{
+				Row: view.AccountMessageRow{
+					BlockHeight:     height,
+					BlockHash:       "",
+					BlockTime:       utctime.UTCTime{},
+					TransactionHash: typedEvent.TxHash(),
+					Success:         typedEvent.TxSuccess(),
+					MessageIndex:    typedEvent.MsgIndex,
+					MessageType:     typedEvent.MsgType(),
+					Data:            typedEvent,
+				},
+				Accounts: []string{
+					typedEvent.Params.Granter,
+					typedEvent.Params.Grantee,
+				},
+			})
+		} else if typedEvent, ok := event.(*event_usecase.MsgRevokeAllowance); ok {
+			accountMessages = append(accountMessages, view.AccountMessageRecord{
+				Row: view.AccountMessageRow{
+					BlockHeight:     height,
+					BlockHash:       "",
+					BlockTime:       utctime.UTCTime{},
+					TransactionHash: typedEvent.TxHash(),
+					Success:         typedEvent.TxSuccess(),
+					MessageIndex:    typedEvent.MsgIndex,
+					MessageType:     typedEvent.MsgType(),
+					Data:            typedEvent,
+				},
+				Accounts: []string{
+					typedEvent.Params.Granter,
+					typedEvent.Params.Grantee,
+				},
+			})
+		} else if typedEvent, ok := event.(*event_usecase.MsgEthereumTx); ok {
+			accounts := make([]string, 0, 2)
+			if typedEvent.Params.FromAccount != "" {
+				accounts = append(accounts, typedEvent.Params.FromAccount)
 			}
-			if err := accountMessagesTotalView.Increment(
-				fmt.Sprintf("%s:%s", involvedAccount, accountMessage.Row.MessageType), 1,
-			); err != nil {
-				return fmt.Errorf("error incremnting total account message of account: %w", err)
+			if typedEvent.Params.MaybeToAccount != nil {
+				accounts = append(accounts, *typedEvent.Params.MaybeToAccount)
 			}
-			deduplicatedAccounts = append(deduplicatedAccounts, involvedAccount)
-			insertedAccounts[involvedAccount] = true
-		}
-
-		if err := accountMessagesView.Insert(&accountMessages[i].Row, deduplicatedAccounts); err != nil {
-			return fmt.Errorf("error inserting account message: %w", err)
+			accountMessages = append(accountMessages, view.AccountMessageRecord{
+				Row: view.AccountMessageRow{
+					BlockHeight:     height,
+					BlockHash:       "",
+					BlockTime:       utctime.UTCTime{},
+					TransactionHash: typedEvent.TxHash(),
+					Success:         typedEvent.TxSuccess(),
+					MessageIndex:    typedEvent.MsgIndex,
+					MessageType:     typedEvent.MsgType(),
+					Data:            typedEvent,
+				},
+				Accounts: accounts,
+			})
+		} else if typedEvent, ok := event.(*event_usecase.MsgUnknown); ok {
+			accountMessages = append(accountMessages, view.AccountMessageRecord{
+				Row: view.AccountMessageRow{
+					BlockHeight:     height,
+					BlockHash:       "",
+					BlockTime:       utctime.UTCTime{},
+					TransactionHash: typedEvent.TxHash(),
+					Success:         typedEvent.TxSuccess(),
+					MessageIndex:    typedEvent.MsgIndex,
+					MessageType:     typedEvent.MsgType(),
+					Data:            typedEvent,
+				},
+				Accounts: typedEvent.Params.Signers,
+			})
 		}
 	}
 
-	if err := projection.UpdateLastHandledEventHeight(rdbTxHandle, height); err != nil {
-		return fmt.Errorf("error updating last handled event height: %v", err)
+	for i := range accountMessages {
+		accountMessages[i].Row.BlockHash = blockHash
+		accountMessages[i].Row.BlockTime = blockTime
 	}
 
-	if err := rdbTx.Commit(); err != nil {
-		return fmt.Errorf("error committing changes: %v", err)
-	}
-	committed = true
-	return nil
+	return accountMessages
 }
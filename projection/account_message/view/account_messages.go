@@ -108,8 +108,15 @@ func (accountMessagesView *AccountMessages) List(
 		}
 	}
 
+	// Rows are inserted block by block, id is increasing with block height
+	cursorKey := rdb.RDbPaginationCursorKey{
+		BlockHeightColumn: "view_account_messages.block_height",
+		IDColumn:          "view_account_messages.id",
+		OrderByIDOnly:     true,
+	}
 	if order.Id == view.ORDER_DESC {
 		stmtBuilder = stmtBuilder.OrderBy("id DESC")
+		cursorKey.IDDesc = true
 	} else {
 		stmtBuilder = stmtBuilder.OrderBy("id")
 	}
@@ -117,6 +124,8 @@ func (accountMessagesView *AccountMessages) List(
 	rDbPagination := rdb.NewRDbPaginationBuilder(
 		pagination,
 		accountMessagesView.rdb,
+	).WithCursorKey(
+		cursorKey,
 	).WithCustomTotalQueryFn(
 		func(rdbHandle *rdb.Handle, _ sq.SelectBuilder) (int64, error) {
 			totalView := NewAccountMessagesTotal(rdbHandle)
@@ -141,12 +150,12 @@ func (accountMessagesView *AccountMessages) List(
 	defer rowsResult.Close()
 
 	accountMessages := make([]AccountMessageRow, 0)
-	for rowsResult.Next() {
+	for rowsResult.Next() && rDbPagination.NextRow() {
 		var accountMessage AccountMessageRow
 		var accountMessageDataJSON *string
 		blockTimeReader := accountMessagesView.rdb.NtotReader()
 
-		if err = rowsResult.Scan(
+		if err = rowsResult.Scan(rDbPagination.ScanArgs(
 			&accountMessage.MaybeAccount,
 			&accountMessage.BlockHeight,
 			&accountMessage.BlockHash,
@@ -156,7 +165,7 @@ func (accountMessagesView *AccountMessages) List(
 			&accountMessage.MessageIndex,
 			&accountMessage.MessageType,
 			&accountMessageDataJSON,
-		); err != nil {
+		)...); err != nil {
 			if errors.Is(err, rdb.ErrNoRows) {
 				return nil, nil, rdb.ErrNoRows
 			}
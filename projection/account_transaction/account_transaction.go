@@ -17,6 +17,7 @@ import (
 )
 
 var _ projection_entity.Projection = &AccountTransaction{}
+var _ rdbprojectionbase.Rebuildable = &AccountTransaction{}
 
 type AccountTransaction struct {
 	*rdbprojectionbase.Base
@@ -46,6 +47,14 @@ func (_ *AccountTransaction) GetEventsToListen() []string {
 	}, event_usecase.MSG_EVENTS...)
 }
 
+func (_ *AccountTransaction) GetViewTables() []string {
+	return []string{
+		"view_account_transactions",
+		"view_account_transaction_data",
+		"view_account_transactions_total",
+	}
+}
+
 func (projection *AccountTransaction) OnInit() error {
 	return nil
 }
@@ -128,6 +137,10 @@ func (projection *AccountTransaction) HandleEvents(height int64, events []event_
 			for _, sender := range senders {
 				transactionInfos[transactionCreatedEvent.TxHash].AddAccount(sender)
 			}
+			// Fee granter pays the fee of the transaction
+			if transactionCreatedEvent.FeeGranter != "" {
+				transactionInfos[transactionCreatedEvent.TxHash].AddAccount(transactionCreatedEvent.FeeGranter)
+			}
 		} else if transactionFailedEvent, ok := event.(*event_usecase.TransactionFailed); ok {
 			row := view.TransactionRow{
 				BlockHeight:   height,
@@ -163,6 +176,10 @@ func (projection *AccountTransaction) HandleEvents(height int64, events []event_
 			for _, sender := range senders {
 				transactionInfos[transactionFailedEvent.TxHash].AddAccount(sender)
 			}
+			// Fee granter pays the fee of the transaction
+			if transactionFailedEvent.FeeGranter != "" {
+				transactionInfos[transactionFailedEvent.TxHash].AddAccount(transactionFailedEvent.FeeGranter)
+			}
 		} else if msgEvent, ok := event.(event_usecase.MsgEvent); ok {
 			if _, exist := txMsgs[msgEvent.TxHash()]; !exist {
 				txMsgs[msgEvent.TxHash()] = make([]event_usecase.MsgEvent, 0)
@@ -341,6 +358,26 @@ func (projection *AccountTransaction) HandleEvents(height int64, events []event_
 		} else if typedEvent, ok := event.(*event_usecase.MsgIBCTimeoutOnClose); ok {
 			transactionInfos[typedEvent.TxHash()].AddAccount(typedEvent.Params.Signer)
 
+		} else if typedEvent, ok := event.(*event_usecase.MsgIBCChannelCloseInit); ok {
+			transactionInfos[typedEvent.TxHash()].AddAccount(typedEvent.Params.Signer)
+
+		} else if typedEvent, ok := event.(*event_usecase.MsgIBCChannelCloseConfirm); ok {
+			transactionInfos[typedEvent.TxHash()].AddAccount(typedEvent.Params.Signer)
+
+		} else if typedEvent, ok := event.(*event_usecase.MsgIBCSubmitMisbehaviour); ok {
+			transactionInfos[typedEvent.TxHash()].AddAccount(typedEvent.Params.Signer)
+
+		} else if typedEvent, ok := event.(*event_usecase.MsgIBCUpgradeClient); ok {
+			transactionInfos[typedEvent.TxHash()].AddAccount(typedEvent.Params.Signer)
+
+		} else if typedEvent, ok := event.(*event_usecase.MsgEthereumTx); ok {
+			if typedEvent.Params.FromAccount != "" {
+				transactionInfos[typedEvent.TxHash()].AddAccount(typedEvent.Params.FromAccount)
+			}
+			if typedEvent.Params.MaybeToAccount != nil {
+				transactionInfos[typedEvent.TxHash()].AddAccount(*typedEvent.Params.MaybeToAccount)
+			}
+
 		}
 	}
 
@@ -126,8 +126,15 @@ func (accountMessagesView *AccountTransactions) List(
 		)
 	}
 
+	// Rows are inserted block by block, id is increasing with block height
+	cursorKey := rdb.RDbPaginationCursorKey{
+		BlockHeightColumn: "view_account_transactions.block_height",
+		IDColumn:          "view_account_transactions.id",
+		OrderByIDOnly:     true,
+	}
 	if order.Id == view.ORDER_DESC {
 		stmtBuilder = stmtBuilder.OrderBy("view_account_transactions.id DESC")
+		cursorKey.IDDesc = true
 	} else {
 		stmtBuilder = stmtBuilder.OrderBy("view_account_transactions.id")
 	}
@@ -135,6 +142,8 @@ func (accountMessagesView *AccountTransactions) List(
 	rDbPagination := rdb.NewRDbPaginationBuilder(
 		pagination,
 		accountMessagesView.rdb,
+	).WithCursorKey(
+		cursorKey,
 	).WithCustomTotalQueryFn(
 		func(rdbHandle *rdb.Handle, _ sq.SelectBuilder) (int64, error) {
 			totalView := NewAccountTransactionsTotal(rdbHandle)
@@ -167,14 +176,14 @@ func (accountMessagesView *AccountTransactions) List(
 	defer rowsResult.Close()
 
 	accountMessages := make([]AccountTransactionReadRow, 0)
-	for rowsResult.Next() {
+	for rowsResult.Next() && rDbPagination.NextRow() {
 		var accountMessage AccountTransactionReadRow
 		var feeJSON *string
 		var messagesJSON *string
 		var messageTypesJSON *string
 		blockTimeReader := accountMessagesView.rdb.NtotReader()
 
-		if err = rowsResult.Scan(
+		if err = rowsResult.Scan(rDbPagination.ScanArgs(
 			&accountMessage.Account,
 			&accountMessage.BlockHeight,
 			&accountMessage.BlockHash,
@@ -193,7 +202,7 @@ func (accountMessagesView *AccountTransactions) List(
 			&accountMessage.TimeoutHeight,
 			&messageTypesJSON,
 			&messagesJSON,
-		); err != nil {
+		)...); err != nil {
 			if errors.Is(err, rdb.ErrNoRows) {
 				return nil, nil, rdb.ErrNoRows
 			}
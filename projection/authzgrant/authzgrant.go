@@ -0,0 +1,113 @@
+package authzgrant
+
+import (
+	"fmt"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/rdbprojectionbase"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	event_entity "github.com/crypto-com/chain-indexing/entity/event"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/projection/authzgrant/view"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+)
+
+var _ rdbprojectionbase.Rebuildable = &AuthzGrant{}
+
+// AuthzGrant projects the authz grants which are not yet revoked. Grants removed by the chain on
+// expiration or on exhausting the spend limit are not tracked and remain until revoked.
+type AuthzGrant struct {
+	*rdbprojectionbase.Base
+
+	rdbConn rdb.Conn
+	logger  applogger.Logger
+}
+
+func NewAuthzGrant(logger applogger.Logger, rdbConn rdb.Conn) *AuthzGrant {
+	return &AuthzGrant{
+		rdbprojectionbase.NewRDbBase(rdbConn.ToHandle(), "AuthzGrant"),
+
+		rdbConn,
+		logger,
+	}
+}
+
+func (_ *AuthzGrant) GetEventsToListen() []string {
+	return []string{
+		event_usecase.BLOCK_CREATED,
+		event_usecase.MSG_GRANT_CREATED,
+		event_usecase.MSG_REVOKE_CREATED,
+	}
+}
+
+func (_ *AuthzGrant) GetViewTables() []string {
+	return []string{
+		view.AUTHZ_GRANTS_TABLE,
+	}
+}
+
+func (projection *AuthzGrant) OnInit() error {
+	return nil
+}
+
+func (projection *AuthzGrant) HandleEvents(height int64, events []event_entity.Event) error {
+	var err error
+
+	rdbTx, err := projection.rdbConn.Begin()
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %v", err)
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			_ = rdbTx.Rollback()
+		}
+	}()
+
+	rdbTxHandle := rdbTx.ToHandle()
+	grantsView := view.NewAuthzGrants(rdbTxHandle)
+
+	var blockTime utctime.UTCTime
+	for _, event := range events {
+		if blockCreatedEvent, ok := event.(*event_usecase.BlockCreated); ok {
+			blockTime = blockCreatedEvent.Block.Time
+		}
+	}
+
+	for _, event := range events {
+		if msgGrantEvent, ok := event.(*event_usecase.MsgGrant); ok {
+			grant := msgGrantEvent.Params.Grant
+			if err = grantsView.Upsert(&view.AuthzGrantRow{
+				Granter:                msgGrantEvent.Params.Granter,
+				Grantee:                msgGrantEvent.Params.Grantee,
+				MsgTypeURL:             grant.MsgTypeURL,
+				AuthorizationType:      grant.AuthorizationType,
+				SpendLimit:             grant.SpendLimit,
+				MaybeMaxTokens:         grant.MaybeMaxTokens,
+				MaybeExpiration:        grant.MaybeExpiration,
+				GrantedBlockHeight:     height,
+				GrantedBlockTime:       blockTime,
+				GrantedTransactionHash: msgGrantEvent.TxHash(),
+			}); err != nil {
+				return fmt.Errorf("error upserting authz grant: %v", err)
+			}
+		} else if msgRevokeEvent, ok := event.(*event_usecase.MsgRevoke); ok {
+			if err = grantsView.Delete(
+				msgRevokeEvent.Params.Granter, msgRevokeEvent.Params.Grantee, msgRevokeEvent.Params.MsgTypeURL,
+			); err != nil {
+				return fmt.Errorf("error deleting authz grant: %v", err)
+			}
+		}
+	}
+
+	if err = projection.UpdateLastHandledEventHeight(rdbTxHandle, height); err != nil {
+		return fmt.Errorf("error updating last handled event height: %v", err)
+	}
+
+	if err = rdbTx.Commit(); err != nil {
+		return fmt.Errorf("error committing changes: %v", err)
+	}
+	committed = true
+	return nil
+}
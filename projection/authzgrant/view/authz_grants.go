@@ -0,0 +1,219 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+
+	pagination_interface "github.com/crypto-com/chain-indexing/appinterface/pagination"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/internal/json"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+)
+
+const AUTHZ_GRANTS_TABLE = "view_authz_grants"
+
+// AuthzGrants projection view of authz grants which are not yet revoked
+type AuthzGrants struct {
+	rdb *rdb.Handle
+}
+
+func NewAuthzGrants(handle *rdb.Handle) *AuthzGrants {
+	return &AuthzGrants{
+		handle,
+	}
+}
+
+// Upsert inserts the grant or replaces the existing grant of the same granter, grantee and message
+// type
+func (grantsView *AuthzGrants) Upsert(grant *AuthzGrantRow) error {
+	var maybeMaxTokens *string
+	if grant.MaybeMaxTokens != nil {
+		maxTokens := json.MustMarshalToString(grant.MaybeMaxTokens)
+		maybeMaxTokens = &maxTokens
+	}
+	spendLimit := grant.SpendLimit
+	if spendLimit == nil {
+		spendLimit = coin.NewEmptyCoins()
+	}
+
+	sql, sqlArgs, err := grantsView.rdb.StmtBuilder.Insert(
+		AUTHZ_GRANTS_TABLE,
+	).Columns(
+		"granter",
+		"grantee",
+		"msg_type_url",
+		"authorization_type",
+		"spend_limit",
+		"max_tokens",
+		"expiration",
+		"granted_block_height",
+		"granted_block_time",
+		"granted_transaction_hash",
+	).Values(
+		grant.Granter,
+		grant.Grantee,
+		grant.MsgTypeURL,
+		grant.AuthorizationType,
+		json.MustMarshalToString(spendLimit),
+		maybeMaxTokens,
+		grantsView.rdb.Tton(grant.MaybeExpiration),
+		grant.GrantedBlockHeight,
+		grantsView.rdb.Tton(&grant.GrantedBlockTime),
+		grant.GrantedTransactionHash,
+	).Suffix(
+		"ON CONFLICT(granter, grantee, msg_type_url) DO UPDATE SET " +
+			"authorization_type = EXCLUDED.authorization_type, " +
+			"spend_limit = EXCLUDED.spend_limit, " +
+			"max_tokens = EXCLUDED.max_tokens, " +
+			"expiration = EXCLUDED.expiration, " +
+			"granted_block_height = EXCLUDED.granted_block_height, " +
+			"granted_block_time = EXCLUDED.granted_block_time, " +
+			"granted_transaction_hash = EXCLUDED.granted_transaction_hash",
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building authz grant upsert sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	result, err := grantsView.rdb.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error upserting authz grant into the table: %v: %w", err, rdb.ErrWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return fmt.Errorf("error upserting authz grant into the table: no rows upserted: %w", rdb.ErrWrite)
+	}
+
+	return nil
+}
+
+// Delete removes the grant of granter to grantee on the message type. It is a no-op when no such
+// grant exists
+func (grantsView *AuthzGrants) Delete(granter string, grantee string, msgTypeURL string) error {
+	sql, sqlArgs, err := grantsView.rdb.StmtBuilder.Delete(
+		AUTHZ_GRANTS_TABLE,
+	).Where(
+		"granter = ? AND grantee = ? AND msg_type_url = ?", granter, grantee, msgTypeURL,
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building authz grant deletion sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	if _, err = grantsView.rdb.Exec(sql, sqlArgs...); err != nil {
+		return fmt.Errorf("error deleting authz grant from the table: %v: %w", err, rdb.ErrWrite)
+	}
+
+	return nil
+}
+
+func (grantsView *AuthzGrants) List(
+	filter AuthzGrantsListFilter,
+	pagination *pagination_interface.Pagination,
+) ([]AuthzGrantRow, *pagination_interface.PaginationResult, error) {
+	stmtBuilder := grantsView.rdb.StmtBuilder.Select(
+		"granter",
+		"grantee",
+		"msg_type_url",
+		"authorization_type",
+		"spend_limit",
+		"max_tokens",
+		"expiration",
+		"granted_block_height",
+		"granted_block_time",
+		"granted_transaction_hash",
+	).From(
+		AUTHZ_GRANTS_TABLE,
+	)
+
+	if filter.MaybeGranter != nil {
+		stmtBuilder = stmtBuilder.Where("granter = ?", *filter.MaybeGranter)
+	}
+	if filter.MaybeGrantee != nil {
+		stmtBuilder = stmtBuilder.Where("grantee = ?", *filter.MaybeGrantee)
+	}
+	stmtBuilder = stmtBuilder.OrderBy("granted_block_height DESC, granter, grantee, msg_type_url")
+
+	rDbPagination := rdb.NewRDbPaginationBuilder(
+		pagination,
+		grantsView.rdb,
+	).BuildStmt(stmtBuilder)
+	sql, sqlArgs, err := rDbPagination.ToStmtBuilder().ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error building authz grants select SQL: %v, %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	rowsResult, err := grantsView.rdb.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error executing authz grants select SQL: %v: %w", err, rdb.ErrQuery)
+	}
+	defer rowsResult.Close()
+
+	grants := make([]AuthzGrantRow, 0)
+	for rowsResult.Next() {
+		var grant AuthzGrantRow
+		var spendLimit string
+		var maybeMaxTokens *string
+		expirationReader := grantsView.rdb.NtotReader()
+		grantedBlockTimeReader := grantsView.rdb.NtotReader()
+
+		if err = rowsResult.Scan(
+			&grant.Granter,
+			&grant.Grantee,
+			&grant.MsgTypeURL,
+			&grant.AuthorizationType,
+			&spendLimit,
+			&maybeMaxTokens,
+			expirationReader.ScannableArg(),
+			&grant.GrantedBlockHeight,
+			grantedBlockTimeReader.ScannableArg(),
+			&grant.GrantedTransactionHash,
+		); err != nil {
+			if errors.Is(err, rdb.ErrNoRows) {
+				return nil, nil, rdb.ErrNoRows
+			}
+			return nil, nil, fmt.Errorf("error scanning authz grant row: %v: %w", err, rdb.ErrQuery)
+		}
+
+		json.MustUnmarshalFromString(spendLimit, &grant.SpendLimit)
+		if maybeMaxTokens != nil {
+			var maxTokens coin.Coin
+			json.MustUnmarshalFromString(*maybeMaxTokens, &maxTokens)
+			grant.MaybeMaxTokens = &maxTokens
+		}
+		grant.MaybeExpiration, err = expirationReader.Parse()
+		if err != nil {
+			return nil, nil, fmt.Errorf("error parsing authz grant expiration: %v: %w", err, rdb.ErrQuery)
+		}
+		grantedBlockTime, err := grantedBlockTimeReader.Parse()
+		if err != nil {
+			return nil, nil, fmt.Errorf("error parsing authz grant granted block time: %v: %w", err, rdb.ErrQuery)
+		}
+		grant.GrantedBlockTime = *grantedBlockTime
+
+		grants = append(grants, grant)
+	}
+
+	paginationResult, err := rDbPagination.Result()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error preparing pagination result: %v", err)
+	}
+
+	return grants, paginationResult, nil
+}
+
+type AuthzGrantsListFilter struct {
+	MaybeGranter *string
+	MaybeGrantee *string
+}
+
+type AuthzGrantRow struct {
+	Granter                string           `json:"granter"`
+	Grantee                string           `json:"grantee"`
+	MsgTypeURL             string           `json:"msgTypeUrl"`
+	AuthorizationType      string           `json:"authorizationType"`
+	SpendLimit             coin.Coins       `json:"spendLimit"`
+	MaybeMaxTokens         *coin.Coin       `json:"maxTokens"`
+	MaybeExpiration        *utctime.UTCTime `json:"expiration"`
+	GrantedBlockHeight     int64            `json:"grantedBlockHeight"`
+	GrantedBlockTime       utctime.UTCTime  `json:"grantedBlockTime"`
+	GrantedTransactionHash string           `json:"grantedTransactionHash"`
+}
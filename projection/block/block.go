@@ -13,6 +13,8 @@ import (
 )
 
 var _ entity_projection.Projection = &Block{}
+var _ rdbprojectionbase.Rebuildable = &Block{}
+var _ entity_projection.BatchProjection = &Block{}
 
 // TODO: Listen to council node related events and project council node
 type Block struct {
@@ -35,11 +37,28 @@ func (_ *Block) GetEventsToListen() []string {
 	return []string{event_usecase.BLOCK_CREATED}
 }
 
+func (_ *Block) GetViewTables() []string {
+	return []string{
+		"view_blocks",
+	}
+}
+
 func (projection *Block) OnInit() error {
 	return nil
 }
 
 func (projection *Block) HandleEvents(height int64, events []event_entity.Event) error {
+	return projection.HandleEventsBatch([]entity_projection.EventsAtHeight{{
+		Height: height,
+		Events: events,
+	}})
+}
+
+func (projection *Block) HandleEventsBatch(batch []entity_projection.EventsAtHeight) error {
+	if len(batch) == 0 {
+		return nil
+	}
+
 	rdbTx, err := projection.rdbConn.Begin()
 	if err != nil {
 		return fmt.Errorf("error beginning transaction: %v", err)
@@ -55,16 +74,18 @@ func (projection *Block) HandleEvents(height int64, events []event_entity.Event)
 	rdbTxHandle := rdbTx.ToHandle()
 	blocksView := view.NewBlocks(rdbTxHandle)
 
-	for _, event := range events {
-		if blockCreatedEvent, ok := event.(*event_usecase.BlockCreated); ok {
-			if handleErr := projection.handleBlockCreatedEvent(blocksView, blockCreatedEvent); handleErr != nil {
-				return fmt.Errorf("error handling BlockCreatedEvent: %v", handleErr)
+	for _, eventsAtHeight := range batch {
+		for _, event := range eventsAtHeight.Events {
+			if blockCreatedEvent, ok := event.(*event_usecase.BlockCreated); ok {
+				if handleErr := projection.handleBlockCreatedEvent(blocksView, blockCreatedEvent); handleErr != nil {
+					return fmt.Errorf("error handling BlockCreatedEvent: %v", handleErr)
+				}
+			} else {
+				return fmt.Errorf("received unexpected event %sV%d(%s)", event.Name(), event.Version(), event.UUID())
 			}
-		} else {
-			return fmt.Errorf("received unexpected event %sV%d(%s)", event.Name(), event.Version(), event.UUID())
 		}
 	}
-	if err = projection.UpdateLastHandledEventHeight(rdbTxHandle, height); err != nil {
+	if err = projection.UpdateLastHandledEventHeight(rdbTxHandle, batch[len(batch)-1].Height); err != nil {
 		return fmt.Errorf("error updating last handled event height: %v", err)
 	}
 
@@ -1,6 +1,8 @@
 package block_test
 
 import (
+	"fmt"
+
 	. "github.com/crypto-com/chain-indexing/appinterface/rdb/test"
 	. "github.com/crypto-com/chain-indexing/entity/event/test"
 	. "github.com/crypto-com/chain-indexing/internal/logger/test"
@@ -116,6 +118,35 @@ var _ = Describe("Block", func() {
 			Expect(projection.GetLastHandledEventHeight()).To(Equal(primptr.Int64(anyHeight)))
 		})
 
+		It("should project all blocks in the batch and update last handled event height to the last height", func() {
+			blocksView := view2.NewBlocks(pgConn.ToHandle())
+
+			newBlockCreated := func(height int64) event_entity.Event {
+				return event_usecase.NewBlockCreated(&usecase_model.Block{
+					Height:          height,
+					Hash:            fmt.Sprintf("%064d", height),
+					Time:            utctime.FromUnixNano(height * 1000000),
+					AppHash:         "24474D86CBFA7E6328D473C17A9E46CD5A80FFE82A348A74844BF3E2BA2B3AF1",
+					ProposerAddress: "F9E6FFB9B536956201AA138224FD888D03775AB4",
+					Txs:             []string{},
+					Signatures:      []usecase_model.BlockSignature{},
+				})
+			}
+
+			fakeLogger := NewFakeLogger()
+			projection := block.NewBlock(fakeLogger, pgConn)
+
+			err := projection.HandleEventsBatch([]entity_projection.EventsAtHeight{
+				{Height: 1, Events: []event_entity.Event{newBlockCreated(1)}},
+				{Height: 2, Events: []event_entity.Event{newBlockCreated(2)}},
+				{Height: 3, Events: []event_entity.Event{}},
+			})
+			Expect(err).To(BeNil())
+
+			Expect(blocksView.Count()).To(Equal(int64(2)))
+			Expect(projection.GetLastHandledEventHeight()).To(Equal(primptr.Int64(3)))
+		})
+
 		It("should not persist projection nor last handled event height on handling error", func() {
 			blocksView := view2.NewBlocks(pgConn.ToHandle())
 
@@ -13,6 +13,8 @@ import (
 	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
 )
 
+var _ rdbprojectionbase.Rebuildable = &BlockEvent{}
+
 type BlockEvent struct {
 	*rdbprojectionbase.Base
 
@@ -44,6 +46,13 @@ func (_ *BlockEvent) GetEventsToListen() []string {
 	}
 }
 
+func (_ *BlockEvent) GetViewTables() []string {
+	return []string{
+		"view_block_events",
+		"view_block_events_total",
+	}
+}
+
 func (projection *BlockEvent) OnInit() error {
 	return nil
 }
@@ -190,8 +190,14 @@ func (eventsView *BlockEvents) List(
 		"view_block_events",
 	)
 
+	cursorKey := rdb.RDbPaginationCursorKey{
+		BlockHeightColumn: "block_height",
+		IDColumn:          "id",
+	}
 	if order.Height == view.ORDER_DESC {
 		stmtBuilder = stmtBuilder.OrderBy("block_height DESC, id DESC")
+		cursorKey.BlockHeightDesc = true
+		cursorKey.IDDesc = true
 	} else {
 		stmtBuilder = stmtBuilder.OrderBy("block_height, id")
 	}
@@ -203,6 +209,8 @@ func (eventsView *BlockEvents) List(
 	rDbPagination := rdb.NewRDbPaginationBuilder(
 		pagination,
 		eventsView.rdb,
+	).WithCursorKey(
+		cursorKey,
 	).WithCustomTotalQueryFn(
 		func(rdbHandle *rdb.Handle, _ sq.SelectBuilder) (int64, error) {
 			identity := "-"
@@ -229,18 +237,18 @@ func (eventsView *BlockEvents) List(
 	defer rowsResult.Close()
 
 	blockEvents := make([]BlockEventRow, 0)
-	for rowsResult.Next() {
+	for rowsResult.Next() && rDbPagination.NextRow() {
 		var blockEvent BlockEventRow
 		var blockEventDataJSON *string
 		blockTimeReader := eventsView.rdb.NtotReader()
 
-		if err = rowsResult.Scan(
+		if err = rowsResult.Scan(rDbPagination.ScanArgs(
 			&blockEvent.MaybeId,
 			&blockEvent.BlockHeight,
 			&blockEvent.BlockHash,
 			blockTimeReader.ScannableArg(),
 			&blockEventDataJSON,
-		); err != nil {
+		)...); err != nil {
 			if errors.Is(err, rdb.ErrNoRows) {
 				return nil, nil, rdb.ErrNoRows
 			}
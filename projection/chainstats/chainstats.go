@@ -24,6 +24,8 @@ const GENESIS_BLOCK_TIME = "genesis_block_time"
 const TOTAL_BLOCK_TIME = "total_block_time"
 const TOTAL_BLOCK_COUNT = "total_block_count"
 
+var _ rdbprojectionbase.Rebuildable = &ChainStats{}
+
 type ChainStats struct {
 	*rdbprojectionbase.Base
 
@@ -53,6 +55,12 @@ func (_ *ChainStats) GetEventsToListen() []string {
 	}
 }
 
+func (_ *ChainStats) GetViewTables() []string {
+	return []string{
+		"view_chain_stats",
+	}
+}
+
 func (projection *ChainStats) OnInit() error {
 	chainStatsView := view.NewChainStats(projection.rdbConn.ToHandle())
 
@@ -0,0 +1,125 @@
+package ethereum_transaction
+
+import (
+	"fmt"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/rdbprojectionbase"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	event_entity "github.com/crypto-com/chain-indexing/entity/event"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/projection/ethereum_transaction/view"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+)
+
+var _ rdbprojectionbase.Rebuildable = &EthereumTransaction{}
+
+// EthereumTransaction projects the Ethereum transactions carried by Ethermint MsgEthereumTx, mapping
+// the Ethereum transaction hash to the Cosmos transaction hash together with the EVM logs. Messages
+// of failed transactions are projected as well.
+type EthereumTransaction struct {
+	*rdbprojectionbase.Base
+
+	rdbConn rdb.Conn
+	logger  applogger.Logger
+}
+
+func NewEthereumTransaction(logger applogger.Logger, rdbConn rdb.Conn) *EthereumTransaction {
+	return &EthereumTransaction{
+		rdbprojectionbase.NewRDbBase(rdbConn.ToHandle(), "EthereumTransaction"),
+
+		rdbConn,
+		logger,
+	}
+}
+
+func (_ *EthereumTransaction) GetEventsToListen() []string {
+	return []string{
+		event_usecase.BLOCK_CREATED,
+		event_usecase.MSG_ETHEREUM_TX_CREATED,
+		event_usecase.MSG_ETHEREUM_TX_FAILED,
+	}
+}
+
+func (_ *EthereumTransaction) GetViewTables() []string {
+	return []string{
+		view.ETHEREUM_TRANSACTIONS_TABLE,
+	}
+}
+
+func (projection *EthereumTransaction) OnInit() error {
+	return nil
+}
+
+func (projection *EthereumTransaction) HandleEvents(height int64, events []event_entity.Event) error {
+	var err error
+
+	rdbTx, err := projection.rdbConn.Begin()
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %v", err)
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			_ = rdbTx.Rollback()
+		}
+	}()
+
+	rdbTxHandle := rdbTx.ToHandle()
+	transactionsView := view.NewEthereumTransactions(rdbTxHandle)
+
+	var blockTime utctime.UTCTime
+	for _, event := range events {
+		if blockCreatedEvent, ok := event.(*event_usecase.BlockCreated); ok {
+			blockTime = blockCreatedEvent.Block.Time
+		}
+	}
+
+	for _, event := range events {
+		typedEvent, ok := event.(*event_usecase.MsgEthereumTx)
+		if !ok {
+			continue
+		}
+
+		params := typedEvent.Params
+		if err = transactionsView.Insert(&view.EthereumTransactionRow{
+			Hash:                 params.Hash,
+			TransactionHash:      typedEvent.MsgTxHash,
+			MsgIndex:             typedEvent.MsgIndex,
+			BlockHeight:          height,
+			BlockTime:            blockTime,
+			Success:              typedEvent.TxSuccess(),
+			TxType:               params.TxType,
+			From:                 params.From,
+			FromAccount:          params.FromAccount,
+			MaybeTo:              params.MaybeTo,
+			MaybeToAccount:       params.MaybeToAccount,
+			IsContractCreation:   params.IsContractCreation,
+			MaybeContractAddress: params.MaybeContractAddress,
+			MaybeChainID:         params.MaybeChainID,
+			Nonce:                params.Nonce,
+			Value:                params.Value,
+			Gas:                  params.Gas,
+			MaybeGasPrice:        params.MaybeGasPrice,
+			MaybeGasTipCap:       params.MaybeGasTipCap,
+			MaybeGasFeeCap:       params.MaybeGasFeeCap,
+			MaybeGasUsed:         params.MaybeGasUsed,
+			MaybeVMError:         params.MaybeVMError,
+			Data:                 params.Data,
+			Logs:                 params.Logs,
+		}); err != nil {
+			return fmt.Errorf("error inserting Ethereum transaction: %v", err)
+		}
+	}
+
+	if err = projection.UpdateLastHandledEventHeight(rdbTxHandle, height); err != nil {
+		return fmt.Errorf("error updating last handled event height: %v", err)
+	}
+
+	if err = rdbTx.Commit(); err != nil {
+		return fmt.Errorf("error committing changes: %v", err)
+	}
+	committed = true
+	return nil
+}
@@ -0,0 +1,280 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	pagination_interface "github.com/crypto-com/chain-indexing/appinterface/pagination"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/internal/json"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+)
+
+const ETHEREUM_TRANSACTIONS_TABLE = "view_ethereum_transactions"
+
+// EthereumTransactions projection view of the Ethereum transactions executed by the EVM, mapping
+// each of them to the Cosmos transaction and message carrying it
+type EthereumTransactions struct {
+	rdb *rdb.Handle
+}
+
+func NewEthereumTransactions(handle *rdb.Handle) *EthereumTransactions {
+	return &EthereumTransactions{
+		handle,
+	}
+}
+
+func (transactionsView *EthereumTransactions) Insert(transaction *EthereumTransactionRow) error {
+	sql, sqlArgs, err := transactionsView.rdb.StmtBuilder.Insert(
+		ETHEREUM_TRANSACTIONS_TABLE,
+	).Columns(
+		"hash",
+		"transaction_hash",
+		"msg_index",
+		"block_height",
+		"block_time",
+		"success",
+		"tx_type",
+		"from_address",
+		"from_account",
+		"to_address",
+		"to_account",
+		"is_contract_creation",
+		"contract_address",
+		"chain_id",
+		"nonce",
+		"value",
+		"gas",
+		"gas_price",
+		"gas_tip_cap",
+		"gas_fee_cap",
+		"gas_used",
+		"vm_error",
+		"data",
+		"logs",
+	).Values(
+		transaction.Hash,
+		transaction.TransactionHash,
+		transaction.MsgIndex,
+		transaction.BlockHeight,
+		transactionsView.rdb.Tton(&transaction.BlockTime),
+		transaction.Success,
+		transaction.TxType,
+		transaction.From,
+		transaction.FromAccount,
+		transaction.MaybeTo,
+		transaction.MaybeToAccount,
+		transaction.IsContractCreation,
+		transaction.MaybeContractAddress,
+		transaction.MaybeChainID,
+		transaction.Nonce,
+		transaction.Value,
+		transaction.Gas,
+		transaction.MaybeGasPrice,
+		transaction.MaybeGasTipCap,
+		transaction.MaybeGasFeeCap,
+		transaction.MaybeGasUsed,
+		transaction.MaybeVMError,
+		transaction.Data,
+		json.MustMarshalToString(transaction.Logs),
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building Ethereum transaction insertion sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	result, err := transactionsView.rdb.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error inserting Ethereum transaction into the table: %v: %w", err, rdb.ErrWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return fmt.Errorf("error inserting Ethereum transaction into the table: no rows inserted: %w", rdb.ErrWrite)
+	}
+
+	return nil
+}
+
+// FindByHash returns the Ethereum transaction of the hash. An Ethereum transaction failed in a
+// Cosmos transaction can be re-submitted with the same hash, the successful or otherwise the latest
+// one is returned.
+func (transactionsView *EthereumTransactions) FindByHash(hash string) (*EthereumTransactionRow, error) {
+	sql, sqlArgs, err := transactionsView.selectStmtBuilder().Where(
+		"hash = ?", hash,
+	).OrderBy("success DESC, id DESC").Limit(1).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building Ethereum transaction selection sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	transaction, err := transactionsView.scan(transactionsView.rdb.QueryRow(sql, sqlArgs...))
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+func (transactionsView *EthereumTransactions) List(
+	filter EthereumTransactionsListFilter,
+	pagination *pagination_interface.Pagination,
+) ([]EthereumTransactionRow, *pagination_interface.PaginationResult, error) {
+	stmtBuilder := transactionsView.selectStmtBuilder()
+	if filter.MaybeAddress != nil {
+		stmtBuilder = stmtBuilder.Where(sq.Or{
+			sq.Eq{"from_address": *filter.MaybeAddress},
+			sq.Eq{"to_address": *filter.MaybeAddress},
+			sq.Eq{"contract_address": *filter.MaybeAddress},
+		})
+	}
+	if filter.MaybeTransactionHash != nil {
+		stmtBuilder = stmtBuilder.Where("transaction_hash = ?", *filter.MaybeTransactionHash)
+	}
+	stmtBuilder = stmtBuilder.OrderBy("id DESC")
+
+	rDbPagination := rdb.NewRDbPaginationBuilder(
+		pagination,
+		transactionsView.rdb,
+	).BuildStmt(stmtBuilder)
+	sql, sqlArgs, err := rDbPagination.ToStmtBuilder().ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error building Ethereum transactions select SQL: %v, %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	rowsResult, err := transactionsView.rdb.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error executing Ethereum transactions select SQL: %v: %w", err, rdb.ErrQuery)
+	}
+	defer rowsResult.Close()
+
+	transactions := make([]EthereumTransactionRow, 0)
+	for rowsResult.Next() {
+		transaction, err := transactionsView.scan(rowsResult)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		transactions = append(transactions, *transaction)
+	}
+
+	paginationResult, err := rDbPagination.Result()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error preparing pagination result: %v", err)
+	}
+
+	return transactions, paginationResult, nil
+}
+
+func (transactionsView *EthereumTransactions) selectStmtBuilder() sq.SelectBuilder {
+	return transactionsView.rdb.StmtBuilder.Select(
+		"hash",
+		"transaction_hash",
+		"msg_index",
+		"block_height",
+		"block_time",
+		"success",
+		"tx_type",
+		"from_address",
+		"from_account",
+		"to_address",
+		"to_account",
+		"is_contract_creation",
+		"contract_address",
+		"chain_id",
+		"nonce",
+		"value",
+		"gas",
+		"gas_price",
+		"gas_tip_cap",
+		"gas_fee_cap",
+		"gas_used",
+		"vm_error",
+		"data",
+		"logs",
+	).From(
+		ETHEREUM_TRANSACTIONS_TABLE,
+	)
+}
+
+func (transactionsView *EthereumTransactions) scan(row rdb.RowResult) (*EthereumTransactionRow, error) {
+	var transaction EthereumTransactionRow
+	var logs string
+	blockTimeReader := transactionsView.rdb.NtotReader()
+
+	if err := row.Scan(
+		&transaction.Hash,
+		&transaction.TransactionHash,
+		&transaction.MsgIndex,
+		&transaction.BlockHeight,
+		blockTimeReader.ScannableArg(),
+		&transaction.Success,
+		&transaction.TxType,
+		&transaction.From,
+		&transaction.FromAccount,
+		&transaction.MaybeTo,
+		&transaction.MaybeToAccount,
+		&transaction.IsContractCreation,
+		&transaction.MaybeContractAddress,
+		&transaction.MaybeChainID,
+		&transaction.Nonce,
+		&transaction.Value,
+		&transaction.Gas,
+		&transaction.MaybeGasPrice,
+		&transaction.MaybeGasTipCap,
+		&transaction.MaybeGasFeeCap,
+		&transaction.MaybeGasUsed,
+		&transaction.MaybeVMError,
+		&transaction.Data,
+		&logs,
+	); err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			return nil, rdb.ErrNoRows
+		}
+		return nil, fmt.Errorf("error scanning Ethereum transaction row: %v: %w", err, rdb.ErrQuery)
+	}
+
+	blockTime, err := blockTimeReader.Parse()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing Ethereum transaction block time: %v: %w", err, rdb.ErrQuery)
+	}
+	transaction.BlockTime = *blockTime
+	json.MustUnmarshalFromString(logs, &transaction.Logs)
+
+	return &transaction, nil
+}
+
+type EthereumTransactionsListFilter struct {
+	// Checksummed hex address the transaction is sent from, sent to, or created
+	MaybeAddress *string
+	// Hash of the Cosmos transaction carrying the Ethereum transaction
+	MaybeTransactionHash *string
+}
+
+type EthereumTransactionRow struct {
+	// Ethereum transaction hash
+	Hash string `json:"hash"`
+	// Cosmos transaction hash
+	TransactionHash      string                `json:"transactionHash"`
+	MsgIndex             int                   `json:"msgIndex"`
+	BlockHeight          int64                 `json:"blockHeight"`
+	BlockTime            utctime.UTCTime       `json:"blockTime"`
+	Success              bool                  `json:"success"`
+	TxType               string                `json:"txType"`
+	From                 string                `json:"from"`
+	FromAccount          string                `json:"fromAccount"`
+	MaybeTo              *string               `json:"to"`
+	MaybeToAccount       *string               `json:"toAccount"`
+	IsContractCreation   bool                  `json:"isContractCreation"`
+	MaybeContractAddress *string               `json:"contractAddress"`
+	MaybeChainID         *string               `json:"chainId"`
+	Nonce                uint64                `json:"nonce"`
+	Value                string                `json:"value"`
+	Gas                  uint64                `json:"gas"`
+	MaybeGasPrice        *string               `json:"gasPrice"`
+	MaybeGasTipCap       *string               `json:"gasTipCap"`
+	MaybeGasFeeCap       *string               `json:"gasFeeCap"`
+	MaybeGasUsed         *uint64               `json:"gasUsed"`
+	MaybeVMError         *string               `json:"vmError"`
+	Data                 string                `json:"data"`
+	Logs                 []model.EthereumTxLog `json:"logs"`
+}
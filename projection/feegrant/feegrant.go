@@ -0,0 +1,114 @@
+package feegrant
+
+import (
+	"fmt"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/rdbprojectionbase"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	event_entity "github.com/crypto-com/chain-indexing/entity/event"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/projection/feegrant/view"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+)
+
+var _ rdbprojectionbase.Rebuildable = &FeeGrant{}
+
+// FeeGrant projects the fee allowances which are not yet revoked. Allowances removed by the chain on
+// expiration or on exhausting the spend limit are not tracked and remain until revoked.
+type FeeGrant struct {
+	*rdbprojectionbase.Base
+
+	rdbConn rdb.Conn
+	logger  applogger.Logger
+}
+
+func NewFeeGrant(logger applogger.Logger, rdbConn rdb.Conn) *FeeGrant {
+	return &FeeGrant{
+		rdbprojectionbase.NewRDbBase(rdbConn.ToHandle(), "FeeGrant"),
+
+		rdbConn,
+		logger,
+	}
+}
+
+func (_ *FeeGrant) GetEventsToListen() []string {
+	return []string{
+		event_usecase.BLOCK_CREATED,
+		event_usecase.MSG_GRANT_ALLOWANCE_CREATED,
+		event_usecase.MSG_REVOKE_ALLOWANCE_CREATED,
+	}
+}
+
+func (_ *FeeGrant) GetViewTables() []string {
+	return []string{
+		view.FEE_GRANTS_TABLE,
+	}
+}
+
+func (projection *FeeGrant) OnInit() error {
+	return nil
+}
+
+func (projection *FeeGrant) HandleEvents(height int64, events []event_entity.Event) error {
+	var err error
+
+	rdbTx, err := projection.rdbConn.Begin()
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %v", err)
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			_ = rdbTx.Rollback()
+		}
+	}()
+
+	rdbTxHandle := rdbTx.ToHandle()
+	grantsView := view.NewFeeGrants(rdbTxHandle)
+
+	var blockTime utctime.UTCTime
+	for _, event := range events {
+		if blockCreatedEvent, ok := event.(*event_usecase.BlockCreated); ok {
+			blockTime = blockCreatedEvent.Block.Time
+		}
+	}
+
+	for _, event := range events {
+		if msgGrantAllowanceEvent, ok := event.(*event_usecase.MsgGrantAllowance); ok {
+			allowance := msgGrantAllowanceEvent.Params.Allowance
+			if err = grantsView.Upsert(&view.FeeGrantRow{
+				Granter:                msgGrantAllowanceEvent.Params.Granter,
+				Grantee:                msgGrantAllowanceEvent.Params.Grantee,
+				AllowanceType:          allowance.AllowanceType,
+				SpendLimit:             allowance.SpendLimit,
+				MaybeExpiration:        allowance.MaybeExpiration,
+				MaybePeriod:            allowance.MaybePeriod,
+				PeriodSpendLimit:       allowance.PeriodSpendLimit,
+				AllowedMessages:        allowance.AllowedMessages,
+				GrantedBlockHeight:     height,
+				GrantedBlockTime:       blockTime,
+				GrantedTransactionHash: msgGrantAllowanceEvent.TxHash(),
+			}); err != nil {
+				return fmt.Errorf("error upserting fee grant: %v", err)
+			}
+		} else if msgRevokeAllowanceEvent, ok := event.(*event_usecase.MsgRevokeAllowance); ok {
+			if err = grantsView.Delete(
+				msgRevokeAllowanceEvent.Params.Granter, msgRevokeAllowanceEvent.Params.Grantee,
+			); err != nil {
+				return fmt.Errorf("error deleting fee grant: %v", err)
+			}
+		}
+	}
+
+	if err = projection.UpdateLastHandledEventHeight(rdbTxHandle, height); err != nil {
+		return fmt.Errorf("error updating last handled event height: %v", err)
+	}
+
+	if err = rdbTx.Commit(); err != nil {
+		return fmt.Errorf("error committing changes: %v", err)
+	}
+	committed = true
+	return nil
+}
@@ -0,0 +1,227 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+
+	pagination_interface "github.com/crypto-com/chain-indexing/appinterface/pagination"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/internal/json"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+)
+
+const FEE_GRANTS_TABLE = "view_fee_grants"
+
+// FeeGrants projection view of fee allowances which are not yet revoked
+type FeeGrants struct {
+	rdb *rdb.Handle
+}
+
+func NewFeeGrants(handle *rdb.Handle) *FeeGrants {
+	return &FeeGrants{
+		handle,
+	}
+}
+
+// Upsert inserts the fee allowance or replaces the existing allowance of the same granter and
+// grantee
+func (grantsView *FeeGrants) Upsert(grant *FeeGrantRow) error {
+	spendLimit := grant.SpendLimit
+	if spendLimit == nil {
+		spendLimit = coin.NewEmptyCoins()
+	}
+	periodSpendLimit := grant.PeriodSpendLimit
+	if periodSpendLimit == nil {
+		periodSpendLimit = coin.NewEmptyCoins()
+	}
+	allowedMessages := grant.AllowedMessages
+	if allowedMessages == nil {
+		allowedMessages = []string{}
+	}
+
+	sql, sqlArgs, err := grantsView.rdb.StmtBuilder.Insert(
+		FEE_GRANTS_TABLE,
+	).Columns(
+		"granter",
+		"grantee",
+		"allowance_type",
+		"spend_limit",
+		"expiration",
+		"period",
+		"period_spend_limit",
+		"allowed_messages",
+		"granted_block_height",
+		"granted_block_time",
+		"granted_transaction_hash",
+	).Values(
+		grant.Granter,
+		grant.Grantee,
+		grant.AllowanceType,
+		json.MustMarshalToString(spendLimit),
+		grantsView.rdb.Tton(grant.MaybeExpiration),
+		grant.MaybePeriod,
+		json.MustMarshalToString(periodSpendLimit),
+		json.MustMarshalToString(allowedMessages),
+		grant.GrantedBlockHeight,
+		grantsView.rdb.Tton(&grant.GrantedBlockTime),
+		grant.GrantedTransactionHash,
+	).Suffix(
+		"ON CONFLICT(granter, grantee) DO UPDATE SET " +
+			"allowance_type = EXCLUDED.allowance_type, " +
+			"spend_limit = EXCLUDED.spend_limit, " +
+			"expiration = EXCLUDED.expiration, " +
+			"period = EXCLUDED.period, " +
+			"period_spend_limit = EXCLUDED.period_spend_limit, " +
+			"allowed_messages = EXCLUDED.allowed_messages, " +
+			"granted_block_height = EXCLUDED.granted_block_height, " +
+			"granted_block_time = EXCLUDED.granted_block_time, " +
+			"granted_transaction_hash = EXCLUDED.granted_transaction_hash",
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building fee grant upsert sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	result, err := grantsView.rdb.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error upserting fee grant into the table: %v: %w", err, rdb.ErrWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return fmt.Errorf("error upserting fee grant into the table: no rows upserted: %w", rdb.ErrWrite)
+	}
+
+	return nil
+}
+
+// Delete removes the fee allowance of granter to grantee. It is a no-op when no such allowance
+// exists
+func (grantsView *FeeGrants) Delete(granter string, grantee string) error {
+	sql, sqlArgs, err := grantsView.rdb.StmtBuilder.Delete(
+		FEE_GRANTS_TABLE,
+	).Where(
+		"granter = ? AND grantee = ?", granter, grantee,
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building fee grant deletion sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	if _, err = grantsView.rdb.Exec(sql, sqlArgs...); err != nil {
+		return fmt.Errorf("error deleting fee grant from the table: %v: %w", err, rdb.ErrWrite)
+	}
+
+	return nil
+}
+
+func (grantsView *FeeGrants) List(
+	filter FeeGrantsListFilter,
+	pagination *pagination_interface.Pagination,
+) ([]FeeGrantRow, *pagination_interface.PaginationResult, error) {
+	stmtBuilder := grantsView.rdb.StmtBuilder.Select(
+		"granter",
+		"grantee",
+		"allowance_type",
+		"spend_limit",
+		"expiration",
+		"period",
+		"period_spend_limit",
+		"allowed_messages",
+		"granted_block_height",
+		"granted_block_time",
+		"granted_transaction_hash",
+	).From(
+		FEE_GRANTS_TABLE,
+	)
+
+	if filter.MaybeGranter != nil {
+		stmtBuilder = stmtBuilder.Where("granter = ?", *filter.MaybeGranter)
+	}
+	if filter.MaybeGrantee != nil {
+		stmtBuilder = stmtBuilder.Where("grantee = ?", *filter.MaybeGrantee)
+	}
+	stmtBuilder = stmtBuilder.OrderBy("granted_block_height DESC, granter, grantee")
+
+	rDbPagination := rdb.NewRDbPaginationBuilder(
+		pagination,
+		grantsView.rdb,
+	).BuildStmt(stmtBuilder)
+	sql, sqlArgs, err := rDbPagination.ToStmtBuilder().ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error building fee grants select SQL: %v, %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	rowsResult, err := grantsView.rdb.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error executing fee grants select SQL: %v: %w", err, rdb.ErrQuery)
+	}
+	defer rowsResult.Close()
+
+	grants := make([]FeeGrantRow, 0)
+	for rowsResult.Next() {
+		var grant FeeGrantRow
+		var spendLimit string
+		var periodSpendLimit string
+		var allowedMessages string
+		expirationReader := grantsView.rdb.NtotReader()
+		grantedBlockTimeReader := grantsView.rdb.NtotReader()
+
+		if err = rowsResult.Scan(
+			&grant.Granter,
+			&grant.Grantee,
+			&grant.AllowanceType,
+			&spendLimit,
+			expirationReader.ScannableArg(),
+			&grant.MaybePeriod,
+			&periodSpendLimit,
+			&allowedMessages,
+			&grant.GrantedBlockHeight,
+			grantedBlockTimeReader.ScannableArg(),
+			&grant.GrantedTransactionHash,
+		); err != nil {
+			if errors.Is(err, rdb.ErrNoRows) {
+				return nil, nil, rdb.ErrNoRows
+			}
+			return nil, nil, fmt.Errorf("error scanning fee grant row: %v: %w", err, rdb.ErrQuery)
+		}
+
+		json.MustUnmarshalFromString(spendLimit, &grant.SpendLimit)
+		json.MustUnmarshalFromString(periodSpendLimit, &grant.PeriodSpendLimit)
+		json.MustUnmarshalFromString(allowedMessages, &grant.AllowedMessages)
+		grant.MaybeExpiration, err = expirationReader.Parse()
+		if err != nil {
+			return nil, nil, fmt.Errorf("error parsing fee grant expiration: %v: %w", err, rdb.ErrQuery)
+		}
+		grantedBlockTime, err := grantedBlockTimeReader.Parse()
+		if err != nil {
+			return nil, nil, fmt.Errorf("error parsing fee grant granted block time: %v: %w", err, rdb.ErrQuery)
+		}
+		grant.GrantedBlockTime = *grantedBlockTime
+
+		grants = append(grants, grant)
+	}
+
+	paginationResult, err := rDbPagination.Result()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error preparing pagination result: %v", err)
+	}
+
+	return grants, paginationResult, nil
+}
+
+type FeeGrantsListFilter struct {
+	MaybeGranter *string
+	MaybeGrantee *string
+}
+
+type FeeGrantRow struct {
+	Granter                string           `json:"granter"`
+	Grantee                string           `json:"grantee"`
+	AllowanceType          string           `json:"allowanceType"`
+	SpendLimit             coin.Coins       `json:"spendLimit"`
+	MaybeExpiration        *utctime.UTCTime `json:"expiration"`
+	MaybePeriod            *int64           `json:"period"`
+	PeriodSpendLimit       coin.Coins       `json:"periodSpendLimit"`
+	AllowedMessages        []string         `json:"allowedMessages"`
+	GrantedBlockHeight     int64            `json:"grantedBlockHeight"`
+	GrantedBlockTime       utctime.UTCTime  `json:"grantedBlockTime"`
+	GrantedTransactionHash string           `json:"grantedTransactionHash"`
+}
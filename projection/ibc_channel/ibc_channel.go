@@ -0,0 +1,597 @@
+package ibc_channel
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/rdbprojectionbase"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	event_entity "github.com/crypto-com/chain-indexing/entity/event"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/projection/ibc_channel/view"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+	ibc_model "github.com/crypto-com/chain-indexing/usecase/model/ibc"
+)
+
+const CHANNEL_ORDERING_ORDERED = "ORDER_ORDERED"
+
+var _ rdbprojectionbase.Rebuildable = &IBCChannel{}
+
+// IBCChannel projects the IBC clients, connections and channels of the chain together with the
+// lifecycle of the packets sent and received through the channels. Only messages of successful
+// transactions are projected.
+type IBCChannel struct {
+	*rdbprojectionbase.Base
+
+	rdbConn rdb.Conn
+	logger  applogger.Logger
+}
+
+func NewIBCChannel(logger applogger.Logger, rdbConn rdb.Conn) *IBCChannel {
+	return &IBCChannel{
+		rdbprojectionbase.NewRDbBase(rdbConn.ToHandle(), "IBCChannel"),
+
+		rdbConn,
+		logger,
+	}
+}
+
+func (_ *IBCChannel) GetEventsToListen() []string {
+	return []string{
+		event_usecase.BLOCK_CREATED,
+
+		event_usecase.MSG_IBC_CREATE_CLIENT_CREATED,
+		event_usecase.MSG_IBC_UPDATE_CLIENT_CREATED,
+		event_usecase.MSG_IBC_UPGRADE_CLIENT_CREATED,
+		event_usecase.MSG_IBC_SUBMIT_MISBEHAVIOUR_CREATED,
+
+		event_usecase.MSG_IBC_CONNECTION_OPEN_INIT_CREATED,
+		event_usecase.MSG_IBC_CONNECTION_OPEN_TRY_CREATED,
+		event_usecase.MSG_IBC_CONNECTION_OPEN_ACK_CREATED,
+		event_usecase.MSG_IBC_CONNECTION_OPEN_CONFIRM_CREATED,
+
+		event_usecase.MSG_IBC_CHANNEL_OPEN_INIT_CREATED,
+		event_usecase.MSG_IBC_CHANNEL_OPEN_TRY_CREATED,
+		event_usecase.MSG_IBC_CHANNEL_OPEN_ACK_CREATED,
+		event_usecase.MSG_IBC_CHANNEL_OPEN_CONFIRM_CREATED,
+		event_usecase.MSG_IBC_CHANNEL_CLOSE_INIT_CREATED,
+		event_usecase.MSG_IBC_CHANNEL_CLOSE_CONFIRM_CREATED,
+
+		event_usecase.MSG_IBC_TRANSFER_TRANSFER_CREATED,
+		event_usecase.MSG_IBC_RECV_PACKET_CREATED,
+		event_usecase.MSG_IBC_ACKNOWLEDGEMENT_CREATED,
+		event_usecase.MSG_IBC_TIMEOUT_CREATED,
+		event_usecase.MSG_IBC_TIMEOUT_ON_CLOSE_CREATED,
+	}
+}
+
+func (_ *IBCChannel) GetViewTables() []string {
+	return []string{
+		view.IBC_CLIENTS_TABLE,
+		view.IBC_CONNECTIONS_TABLE,
+		view.IBC_CHANNELS_TABLE,
+		view.IBC_PACKETS_TABLE,
+	}
+}
+
+func (projection *IBCChannel) OnInit() error {
+	return nil
+}
+
+// ibcViews bundles the views updated by the projection within the same database transaction
+type ibcViews struct {
+	clients     *view.IBCClients
+	connections *view.IBCConnections
+	channels    *view.IBCChannels
+	packets     *view.IBCPackets
+}
+
+// blockContext is the block information recorded on each updated row
+type blockContext struct {
+	height int64
+	time   utctime.UTCTime
+}
+
+func (projection *IBCChannel) HandleEvents(height int64, events []event_entity.Event) error {
+	var err error
+
+	rdbTx, err := projection.rdbConn.Begin()
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %v", err)
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			_ = rdbTx.Rollback()
+		}
+	}()
+
+	rdbTxHandle := rdbTx.ToHandle()
+	views := &ibcViews{
+		clients:     view.NewIBCClients(rdbTxHandle),
+		connections: view.NewIBCConnections(rdbTxHandle),
+		channels:    view.NewIBCChannels(rdbTxHandle),
+		packets:     view.NewIBCPackets(rdbTxHandle),
+	}
+
+	block := blockContext{
+		height: height,
+	}
+	for _, event := range events {
+		if blockCreatedEvent, ok := event.(*event_usecase.BlockCreated); ok {
+			block.time = blockCreatedEvent.Block.Time
+		}
+	}
+
+	for _, event := range events {
+		if err = projection.handleClientEvent(views.clients, block, event); err != nil {
+			return err
+		}
+		if err = projection.handleConnectionEvent(views.connections, block, event); err != nil {
+			return err
+		}
+		if err = projection.handleChannelEvent(views.channels, block, event); err != nil {
+			return err
+		}
+		if err = projection.handlePacketEvent(views.packets, block, event); err != nil {
+			return err
+		}
+	}
+
+	if err = projection.UpdateLastHandledEventHeight(rdbTxHandle, height); err != nil {
+		return fmt.Errorf("error updating last handled event height: %v", err)
+	}
+
+	if err = rdbTx.Commit(); err != nil {
+		return fmt.Errorf("error committing changes: %v", err)
+	}
+	committed = true
+	return nil
+}
+
+func (projection *IBCChannel) handleClientEvent(
+	clientsView *view.IBCClients,
+	block blockContext,
+	event event_entity.Event,
+) error {
+	var client *view.IBCClientRow
+	var txHash string
+	var err error
+
+	if typedEvent, ok := event.(*event_usecase.MsgIBCCreateClient); ok {
+		if typedEvent.Params.MaybeTendermintLightClient == nil {
+			return nil
+		}
+		clientState := typedEvent.Params.MaybeTendermintLightClient.TendermintClientState
+
+		txHash = typedEvent.TxHash()
+		client = &view.IBCClientRow{
+			ClientID:                   typedEvent.Params.ClientID,
+			ClientType:                 typedEvent.Params.ClientType,
+			CounterpartyChainID:        clientState.ChainID,
+			TrustingPeriod:             clientState.TrustingPeriod.Nanoseconds(),
+			LatestHeightRevisionNumber: clientState.LatestHeight.RevisionNumber,
+			LatestHeightRevisionHeight: clientState.LatestHeight.RevisionHeight,
+			Frozen:                     false,
+			CreatedBlockHeight:         block.height,
+		}
+	} else if typedEvent, ok := event.(*event_usecase.MsgIBCUpdateClient); ok {
+		txHash = typedEvent.TxHash()
+		if client, err = projection.findOrNewClient(clientsView, typedEvent.Params.ClientID, block); err != nil {
+			return err
+		}
+		client.ClientType = typedEvent.Params.ClientType
+
+		// Client can be updated with a header older than the latest one to fill in a missing
+		// consensus state
+		consensusHeight := typedEvent.Params.ConsensusHeight
+		if isHeightNewer(consensusHeight, client.LatestHeightRevisionNumber, client.LatestHeightRevisionHeight) {
+			client.LatestHeightRevisionNumber = consensusHeight.RevisionNumber
+			client.LatestHeightRevisionHeight = consensusHeight.RevisionHeight
+		}
+	} else if typedEvent, ok := event.(*event_usecase.MsgIBCUpgradeClient); ok {
+		txHash = typedEvent.TxHash()
+		if client, err = projection.findOrNewClient(clientsView, typedEvent.Params.ClientID, block); err != nil {
+			return err
+		}
+		client.ClientType = typedEvent.Params.ClientType
+		if typedEvent.Params.MaybeTendermintLightClient != nil {
+			clientState := typedEvent.Params.MaybeTendermintLightClient.TendermintClientState
+			client.CounterpartyChainID = clientState.ChainID
+			client.TrustingPeriod = clientState.TrustingPeriod.Nanoseconds()
+		}
+		client.LatestHeightRevisionNumber = typedEvent.Params.ConsensusHeight.RevisionNumber
+		client.LatestHeightRevisionHeight = typedEvent.Params.ConsensusHeight.RevisionHeight
+	} else if typedEvent, ok := event.(*event_usecase.MsgIBCSubmitMisbehaviour); ok {
+		txHash = typedEvent.TxHash()
+		if client, err = projection.findOrNewClient(clientsView, typedEvent.Params.ClientID, block); err != nil {
+			return err
+		}
+		client.ClientType = typedEvent.Params.ClientType
+		client.Frozen = true
+	} else {
+		return nil
+	}
+
+	client.LastUpdatedBlockHeight = block.height
+	client.LastUpdatedBlockTime = block.time
+	client.LastUpdatedTransactionHash = txHash
+	if err = clientsView.Upsert(client); err != nil {
+		return fmt.Errorf("error upserting IBC client: %v", err)
+	}
+
+	return nil
+}
+
+func (projection *IBCChannel) findOrNewClient(
+	clientsView *view.IBCClients,
+	clientID string,
+	block blockContext,
+) (*view.IBCClientRow, error) {
+	client, err := clientsView.FindBy(clientID)
+	if err == nil {
+		return client, nil
+	}
+	if !errors.Is(err, rdb.ErrNoRows) {
+		return nil, fmt.Errorf("error finding IBC client: %v", err)
+	}
+
+	// Client created before the indexing started
+	return &view.IBCClientRow{
+		ClientID:           clientID,
+		CreatedBlockHeight: block.height,
+	}, nil
+}
+
+func isHeightNewer(height ibc_model.Height, revisionNumber uint64, revisionHeight uint64) bool {
+	if height.RevisionNumber != revisionNumber {
+		return height.RevisionNumber > revisionNumber
+	}
+	return height.RevisionHeight > revisionHeight
+}
+
+func (projection *IBCChannel) handleConnectionEvent(
+	connectionsView *view.IBCConnections,
+	block blockContext,
+	event event_entity.Event,
+) error {
+	var connectionID string
+	var txHash string
+	var update func(connection *view.IBCConnectionRow)
+
+	if typedEvent, ok := event.(*event_usecase.MsgIBCConnectionOpenInit); ok {
+		params := typedEvent.Params
+		connectionID = params.ConnectionID
+		txHash = typedEvent.TxHash()
+		update = func(connection *view.IBCConnectionRow) {
+			connection.ClientID = params.ClientID
+			connection.CounterpartyClientID = params.Counterparty.ClientID
+			connection.State = view.IBC_CONNECTION_STATE_INIT
+		}
+	} else if typedEvent, ok := event.(*event_usecase.MsgIBCConnectionOpenTry); ok {
+		params := typedEvent.Params
+		connectionID = params.ConnectionID
+		txHash = typedEvent.TxHash()
+		update = func(connection *view.IBCConnectionRow) {
+			connection.ClientID = params.ClientID
+			connection.CounterpartyClientID = params.Counterparty.ClientID
+			connection.CounterpartyConnectionID = params.Counterparty.ConnectionID
+			connection.State = view.IBC_CONNECTION_STATE_TRYOPEN
+		}
+	} else if typedEvent, ok := event.(*event_usecase.MsgIBCConnectionOpenAck); ok {
+		params := typedEvent.Params
+		connectionID = params.ConnectionID
+		txHash = typedEvent.TxHash()
+		update = func(connection *view.IBCConnectionRow) {
+			connection.ClientID = params.ClientID
+			connection.CounterpartyClientID = params.CounterpartyClientID
+			connection.CounterpartyConnectionID = params.CounterpartyConnectionID
+			connection.State = view.IBC_CONNECTION_STATE_OPEN
+		}
+	} else if typedEvent, ok := event.(*event_usecase.MsgIBCConnectionOpenConfirm); ok {
+		params := typedEvent.Params
+		connectionID = params.ConnectionID
+		txHash = typedEvent.TxHash()
+		update = func(connection *view.IBCConnectionRow) {
+			connection.ClientID = params.ClientID
+			connection.CounterpartyClientID = params.CounterpartyClientID
+			connection.CounterpartyConnectionID = params.CounterpartyConnectionID
+			connection.State = view.IBC_CONNECTION_STATE_OPEN
+		}
+	} else {
+		return nil
+	}
+
+	connection, err := connectionsView.FindBy(connectionID)
+	if err != nil {
+		if !errors.Is(err, rdb.ErrNoRows) {
+			return fmt.Errorf("error finding IBC connection: %v", err)
+		}
+		connection = &view.IBCConnectionRow{
+			ConnectionID:       connectionID,
+			CreatedBlockHeight: block.height,
+		}
+	}
+
+	update(connection)
+	connection.LastUpdatedBlockHeight = block.height
+	connection.LastUpdatedBlockTime = block.time
+	connection.LastUpdatedTransactionHash = txHash
+	if err = connectionsView.Upsert(connection); err != nil {
+		return fmt.Errorf("error upserting IBC connection: %v", err)
+	}
+
+	return nil
+}
+
+func (projection *IBCChannel) handleChannelEvent(
+	channelsView *view.IBCChannels,
+	block blockContext,
+	event event_entity.Event,
+) error {
+	var portID string
+	var channelID string
+	var txHash string
+	var update func(channel *view.IBCChannelRow)
+
+	if typedEvent, ok := event.(*event_usecase.MsgIBCChannelOpenInit); ok {
+		params := typedEvent.Params
+		portID = params.PortID
+		channelID = params.ChannelID
+		txHash = typedEvent.TxHash()
+		update = func(channel *view.IBCChannelRow) {
+			if len(params.Channel.ConnectionHops) > 0 {
+				channel.ConnectionID = params.Channel.ConnectionHops[0]
+			}
+			channel.CounterpartyPortID = params.Channel.Counterparty.PortID
+			channel.State = view.IBC_CHANNEL_STATE_INIT
+			channel.Ordering = params.Channel.Ordering
+			channel.Version = params.Channel.Version
+		}
+	} else if typedEvent, ok := event.(*event_usecase.MsgIBCChannelOpenTry); ok {
+		params := typedEvent.Params
+		portID = params.PortID
+		channelID = params.ChannelID
+		txHash = typedEvent.TxHash()
+		update = func(channel *view.IBCChannelRow) {
+			channel.ConnectionID = params.ConnectionID
+			channel.CounterpartyPortID = params.Channel.Counterparty.PortID
+			channel.CounterpartyChannelID = params.Channel.Counterparty.ChannelID
+			channel.State = view.IBC_CHANNEL_STATE_TRYOPEN
+			channel.Ordering = params.Channel.Ordering
+			channel.Version = params.Channel.Version
+		}
+	} else if typedEvent, ok := event.(*event_usecase.MsgIBCChannelOpenAck); ok {
+		params := typedEvent.Params
+		portID = params.PortID
+		channelID = params.ChannelID
+		txHash = typedEvent.TxHash()
+		update = func(channel *view.IBCChannelRow) {
+			channel.ConnectionID = params.ConnectionID
+			channel.CounterpartyPortID = params.CounterpartyPortID
+			channel.CounterpartyChannelID = params.CounterpartyChannelID
+			channel.State = view.IBC_CHANNEL_STATE_OPEN
+			channel.Version = params.CounterpartyVersion
+		}
+	} else if typedEvent, ok := event.(*event_usecase.MsgIBCChannelOpenConfirm); ok {
+		params := typedEvent.Params
+		portID = params.PortID
+		channelID = params.ChannelID
+		txHash = typedEvent.TxHash()
+		update = func(channel *view.IBCChannelRow) {
+			channel.ConnectionID = params.ConnectionID
+			channel.CounterpartyPortID = params.CounterpartyPortID
+			channel.CounterpartyChannelID = params.CounterpartyChannelID
+			channel.State = view.IBC_CHANNEL_STATE_OPEN
+		}
+	} else if typedEvent, ok := event.(*event_usecase.MsgIBCChannelCloseInit); ok {
+		params := typedEvent.Params
+		portID = params.PortID
+		channelID = params.ChannelID
+		txHash = typedEvent.TxHash()
+		update = func(channel *view.IBCChannelRow) {
+			channel.ConnectionID = params.ConnectionID
+			channel.CounterpartyPortID = params.CounterpartyPortID
+			channel.CounterpartyChannelID = params.CounterpartyChannelID
+			channel.State = view.IBC_CHANNEL_STATE_CLOSED
+		}
+	} else if typedEvent, ok := event.(*event_usecase.MsgIBCChannelCloseConfirm); ok {
+		params := typedEvent.Params
+		portID = params.PortID
+		channelID = params.ChannelID
+		txHash = typedEvent.TxHash()
+		update = func(channel *view.IBCChannelRow) {
+			channel.ConnectionID = params.ConnectionID
+			channel.CounterpartyPortID = params.CounterpartyPortID
+			channel.CounterpartyChannelID = params.CounterpartyChannelID
+			channel.State = view.IBC_CHANNEL_STATE_CLOSED
+		}
+	} else if typedEvent, ok := event.(*event_usecase.MsgIBCTimeout); ok {
+		// Timeout of a packet on an ordered channel closes the channel
+		if typedEvent.Params.ChannelOrdering != CHANNEL_ORDERING_ORDERED {
+			return nil
+		}
+		packet := typedEvent.Params.Packet
+		portID = packet.SourcePort
+		channelID = packet.SourceChannel
+		txHash = typedEvent.TxHash()
+		update = func(channel *view.IBCChannelRow) {
+			channel.State = view.IBC_CHANNEL_STATE_CLOSED
+		}
+	} else {
+		return nil
+	}
+
+	channel, err := channelsView.FindBy(portID, channelID)
+	if err != nil {
+		if !errors.Is(err, rdb.ErrNoRows) {
+			return fmt.Errorf("error finding IBC channel: %v", err)
+		}
+		channel = &view.IBCChannelRow{
+			ChannelID:          channelID,
+			PortID:             portID,
+			CreatedBlockHeight: block.height,
+		}
+	}
+
+	update(channel)
+	channel.LastUpdatedBlockHeight = block.height
+	channel.LastUpdatedBlockTime = block.time
+	channel.LastUpdatedTransactionHash = txHash
+	if err = channelsView.Upsert(channel); err != nil {
+		return fmt.Errorf("error upserting IBC channel: %v", err)
+	}
+
+	return nil
+}
+
+func (projection *IBCChannel) handlePacketEvent(
+	packetsView *view.IBCPackets,
+	block blockContext,
+	event event_entity.Event,
+) error {
+	var packet *view.IBCPacketRow
+	var err error
+
+	if typedEvent, ok := event.(*event_usecase.MsgIBCTransferTransfer); ok {
+		params := typedEvent.Params
+		txHash := typedEvent.TxHash()
+		if packet, err = projection.findOrNewPacket(
+			packetsView,
+			params.SourcePort,
+			params.SourceChannel,
+			view.IBC_PACKET_DIRECTION_OUTGOING,
+			params.PacketSequence,
+			block,
+		); err != nil {
+			return err
+		}
+		packet.CounterpartyPortID = params.DestinationPort
+		packet.CounterpartyChannelID = params.DestinationChannel
+		packet.Status = view.IBC_PACKET_STATUS_SENT
+		packet.MaybeFungibleTokenPacketData = &ibc_model.FungibleTokenPacketData{
+			Sender:   params.Sender,
+			Receiver: params.Receiver,
+			Denom:    params.Token.Denom,
+			Amount:   params.Token.Amount,
+		}
+		packet.MaybeSentTransactionHash = &txHash
+	} else if typedEvent, ok := event.(*event_usecase.MsgIBCRecvPacket); ok {
+		params := typedEvent.Params
+		txHash := typedEvent.TxHash()
+		if packet, err = projection.findOrNewPacket(
+			packetsView,
+			params.Packet.DestinationPort,
+			params.Packet.DestinationChannel,
+			view.IBC_PACKET_DIRECTION_INCOMING,
+			params.PacketSequence,
+			block,
+		); err != nil {
+			return err
+		}
+		packet.CounterpartyPortID = params.Packet.SourcePort
+		packet.CounterpartyChannelID = params.Packet.SourceChannel
+		packet.Status = view.IBC_PACKET_STATUS_RECEIVED
+		if params.MaybeFungibleTokenPacketData != nil {
+			data := params.MaybeFungibleTokenPacketData.FungibleTokenPacketData
+			packet.MaybeFungibleTokenPacketData = &data
+		}
+		packet.MaybeReceivedTransactionHash = &txHash
+	} else if typedEvent, ok := event.(*event_usecase.MsgIBCAcknowledgement); ok {
+		params := typedEvent.Params
+		txHash := typedEvent.TxHash()
+		if packet, err = projection.findOrNewPacket(
+			packetsView,
+			params.Packet.SourcePort,
+			params.Packet.SourceChannel,
+			view.IBC_PACKET_DIRECTION_OUTGOING,
+			params.PacketSequence,
+			block,
+		); err != nil {
+			return err
+		}
+		packet.CounterpartyPortID = params.Packet.DestinationPort
+		packet.CounterpartyChannelID = params.Packet.DestinationChannel
+		packet.Status = view.IBC_PACKET_STATUS_ACKNOWLEDGED
+		if params.MaybeFungibleTokenPacketData != nil {
+			if packet.MaybeFungibleTokenPacketData == nil {
+				data := params.MaybeFungibleTokenPacketData.FungibleTokenPacketData
+				packet.MaybeFungibleTokenPacketData = &data
+			}
+			success := params.MaybeFungibleTokenPacketData.Success
+			packet.MaybeAcknowledgementSuccess = &success
+		}
+		packet.MaybeAcknowledgedTransactionHash = &txHash
+	} else if typedEvent, ok := event.(*event_usecase.MsgIBCTimeout); ok {
+		params := typedEvent.Params
+		if packet, err = projection.findOrNewPacket(
+			packetsView,
+			params.Packet.SourcePort,
+			params.Packet.SourceChannel,
+			view.IBC_PACKET_DIRECTION_OUTGOING,
+			params.PacketSequence,
+			block,
+		); err != nil {
+			return err
+		}
+		markPacketTimedOut(packet, params.Packet, typedEvent.TxHash())
+	} else if typedEvent, ok := event.(*event_usecase.MsgIBCTimeoutOnClose); ok {
+		params := typedEvent.Params
+		if packet, err = projection.findOrNewPacket(
+			packetsView,
+			params.Packet.SourcePort,
+			params.Packet.SourceChannel,
+			view.IBC_PACKET_DIRECTION_OUTGOING,
+			params.PacketSequence,
+			block,
+		); err != nil {
+			return err
+		}
+		markPacketTimedOut(packet, params.Packet, typedEvent.TxHash())
+	} else {
+		return nil
+	}
+
+	packet.LastUpdatedBlockHeight = block.height
+	packet.LastUpdatedBlockTime = block.time
+	if err = packetsView.Upsert(packet); err != nil {
+		return fmt.Errorf("error upserting IBC packet: %v", err)
+	}
+
+	return nil
+}
+
+func (projection *IBCChannel) findOrNewPacket(
+	packetsView *view.IBCPackets,
+	portID string,
+	channelID string,
+	direction string,
+	sequence uint64,
+	block blockContext,
+) (*view.IBCPacketRow, error) {
+	packet, err := packetsView.FindBy(portID, channelID, direction, sequence)
+	if err == nil {
+		return packet, nil
+	}
+	if !errors.Is(err, rdb.ErrNoRows) {
+		return nil, fmt.Errorf("error finding IBC packet: %v", err)
+	}
+
+	// Packet sent before the indexing started or by an application other than transfer
+	return &view.IBCPacketRow{
+		ChannelID:          channelID,
+		PortID:             portID,
+		Direction:          direction,
+		Sequence:           sequence,
+		CreatedBlockHeight: block.height,
+	}, nil
+}
+
+func markPacketTimedOut(packet *view.IBCPacketRow, rawPacket ibc_model.Packet, txHash string) {
+	packet.CounterpartyPortID = rawPacket.DestinationPort
+	packet.CounterpartyChannelID = rawPacket.DestinationChannel
+	packet.Status = view.IBC_PACKET_STATUS_TIMED_OUT
+	packet.MaybeTimedOutTransactionHash = &txHash
+}
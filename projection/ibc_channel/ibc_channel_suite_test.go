@@ -0,0 +1,13 @@
+package ibc_channel_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestIBCChannel(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "IBCChannel Suite")
+}
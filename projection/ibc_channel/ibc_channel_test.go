@@ -0,0 +1,590 @@
+package ibc_channel_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	. "github.com/crypto-com/chain-indexing/appinterface/rdb/test"
+	event_entity "github.com/crypto-com/chain-indexing/entity/event"
+	entity_projection "github.com/crypto-com/chain-indexing/entity/projection"
+	"github.com/crypto-com/chain-indexing/infrastructure/pg"
+	"github.com/crypto-com/chain-indexing/internal/json"
+	. "github.com/crypto-com/chain-indexing/internal/logger/test"
+	"github.com/crypto-com/chain-indexing/internal/primptr"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/projection/ibc_channel"
+	"github.com/crypto-com/chain-indexing/projection/ibc_channel/view"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+	usecase_model "github.com/crypto-com/chain-indexing/usecase/model"
+	ibc_model "github.com/crypto-com/chain-indexing/usecase/model/ibc"
+)
+
+var _ = Describe("IBCChannel", func() {
+	anyHeight := int64(100)
+	anyBlockTime := utctime.FromUnixNano(int64(1620000000000000000))
+	anyTxHash := "4936522F7391D425F2A93AD47576F8AEC3947DC907113BE8A2FBCFF8E9F2A416"
+	anyBlockCreated := event_usecase.NewBlockCreated(&usecase_model.Block{
+		Height: anyHeight,
+		Time:   anyBlockTime,
+	})
+	msgCommonParams := event_usecase.MsgCommonParams{
+		BlockHeight: anyHeight,
+		TxHash:      anyTxHash,
+		TxSuccess:   true,
+		MsgIndex:    0,
+	}
+
+	It("should implement projection", func() {
+		fakeLogger := NewFakeLogger()
+		fakeRdbConn := NewFakeRDbConn()
+		var _ entity_projection.Projection = ibc_channel.NewIBCChannel(fakeLogger, fakeRdbConn)
+	})
+
+	Describe("HandleEvents", func() {
+		var mockRDb *mockIBCChannelRDb
+		BeforeEach(func() {
+			mockRDb = newMockIBCChannelRDb()
+		})
+
+		It("should key outgoing packet by the source channel end and the sequence", func() {
+			err := mockRDb.handleEvents(anyHeight, anyBlockCreated, event_usecase.NewMsgIBCTransferTransfer(
+				msgCommonParams,
+				ibc_model.MsgTransferParams{
+					RawMsgTransfer: ibc_model.RawMsgTransfer{
+						SourcePort:    "transfer",
+						SourceChannel: "channel-0",
+						Token: ibc_model.MsgTransferToken{
+							Denom:  "basecro",
+							Amount: 1234,
+						},
+						Sender:   "cro1dulwqgcdpemn8c34sjd92fxepz5p0sqpeevw7f",
+						Receiver: "cosmos1dulwqgcdpemn8c34sjd92fxepz5p0sqpfj2g4d",
+					},
+					PacketSequence:     3,
+					DestinationPort:    "transfer",
+					DestinationChannel: "channel-9",
+				},
+			))
+			Expect(err).To(BeNil())
+
+			Expect(mockRDb.findArgs(view.IBC_PACKETS_TABLE)).To(Equal([][]interface{}{
+				{"transfer", "channel-0", view.IBC_PACKET_DIRECTION_OUTGOING, uint64(3)},
+			}))
+			Expect(mockRDb.upsertArgs(view.IBC_PACKETS_TABLE)).To(Equal([][]interface{}{
+				{
+					"channel-0",
+					"transfer",
+					view.IBC_PACKET_DIRECTION_OUTGOING,
+					uint64(3),
+					"transfer",
+					"channel-9",
+					view.IBC_PACKET_STATUS_SENT,
+					primptr.String(json.MustMarshalToString(&ibc_model.FungibleTokenPacketData{
+						Sender:   "cro1dulwqgcdpemn8c34sjd92fxepz5p0sqpeevw7f",
+						Receiver: "cosmos1dulwqgcdpemn8c34sjd92fxepz5p0sqpfj2g4d",
+						Denom:    "basecro",
+						Amount:   1234,
+					})),
+					primptr.String(anyTxHash),
+					(*string)(nil),
+					(*string)(nil),
+					(*bool)(nil),
+					(*string)(nil),
+					anyHeight,
+					anyHeight,
+					anyBlockTime.UnixNano(),
+				},
+			}))
+			Expect(mockRDb.committed()).To(BeTrue())
+		})
+
+		It("should key incoming packet by the destination channel end apart from outgoing packet of the same sequence", func() {
+			err := mockRDb.handleEvents(
+				anyHeight,
+				anyBlockCreated,
+				event_usecase.NewMsgIBCRecvPacket(msgCommonParams, ibc_model.MsgRecvPacketParams{
+					RawMsgRecvPacket: ibc_model.RawMsgRecvPacket{
+						Packet: ibc_model.Packet{
+							Sequence:           "3",
+							SourcePort:         "transfer",
+							SourceChannel:      "channel-9",
+							DestinationPort:    "transfer",
+							DestinationChannel: "channel-0",
+						},
+					},
+					PacketSequence: 3,
+				}),
+				event_usecase.NewMsgIBCTransferTransfer(msgCommonParams, ibc_model.MsgTransferParams{
+					RawMsgTransfer: ibc_model.RawMsgTransfer{
+						SourcePort:    "transfer",
+						SourceChannel: "channel-0",
+					},
+					PacketSequence:     3,
+					DestinationPort:    "transfer",
+					DestinationChannel: "channel-9",
+				}),
+			)
+			Expect(err).To(BeNil())
+
+			Expect(mockRDb.findArgs(view.IBC_PACKETS_TABLE)).To(Equal([][]interface{}{
+				{"transfer", "channel-0", view.IBC_PACKET_DIRECTION_INCOMING, uint64(3)},
+				{"transfer", "channel-0", view.IBC_PACKET_DIRECTION_OUTGOING, uint64(3)},
+			}))
+			upserts := mockRDb.upsertArgs(view.IBC_PACKETS_TABLE)
+			Expect(upserts).To(HaveLen(2))
+			Expect(upserts[0][:7]).To(Equal([]interface{}{
+				"channel-0",
+				"transfer",
+				view.IBC_PACKET_DIRECTION_INCOMING,
+				uint64(3),
+				"transfer",
+				"channel-9",
+				view.IBC_PACKET_STATUS_RECEIVED,
+			}))
+			Expect(upserts[0][9]).To(Equal(primptr.String(anyTxHash)))
+			Expect(upserts[1][:7]).To(Equal([]interface{}{
+				"channel-0",
+				"transfer",
+				view.IBC_PACKET_DIRECTION_OUTGOING,
+				uint64(3),
+				"transfer",
+				"channel-9",
+				view.IBC_PACKET_STATUS_SENT,
+			}))
+		})
+
+		It("should acknowledge the sent packet and keep its sent transaction and creation height", func() {
+			sentHeight := int64(90)
+			sentTxHash := "0AC4A46E1C4EDAF5A4E9AB2A0BE4C1FF2BCB6F6A25D0CB6C8BF4D1F6A71B5F2B"
+			packetData := ibc_model.FungibleTokenPacketData{
+				Sender:   "cro1dulwqgcdpemn8c34sjd92fxepz5p0sqpeevw7f",
+				Receiver: "cosmos1dulwqgcdpemn8c34sjd92fxepz5p0sqpfj2g4d",
+				Denom:    "basecro",
+				Amount:   1234,
+			}
+			mockRDb.stubRow(
+				view.IBC_PACKETS_TABLE,
+				[]interface{}{"transfer", "channel-0", view.IBC_PACKET_DIRECTION_OUTGOING, uint64(3)},
+				"channel-0",
+				"transfer",
+				view.IBC_PACKET_DIRECTION_OUTGOING,
+				uint64(3),
+				"transfer",
+				"channel-9",
+				view.IBC_PACKET_STATUS_SENT,
+				primptr.String(json.MustMarshalToString(&packetData)),
+				primptr.String(sentTxHash),
+				nil,
+				nil,
+				nil,
+				nil,
+				sentHeight,
+				sentHeight,
+				primptr.Int64(int64(1610000000000000000)),
+			)
+
+			err := mockRDb.handleEvents(anyHeight, anyBlockCreated, event_usecase.NewMsgIBCAcknowledgement(
+				msgCommonParams,
+				ibc_model.MsgAcknowledgementParams{
+					RawMsgAcknowledgement: ibc_model.RawMsgAcknowledgement{
+						Packet: ibc_model.Packet{
+							Sequence:           "3",
+							SourcePort:         "transfer",
+							SourceChannel:      "channel-0",
+							DestinationPort:    "transfer",
+							DestinationChannel: "channel-9",
+						},
+					},
+					MaybeFungibleTokenPacketData: &ibc_model.MsgAcknowledgementFungibleTokenPacketData{
+						FungibleTokenPacketData: packetData,
+						Success:                 true,
+					},
+					PacketSequence: 3,
+				},
+			))
+			Expect(err).To(BeNil())
+
+			Expect(mockRDb.upsertArgs(view.IBC_PACKETS_TABLE)).To(Equal([][]interface{}{
+				{
+					"channel-0",
+					"transfer",
+					view.IBC_PACKET_DIRECTION_OUTGOING,
+					uint64(3),
+					"transfer",
+					"channel-9",
+					view.IBC_PACKET_STATUS_ACKNOWLEDGED,
+					primptr.String(json.MustMarshalToString(&packetData)),
+					primptr.String(sentTxHash),
+					(*string)(nil),
+					primptr.String(anyTxHash),
+					primptr.Bool(true),
+					(*string)(nil),
+					sentHeight,
+					anyHeight,
+					anyBlockTime.UnixNano(),
+				},
+			}))
+		})
+
+		It("should project channel opening handshake as INIT and TRYOPEN", func() {
+			err := mockRDb.handleEvents(
+				anyHeight,
+				anyBlockCreated,
+				event_usecase.NewMsgIBCChannelOpenInit(msgCommonParams, ibc_model.MsgChannelOpenInitParams{
+					RawMsgChannelOpenInit: ibc_model.RawMsgChannelOpenInit{
+						PortID: "transfer",
+						Channel: ibc_model.Channel{
+							Ordering: "ORDER_UNORDERED",
+							Counterparty: ibc_model.ChannelCounterparty{
+								PortID: "transfer",
+							},
+							ConnectionHops: []string{"connection-0"},
+							Version:        "ics20-1",
+						},
+					},
+					ChannelID: "channel-0",
+				}),
+				event_usecase.NewMsgIBCChannelOpenTry(msgCommonParams, ibc_model.MsgChannelOpenTryParams{
+					RawMsgChannelOpenTry: ibc_model.RawMsgChannelOpenTry{
+						PortID: "transfer",
+						Channel: ibc_model.Channel{
+							Ordering: "ORDER_UNORDERED",
+							Counterparty: ibc_model.ChannelCounterparty{
+								PortID:    "transfer",
+								ChannelID: "channel-7",
+							},
+							ConnectionHops: []string{"connection-1"},
+							Version:        "ics20-1",
+						},
+					},
+					ChannelID:    "channel-1",
+					ConnectionID: "connection-1",
+				}),
+			)
+			Expect(err).To(BeNil())
+
+			Expect(mockRDb.findArgs(view.IBC_CHANNELS_TABLE)).To(Equal([][]interface{}{
+				{"transfer", "channel-0"},
+				{"transfer", "channel-1"},
+			}))
+			Expect(mockRDb.upsertArgs(view.IBC_CHANNELS_TABLE)).To(Equal([][]interface{}{
+				{
+					"channel-0",
+					"transfer",
+					"connection-0",
+					"transfer",
+					"",
+					view.IBC_CHANNEL_STATE_INIT,
+					"ORDER_UNORDERED",
+					"ics20-1",
+					anyHeight,
+					anyHeight,
+					anyBlockTime.UnixNano(),
+					anyTxHash,
+				},
+				{
+					"channel-1",
+					"transfer",
+					"connection-1",
+					"transfer",
+					"channel-7",
+					view.IBC_CHANNEL_STATE_TRYOPEN,
+					"ORDER_UNORDERED",
+					"ics20-1",
+					anyHeight,
+					anyHeight,
+					anyBlockTime.UnixNano(),
+					anyTxHash,
+				},
+			}))
+		})
+
+		It("should open the INIT channel on MsgChannelOpenAck and keep its ordering and creation height", func() {
+			createdHeight := int64(80)
+			mockRDb.stubRow(
+				view.IBC_CHANNELS_TABLE,
+				[]interface{}{"transfer", "channel-0"},
+				"channel-0",
+				"transfer",
+				"connection-0",
+				"transfer",
+				"",
+				view.IBC_CHANNEL_STATE_INIT,
+				"ORDER_UNORDERED",
+				"ics20-1",
+				createdHeight,
+				createdHeight,
+				primptr.Int64(int64(1610000000000000000)),
+				"0AC4A46E1C4EDAF5A4E9AB2A0BE4C1FF2BCB6F6A25D0CB6C8BF4D1F6A71B5F2B",
+			)
+
+			err := mockRDb.handleEvents(anyHeight, anyBlockCreated, event_usecase.NewMsgIBCChannelOpenAck(
+				msgCommonParams,
+				ibc_model.MsgChannelOpenAckParams{
+					RawMsgChannelOpenAck: ibc_model.RawMsgChannelOpenAck{
+						PortID:                "transfer",
+						ChannelID:             "channel-0",
+						CounterpartyChannelID: "channel-7",
+						CounterpartyVersion:   "ics20-1",
+					},
+					CounterpartyPortID: "transfer",
+					ConnectionID:       "connection-0",
+				},
+			))
+			Expect(err).To(BeNil())
+
+			Expect(mockRDb.upsertArgs(view.IBC_CHANNELS_TABLE)).To(Equal([][]interface{}{
+				{
+					"channel-0",
+					"transfer",
+					"connection-0",
+					"transfer",
+					"channel-7",
+					view.IBC_CHANNEL_STATE_OPEN,
+					"ORDER_UNORDERED",
+					"ics20-1",
+					createdHeight,
+					anyHeight,
+					anyBlockTime.UnixNano(),
+					anyTxHash,
+				},
+			}))
+		})
+
+		It("should close the OPEN channel on MsgChannelCloseInit", func() {
+			createdHeight := int64(80)
+			mockRDb.stubRow(
+				view.IBC_CHANNELS_TABLE,
+				[]interface{}{"transfer", "channel-0"},
+				"channel-0",
+				"transfer",
+				"connection-0",
+				"transfer",
+				"channel-7",
+				view.IBC_CHANNEL_STATE_OPEN,
+				"ORDER_UNORDERED",
+				"ics20-1",
+				createdHeight,
+				createdHeight,
+				primptr.Int64(int64(1610000000000000000)),
+				"0AC4A46E1C4EDAF5A4E9AB2A0BE4C1FF2BCB6F6A25D0CB6C8BF4D1F6A71B5F2B",
+			)
+
+			err := mockRDb.handleEvents(anyHeight, anyBlockCreated, event_usecase.NewMsgIBCChannelCloseInit(
+				msgCommonParams,
+				ibc_model.MsgChannelCloseInitParams{
+					RawMsgChannelCloseInit: ibc_model.RawMsgChannelCloseInit{
+						PortID:    "transfer",
+						ChannelID: "channel-0",
+					},
+					CounterpartyPortID:    "transfer",
+					CounterpartyChannelID: "channel-7",
+					ConnectionID:          "connection-0",
+				},
+			))
+			Expect(err).To(BeNil())
+
+			upserts := mockRDb.upsertArgs(view.IBC_CHANNELS_TABLE)
+			Expect(upserts).To(HaveLen(1))
+			Expect(upserts[0][5]).To(Equal(view.IBC_CHANNEL_STATE_CLOSED))
+			Expect(upserts[0][6]).To(Equal("ORDER_UNORDERED"))
+			Expect(upserts[0][8]).To(Equal(createdHeight))
+		})
+
+		It("should close the ordered channel but not the unordered channel on packet timeout", func() {
+			newMsgTimeout := func(channelID string, ordering string) event_entity.Event {
+				return event_usecase.NewMsgIBCTimeout(msgCommonParams, ibc_model.MsgTimeoutParams{
+					RawMsgTimeout: ibc_model.RawMsgTimeout{
+						Packet: ibc_model.Packet{
+							Sequence:           "5",
+							SourcePort:         "transfer",
+							SourceChannel:      channelID,
+							DestinationPort:    "transfer",
+							DestinationChannel: "channel-9",
+						},
+					},
+					PacketSequence:  5,
+					ChannelOrdering: ordering,
+				})
+			}
+
+			err := mockRDb.handleEvents(
+				anyHeight,
+				anyBlockCreated,
+				newMsgTimeout("channel-0", ibc_channel.CHANNEL_ORDERING_ORDERED),
+				newMsgTimeout("channel-1", "ORDER_UNORDERED"),
+			)
+			Expect(err).To(BeNil())
+
+			channelUpserts := mockRDb.upsertArgs(view.IBC_CHANNELS_TABLE)
+			Expect(channelUpserts).To(HaveLen(1))
+			Expect(channelUpserts[0][:2]).To(Equal([]interface{}{"channel-0", "transfer"}))
+			Expect(channelUpserts[0][5]).To(Equal(view.IBC_CHANNEL_STATE_CLOSED))
+
+			packetUpserts := mockRDb.upsertArgs(view.IBC_PACKETS_TABLE)
+			Expect(packetUpserts).To(HaveLen(2))
+			for i, channelID := range []string{"channel-0", "channel-1"} {
+				Expect(packetUpserts[i][:4]).To(Equal([]interface{}{
+					channelID, "transfer", view.IBC_PACKET_DIRECTION_OUTGOING, uint64(5),
+				}))
+				Expect(packetUpserts[i][6]).To(Equal(view.IBC_PACKET_STATUS_TIMED_OUT))
+				Expect(packetUpserts[i][12]).To(Equal(primptr.String(anyTxHash)))
+			}
+		})
+
+		It("should update last handled event height when there is no IBC event at the height", func() {
+			err := mockRDb.handleEvents(anyHeight, anyBlockCreated)
+			Expect(err).To(BeNil())
+
+			Expect(mockRDb.upsertArgs(view.IBC_CHANNELS_TABLE)).To(BeEmpty())
+			Expect(mockRDb.upsertArgs(view.IBC_PACKETS_TABLE)).To(BeEmpty())
+			Expect(mockRDb.upsertArgs("projections")).To(Equal([][]interface{}{
+				{"IBCChannel", anyHeight},
+			}))
+			Expect(mockRDb.committed()).To(BeTrue())
+		})
+
+		It("should roll back without committing when the view fails", func() {
+			mockRDb.tx.On(
+				"Exec", append([]interface{}{insertInto(view.IBC_CHANNELS_TABLE)}, anyArgs(12)...)...,
+			).Return(nil, errors.New("connection reset"))
+
+			err := mockRDb.handleEvents(anyHeight, anyBlockCreated, event_usecase.NewMsgIBCChannelCloseInit(
+				msgCommonParams,
+				ibc_model.MsgChannelCloseInitParams{
+					RawMsgChannelCloseInit: ibc_model.RawMsgChannelCloseInit{
+						PortID:    "transfer",
+						ChannelID: "channel-0",
+					},
+				},
+			))
+			Expect(err).NotTo(BeNil())
+
+			Expect(mockRDb.committed()).To(BeFalse())
+			Expect(mockRDb.rolledBack()).To(BeTrue())
+		})
+	})
+})
+
+// mockIBCChannelRDb mocks the database transaction of the projection. Rows are not found unless
+// stubbed, statements are always executed successfully unless stubbed, and all calls are recorded
+// by the transaction mock.
+type mockIBCChannelRDb struct {
+	tx         *MockRDbTx
+	projection *ibc_channel.IBCChannel
+}
+
+func newMockIBCChannelRDb() *mockIBCChannelRDb {
+	conn := NewMockRDBbConn()
+	tx := &MockRDbTx{}
+	conn.On("ToHandle").Return(&rdb.Handle{
+		Runner:      conn,
+		TypeConv:    &pg.PgxTypeConv{},
+		StmtBuilder: pg.PostgresStmtBuilder,
+	})
+	conn.On("Begin").Return(tx, nil)
+	tx.On("ToHandle").Return(&rdb.Handle{
+		Runner:      tx,
+		TypeConv:    &pg.PgxTypeConv{},
+		StmtBuilder: pg.PostgresStmtBuilder,
+	})
+	tx.On("Commit").Return(nil)
+	tx.On("Rollback").Return(nil)
+
+	return &mockIBCChannelRDb{
+		tx:         tx,
+		projection: ibc_channel.NewIBCChannel(NewFakeLogger(), conn),
+	}
+}
+
+// stubRow stubs the row of the table found by the where arguments with the column values
+func (db *mockIBCChannelRDb) stubRow(table string, whereArgs []interface{}, columns ...interface{}) {
+	row := &MockRDbRowResult{}
+	row.On("Scan", anyArgs(len(columns))...).Run(func(args mock.Arguments) {
+		for i, dest := range args {
+			destValue := reflect.ValueOf(dest).Elem()
+			if columns[i] == nil {
+				destValue.Set(reflect.Zero(destValue.Type()))
+			} else {
+				destValue.Set(reflect.ValueOf(columns[i]))
+			}
+		}
+	}).Return(nil)
+	db.tx.On("QueryRow", append([]interface{}{selectFrom(table)}, whereArgs...)...).Return(row)
+}
+
+func (db *mockIBCChannelRDb) handleEvents(height int64, events ...event_entity.Event) error {
+	notFoundRow := &MockRDbRowResult{}
+	execResult := &MockRDbExecResult{}
+	execResult.On("RowsAffected").Return(int64(1))
+	for argCount := 0; argCount <= 20; argCount++ {
+		notFoundRow.On("Scan", anyArgs(argCount)...).Return(rdb.ErrNoRows)
+		db.tx.On("QueryRow", append([]interface{}{mock.Anything}, anyArgs(argCount)...)...).Return(notFoundRow)
+		db.tx.On("Exec", append([]interface{}{mock.Anything}, anyArgs(argCount)...)...).Return(execResult, nil)
+	}
+
+	return db.projection.HandleEvents(height, events)
+}
+
+// findArgs returns the where arguments of every row selection from the table
+func (db *mockIBCChannelRDb) findArgs(table string) [][]interface{} {
+	return db.statementArgs("QueryRow", "FROM "+table+" ")
+}
+
+// upsertArgs returns the values of every row insertion into the table
+func (db *mockIBCChannelRDb) upsertArgs(table string) [][]interface{} {
+	return db.statementArgs("Exec", "INSERT INTO "+table+" ")
+}
+
+func (db *mockIBCChannelRDb) statementArgs(method string, sqlFragment string) [][]interface{} {
+	result := make([][]interface{}, 0)
+	for _, call := range db.tx.Calls {
+		if call.Method == method && strings.Contains(call.Arguments.String(0), sqlFragment) {
+			result = append(result, []interface{}(call.Arguments[1:]))
+		}
+	}
+	return result
+}
+
+func (db *mockIBCChannelRDb) committed() bool {
+	return db.called("Commit")
+}
+
+func (db *mockIBCChannelRDb) rolledBack() bool {
+	return db.called("Rollback")
+}
+
+func (db *mockIBCChannelRDb) called(method string) bool {
+	for _, call := range db.tx.Calls {
+		if call.Method == method {
+			return true
+		}
+	}
+	return false
+}
+
+func selectFrom(table string) interface{} {
+	return mock.MatchedBy(func(sql string) bool {
+		return strings.Contains(sql, "FROM "+table+" ")
+	})
+}
+
+func insertInto(table string) interface{} {
+	return mock.MatchedBy(func(sql string) bool {
+		return strings.HasPrefix(sql, "INSERT INTO "+table+" ")
+	})
+}
+
+func anyArgs(count int) []interface{} {
+	args := make([]interface{}, count)
+	for i := range args {
+		args[i] = mock.Anything
+	}
+	return args
+}
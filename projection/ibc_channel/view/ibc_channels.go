@@ -0,0 +1,219 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	pagination_interface "github.com/crypto-com/chain-indexing/appinterface/pagination"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+)
+
+const IBC_CHANNELS_TABLE = "view_ibc_channels"
+
+const (
+	IBC_CHANNEL_STATE_INIT    = "STATE_INIT"
+	IBC_CHANNEL_STATE_TRYOPEN = "STATE_TRYOPEN"
+	IBC_CHANNEL_STATE_OPEN    = "STATE_OPEN"
+	IBC_CHANNEL_STATE_CLOSED  = "STATE_CLOSED"
+)
+
+// IBCChannels projection view of the IBC channels ends on the chain
+type IBCChannels struct {
+	rdb *rdb.Handle
+}
+
+func NewIBCChannels(handle *rdb.Handle) *IBCChannels {
+	return &IBCChannels{
+		handle,
+	}
+}
+
+// Upsert inserts the channel or replaces the existing channel of the same port and channel id
+func (channelsView *IBCChannels) Upsert(channel *IBCChannelRow) error {
+	sql, sqlArgs, err := channelsView.rdb.StmtBuilder.Insert(
+		IBC_CHANNELS_TABLE,
+	).Columns(
+		"channel_id",
+		"port_id",
+		"connection_id",
+		"counterparty_port_id",
+		"counterparty_channel_id",
+		"state",
+		"ordering",
+		"version",
+		"created_block_height",
+		"last_updated_block_height",
+		"last_updated_block_time",
+		"last_updated_transaction_hash",
+	).Values(
+		channel.ChannelID,
+		channel.PortID,
+		channel.ConnectionID,
+		channel.CounterpartyPortID,
+		channel.CounterpartyChannelID,
+		channel.State,
+		channel.Ordering,
+		channel.Version,
+		channel.CreatedBlockHeight,
+		channel.LastUpdatedBlockHeight,
+		channelsView.rdb.Tton(&channel.LastUpdatedBlockTime),
+		channel.LastUpdatedTransactionHash,
+	).Suffix(
+		"ON CONFLICT(channel_id, port_id) DO UPDATE SET " +
+			"connection_id = EXCLUDED.connection_id, " +
+			"counterparty_port_id = EXCLUDED.counterparty_port_id, " +
+			"counterparty_channel_id = EXCLUDED.counterparty_channel_id, " +
+			"state = EXCLUDED.state, " +
+			"ordering = EXCLUDED.ordering, " +
+			"version = EXCLUDED.version, " +
+			"last_updated_block_height = EXCLUDED.last_updated_block_height, " +
+			"last_updated_block_time = EXCLUDED.last_updated_block_time, " +
+			"last_updated_transaction_hash = EXCLUDED.last_updated_transaction_hash",
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building IBC channel upsert sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	result, err := channelsView.rdb.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error upserting IBC channel into the table: %v: %w", err, rdb.ErrWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return fmt.Errorf("error upserting IBC channel into the table: no rows upserted: %w", rdb.ErrWrite)
+	}
+
+	return nil
+}
+
+func (channelsView *IBCChannels) FindBy(portID string, channelID string) (*IBCChannelRow, error) {
+	sql, sqlArgs, err := channelsView.selectStmtBuilder().Where(
+		"port_id = ? AND channel_id = ?", portID, channelID,
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building IBC channel selection sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	channel, err := channelsView.scan(channelsView.rdb.QueryRow(sql, sqlArgs...))
+	if err != nil {
+		return nil, err
+	}
+
+	return channel, nil
+}
+
+func (channelsView *IBCChannels) List(
+	filter IBCChannelsListFilter,
+	pagination *pagination_interface.Pagination,
+) ([]IBCChannelRow, *pagination_interface.PaginationResult, error) {
+	stmtBuilder := channelsView.selectStmtBuilder()
+	if filter.MaybeState != nil {
+		stmtBuilder = stmtBuilder.Where("state = ?", *filter.MaybeState)
+	}
+	stmtBuilder = stmtBuilder.OrderBy("created_block_height, channel_id, port_id")
+
+	rDbPagination := rdb.NewRDbPaginationBuilder(
+		pagination,
+		channelsView.rdb,
+	).BuildStmt(stmtBuilder)
+	sql, sqlArgs, err := rDbPagination.ToStmtBuilder().ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error building IBC channels select SQL: %v, %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	rowsResult, err := channelsView.rdb.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error executing IBC channels select SQL: %v: %w", err, rdb.ErrQuery)
+	}
+	defer rowsResult.Close()
+
+	channels := make([]IBCChannelRow, 0)
+	for rowsResult.Next() {
+		channel, err := channelsView.scan(rowsResult)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		channels = append(channels, *channel)
+	}
+
+	paginationResult, err := rDbPagination.Result()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error preparing pagination result: %v", err)
+	}
+
+	return channels, paginationResult, nil
+}
+
+func (channelsView *IBCChannels) selectStmtBuilder() sq.SelectBuilder {
+	return channelsView.rdb.StmtBuilder.Select(
+		"channel_id",
+		"port_id",
+		"connection_id",
+		"counterparty_port_id",
+		"counterparty_channel_id",
+		"state",
+		"ordering",
+		"version",
+		"created_block_height",
+		"last_updated_block_height",
+		"last_updated_block_time",
+		"last_updated_transaction_hash",
+	).From(
+		IBC_CHANNELS_TABLE,
+	)
+}
+
+func (channelsView *IBCChannels) scan(row rdb.RowResult) (*IBCChannelRow, error) {
+	var channel IBCChannelRow
+	lastUpdatedBlockTimeReader := channelsView.rdb.NtotReader()
+
+	if err := row.Scan(
+		&channel.ChannelID,
+		&channel.PortID,
+		&channel.ConnectionID,
+		&channel.CounterpartyPortID,
+		&channel.CounterpartyChannelID,
+		&channel.State,
+		&channel.Ordering,
+		&channel.Version,
+		&channel.CreatedBlockHeight,
+		&channel.LastUpdatedBlockHeight,
+		lastUpdatedBlockTimeReader.ScannableArg(),
+		&channel.LastUpdatedTransactionHash,
+	); err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			return nil, rdb.ErrNoRows
+		}
+		return nil, fmt.Errorf("error scanning IBC channel row: %v: %w", err, rdb.ErrQuery)
+	}
+
+	lastUpdatedBlockTime, err := lastUpdatedBlockTimeReader.Parse()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing IBC channel last updated block time: %v: %w", err, rdb.ErrQuery)
+	}
+	channel.LastUpdatedBlockTime = *lastUpdatedBlockTime
+
+	return &channel, nil
+}
+
+type IBCChannelsListFilter struct {
+	MaybeState *string
+}
+
+type IBCChannelRow struct {
+	ChannelID                  string          `json:"channelId"`
+	PortID                     string          `json:"portId"`
+	ConnectionID               string          `json:"connectionId"`
+	CounterpartyPortID         string          `json:"counterpartyPortId"`
+	CounterpartyChannelID      string          `json:"counterpartyChannelId"`
+	State                      string          `json:"state"`
+	Ordering                   string          `json:"ordering"`
+	Version                    string          `json:"version"`
+	CreatedBlockHeight         int64           `json:"createdBlockHeight"`
+	LastUpdatedBlockHeight     int64           `json:"lastUpdatedBlockHeight"`
+	LastUpdatedBlockTime       utctime.UTCTime `json:"lastUpdatedBlockTime"`
+	LastUpdatedTransactionHash string          `json:"lastUpdatedTransactionHash"`
+}
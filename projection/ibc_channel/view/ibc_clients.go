@@ -0,0 +1,199 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	pagination_interface "github.com/crypto-com/chain-indexing/appinterface/pagination"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+)
+
+const IBC_CLIENTS_TABLE = "view_ibc_clients"
+
+// IBCClients projection view of the IBC light clients created on the chain
+type IBCClients struct {
+	rdb *rdb.Handle
+}
+
+func NewIBCClients(handle *rdb.Handle) *IBCClients {
+	return &IBCClients{
+		handle,
+	}
+}
+
+// Upsert inserts the client or replaces the existing client of the same client id
+func (clientsView *IBCClients) Upsert(client *IBCClientRow) error {
+	sql, sqlArgs, err := clientsView.rdb.StmtBuilder.Insert(
+		IBC_CLIENTS_TABLE,
+	).Columns(
+		"client_id",
+		"client_type",
+		"counterparty_chain_id",
+		"trusting_period",
+		"latest_height_revision_number",
+		"latest_height_revision_height",
+		"frozen",
+		"created_block_height",
+		"last_updated_block_height",
+		"last_updated_block_time",
+		"last_updated_transaction_hash",
+	).Values(
+		client.ClientID,
+		client.ClientType,
+		client.CounterpartyChainID,
+		client.TrustingPeriod,
+		client.LatestHeightRevisionNumber,
+		client.LatestHeightRevisionHeight,
+		client.Frozen,
+		client.CreatedBlockHeight,
+		client.LastUpdatedBlockHeight,
+		clientsView.rdb.Tton(&client.LastUpdatedBlockTime),
+		client.LastUpdatedTransactionHash,
+	).Suffix(
+		"ON CONFLICT(client_id) DO UPDATE SET " +
+			"client_type = EXCLUDED.client_type, " +
+			"counterparty_chain_id = EXCLUDED.counterparty_chain_id, " +
+			"trusting_period = EXCLUDED.trusting_period, " +
+			"latest_height_revision_number = EXCLUDED.latest_height_revision_number, " +
+			"latest_height_revision_height = EXCLUDED.latest_height_revision_height, " +
+			"frozen = EXCLUDED.frozen, " +
+			"last_updated_block_height = EXCLUDED.last_updated_block_height, " +
+			"last_updated_block_time = EXCLUDED.last_updated_block_time, " +
+			"last_updated_transaction_hash = EXCLUDED.last_updated_transaction_hash",
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building IBC client upsert sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	result, err := clientsView.rdb.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error upserting IBC client into the table: %v: %w", err, rdb.ErrWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return fmt.Errorf("error upserting IBC client into the table: no rows upserted: %w", rdb.ErrWrite)
+	}
+
+	return nil
+}
+
+func (clientsView *IBCClients) FindBy(clientID string) (*IBCClientRow, error) {
+	sql, sqlArgs, err := clientsView.selectStmtBuilder().Where(
+		"client_id = ?", clientID,
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building IBC client selection sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	client, err := clientsView.scan(clientsView.rdb.QueryRow(sql, sqlArgs...))
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+func (clientsView *IBCClients) List(
+	pagination *pagination_interface.Pagination,
+) ([]IBCClientRow, *pagination_interface.PaginationResult, error) {
+	stmtBuilder := clientsView.selectStmtBuilder().OrderBy("created_block_height, client_id")
+
+	rDbPagination := rdb.NewRDbPaginationBuilder(
+		pagination,
+		clientsView.rdb,
+	).BuildStmt(stmtBuilder)
+	sql, sqlArgs, err := rDbPagination.ToStmtBuilder().ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error building IBC clients select SQL: %v, %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	rowsResult, err := clientsView.rdb.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error executing IBC clients select SQL: %v: %w", err, rdb.ErrQuery)
+	}
+	defer rowsResult.Close()
+
+	clients := make([]IBCClientRow, 0)
+	for rowsResult.Next() {
+		client, err := clientsView.scan(rowsResult)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		clients = append(clients, *client)
+	}
+
+	paginationResult, err := rDbPagination.Result()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error preparing pagination result: %v", err)
+	}
+
+	return clients, paginationResult, nil
+}
+
+func (clientsView *IBCClients) selectStmtBuilder() sq.SelectBuilder {
+	return clientsView.rdb.StmtBuilder.Select(
+		"client_id",
+		"client_type",
+		"counterparty_chain_id",
+		"trusting_period",
+		"latest_height_revision_number",
+		"latest_height_revision_height",
+		"frozen",
+		"created_block_height",
+		"last_updated_block_height",
+		"last_updated_block_time",
+		"last_updated_transaction_hash",
+	).From(
+		IBC_CLIENTS_TABLE,
+	)
+}
+
+func (clientsView *IBCClients) scan(row rdb.RowResult) (*IBCClientRow, error) {
+	var client IBCClientRow
+	lastUpdatedBlockTimeReader := clientsView.rdb.NtotReader()
+
+	if err := row.Scan(
+		&client.ClientID,
+		&client.ClientType,
+		&client.CounterpartyChainID,
+		&client.TrustingPeriod,
+		&client.LatestHeightRevisionNumber,
+		&client.LatestHeightRevisionHeight,
+		&client.Frozen,
+		&client.CreatedBlockHeight,
+		&client.LastUpdatedBlockHeight,
+		lastUpdatedBlockTimeReader.ScannableArg(),
+		&client.LastUpdatedTransactionHash,
+	); err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			return nil, rdb.ErrNoRows
+		}
+		return nil, fmt.Errorf("error scanning IBC client row: %v: %w", err, rdb.ErrQuery)
+	}
+
+	lastUpdatedBlockTime, err := lastUpdatedBlockTimeReader.Parse()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing IBC client last updated block time: %v: %w", err, rdb.ErrQuery)
+	}
+	client.LastUpdatedBlockTime = *lastUpdatedBlockTime
+
+	return &client, nil
+}
+
+type IBCClientRow struct {
+	ClientID            string `json:"clientId"`
+	ClientType          string `json:"clientType"`
+	CounterpartyChainID string `json:"counterpartyChainId"`
+	// Trusting period in nanoseconds
+	TrustingPeriod             int64           `json:"trustingPeriod,string"`
+	LatestHeightRevisionNumber uint64          `json:"latestHeightRevisionNumber,string"`
+	LatestHeightRevisionHeight uint64          `json:"latestHeightRevisionHeight,string"`
+	Frozen                     bool            `json:"frozen"`
+	CreatedBlockHeight         int64           `json:"createdBlockHeight"`
+	LastUpdatedBlockHeight     int64           `json:"lastUpdatedBlockHeight"`
+	LastUpdatedBlockTime       utctime.UTCTime `json:"lastUpdatedBlockTime"`
+	LastUpdatedTransactionHash string          `json:"lastUpdatedTransactionHash"`
+}
@@ -0,0 +1,143 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+)
+
+const IBC_CONNECTIONS_TABLE = "view_ibc_connections"
+
+const (
+	IBC_CONNECTION_STATE_INIT    = "STATE_INIT"
+	IBC_CONNECTION_STATE_TRYOPEN = "STATE_TRYOPEN"
+	IBC_CONNECTION_STATE_OPEN    = "STATE_OPEN"
+)
+
+// IBCConnections projection view of the IBC connections and their handshake state
+type IBCConnections struct {
+	rdb *rdb.Handle
+}
+
+func NewIBCConnections(handle *rdb.Handle) *IBCConnections {
+	return &IBCConnections{
+		handle,
+	}
+}
+
+// Upsert inserts the connection or replaces the existing connection of the same connection id
+func (connectionsView *IBCConnections) Upsert(connection *IBCConnectionRow) error {
+	sql, sqlArgs, err := connectionsView.rdb.StmtBuilder.Insert(
+		IBC_CONNECTIONS_TABLE,
+	).Columns(
+		"connection_id",
+		"client_id",
+		"counterparty_connection_id",
+		"counterparty_client_id",
+		"state",
+		"created_block_height",
+		"last_updated_block_height",
+		"last_updated_block_time",
+		"last_updated_transaction_hash",
+	).Values(
+		connection.ConnectionID,
+		connection.ClientID,
+		connection.CounterpartyConnectionID,
+		connection.CounterpartyClientID,
+		connection.State,
+		connection.CreatedBlockHeight,
+		connection.LastUpdatedBlockHeight,
+		connectionsView.rdb.Tton(&connection.LastUpdatedBlockTime),
+		connection.LastUpdatedTransactionHash,
+	).Suffix(
+		"ON CONFLICT(connection_id) DO UPDATE SET " +
+			"client_id = EXCLUDED.client_id, " +
+			"counterparty_connection_id = EXCLUDED.counterparty_connection_id, " +
+			"counterparty_client_id = EXCLUDED.counterparty_client_id, " +
+			"state = EXCLUDED.state, " +
+			"last_updated_block_height = EXCLUDED.last_updated_block_height, " +
+			"last_updated_block_time = EXCLUDED.last_updated_block_time, " +
+			"last_updated_transaction_hash = EXCLUDED.last_updated_transaction_hash",
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building IBC connection upsert sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	result, err := connectionsView.rdb.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error upserting IBC connection into the table: %v: %w", err, rdb.ErrWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return fmt.Errorf("error upserting IBC connection into the table: no rows upserted: %w", rdb.ErrWrite)
+	}
+
+	return nil
+}
+
+func (connectionsView *IBCConnections) FindBy(connectionID string) (*IBCConnectionRow, error) {
+	sql, sqlArgs, err := connectionsView.selectStmtBuilder().Where(
+		"connection_id = ?", connectionID,
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building IBC connection selection sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	var connection IBCConnectionRow
+	lastUpdatedBlockTimeReader := connectionsView.rdb.NtotReader()
+	if err = connectionsView.rdb.QueryRow(sql, sqlArgs...).Scan(
+		&connection.ConnectionID,
+		&connection.ClientID,
+		&connection.CounterpartyConnectionID,
+		&connection.CounterpartyClientID,
+		&connection.State,
+		&connection.CreatedBlockHeight,
+		&connection.LastUpdatedBlockHeight,
+		lastUpdatedBlockTimeReader.ScannableArg(),
+		&connection.LastUpdatedTransactionHash,
+	); err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			return nil, rdb.ErrNoRows
+		}
+		return nil, fmt.Errorf("error scanning IBC connection row: %v: %w", err, rdb.ErrQuery)
+	}
+
+	lastUpdatedBlockTime, err := lastUpdatedBlockTimeReader.Parse()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing IBC connection last updated block time: %v: %w", err, rdb.ErrQuery)
+	}
+	connection.LastUpdatedBlockTime = *lastUpdatedBlockTime
+
+	return &connection, nil
+}
+
+func (connectionsView *IBCConnections) selectStmtBuilder() sq.SelectBuilder {
+	return connectionsView.rdb.StmtBuilder.Select(
+		"connection_id",
+		"client_id",
+		"counterparty_connection_id",
+		"counterparty_client_id",
+		"state",
+		"created_block_height",
+		"last_updated_block_height",
+		"last_updated_block_time",
+		"last_updated_transaction_hash",
+	).From(
+		IBC_CONNECTIONS_TABLE,
+	)
+}
+
+type IBCConnectionRow struct {
+	ConnectionID               string          `json:"connectionId"`
+	ClientID                   string          `json:"clientId"`
+	CounterpartyConnectionID   string          `json:"counterpartyConnectionId"`
+	CounterpartyClientID       string          `json:"counterpartyClientId"`
+	State                      string          `json:"state"`
+	CreatedBlockHeight         int64           `json:"createdBlockHeight"`
+	LastUpdatedBlockHeight     int64           `json:"lastUpdatedBlockHeight"`
+	LastUpdatedBlockTime       utctime.UTCTime `json:"lastUpdatedBlockTime"`
+	LastUpdatedTransactionHash string          `json:"lastUpdatedTransactionHash"`
+}
@@ -0,0 +1,274 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	pagination_interface "github.com/crypto-com/chain-indexing/appinterface/pagination"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/internal/json"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	ibc_model "github.com/crypto-com/chain-indexing/usecase/model/ibc"
+)
+
+const IBC_PACKETS_TABLE = "view_ibc_packets"
+
+const (
+	// Packet sent from the channel to the counterparty chain
+	IBC_PACKET_DIRECTION_OUTGOING = "OUTGOING"
+	// Packet sent from the counterparty chain to the channel
+	IBC_PACKET_DIRECTION_INCOMING = "INCOMING"
+)
+
+const (
+	IBC_PACKET_STATUS_SENT         = "SENT"
+	IBC_PACKET_STATUS_RECEIVED     = "RECEIVED"
+	IBC_PACKET_STATUS_ACKNOWLEDGED = "ACKNOWLEDGED"
+	IBC_PACKET_STATUS_TIMED_OUT    = "TIMED_OUT"
+)
+
+// IBCPackets projection view of the packets going through the IBC channels. A packet is identified
+// by the channel end on this chain, its direction and its sequence
+type IBCPackets struct {
+	rdb *rdb.Handle
+}
+
+func NewIBCPackets(handle *rdb.Handle) *IBCPackets {
+	return &IBCPackets{
+		handle,
+	}
+}
+
+// Upsert inserts the packet or replaces the existing packet of the same channel, direction and
+// sequence
+func (packetsView *IBCPackets) Upsert(packet *IBCPacketRow) error {
+	var maybeFungibleTokenPacketData *string
+	if packet.MaybeFungibleTokenPacketData != nil {
+		data := json.MustMarshalToString(packet.MaybeFungibleTokenPacketData)
+		maybeFungibleTokenPacketData = &data
+	}
+
+	sql, sqlArgs, err := packetsView.rdb.StmtBuilder.Insert(
+		IBC_PACKETS_TABLE,
+	).Columns(
+		"channel_id",
+		"port_id",
+		"direction",
+		"sequence",
+		"counterparty_port_id",
+		"counterparty_channel_id",
+		"status",
+		"fungible_token_packet_data",
+		"sent_transaction_hash",
+		"received_transaction_hash",
+		"acknowledged_transaction_hash",
+		"acknowledgement_success",
+		"timed_out_transaction_hash",
+		"created_block_height",
+		"last_updated_block_height",
+		"last_updated_block_time",
+	).Values(
+		packet.ChannelID,
+		packet.PortID,
+		packet.Direction,
+		packet.Sequence,
+		packet.CounterpartyPortID,
+		packet.CounterpartyChannelID,
+		packet.Status,
+		maybeFungibleTokenPacketData,
+		packet.MaybeSentTransactionHash,
+		packet.MaybeReceivedTransactionHash,
+		packet.MaybeAcknowledgedTransactionHash,
+		packet.MaybeAcknowledgementSuccess,
+		packet.MaybeTimedOutTransactionHash,
+		packet.CreatedBlockHeight,
+		packet.LastUpdatedBlockHeight,
+		packetsView.rdb.Tton(&packet.LastUpdatedBlockTime),
+	).Suffix(
+		"ON CONFLICT(channel_id, port_id, direction, sequence) DO UPDATE SET " +
+			"counterparty_port_id = EXCLUDED.counterparty_port_id, " +
+			"counterparty_channel_id = EXCLUDED.counterparty_channel_id, " +
+			"status = EXCLUDED.status, " +
+			"fungible_token_packet_data = EXCLUDED.fungible_token_packet_data, " +
+			"sent_transaction_hash = EXCLUDED.sent_transaction_hash, " +
+			"received_transaction_hash = EXCLUDED.received_transaction_hash, " +
+			"acknowledged_transaction_hash = EXCLUDED.acknowledged_transaction_hash, " +
+			"acknowledgement_success = EXCLUDED.acknowledgement_success, " +
+			"timed_out_transaction_hash = EXCLUDED.timed_out_transaction_hash, " +
+			"last_updated_block_height = EXCLUDED.last_updated_block_height, " +
+			"last_updated_block_time = EXCLUDED.last_updated_block_time",
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building IBC packet upsert sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	result, err := packetsView.rdb.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error upserting IBC packet into the table: %v: %w", err, rdb.ErrWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return fmt.Errorf("error upserting IBC packet into the table: no rows upserted: %w", rdb.ErrWrite)
+	}
+
+	return nil
+}
+
+func (packetsView *IBCPackets) FindBy(
+	portID string,
+	channelID string,
+	direction string,
+	sequence uint64,
+) (*IBCPacketRow, error) {
+	sql, sqlArgs, err := packetsView.selectStmtBuilder().Where(
+		"port_id = ? AND channel_id = ? AND direction = ? AND sequence = ?", portID, channelID, direction, sequence,
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building IBC packet selection sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	packet, err := packetsView.scan(packetsView.rdb.QueryRow(sql, sqlArgs...))
+	if err != nil {
+		return nil, err
+	}
+
+	return packet, nil
+}
+
+func (packetsView *IBCPackets) List(
+	filter IBCPacketsListFilter,
+	pagination *pagination_interface.Pagination,
+) ([]IBCPacketRow, *pagination_interface.PaginationResult, error) {
+	stmtBuilder := packetsView.selectStmtBuilder().Where("channel_id = ?", filter.ChannelID)
+	if filter.MaybeDirection != nil {
+		stmtBuilder = stmtBuilder.Where("direction = ?", *filter.MaybeDirection)
+	}
+	if filter.MaybeStatus != nil {
+		stmtBuilder = stmtBuilder.Where("status = ?", *filter.MaybeStatus)
+	}
+	stmtBuilder = stmtBuilder.OrderBy("created_block_height DESC, sequence DESC")
+
+	rDbPagination := rdb.NewRDbPaginationBuilder(
+		pagination,
+		packetsView.rdb,
+	).BuildStmt(stmtBuilder)
+	sql, sqlArgs, err := rDbPagination.ToStmtBuilder().ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error building IBC packets select SQL: %v, %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	rowsResult, err := packetsView.rdb.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error executing IBC packets select SQL: %v: %w", err, rdb.ErrQuery)
+	}
+	defer rowsResult.Close()
+
+	packets := make([]IBCPacketRow, 0)
+	for rowsResult.Next() {
+		packet, err := packetsView.scan(rowsResult)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		packets = append(packets, *packet)
+	}
+
+	paginationResult, err := rDbPagination.Result()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error preparing pagination result: %v", err)
+	}
+
+	return packets, paginationResult, nil
+}
+
+func (packetsView *IBCPackets) selectStmtBuilder() sq.SelectBuilder {
+	return packetsView.rdb.StmtBuilder.Select(
+		"channel_id",
+		"port_id",
+		"direction",
+		"sequence",
+		"counterparty_port_id",
+		"counterparty_channel_id",
+		"status",
+		"fungible_token_packet_data",
+		"sent_transaction_hash",
+		"received_transaction_hash",
+		"acknowledged_transaction_hash",
+		"acknowledgement_success",
+		"timed_out_transaction_hash",
+		"created_block_height",
+		"last_updated_block_height",
+		"last_updated_block_time",
+	).From(
+		IBC_PACKETS_TABLE,
+	)
+}
+
+func (packetsView *IBCPackets) scan(row rdb.RowResult) (*IBCPacketRow, error) {
+	var packet IBCPacketRow
+	var maybeFungibleTokenPacketData *string
+	lastUpdatedBlockTimeReader := packetsView.rdb.NtotReader()
+
+	if err := row.Scan(
+		&packet.ChannelID,
+		&packet.PortID,
+		&packet.Direction,
+		&packet.Sequence,
+		&packet.CounterpartyPortID,
+		&packet.CounterpartyChannelID,
+		&packet.Status,
+		&maybeFungibleTokenPacketData,
+		&packet.MaybeSentTransactionHash,
+		&packet.MaybeReceivedTransactionHash,
+		&packet.MaybeAcknowledgedTransactionHash,
+		&packet.MaybeAcknowledgementSuccess,
+		&packet.MaybeTimedOutTransactionHash,
+		&packet.CreatedBlockHeight,
+		&packet.LastUpdatedBlockHeight,
+		lastUpdatedBlockTimeReader.ScannableArg(),
+	); err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			return nil, rdb.ErrNoRows
+		}
+		return nil, fmt.Errorf("error scanning IBC packet row: %v: %w", err, rdb.ErrQuery)
+	}
+
+	if maybeFungibleTokenPacketData != nil {
+		var data ibc_model.FungibleTokenPacketData
+		json.MustUnmarshalFromString(*maybeFungibleTokenPacketData, &data)
+		packet.MaybeFungibleTokenPacketData = &data
+	}
+	lastUpdatedBlockTime, err := lastUpdatedBlockTimeReader.Parse()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing IBC packet last updated block time: %v: %w", err, rdb.ErrQuery)
+	}
+	packet.LastUpdatedBlockTime = *lastUpdatedBlockTime
+
+	return &packet, nil
+}
+
+type IBCPacketsListFilter struct {
+	ChannelID      string
+	MaybeDirection *string
+	MaybeStatus    *string
+}
+
+type IBCPacketRow struct {
+	ChannelID                        string                             `json:"channelId"`
+	PortID                           string                             `json:"portId"`
+	Direction                        string                             `json:"direction"`
+	Sequence                         uint64                             `json:"sequence,string"`
+	CounterpartyPortID               string                             `json:"counterpartyPortId"`
+	CounterpartyChannelID            string                             `json:"counterpartyChannelId"`
+	Status                           string                             `json:"status"`
+	MaybeFungibleTokenPacketData     *ibc_model.FungibleTokenPacketData `json:"fungibleTokenPacketData"`
+	MaybeSentTransactionHash         *string                            `json:"sentTransactionHash"`
+	MaybeReceivedTransactionHash     *string                            `json:"receivedTransactionHash"`
+	MaybeAcknowledgedTransactionHash *string                            `json:"acknowledgedTransactionHash"`
+	MaybeAcknowledgementSuccess      *bool                              `json:"acknowledgementSuccess"`
+	MaybeTimedOutTransactionHash     *string                            `json:"timedOutTransactionHash"`
+	CreatedBlockHeight               int64                              `json:"createdBlockHeight"`
+	LastUpdatedBlockHeight           int64                              `json:"lastUpdatedBlockHeight"`
+	LastUpdatedBlockTime             utctime.UTCTime                    `json:"lastUpdatedBlockTime"`
+}
@@ -0,0 +1,123 @@
+package ibc_denom_trace
+
+import (
+	"fmt"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/rdbprojectionbase"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	event_entity "github.com/crypto-com/chain-indexing/entity/event"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	"github.com/crypto-com/chain-indexing/projection/ibc_denom_trace/view"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+)
+
+var _ rdbprojectionbase.Rebuildable = &IBCDenomTrace{}
+
+// IBCDenomTrace projects the traces of ICS-20 voucher denominations, i.e. ibc/{hash}, from the
+// fungible token packets received by the chain, and from the acknowledged and timed out packets
+// sending vouchers out of the chain
+type IBCDenomTrace struct {
+	*rdbprojectionbase.Base
+
+	rdbConn rdb.Conn
+	logger  applogger.Logger
+}
+
+func NewIBCDenomTrace(logger applogger.Logger, rdbConn rdb.Conn) *IBCDenomTrace {
+	return &IBCDenomTrace{
+		rdbprojectionbase.NewRDbBase(rdbConn.ToHandle(), "IBCDenomTrace"),
+
+		rdbConn,
+		logger,
+	}
+}
+
+func (_ *IBCDenomTrace) GetEventsToListen() []string {
+	return []string{
+		event_usecase.MSG_IBC_RECV_PACKET_CREATED,
+		event_usecase.MSG_IBC_ACKNOWLEDGEMENT_CREATED,
+		event_usecase.MSG_IBC_TIMEOUT_CREATED,
+		event_usecase.MSG_IBC_TIMEOUT_ON_CLOSE_CREATED,
+	}
+}
+
+func (_ *IBCDenomTrace) GetViewTables() []string {
+	return []string{
+		view.IBC_DENOM_TRACES_TABLE,
+	}
+}
+
+func (projection *IBCDenomTrace) OnInit() error {
+	return nil
+}
+
+func (projection *IBCDenomTrace) HandleEvents(height int64, events []event_entity.Event) error {
+	var err error
+
+	rdbTx, err := projection.rdbConn.Begin()
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %v", err)
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			_ = rdbTx.Rollback()
+		}
+	}()
+
+	rdbTxHandle := rdbTx.ToHandle()
+	tracesView := view.NewIBCDenomTraces(rdbTxHandle)
+
+	for _, event := range events {
+		var maybeTrace *coin.DenomTrace
+
+		if typedEvent, ok := event.(*event_usecase.MsgIBCRecvPacket); ok {
+			data := typedEvent.Params.MaybeFungibleTokenPacketData
+			// Voucher is minted only when the packet is received successfully
+			if data != nil && data.Success {
+				packet := typedEvent.Params.Packet
+				trace := coin.ReceivedDenomTrace(
+					packet.SourcePort, packet.SourceChannel,
+					packet.DestinationPort, packet.DestinationChannel,
+					data.Denom,
+				)
+				maybeTrace = &trace
+			}
+		} else if typedEvent, ok := event.(*event_usecase.MsgIBCAcknowledgement); ok {
+			// Packet data of voucher sent out of the chain carries its full denom path
+			if data := typedEvent.Params.MaybeFungibleTokenPacketData; data != nil {
+				trace := coin.ParseDenomTrace(data.Denom)
+				maybeTrace = &trace
+			}
+		} else if typedEvent, ok := event.(*event_usecase.MsgIBCTimeout); ok {
+			if transfer := typedEvent.Params.MaybeMsgTransfer; transfer != nil {
+				trace := coin.ParseDenomTrace(transfer.RefundDenom)
+				maybeTrace = &trace
+			}
+		} else if typedEvent, ok := event.(*event_usecase.MsgIBCTimeoutOnClose); ok {
+			if transfer := typedEvent.Params.MaybeMsgTransfer; transfer != nil {
+				trace := coin.ParseDenomTrace(transfer.RefundDenom)
+				maybeTrace = &trace
+			}
+		}
+
+		if maybeTrace == nil || maybeTrace.IsNative() {
+			continue
+		}
+		if err = tracesView.Insert(view.NewIBCDenomTraceRow(*maybeTrace, height)); err != nil {
+			return fmt.Errorf("error inserting IBC denom trace: %v", err)
+		}
+	}
+
+	if err = projection.UpdateLastHandledEventHeight(rdbTxHandle, height); err != nil {
+		return fmt.Errorf("error updating last handled event height: %v", err)
+	}
+
+	if err = rdbTx.Commit(); err != nil {
+		return fmt.Errorf("error committing changes: %v", err)
+	}
+	committed = true
+	return nil
+}
@@ -0,0 +1,13 @@
+package ibc_denom_trace_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestIBCDenomTrace(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "IBCDenomTrace Suite")
+}
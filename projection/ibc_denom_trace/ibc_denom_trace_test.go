@@ -0,0 +1,249 @@
+package ibc_denom_trace_test
+
+import (
+	"errors"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	. "github.com/crypto-com/chain-indexing/appinterface/rdb/test"
+	event_entity "github.com/crypto-com/chain-indexing/entity/event"
+	entity_projection "github.com/crypto-com/chain-indexing/entity/projection"
+	"github.com/crypto-com/chain-indexing/infrastructure/pg"
+	. "github.com/crypto-com/chain-indexing/internal/logger/test"
+	"github.com/crypto-com/chain-indexing/projection/ibc_denom_trace"
+	"github.com/crypto-com/chain-indexing/projection/ibc_denom_trace/view"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+	ibc_model "github.com/crypto-com/chain-indexing/usecase/model/ibc"
+)
+
+var _ = Describe("IBCDenomTrace", func() {
+	anyHeight := int64(100)
+	msgCommonParams := event_usecase.MsgCommonParams{
+		BlockHeight: anyHeight,
+		TxHash:      "4936522F7391D425F2A93AD47576F8AEC3947DC907113BE8A2FBCFF8E9F2A416",
+		TxSuccess:   true,
+		MsgIndex:    0,
+	}
+	voucherTrace := coin.DenomTrace{
+		Path:      "transfer/channel-0",
+		BaseDenom: "uatom",
+	}
+
+	newMsgIBCRecvPacket := func(denom string, success bool) *event_usecase.MsgIBCRecvPacket {
+		return event_usecase.NewMsgIBCRecvPacket(msgCommonParams, ibc_model.MsgRecvPacketParams{
+			RawMsgRecvPacket: ibc_model.RawMsgRecvPacket{
+				Packet: ibc_model.Packet{
+					Sequence:           "3",
+					SourcePort:         "transfer",
+					SourceChannel:      "channel-9",
+					DestinationPort:    "transfer",
+					DestinationChannel: "channel-0",
+				},
+			},
+			MaybeFungibleTokenPacketData: &ibc_model.MsgRecvPacketFungibleTokenPacketData{
+				FungibleTokenPacketData: ibc_model.FungibleTokenPacketData{
+					Denom:  denom,
+					Amount: 1234,
+				},
+				Success: success,
+			},
+			PacketSequence: 3,
+		})
+	}
+
+	It("should implement projection", func() {
+		var _ entity_projection.Projection = ibc_denom_trace.NewIBCDenomTrace(NewFakeLogger(), NewFakeRDbConn())
+	})
+
+	Describe("HandleEvents", func() {
+		var mockRDb *mockIBCDenomTraceRDb
+		BeforeEach(func() {
+			mockRDb = newMockIBCDenomTraceRDb()
+		})
+
+		It("should insert the trace of the voucher minted by the received packet", func() {
+			err := mockRDb.handleEvents(anyHeight, newMsgIBCRecvPacket("uatom", true))
+			Expect(err).To(BeNil())
+
+			Expect(mockRDb.insertArgs(view.IBC_DENOM_TRACES_TABLE)).To(Equal([][]interface{}{
+				{voucherTrace.Hash(), "transfer/channel-0", "uatom", anyHeight},
+			}))
+			Expect(mockRDb.insertArgs("projections")).To(Equal([][]interface{}{
+				{"IBCDenomTrace", anyHeight},
+			}))
+			Expect(mockRDb.committed()).To(BeTrue())
+		})
+
+		It("should not insert trace when the received packet returns the native token", func() {
+			err := mockRDb.handleEvents(anyHeight, newMsgIBCRecvPacket("transfer/channel-9/basecro", true))
+			Expect(err).To(BeNil())
+
+			Expect(mockRDb.insertArgs(view.IBC_DENOM_TRACES_TABLE)).To(BeEmpty())
+			Expect(mockRDb.insertArgs("projections")).To(Equal([][]interface{}{
+				{"IBCDenomTrace", anyHeight},
+			}))
+			Expect(mockRDb.committed()).To(BeTrue())
+		})
+
+		It("should not insert trace when the received packet fails", func() {
+			err := mockRDb.handleEvents(anyHeight, newMsgIBCRecvPacket("uatom", false))
+			Expect(err).To(BeNil())
+
+			Expect(mockRDb.insertArgs(view.IBC_DENOM_TRACES_TABLE)).To(BeEmpty())
+			Expect(mockRDb.committed()).To(BeTrue())
+		})
+
+		It("should insert the trace of the voucher sent out of the chain from the acknowledgement", func() {
+			err := mockRDb.handleEvents(anyHeight, event_usecase.NewMsgIBCAcknowledgement(
+				msgCommonParams,
+				ibc_model.MsgAcknowledgementParams{
+					MaybeFungibleTokenPacketData: &ibc_model.MsgAcknowledgementFungibleTokenPacketData{
+						FungibleTokenPacketData: ibc_model.FungibleTokenPacketData{
+							Denom:  "transfer/channel-0/uatom",
+							Amount: 1234,
+						},
+						Success: true,
+					},
+				},
+			))
+			Expect(err).To(BeNil())
+
+			Expect(mockRDb.insertArgs(view.IBC_DENOM_TRACES_TABLE)).To(Equal([][]interface{}{
+				{voucherTrace.Hash(), "transfer/channel-0", "uatom", anyHeight},
+			}))
+			Expect(mockRDb.committed()).To(BeTrue())
+		})
+
+		It("should insert the traces of the vouchers refunded by timeout and timeout on close", func() {
+			err := mockRDb.handleEvents(
+				anyHeight,
+				event_usecase.NewMsgIBCTimeout(msgCommonParams, ibc_model.MsgTimeoutParams{
+					MaybeMsgTransfer: &ibc_model.MsgTimeoutMsgTransfer{
+						RefundDenom:  "transfer/channel-0/uatom",
+						RefundAmount: 1234,
+					},
+				}),
+				event_usecase.NewMsgIBCTimeoutOnClose(msgCommonParams, ibc_model.MsgTimeoutOnCloseParams{
+					MaybeMsgTransfer: &ibc_model.MsgTimeoutMsgTransfer{
+						RefundDenom:  "transfer/channel-1/uosmo",
+						RefundAmount: 1234,
+					},
+				}),
+			)
+			Expect(err).To(BeNil())
+
+			anotherVoucherTrace := coin.DenomTrace{
+				Path:      "transfer/channel-1",
+				BaseDenom: "uosmo",
+			}
+			Expect(mockRDb.insertArgs(view.IBC_DENOM_TRACES_TABLE)).To(Equal([][]interface{}{
+				{voucherTrace.Hash(), "transfer/channel-0", "uatom", anyHeight},
+				{anotherVoucherTrace.Hash(), "transfer/channel-1", "uosmo", anyHeight},
+			}))
+			Expect(mockRDb.committed()).To(BeTrue())
+		})
+
+		It("should roll back without committing when the view fails", func() {
+			mockRDb.tx.On(
+				"Exec", append([]interface{}{insertInto(view.IBC_DENOM_TRACES_TABLE)}, anyArgs(4)...)...,
+			).Return(nil, errors.New("connection reset"))
+
+			err := mockRDb.handleEvents(anyHeight, newMsgIBCRecvPacket("uatom", true))
+			Expect(err).NotTo(BeNil())
+
+			Expect(mockRDb.committed()).To(BeFalse())
+			Expect(mockRDb.rolledBack()).To(BeTrue())
+		})
+	})
+})
+
+// mockIBCDenomTraceRDb mocks the database transaction of the projection. Rows are not found,
+// statements are always executed successfully unless stubbed, and all calls are recorded by the
+// transaction mock.
+type mockIBCDenomTraceRDb struct {
+	tx         *MockRDbTx
+	projection *ibc_denom_trace.IBCDenomTrace
+}
+
+func newMockIBCDenomTraceRDb() *mockIBCDenomTraceRDb {
+	conn := NewMockRDBbConn()
+	tx := &MockRDbTx{}
+	conn.On("ToHandle").Return(&rdb.Handle{
+		Runner:      conn,
+		TypeConv:    &pg.PgxTypeConv{},
+		StmtBuilder: pg.PostgresStmtBuilder,
+	})
+	conn.On("Begin").Return(tx, nil)
+	tx.On("ToHandle").Return(&rdb.Handle{
+		Runner:      tx,
+		TypeConv:    &pg.PgxTypeConv{},
+		StmtBuilder: pg.PostgresStmtBuilder,
+	})
+	tx.On("Commit").Return(nil)
+	tx.On("Rollback").Return(nil)
+
+	return &mockIBCDenomTraceRDb{
+		tx:         tx,
+		projection: ibc_denom_trace.NewIBCDenomTrace(NewFakeLogger(), conn),
+	}
+}
+
+func (db *mockIBCDenomTraceRDb) handleEvents(height int64, events ...event_entity.Event) error {
+	notFoundRow := &MockRDbRowResult{}
+	execResult := &MockRDbExecResult{}
+	execResult.On("RowsAffected").Return(int64(1))
+	for argCount := 0; argCount <= 4; argCount++ {
+		notFoundRow.On("Scan", anyArgs(argCount)...).Return(rdb.ErrNoRows)
+		db.tx.On("QueryRow", append([]interface{}{mock.Anything}, anyArgs(argCount)...)...).Return(notFoundRow)
+		db.tx.On("Exec", append([]interface{}{mock.Anything}, anyArgs(argCount)...)...).Return(execResult, nil)
+	}
+
+	return db.projection.HandleEvents(height, events)
+}
+
+// insertArgs returns the values of every row insertion into the table
+func (db *mockIBCDenomTraceRDb) insertArgs(table string) [][]interface{} {
+	result := make([][]interface{}, 0)
+	for _, call := range db.tx.Calls {
+		if call.Method == "Exec" && strings.HasPrefix(call.Arguments.String(0), "INSERT INTO "+table+" ") {
+			result = append(result, []interface{}(call.Arguments[1:]))
+		}
+	}
+	return result
+}
+
+func (db *mockIBCDenomTraceRDb) committed() bool {
+	return db.called("Commit")
+}
+
+func (db *mockIBCDenomTraceRDb) rolledBack() bool {
+	return db.called("Rollback")
+}
+
+func (db *mockIBCDenomTraceRDb) called(method string) bool {
+	for _, call := range db.tx.Calls {
+		if call.Method == method {
+			return true
+		}
+	}
+	return false
+}
+
+func insertInto(table string) interface{} {
+	return mock.MatchedBy(func(sql string) bool {
+		return strings.HasPrefix(sql, "INSERT INTO "+table+" ")
+	})
+}
+
+func anyArgs(count int) []interface{} {
+	args := make([]interface{}, count)
+	for i := range args {
+		args[i] = mock.Anything
+	}
+	return args
+}
@@ -0,0 +1,176 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	pagination_interface "github.com/crypto-com/chain-indexing/appinterface/pagination"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+)
+
+const IBC_DENOM_TRACES_TABLE = "view_ibc_denom_traces"
+
+// IBCDenomTraces projection view of the traces of ICS-20 voucher denominations seen on the chain
+type IBCDenomTraces struct {
+	rdb *rdb.Handle
+}
+
+func NewIBCDenomTraces(handle *rdb.Handle) *IBCDenomTraces {
+	return &IBCDenomTraces{
+		handle,
+	}
+}
+
+// Insert inserts the denom trace. It is a no-op when the trace of the same hash already exists
+func (tracesView *IBCDenomTraces) Insert(trace *IBCDenomTraceRow) error {
+	sql, sqlArgs, err := tracesView.rdb.StmtBuilder.Insert(
+		IBC_DENOM_TRACES_TABLE,
+	).Columns(
+		"hash",
+		"path",
+		"base_denom",
+		"created_block_height",
+	).Values(
+		trace.Hash,
+		trace.Path,
+		trace.BaseDenom,
+		trace.CreatedBlockHeight,
+	).Suffix("ON CONFLICT(hash) DO NOTHING").ToSql()
+	if err != nil {
+		return fmt.Errorf("error building IBC denom trace insertion sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	if _, err = tracesView.rdb.Exec(sql, sqlArgs...); err != nil {
+		return fmt.Errorf("error inserting IBC denom trace into the table: %v: %w", err, rdb.ErrWrite)
+	}
+
+	return nil
+}
+
+// FindAllByHashes returns the denom traces of the hashes keyed by hash. Hashes without trace are
+// absent from the result
+func (tracesView *IBCDenomTraces) FindAllByHashes(hashes []string) (map[string]IBCDenomTraceRow, error) {
+	traces := make(map[string]IBCDenomTraceRow)
+	if len(hashes) == 0 {
+		return traces, nil
+	}
+
+	sql, sqlArgs, err := tracesView.rdb.StmtBuilder.Select(
+		"hash",
+		"path",
+		"base_denom",
+		"created_block_height",
+	).From(
+		IBC_DENOM_TRACES_TABLE,
+	).Where(
+		sq.Eq{"hash": hashes},
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building IBC denom traces select SQL: %v, %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	rowsResult, err := tracesView.rdb.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("error executing IBC denom traces select SQL: %v: %w", err, rdb.ErrQuery)
+	}
+	defer rowsResult.Close()
+
+	for rowsResult.Next() {
+		var trace IBCDenomTraceRow
+		if err = rowsResult.Scan(
+			&trace.Hash,
+			&trace.Path,
+			&trace.BaseDenom,
+			&trace.CreatedBlockHeight,
+		); err != nil {
+			if errors.Is(err, rdb.ErrNoRows) {
+				return nil, rdb.ErrNoRows
+			}
+			return nil, fmt.Errorf("error scanning IBC denom trace row: %v: %w", err, rdb.ErrQuery)
+		}
+
+		traces[trace.Hash] = trace
+	}
+
+	return traces, nil
+}
+
+func (tracesView *IBCDenomTraces) List(
+	pagination *pagination_interface.Pagination,
+) ([]IBCDenomTraceRow, *pagination_interface.PaginationResult, error) {
+	stmtBuilder := tracesView.rdb.StmtBuilder.Select(
+		"hash",
+		"path",
+		"base_denom",
+		"created_block_height",
+	).From(
+		IBC_DENOM_TRACES_TABLE,
+	).OrderBy("created_block_height, hash")
+
+	rDbPagination := rdb.NewRDbPaginationBuilder(
+		pagination,
+		tracesView.rdb,
+	).BuildStmt(stmtBuilder)
+	sql, sqlArgs, err := rDbPagination.ToStmtBuilder().ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error building IBC denom traces select SQL: %v, %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	rowsResult, err := tracesView.rdb.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error executing IBC denom traces select SQL: %v: %w", err, rdb.ErrQuery)
+	}
+	defer rowsResult.Close()
+
+	traces := make([]IBCDenomTraceRow, 0)
+	for rowsResult.Next() {
+		var trace IBCDenomTraceRow
+		if err = rowsResult.Scan(
+			&trace.Hash,
+			&trace.Path,
+			&trace.BaseDenom,
+			&trace.CreatedBlockHeight,
+		); err != nil {
+			if errors.Is(err, rdb.ErrNoRows) {
+				return nil, nil, rdb.ErrNoRows
+			}
+			return nil, nil, fmt.Errorf("error scanning IBC denom trace row: %v: %w", err, rdb.ErrQuery)
+		}
+
+		traces = append(traces, trace)
+	}
+
+	paginationResult, err := rDbPagination.Result()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error preparing pagination result: %v", err)
+	}
+
+	return traces, paginationResult, nil
+}
+
+type IBCDenomTraceRow struct {
+	Hash               string `json:"hash"`
+	Path               string `json:"path"`
+	BaseDenom          string `json:"baseDenom"`
+	CreatedBlockHeight int64  `json:"createdBlockHeight"`
+}
+
+func NewIBCDenomTraceRow(trace coin.DenomTrace, createdBlockHeight int64) *IBCDenomTraceRow {
+	return &IBCDenomTraceRow{
+		Hash:               trace.Hash(),
+		Path:               trace.Path,
+		BaseDenom:          trace.BaseDenom,
+		CreatedBlockHeight: createdBlockHeight,
+	}
+}
+
+// DenomTrace returns the trace of the row
+func (row IBCDenomTraceRow) DenomTrace() coin.DenomTrace {
+	return coin.DenomTrace{
+		Path:      row.Path,
+		BaseDenom: row.BaseDenom,
+	}
+}
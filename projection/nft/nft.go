@@ -16,6 +16,7 @@ import (
 )
 
 var _ projection_entity.Projection = &NFT{}
+var _ rdbprojectionbase.Rebuildable = &NFT{}
 
 const DO_NOT_MODIFY = "[do-not-modify]"
 
@@ -58,6 +59,17 @@ func (nft *NFT) GetEventsToListen() []string {
 	}
 }
 
+func (nft *NFT) GetViewTables() []string {
+	return []string{
+		view.DENOMS_TABLE_NAME,
+		view.DENOMS_TOTAL_TABLE_NAME,
+		view.TOKENS_TABLE_NAME,
+		view.TOKENS_TOTAL_TABLE_NAME,
+		view.MESSAGES_TABLE_NAME,
+		"view_nft_messages_total",
+	}
+}
+
 func (nft *NFT) OnInit() error {
 	return nil
 }
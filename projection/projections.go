@@ -12,13 +12,19 @@ import (
 	"github.com/crypto-com/chain-indexing/projection/account"
 	"github.com/crypto-com/chain-indexing/projection/account_message"
 	"github.com/crypto-com/chain-indexing/projection/account_transaction"
+	"github.com/crypto-com/chain-indexing/projection/authzgrant"
 	"github.com/crypto-com/chain-indexing/projection/block"
 	"github.com/crypto-com/chain-indexing/projection/blockevent"
+	"github.com/crypto-com/chain-indexing/projection/ethereum_transaction"
+	"github.com/crypto-com/chain-indexing/projection/feegrant"
+	"github.com/crypto-com/chain-indexing/projection/ibc_channel"
+	"github.com/crypto-com/chain-indexing/projection/ibc_denom_trace"
 	"github.com/crypto-com/chain-indexing/projection/nft"
 	"github.com/crypto-com/chain-indexing/projection/proposal"
 	"github.com/crypto-com/chain-indexing/projection/transaction"
 	"github.com/crypto-com/chain-indexing/projection/validator"
 	"github.com/crypto-com/chain-indexing/projection/validatorstats"
+	"github.com/crypto-com/chain-indexing/projection/webhook"
 )
 
 func InitProjection(name string, params InitParams) projection_entity.Projection {
@@ -29,12 +35,22 @@ func InitProjection(name string, params InitParams) projection_entity.Projection
 		return account_transaction.NewAccountTransaction(params.Logger, params.RdbConn, params.AccountAddressPrefix)
 	case "AccountMessage":
 		return account_message.NewAccountMessage(params.Logger, params.RdbConn, params.AccountAddressPrefix)
+	case "AuthzGrant":
+		return authzgrant.NewAuthzGrant(params.Logger, params.RdbConn)
 	case "Block":
 		return block.NewBlock(params.Logger, params.RdbConn)
 	case "BlockEvent":
 		return blockevent.NewBlockEvent(params.Logger, params.RdbConn)
 	case "ChainStats":
 		return chainstats.NewChainStats(params.Logger, params.RdbConn)
+	case "EthereumTransaction":
+		return ethereum_transaction.NewEthereumTransaction(params.Logger, params.RdbConn)
+	case "FeeGrant":
+		return feegrant.NewFeeGrant(params.Logger, params.RdbConn)
+	case "IBCChannel":
+		return ibc_channel.NewIBCChannel(params.Logger, params.RdbConn)
+	case "IBCDenomTrace":
+		return ibc_denom_trace.NewIBCDenomTrace(params.Logger, params.RdbConn)
 	case "Proposal":
 		return proposal.NewProposal(params.Logger, params.RdbConn, params.ConsNodeAddressPrefix)
 	case "Transaction":
@@ -45,6 +61,8 @@ func InitProjection(name string, params InitParams) projection_entity.Projection
 		)
 	case "ValidatorStats":
 		return validatorstats.NewValidatorStats(params.Logger, params.RdbConn)
+	case "Webhook":
+		return webhook.NewWebhook(params.Logger, params.RdbConn)
 	case "NFT":
 		return nft.NewNFT(params.Logger, params.RdbConn, nft.Config{
 			EnableDrop:       false,
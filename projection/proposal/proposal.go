@@ -23,6 +23,7 @@ import (
 )
 
 var _ projection_entity.Projection = &Proposal{}
+var _ rdbprojectionbase.Rebuildable = &Proposal{}
 
 type Proposal struct {
 	*rdbprojectionbase.Base
@@ -85,6 +86,18 @@ func (proposal *Proposal) GetEventsToListen() []string {
 	)
 }
 
+func (_ *Proposal) GetViewTables() []string {
+	return []string{
+		view.PROPOSALS_TABLE_NAME,
+		view.PARAMS_TABLE_NAME,
+		view.VALIDATORS_TABLE_NAME,
+		view.VOTES_TABLE_NAME,
+		view.VOTES_TOTAL_TABLE_NAME,
+		view.DEPOSITORS_TABLE_NAME,
+		view.DEPOSITORS_TOTAL_TABLE_NAME,
+	}
+}
+
 func (_ *Proposal) OnInit() error {
 	return nil
 }
@@ -17,6 +17,7 @@ import (
 )
 
 var _ projection_entity.Projection = &Transaction{}
+var _ rdbprojectionbase.Rebuildable = &Transaction{}
 
 type Transaction struct {
 	*rdbprojectionbase.Base
@@ -39,9 +40,19 @@ func (_ *Transaction) GetEventsToListen() []string {
 		event_usecase.BLOCK_CREATED,
 		event_usecase.TRANSACTION_CREATED,
 		event_usecase.TRANSACTION_FAILED,
+		event_usecase.TRANSACTION_UNDECODABLE,
 	}, event_usecase.MSG_EVENTS...)
 }
 
+func (_ *Transaction) GetViewTables() []string {
+	return []string{
+		"view_transactions",
+		"view_transactions_total",
+		transaction_view.UNKNOWN_MSGS_TOTAL_TABLE,
+		transaction_view.UNDECODABLE_TRANSACTIONS_TABLE,
+	}
+}
+
 func (projection *Transaction) OnInit() error {
 	return nil
 }
@@ -62,11 +73,15 @@ func (projection *Transaction) HandleEvents(height int64, events []event_entity.
 	rdbTxHandle := rdbTx.ToHandle()
 	transactionsView := transaction_view.NewTransactions(rdbTxHandle)
 	transactionsTotalView := transaction_view.NewTransactionsTotal(rdbTxHandle)
+	unknownMsgsTotalView := transaction_view.NewUnknownMsgsTotal(rdbTxHandle)
+	undecodableTransactionsView := transaction_view.NewUndecodableTransactions(rdbTxHandle)
 
 	var blockTime utctime.UTCTime
 	var blockHash string
 	txs := make([]transaction_view.TransactionRow, 0)
+	undecodableTxs := make([]transaction_view.UndecodableTransactionRow, 0)
 	txMsgs := make(map[string][]event_usecase.MsgEvent)
+	unknownMsgTypeURLs := make([]string, 0)
 	for _, event := range events {
 		if blockCreatedEvent, ok := event.(*event_usecase.BlockCreated); ok {
 			blockTime = blockCreatedEvent.Block.Time
@@ -136,14 +151,37 @@ func (projection *Transaction) HandleEvents(height int64, events []event_entity.
 
 			tx.Signers = signers
 			txs = append(txs, tx)
+		} else if transactionUndecodableEvent, ok := event.(*event_usecase.TransactionUndecodable); ok {
+			undecodableTxs = append(undecodableTxs, transaction_view.UndecodableTransactionRow{
+				BlockHeight: height,
+				BlockTime:   utctime.UTCTime{}, // placeholder
+				Hash:        transactionUndecodableEvent.TxHash,
+				Index:       transactionUndecodableEvent.Index,
+				Code:        transactionUndecodableEvent.Code,
+				Log:         transactionUndecodableEvent.Log,
+				RawTx:       transactionUndecodableEvent.RawTx,
+				DecodeError: transactionUndecodableEvent.DecodeError,
+			})
 		} else if msgEvent, ok := event.(event_usecase.MsgEvent); ok {
 			if _, exist := txMsgs[msgEvent.TxHash()]; !exist {
 				txMsgs[msgEvent.TxHash()] = make([]event_usecase.MsgEvent, 0)
 			}
 			txMsgs[msgEvent.TxHash()] = append(txMsgs[msgEvent.TxHash()], msgEvent)
+
+			if unknownMsgEvent, ok := msgEvent.(*event_usecase.MsgUnknown); ok {
+				unknownMsgTypeURLs = append(unknownMsgTypeURLs, unknownMsgEvent.Params.TypeURL)
+			}
 		}
 	}
 
+	for i := range undecodableTxs {
+		undecodableTxs[i].BlockTime = blockTime
+		undecodableTxs[i].BlockHash = blockHash
+	}
+	if insertErr := undecodableTransactionsView.InsertAll(undecodableTxs); insertErr != nil {
+		return fmt.Errorf("error inserting undecodable transactions into view: %v", insertErr)
+	}
+
 	if len(txs) == 0 {
 		if err := projection.UpdateLastHandledEventHeight(rdbTxHandle, height); err != nil {
 			return fmt.Errorf("error updating last handled event height: %v", err)
@@ -179,6 +217,12 @@ func (projection *Transaction) HandleEvents(height int64, events []event_entity.
 		return fmt.Errorf("error setting total blcok transactions: %w", err)
 	}
 
+	for _, typeURL := range unknownMsgTypeURLs {
+		if err := unknownMsgsTotalView.IncrementAll([]string{"-", typeURL}, 1); err != nil {
+			return fmt.Errorf("error incrementing total unknown messages: %w", err)
+		}
+	}
+
 	if err := projection.UpdateLastHandledEventHeight(rdbTxHandle, height); err != nil {
 		return fmt.Errorf("error updating last handled event height: %v", err)
 	}
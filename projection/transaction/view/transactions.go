@@ -308,8 +308,13 @@ func (transactionsView *BlockTransactions) List(
 		"view_transactions",
 	)
 
+	cursorKey := rdb.RDbPaginationCursorKey{
+		BlockHeightColumn: "block_height",
+		IDColumn:          "id",
+	}
 	if order.Height == view.ORDER_DESC {
 		stmtBuilder = stmtBuilder.OrderBy("block_height DESC, id")
+		cursorKey.BlockHeightDesc = true
 	} else {
 		stmtBuilder = stmtBuilder.OrderBy("block_height, id")
 	}
@@ -321,6 +326,8 @@ func (transactionsView *BlockTransactions) List(
 	rDbPagination := rdb.NewRDbPaginationBuilder(
 		pagination,
 		transactionsView.rdb,
+	).WithCursorKey(
+		cursorKey,
 	).WithCustomTotalQueryFn(
 		func(rdbHandle *rdb.Handle, _ sq.SelectBuilder) (int64, error) {
 			identity := "-"
@@ -347,14 +354,14 @@ func (transactionsView *BlockTransactions) List(
 	defer rowsResult.Close()
 
 	transactions := make([]TransactionRow, 0)
-	for rowsResult.Next() {
+	for rowsResult.Next() && rDbPagination.NextRow() {
 		var transaction TransactionRow
 		var feeJSON *string
 		var messagesJSON *string
 		var signersJSON *string
 		blockTimeReader := transactionsView.rdb.NtotReader()
 
-		if err = rowsResult.Scan(
+		if err = rowsResult.Scan(rDbPagination.ScanArgs(
 			&transaction.BlockHeight,
 			&transaction.BlockHash,
 			blockTimeReader.ScannableArg(),
@@ -371,7 +378,7 @@ func (transactionsView *BlockTransactions) List(
 			&transaction.TimeoutHeight,
 			&messagesJSON,
 			&signersJSON,
-		); err != nil {
+		)...); err != nil {
 			if errors.Is(err, rdb.ErrNoRows) {
 				return nil, nil, rdb.ErrNoRows
 			}
@@ -0,0 +1,163 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+
+	pagination_interface "github.com/crypto-com/chain-indexing/appinterface/pagination"
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+)
+
+const UNDECODABLE_TRANSACTIONS_TABLE = "view_undecodable_transactions"
+
+// UndecodableTransactions projection view of transactions the transaction decoder failed to decode
+type UndecodableTransactions struct {
+	rdb *rdb.Handle
+}
+
+func NewUndecodableTransactions(handle *rdb.Handle) *UndecodableTransactions {
+	return &UndecodableTransactions{
+		handle,
+	}
+}
+
+func (transactionsView *UndecodableTransactions) InsertAll(transactions []UndecodableTransactionRow) error {
+	if len(transactions) == 0 {
+		return nil
+	}
+
+	stmtBuilder := transactionsView.rdb.StmtBuilder.Insert(
+		UNDECODABLE_TRANSACTIONS_TABLE,
+	).Columns(
+		"block_height",
+		"block_hash",
+		"block_time",
+		"hash",
+		"index",
+		"code",
+		"log",
+		"raw_tx",
+		"decode_error",
+	)
+	for _, transaction := range transactions {
+		stmtBuilder = stmtBuilder.Values(
+			transaction.BlockHeight,
+			transaction.BlockHash,
+			transactionsView.rdb.Tton(&transaction.BlockTime),
+			transaction.Hash,
+			transaction.Index,
+			transaction.Code,
+			transaction.Log,
+			transaction.RawTx,
+			transaction.DecodeError,
+		)
+	}
+
+	sql, sqlArgs, err := stmtBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("error building undecodable transactions insertion sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+	result, err := transactionsView.rdb.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error inserting undecodable transactions into the table: %v: %w", err, rdb.ErrWrite)
+	}
+	if result.RowsAffected() != int64(len(transactions)) {
+		return fmt.Errorf("error inserting undecodable transactions into the table: no rows inserted: %w", rdb.ErrWrite)
+	}
+
+	return nil
+}
+
+func (transactionsView *UndecodableTransactions) List(
+	order TransactionsListOrder,
+	pagination *pagination_interface.Pagination,
+) ([]UndecodableTransactionRow, *pagination_interface.PaginationResult, error) {
+	stmtBuilder := transactionsView.rdb.StmtBuilder.Select(
+		"block_height",
+		"block_hash",
+		"block_time",
+		"hash",
+		"index",
+		"code",
+		"log",
+		"raw_tx",
+		"decode_error",
+	).From(
+		UNDECODABLE_TRANSACTIONS_TABLE,
+	)
+
+	if order.Height == view.ORDER_DESC {
+		stmtBuilder = stmtBuilder.OrderBy("block_height DESC, index DESC")
+	} else {
+		stmtBuilder = stmtBuilder.OrderBy("block_height, index")
+	}
+
+	rDbPagination := rdb.NewRDbPaginationBuilder(
+		pagination,
+		transactionsView.rdb,
+	).BuildStmt(stmtBuilder)
+	sql, sqlArgs, err := rDbPagination.ToStmtBuilder().ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error building undecodable transactions select SQL: %v, %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	rowsResult, err := transactionsView.rdb.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error executing undecodable transactions select SQL: %v: %w", err, rdb.ErrQuery)
+	}
+	defer rowsResult.Close()
+
+	transactions := make([]UndecodableTransactionRow, 0)
+	for rowsResult.Next() {
+		var transaction UndecodableTransactionRow
+		blockTimeReader := transactionsView.rdb.NtotReader()
+
+		if err = rowsResult.Scan(
+			&transaction.BlockHeight,
+			&transaction.BlockHash,
+			blockTimeReader.ScannableArg(),
+			&transaction.Hash,
+			&transaction.Index,
+			&transaction.Code,
+			&transaction.Log,
+			&transaction.RawTx,
+			&transaction.DecodeError,
+		); err != nil {
+			if errors.Is(err, rdb.ErrNoRows) {
+				return nil, nil, rdb.ErrNoRows
+			}
+			return nil, nil, fmt.Errorf("error scanning undecodable transaction row: %v: %w", err, rdb.ErrQuery)
+		}
+		blockTime, parseErr := blockTimeReader.Parse()
+		if parseErr != nil {
+			return nil, nil, fmt.Errorf(
+				"error parsing undecodable transaction block time: %v: %w", parseErr, rdb.ErrQuery,
+			)
+		}
+		transaction.BlockTime = *blockTime
+
+		transactions = append(transactions, transaction)
+	}
+
+	paginationResult, err := rDbPagination.Result()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error preparing pagination result: %v", err)
+	}
+
+	return transactions, paginationResult, nil
+}
+
+type UndecodableTransactionRow struct {
+	BlockHeight int64           `json:"blockHeight"`
+	BlockHash   string          `json:"blockHash"`
+	BlockTime   utctime.UTCTime `json:"blockTime"`
+	Hash        string          `json:"hash"`
+	Index       int             `json:"index"`
+	Code        int             `json:"code"`
+	Log         string          `json:"log"`
+	// Base64 encoded transaction bytes
+	RawTx       string `json:"rawTx"`
+	DecodeError string `json:"decodeError"`
+}
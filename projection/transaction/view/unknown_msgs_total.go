@@ -0,0 +1,58 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+)
+
+const UNKNOWN_MSGS_TOTAL_TABLE = "view_transaction_unknown_msgs_total"
+
+// UnknownMsgsTotal counts the messages of type without registered parser. Identity is the message
+// type URL and "-" is the total of all types.
+type UnknownMsgsTotal struct {
+	*view.Total
+
+	rdbHandle *rdb.Handle
+}
+
+func NewUnknownMsgsTotal(rdbHandle *rdb.Handle) *UnknownMsgsTotal {
+	return &UnknownMsgsTotal{
+		view.NewTotal(rdbHandle, UNKNOWN_MSGS_TOTAL_TABLE),
+
+		rdbHandle,
+	}
+}
+
+// ListByType returns the total of each unknown message type URL
+func (totalView *UnknownMsgsTotal) ListByType() (map[string]int64, error) {
+	sql, sqlArgs, err := totalView.rdbHandle.StmtBuilder.Select(
+		"identity", "total",
+	).From(
+		UNKNOWN_MSGS_TOTAL_TABLE,
+	).Where(
+		"identity <> ?", "-",
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building unknown messages total select SQL: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	rowsResult, err := totalView.rdbHandle.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("error executing unknown messages total select SQL: %v: %w", err, rdb.ErrQuery)
+	}
+	defer rowsResult.Close()
+
+	totals := make(map[string]int64)
+	for rowsResult.Next() {
+		var typeURL string
+		var total int64
+		if err = rowsResult.Scan(&typeURL, &total); err != nil {
+			return nil, fmt.Errorf("error scanning unknown messages total row: %v: %w", err, rdb.ErrQuery)
+		}
+		totals[typeURL] = total
+	}
+
+	return totals, nil
+}
@@ -21,6 +21,7 @@ import (
 )
 
 var _ projection_entity.Projection = &Validator{}
+var _ rdbprojectionbase.Rebuildable = &Validator{}
 
 const DO_NOT_MODIFY = "[do-not-modify]"
 
@@ -66,6 +67,16 @@ func (_ *Validator) GetEventsToListen() []string {
 	}
 }
 
+func (_ *Validator) GetViewTables() []string {
+	return []string{
+		"view_validators",
+		"view_validator_block_commitments",
+		"view_validator_block_commitments_total",
+		"view_validator_activities",
+		"view_validator_activities_total",
+	}
+}
+
 func (projection *Validator) OnInit() error {
 	return nil
 }
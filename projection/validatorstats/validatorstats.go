@@ -21,6 +21,8 @@ var _ entity_projection.Projection = &ValidatorStats{}
 const TOTAL_REWARD = "total_reward"
 const TOTAL_DELEGATE = "total_delegate"
 
+var _ rdbprojectionbase.Rebuildable = &ValidatorStats{}
+
 type ValidatorStats struct {
 	*rdbprojectionbase.Base
 
@@ -48,6 +50,12 @@ func (_ *ValidatorStats) GetEventsToListen() []string {
 	}
 }
 
+func (_ *ValidatorStats) GetViewTables() []string {
+	return []string{
+		"view_validator_stats",
+	}
+}
+
 func (projection *ValidatorStats) OnInit() error {
 	return nil
 }
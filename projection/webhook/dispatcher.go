@@ -0,0 +1,235 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/projection/webhook/view"
+)
+
+const (
+	DEFAULT_POLL_INTERVAL   = 5 * time.Second
+	DEFAULT_BATCH_SIZE      = 100
+	DEFAULT_MAX_ATTEMPTS    = 10
+	DEFAULT_INITIAL_BACKOFF = 10 * time.Second
+	DEFAULT_MAX_BACKOFF     = 1 * time.Hour
+	DEFAULT_REQUEST_TIMEOUT = 10 * time.Second
+)
+
+// Maximum length of the receiver response recorded as the last error
+const MAX_ERROR_RESPONSE_LENGTH = 512
+
+// DeliveryStore is the outbox the Dispatcher sends from. It is implemented by view.Deliveries.
+type DeliveryStore interface {
+	ListDue(now utctime.UTCTime, limit uint64) ([]view.DueDeliveryRow, error)
+	MarkDelivered(id int64, attempts int, deliveredAt utctime.UTCTime) error
+	MarkRetry(id int64, attempts int, nextAttemptAt utctime.UTCTime, lastError string) error
+	MarkDead(id int64, attempts int, lastError string) error
+}
+
+// DispatcherConfig of the Dispatcher. Default values are used for the zero values.
+type DispatcherConfig struct {
+	PollInterval time.Duration
+	// Maximum number of deliveries loaded per poll
+	BatchSize uint64
+	// Delivery failed this number of attempts is moved to the dead letters
+	MaxAttempts int
+	// Wait before the second attempt. It is doubled after each failed attempt up to MaxBackoff
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	RequestTimeout time.Duration
+}
+
+// Dispatcher sends the pending deliveries in the outbox to the receivers. A delivery succeeds when
+// the receiver responds 2xx, otherwise it is retried with exponential backoff until it is moved to
+// the dead letters. Deliveries are at-least-once: a delivery is sent again if the dispatcher stops
+// before recording its result. Only one dispatcher should run against the same outbox.
+type Dispatcher struct {
+	logger       applogger.Logger
+	store        DeliveryStore
+	secretCipher *SecretCipher
+	httpClient   *http.Client
+	config       DispatcherConfig
+}
+
+// NewDispatcher creates a Dispatcher signing the payloads with the watch secrets decrypted by the
+// secretCipher
+func NewDispatcher(
+	logger applogger.Logger,
+	store DeliveryStore,
+	secretCipher *SecretCipher,
+	config DispatcherConfig,
+) *Dispatcher {
+	if config.PollInterval <= 0 {
+		config.PollInterval = DEFAULT_POLL_INTERVAL
+	}
+	if config.BatchSize == 0 {
+		config.BatchSize = DEFAULT_BATCH_SIZE
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = DEFAULT_MAX_ATTEMPTS
+	}
+	if config.InitialBackoff <= 0 {
+		config.InitialBackoff = DEFAULT_INITIAL_BACKOFF
+	}
+	if config.MaxBackoff <= 0 {
+		config.MaxBackoff = DEFAULT_MAX_BACKOFF
+	}
+	if config.RequestTimeout <= 0 {
+		config.RequestTimeout = DEFAULT_REQUEST_TIMEOUT
+	}
+
+	return &Dispatcher{
+		logger.WithFields(applogger.LogFields{
+			"module": "WebhookDispatcher",
+		}),
+		store,
+		secretCipher,
+		&http.Client{
+			Timeout: config.RequestTimeout,
+		},
+		config,
+	}
+}
+
+// Run dispatches the due deliveries until the context is done
+func (dispatcher *Dispatcher) Run(ctx context.Context) error {
+	dispatcher.logger.Info("webhook dispatcher start running")
+	for ctx.Err() == nil {
+		dispatchedCount, err := dispatcher.DispatchDue(ctx)
+		if err != nil {
+			dispatcher.logger.Errorf("error dispatching webhook deliveries: %v", err)
+		}
+		// Keep dispatching without waiting when there may be more due deliveries
+		if err == nil && uint64(dispatchedCount) == dispatcher.config.BatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(dispatcher.config.PollInterval):
+		}
+	}
+
+	dispatcher.logger.Info("webhook dispatcher stopped")
+	return nil
+}
+
+// DispatchDue attempts the due deliveries once. Returns the number of attempted deliveries.
+func (dispatcher *Dispatcher) DispatchDue(ctx context.Context) (int, error) {
+	deliveries, err := dispatcher.store.ListDue(utctime.Now(), dispatcher.config.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("error listing due webhook deliveries: %v", err)
+	}
+
+	for i, delivery := range deliveries {
+		if ctx.Err() != nil {
+			return i, nil
+		}
+		if err := dispatcher.dispatch(ctx, delivery); err != nil {
+			return i + 1, err
+		}
+	}
+
+	return len(deliveries), nil
+}
+
+func (dispatcher *Dispatcher) dispatch(ctx context.Context, delivery view.DueDeliveryRow) error {
+	logger := dispatcher.logger.WithFields(applogger.LogFields{
+		"deliveryId": delivery.Id,
+		"watchId":    delivery.WatchId,
+	})
+
+	attempts := delivery.Attempts + 1
+	sendErr := dispatcher.send(ctx, delivery)
+	if sendErr == nil {
+		if err := dispatcher.store.MarkDelivered(delivery.Id, attempts, utctime.Now()); err != nil {
+			return fmt.Errorf("error marking webhook delivery %d delivered: %v", delivery.Id, err)
+		}
+		logger.Debugf("webhook delivered at attempt %d", attempts)
+		return nil
+	}
+
+	// Delivery is not attempted when the dispatcher is stopping
+	if ctx.Err() != nil {
+		return nil
+	}
+
+	if attempts >= dispatcher.config.MaxAttempts {
+		if err := dispatcher.store.MarkDead(delivery.Id, attempts, sendErr.Error()); err != nil {
+			return fmt.Errorf("error marking webhook delivery %d dead: %v", delivery.Id, err)
+		}
+		logger.Errorf("webhook delivery failed all %d attempts, moved to dead letters: %v", attempts, sendErr)
+		return nil
+	}
+
+	nextAttemptAt := utctime.Now().Add(Backoff(
+		dispatcher.config.InitialBackoff, dispatcher.config.MaxBackoff, attempts,
+	))
+	if err := dispatcher.store.MarkRetry(delivery.Id, attempts, nextAttemptAt, sendErr.Error()); err != nil {
+		return fmt.Errorf("error marking webhook delivery %d for retry: %v", delivery.Id, err)
+	}
+	logger.Infof("webhook delivery failed at attempt %d, retry at %s: %v", attempts, nextAttemptAt, sendErr)
+	return nil
+}
+
+func (dispatcher *Dispatcher) send(ctx context.Context, delivery view.DueDeliveryRow) error {
+	// A secret not decryptable, e.g. because of a wrong key, fails the attempt. The delivery can be
+	// redelivered after fixing the key.
+	secret, err := dispatcher.secretCipher.Decrypt(delivery.EncryptedSecret)
+	if err != nil {
+		return fmt.Errorf("error decrypting watch secret: %v", err)
+	}
+
+	body := []byte(delivery.Payload)
+	timestamp := time.Now().Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error creating request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HEADER_DELIVERY_ID, strconv.FormatInt(delivery.Id, 10))
+	req.Header.Set(HEADER_EVENT, delivery.EventName)
+	req.Header.Set(HEADER_TIMESTAMP, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HEADER_SIGNATURE, Sign(secret, timestamp, body))
+
+	resp, err := dispatcher.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error requesting receiver: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := ioutil.ReadAll(io.LimitReader(resp.Body, MAX_ERROR_RESPONSE_LENGTH))
+		return fmt.Errorf("receiver responded %d: %s", resp.StatusCode, respBody)
+	}
+	// Drain the body so that the connection can be reused
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	return nil
+}
+
+// Backoff returns the wait before the next attempt after the number of failed attempts. It starts
+// from the initial backoff and doubles after each attempt, up to the max backoff.
+func Backoff(initialBackoff time.Duration, maxBackoff time.Duration, failedAttempts int) time.Duration {
+	backoff := initialBackoff
+	for i := 1; i < failedAttempts; i++ {
+		if backoff >= maxBackoff/2 {
+			return maxBackoff
+		}
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		return maxBackoff
+	}
+	return backoff
+}
@@ -0,0 +1,293 @@
+package webhook_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/crypto-com/chain-indexing/internal/logger/test"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/projection/webhook"
+	"github.com/crypto-com/chain-indexing/projection/webhook/view"
+)
+
+var _ = Describe("Dispatcher", func() {
+	const anySecret = "secret"
+	const anyPayload = `{"watchId":1,"eventName":"ProposalEnded"}`
+
+	var receiver *httptest.Server
+	var receivedRequests []receivedRequest
+	var receiverStatusCode int
+	var store *fakeDeliveryStore
+	var secretCipher *webhook.SecretCipher
+
+	BeforeEach(func() {
+		receivedRequests = make([]receivedRequest, 0)
+		receiverStatusCode = http.StatusOK
+		receiver = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			receivedRequests = append(receivedRequests, receivedRequest{
+				Header: r.Header,
+				Body:   body,
+			})
+			w.WriteHeader(receiverStatusCode)
+			_, _ = w.Write([]byte("receiver response"))
+		}))
+
+		var err error
+		secretCipher, err = webhook.NewSecretCipher(anySecretKey)
+		Expect(err).To(BeNil())
+		encryptedSecret, err := secretCipher.Encrypt(anySecret)
+		Expect(err).To(BeNil())
+
+		store = newFakeDeliveryStore()
+		store.Add(view.DueDeliveryRow{
+			DeliveryRow: view.DeliveryRow{
+				Id:        1,
+				WatchId:   1,
+				EventName: "ProposalEnded",
+				Payload:   anyPayload,
+				Status:    view.DELIVERY_STATUS_PENDING,
+			},
+			URL:             receiver.URL,
+			EncryptedSecret: encryptedSecret,
+		})
+	})
+
+	AfterEach(func() {
+		receiver.Close()
+	})
+
+	newDispatcher := func() *webhook.Dispatcher {
+		return webhook.NewDispatcher(NewFakeLogger(), store, secretCipher, webhook.DispatcherConfig{
+			MaxAttempts:    3,
+			InitialBackoff: time.Minute,
+			MaxBackoff:     time.Hour,
+		})
+	}
+
+	It("should post the signed payload to the receiver and mark the delivery delivered", func() {
+		dispatchedCount, err := newDispatcher().DispatchDue(context.Background())
+		Expect(err).To(BeNil())
+		Expect(dispatchedCount).To(Equal(1))
+
+		Expect(receivedRequests).To(HaveLen(1))
+		request := receivedRequests[0]
+		Expect(string(request.Body)).To(Equal(anyPayload))
+		Expect(request.Header.Get("Content-Type")).To(Equal("application/json"))
+		Expect(request.Header.Get(webhook.HEADER_DELIVERY_ID)).To(Equal("1"))
+		Expect(request.Header.Get(webhook.HEADER_EVENT)).To(Equal("ProposalEnded"))
+		timestamp, err := strconv.ParseInt(request.Header.Get(webhook.HEADER_TIMESTAMP), 10, 64)
+		Expect(err).To(BeNil())
+		Expect(webhook.VerifySignature(
+			anySecret, timestamp, request.Body, request.Header.Get(webhook.HEADER_SIGNATURE),
+		)).To(BeTrue())
+		Expect(webhook.VerifySignature(
+			"wrong secret", timestamp, request.Body, request.Header.Get(webhook.HEADER_SIGNATURE),
+		)).To(BeFalse())
+
+		delivery := store.Get(1)
+		Expect(delivery.Status).To(Equal(view.DELIVERY_STATUS_DELIVERED))
+		Expect(delivery.Attempts).To(Equal(1))
+		Expect(delivery.MaybeDeliveredAt).NotTo(BeNil())
+
+		dispatchedCount, err = newDispatcher().DispatchDue(context.Background())
+		Expect(err).To(BeNil())
+		Expect(dispatchedCount).To(Equal(0))
+	})
+
+	It("should retry the failed delivery with exponential backoff", func() {
+		receiverStatusCode = http.StatusInternalServerError
+
+		beforeDispatch := utctime.Now()
+		_, err := newDispatcher().DispatchDue(context.Background())
+		Expect(err).To(BeNil())
+
+		delivery := store.Get(1)
+		Expect(delivery.Status).To(Equal(view.DELIVERY_STATUS_PENDING))
+		Expect(delivery.Attempts).To(Equal(1))
+		Expect(*delivery.MaybeLastError).To(Equal("receiver responded 500: receiver response"))
+		Expect(delivery.NextAttemptAt.UnixNano()).To(BeNumerically(">=", beforeDispatch.Add(time.Minute).UnixNano()))
+
+		// Retry is not due yet
+		dispatchedCount, err := newDispatcher().DispatchDue(context.Background())
+		Expect(err).To(BeNil())
+		Expect(dispatchedCount).To(Equal(0))
+		Expect(receivedRequests).To(HaveLen(1))
+
+		store.MakeDue(1)
+		_, err = newDispatcher().DispatchDue(context.Background())
+		Expect(err).To(BeNil())
+
+		delivery = store.Get(1)
+		Expect(delivery.Attempts).To(Equal(2))
+		Expect(delivery.NextAttemptAt.UnixNano()).To(BeNumerically(">=", beforeDispatch.Add(2*time.Minute).UnixNano()))
+	})
+
+	It("should move the delivery to the dead letters after the max attempts", func() {
+		receiverStatusCode = http.StatusBadRequest
+
+		for i := 0; i < 3; i++ {
+			store.MakeDue(1)
+			_, err := newDispatcher().DispatchDue(context.Background())
+			Expect(err).To(BeNil())
+		}
+
+		Expect(receivedRequests).To(HaveLen(3))
+		delivery := store.Get(1)
+		Expect(delivery.Status).To(Equal(view.DELIVERY_STATUS_DEAD))
+		Expect(delivery.Attempts).To(Equal(3))
+
+		store.MakeDue(1)
+		dispatchedCount, err := newDispatcher().DispatchDue(context.Background())
+		Expect(err).To(BeNil())
+		Expect(dispatchedCount).To(Equal(0))
+	})
+
+	It("should retry the delivery when the receiver is unreachable", func() {
+		receiver.Close()
+
+		_, err := newDispatcher().DispatchDue(context.Background())
+		Expect(err).To(BeNil())
+
+		delivery := store.Get(1)
+		Expect(delivery.Status).To(Equal(view.DELIVERY_STATUS_PENDING))
+		Expect(delivery.Attempts).To(Equal(1))
+		Expect(delivery.MaybeLastError).NotTo(BeNil())
+	})
+
+	It("should retry the delivery without requesting the receiver when the secret cannot be decrypted", func() {
+		var err error
+		secretCipher, err = webhook.NewSecretCipher("AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE=")
+		Expect(err).To(BeNil())
+
+		_, err = newDispatcher().DispatchDue(context.Background())
+		Expect(err).To(BeNil())
+
+		Expect(receivedRequests).To(HaveLen(0))
+		delivery := store.Get(1)
+		Expect(delivery.Status).To(Equal(view.DELIVERY_STATUS_PENDING))
+		Expect(delivery.Attempts).To(Equal(1))
+		Expect(*delivery.MaybeLastError).To(HavePrefix("error decrypting watch secret"))
+	})
+})
+
+var _ = Describe("Backoff", func() {
+	It("should double the backoff after each failed attempt up to the max backoff", func() {
+		Expect(webhook.Backoff(10*time.Second, time.Hour, 1)).To(Equal(10 * time.Second))
+		Expect(webhook.Backoff(10*time.Second, time.Hour, 2)).To(Equal(20 * time.Second))
+		Expect(webhook.Backoff(10*time.Second, time.Hour, 3)).To(Equal(40 * time.Second))
+		Expect(webhook.Backoff(10*time.Second, time.Hour, 9)).To(Equal(2560 * time.Second))
+		Expect(webhook.Backoff(10*time.Second, time.Hour, 10)).To(Equal(time.Hour))
+		Expect(webhook.Backoff(10*time.Second, time.Hour, 1000)).To(Equal(time.Hour))
+	})
+})
+
+type receivedRequest struct {
+	Header http.Header
+	Body   []byte
+}
+
+type fakeDeliveryStore struct {
+	mutex      sync.Mutex
+	deliveries []view.DueDeliveryRow
+}
+
+func newFakeDeliveryStore() *fakeDeliveryStore {
+	return &fakeDeliveryStore{
+		deliveries: make([]view.DueDeliveryRow, 0),
+	}
+}
+
+func (store *fakeDeliveryStore) Add(delivery view.DueDeliveryRow) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	store.deliveries = append(store.deliveries, delivery)
+}
+
+func (store *fakeDeliveryStore) Get(id int64) view.DeliveryRow {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	return store.find(id).DeliveryRow
+}
+
+// MakeDue makes the next attempt of the delivery due now
+func (store *fakeDeliveryStore) MakeDue(id int64) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	store.find(id).NextAttemptAt = utctime.Now()
+}
+
+func (store *fakeDeliveryStore) ListDue(now utctime.UTCTime, limit uint64) ([]view.DueDeliveryRow, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	dueDeliveries := make([]view.DueDeliveryRow, 0)
+	for _, delivery := range store.deliveries {
+		if uint64(len(dueDeliveries)) == limit {
+			break
+		}
+		if delivery.Status == view.DELIVERY_STATUS_PENDING && delivery.NextAttemptAt.UnixNano() <= now.UnixNano() {
+			dueDeliveries = append(dueDeliveries, delivery)
+		}
+	}
+	return dueDeliveries, nil
+}
+
+func (store *fakeDeliveryStore) MarkDelivered(id int64, attempts int, deliveredAt utctime.UTCTime) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	delivery := store.find(id)
+	delivery.Status = view.DELIVERY_STATUS_DELIVERED
+	delivery.Attempts = attempts
+	delivery.MaybeLastError = nil
+	delivery.MaybeDeliveredAt = &deliveredAt
+	return nil
+}
+
+func (store *fakeDeliveryStore) MarkRetry(
+	id int64,
+	attempts int,
+	nextAttemptAt utctime.UTCTime,
+	lastError string,
+) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	delivery := store.find(id)
+	delivery.Attempts = attempts
+	delivery.NextAttemptAt = nextAttemptAt
+	delivery.MaybeLastError = &lastError
+	return nil
+}
+
+func (store *fakeDeliveryStore) MarkDead(id int64, attempts int, lastError string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	delivery := store.find(id)
+	delivery.Status = view.DELIVERY_STATUS_DEAD
+	delivery.Attempts = attempts
+	delivery.MaybeLastError = &lastError
+	return nil
+}
+
+func (store *fakeDeliveryStore) find(id int64) *view.DueDeliveryRow {
+	for i := range store.deliveries {
+		if store.deliveries[i].Id == id {
+			return &store.deliveries[i]
+		}
+	}
+	panic("delivery not found")
+}
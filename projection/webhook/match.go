@@ -0,0 +1,82 @@
+package webhook
+
+import (
+	event_entity "github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/projection/webhook/view"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+)
+
+// WATCHABLE_EVENTS are the event names the watches can match
+var WATCHABLE_EVENTS = []string{
+	event_usecase.ACCOUNT_TRANSFERRED,
+	event_usecase.MSG_SEND_CREATED,
+	event_usecase.MSG_MULTI_SEND_CREATED,
+	event_usecase.PROPOSAL_VOTING_PERIOD_STARTED,
+	event_usecase.PROPOSAL_ENDED,
+	event_usecase.PROPOSAL_INACTIVED,
+}
+
+// IsWatchableEvent returns true when the watches can match the event name
+func IsWatchableEvent(eventName string) bool {
+	for _, watchableEvent := range WATCHABLE_EVENTS {
+		if eventName == watchableEvent {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchWatches returns the watches matching the event
+func MatchWatches(watches []view.WatchRow, event event_entity.Event) []view.WatchRow {
+	matchedWatches := make([]view.WatchRow, 0)
+	for _, watch := range watches {
+		if MatchWatch(watch, event) {
+			matchedWatches = append(matchedWatches, watch)
+		}
+	}
+	return matchedWatches
+}
+
+// MatchWatch returns true when the event is at or after the from height of the watch, and matches
+// both the event name and the address of the watch if present
+func MatchWatch(watch view.WatchRow, event event_entity.Event) bool {
+	if event.Height() < watch.FromBlockHeight {
+		return false
+	}
+	if watch.MaybeEventName == nil && watch.MaybeAddress == nil {
+		return false
+	}
+	if watch.MaybeEventName != nil && *watch.MaybeEventName != event.Name() {
+		return false
+	}
+	if watch.MaybeAddress != nil {
+		for _, address := range EventAddresses(event) {
+			if address == *watch.MaybeAddress {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// EventAddresses returns the addresses sending or receiving funds in the event. Events not moving
+// funds have no address.
+func EventAddresses(event event_entity.Event) []string {
+	if typedEvent, ok := event.(*event_usecase.AccountTransferred); ok {
+		return []string{typedEvent.Sender, typedEvent.Recipient}
+	} else if typedEvent, ok := event.(*event_usecase.MsgSend); ok {
+		return []string{typedEvent.FromAddress, typedEvent.ToAddress}
+	} else if typedEvent, ok := event.(*event_usecase.MsgMultiSend); ok {
+		addresses := make([]string, 0, len(typedEvent.Inputs)+len(typedEvent.Outputs))
+		for _, input := range typedEvent.Inputs {
+			addresses = append(addresses, input.Address)
+		}
+		for _, output := range typedEvent.Outputs {
+			addresses = append(addresses, output.Address)
+		}
+		return addresses
+	}
+
+	return []string{}
+}
@@ -0,0 +1,117 @@
+package webhook_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/internal/primptr"
+	"github.com/crypto-com/chain-indexing/projection/webhook"
+	"github.com/crypto-com/chain-indexing/projection/webhook/view"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+)
+
+var _ = Describe("MatchWatch", func() {
+	anyAmount := coin.NewCoins(coin.NewCoin("basecro", coin.NewInt(1000)))
+	transferredEvent := event_usecase.NewAccountTransferred(10, model.AccountTransferParams{
+		Sender:    "cro1sender",
+		Recipient: "cro1recipient",
+		Amount:    anyAmount,
+	})
+	msgSendEvent := event_usecase.NewMsgSend(event_usecase.MsgCommonParams{
+		BlockHeight: 10,
+		TxHash:      "A1B2C3",
+		TxSuccess:   true,
+		MsgIndex:    0,
+	}, event_usecase.MsgSendCreatedParams{
+		FromAddress: "cro1sender",
+		ToAddress:   "cro1recipient",
+		Amount:      anyAmount,
+	})
+	proposalEndedEvent := event_usecase.NewProposalEnded(10, "1", "PROPOSAL_STATUS_PASSED")
+
+	It("should match the events involving the address of the watch", func() {
+		watch := view.WatchRow{
+			MaybeAddress: primptr.String("cro1recipient"),
+		}
+
+		Expect(webhook.MatchWatch(watch, transferredEvent)).To(BeTrue())
+		Expect(webhook.MatchWatch(watch, msgSendEvent)).To(BeTrue())
+		Expect(webhook.MatchWatch(watch, proposalEndedEvent)).To(BeFalse())
+
+		otherWatch := view.WatchRow{
+			MaybeAddress: primptr.String("cro1other"),
+		}
+		Expect(webhook.MatchWatch(otherWatch, transferredEvent)).To(BeFalse())
+	})
+
+	It("should match the events of the event name of the watch", func() {
+		watch := view.WatchRow{
+			MaybeEventName: primptr.String(event_usecase.PROPOSAL_ENDED),
+		}
+
+		Expect(webhook.MatchWatch(watch, proposalEndedEvent)).To(BeTrue())
+		Expect(webhook.MatchWatch(watch, transferredEvent)).To(BeFalse())
+	})
+
+	It("should match the events satisfying both the address and the event name of the watch", func() {
+		watch := view.WatchRow{
+			MaybeAddress:   primptr.String("cro1recipient"),
+			MaybeEventName: primptr.String(event_usecase.MSG_SEND_CREATED),
+		}
+
+		Expect(webhook.MatchWatch(watch, msgSendEvent)).To(BeTrue())
+		Expect(webhook.MatchWatch(watch, transferredEvent)).To(BeFalse())
+	})
+
+	It("should not match the events before the from height of the watch", func() {
+		watch := view.WatchRow{
+			MaybeEventName:  primptr.String(event_usecase.PROPOSAL_ENDED),
+			FromBlockHeight: 11,
+		}
+
+		Expect(webhook.MatchWatch(watch, proposalEndedEvent)).To(BeFalse())
+	})
+
+	It("should not match any event when the watch has no condition", func() {
+		Expect(webhook.MatchWatch(view.WatchRow{}, transferredEvent)).To(BeFalse())
+	})
+
+	It("should return the matched watches", func() {
+		watches := []view.WatchRow{
+			{Id: 1, MaybeAddress: primptr.String("cro1sender")},
+			{Id: 2, MaybeEventName: primptr.String(event_usecase.PROPOSAL_ENDED)},
+			{Id: 3, MaybeAddress: primptr.String("cro1recipient")},
+		}
+
+		matchedWatches := webhook.MatchWatches(watches, transferredEvent)
+		Expect(matchedWatches).To(HaveLen(2))
+		Expect(matchedWatches[0].Id).To(Equal(int64(1)))
+		Expect(matchedWatches[1].Id).To(Equal(int64(3)))
+	})
+})
+
+var _ = Describe("NewPayload", func() {
+	It("should embed the event JSON in the payload", func() {
+		event := event_usecase.NewProposalEnded(10, "1", "PROPOSAL_STATUS_PASSED")
+		watch := view.WatchRow{
+			Id:             1,
+			MaybeEventName: primptr.String(event_usecase.PROPOSAL_ENDED),
+		}
+
+		payload, err := webhook.NewPayload(watch, event)
+		Expect(err).To(BeNil())
+
+		eventJSON, _ := event.ToJSON()
+		Expect(payload).To(MatchJSON(`{
+			"watchId": 1,
+			"address": null,
+			"eventName": "ProposalEnded",
+			"eventVersion": 1,
+			"eventUUID": "` + event.UUID() + `",
+			"blockHeight": 10,
+			"event": ` + eventJSON + `
+		}`))
+	})
+})
@@ -0,0 +1,79 @@
+package webhook
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Size in bytes of the secret key, which selects AES-256
+const SECRET_KEY_SIZE = 32
+
+// SecretCipher encrypts the watch secrets at rest with AES-256-GCM. The encrypted secret is the
+// base64-encoded nonce followed by the sealed secret, so that the secrets are not readable from the
+// database or its backups without the key.
+type SecretCipher struct {
+	aead cipher.AEAD
+}
+
+// NewSecretCipher creates a SecretCipher from the base64-encoded 32 bytes key, which can be
+// generated by `openssl rand -base64 32`
+func NewSecretCipher(base64Key string) (*SecretCipher, error) {
+	if base64Key == "" {
+		return nil, errors.New("webhook secret key is required to encrypt the watch secrets")
+	}
+	key, err := base64.StdEncoding.DecodeString(base64Key)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding webhook secret key from base64: %v", err)
+	}
+	if len(key) != SECRET_KEY_SIZE {
+		return nil, fmt.Errorf("webhook secret key must be %d bytes, got %d bytes", SECRET_KEY_SIZE, len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("error creating webhook secret cipher: %v", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("error creating webhook secret cipher: %v", err)
+	}
+
+	return &SecretCipher{
+		aead,
+	}, nil
+}
+
+// Encrypt returns the encrypted secret. Encrypting the same secret twice gives different results.
+func (secretCipher *SecretCipher) Encrypt(secret string) (string, error) {
+	nonce := make([]byte, secretCipher.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", fmt.Errorf("error generating webhook secret nonce: %v", err)
+	}
+
+	sealed := secretCipher.aead.Seal(nonce, nonce, []byte(secret), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt returns the secret of the encrypted secret. Returns an error when the encrypted secret is
+// tampered or encrypted by another key.
+func (secretCipher *SecretCipher) Decrypt(encryptedSecret string) (string, error) {
+	sealed, err := base64.StdEncoding.DecodeString(encryptedSecret)
+	if err != nil {
+		return "", fmt.Errorf("error decoding encrypted webhook secret from base64: %v", err)
+	}
+	nonceSize := secretCipher.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return "", errors.New("encrypted webhook secret is too short")
+	}
+
+	secret, err := secretCipher.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], nil)
+	if err != nil {
+		return "", fmt.Errorf("error decrypting webhook secret: %v", err)
+	}
+	return string(secret), nil
+}
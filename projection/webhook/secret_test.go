@@ -0,0 +1,73 @@
+package webhook_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/projection/webhook"
+)
+
+// Base64-encoded 32 bytes key
+const anySecretKey = "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8="
+
+var _ = Describe("SecretCipher", func() {
+	It("should return error when the key is empty, not base64 or not 32 bytes", func() {
+		_, err := webhook.NewSecretCipher("")
+		Expect(err).NotTo(BeNil())
+
+		_, err = webhook.NewSecretCipher("not base64")
+		Expect(err).NotTo(BeNil())
+
+		_, err = webhook.NewSecretCipher("AAECAwQFBgcICQoLDA0ODw==")
+		Expect(err).To(MatchError("webhook secret key must be 32 bytes, got 16 bytes"))
+	})
+
+	It("should decrypt the encrypted secret", func() {
+		secretCipher, err := webhook.NewSecretCipher(anySecretKey)
+		Expect(err).To(BeNil())
+
+		encryptedSecret, err := secretCipher.Encrypt("secret")
+		Expect(err).To(BeNil())
+		Expect(encryptedSecret).NotTo(ContainSubstring("secret"))
+
+		anotherEncryptedSecret, err := secretCipher.Encrypt("secret")
+		Expect(err).To(BeNil())
+		Expect(anotherEncryptedSecret).NotTo(Equal(encryptedSecret))
+
+		Expect(secretCipher.Decrypt(encryptedSecret)).To(Equal("secret"))
+		Expect(secretCipher.Decrypt(anotherEncryptedSecret)).To(Equal("secret"))
+	})
+
+	It("should return error when the secret is encrypted by another key", func() {
+		secretCipher, err := webhook.NewSecretCipher(anySecretKey)
+		Expect(err).To(BeNil())
+		anotherSecretCipher, err := webhook.NewSecretCipher("AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE=")
+		Expect(err).To(BeNil())
+
+		encryptedSecret, err := anotherSecretCipher.Encrypt("secret")
+		Expect(err).To(BeNil())
+
+		_, err = secretCipher.Decrypt(encryptedSecret)
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("should return error when the encrypted secret is tampered", func() {
+		secretCipher, err := webhook.NewSecretCipher(anySecretKey)
+		Expect(err).To(BeNil())
+
+		encryptedSecret, err := secretCipher.Encrypt("secret")
+		Expect(err).To(BeNil())
+
+		tampered := []byte(encryptedSecret)
+		if tampered[20] == 'A' {
+			tampered[20] = 'B'
+		} else {
+			tampered[20] = 'A'
+		}
+		_, err = secretCipher.Decrypt(string(tampered))
+		Expect(err).NotTo(BeNil())
+
+		_, err = secretCipher.Decrypt("AAEC")
+		Expect(err).NotTo(BeNil())
+	})
+})
@@ -0,0 +1,36 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+)
+
+// Headers of the webhook requests
+const (
+	HEADER_DELIVERY_ID = "X-Webhook-Delivery-Id"
+	HEADER_EVENT       = "X-Webhook-Event"
+	HEADER_TIMESTAMP   = "X-Webhook-Timestamp"
+	HEADER_SIGNATURE   = "X-Webhook-Signature"
+)
+
+const SIGNATURE_PREFIX = "sha256="
+
+// Sign returns the signature header value of the request body sent at the Unix timestamp in
+// seconds. It is the hex-encoded HMAC-SHA256 of `{timestamp}.{body}` keyed by the watch secret.
+// Receivers should recompute it and reject stale timestamps to prevent replay.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return SIGNATURE_PREFIX + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature returns true when the signature header value matches the request body sent at
+// the timestamp
+func VerifySignature(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
@@ -0,0 +1,339 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+
+	pagination_interface "github.com/crypto-com/chain-indexing/appinterface/pagination"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+)
+
+const DELIVERIES_TABLE = "view_webhook_deliveries"
+
+const (
+	// Delivery is waiting for its next attempt
+	DELIVERY_STATUS_PENDING = "PENDING"
+	// Receiver has responded 2xx
+	DELIVERY_STATUS_DELIVERED = "DELIVERED"
+	// Delivery has failed all the attempts and is not retried until it is redelivered manually
+	DELIVERY_STATUS_DEAD = "DEAD"
+)
+
+// Deliveries projection view is the outbox of the webhook payloads. The deliveries are inserted by
+// the projection in the same transaction as the handled height, and then sent by the dispatcher.
+type Deliveries struct {
+	rdb *rdb.Handle
+}
+
+func NewDeliveries(handle *rdb.Handle) *Deliveries {
+	return &Deliveries{
+		handle,
+	}
+}
+
+// Insert inserts the delivery. It is a no-op when the event has been enqueued to the watch already,
+// so that replaying the events does not notify the receivers twice.
+func (deliveriesView *Deliveries) Insert(delivery *DeliveryRow) error {
+	sql, sqlArgs, err := deliveriesView.rdb.StmtBuilder.Insert(
+		DELIVERIES_TABLE,
+	).Columns(
+		"watch_id",
+		"event_uuid",
+		"event_name",
+		"block_height",
+		"payload",
+		"status",
+		"attempts",
+		"next_attempt_at",
+		"last_error",
+		"delivered_at",
+		"created_at",
+	).Values(
+		delivery.WatchId,
+		delivery.EventUUID,
+		delivery.EventName,
+		delivery.BlockHeight,
+		delivery.Payload,
+		delivery.Status,
+		delivery.Attempts,
+		deliveriesView.rdb.Tton(&delivery.NextAttemptAt),
+		delivery.MaybeLastError,
+		deliveriesView.rdb.Tton(delivery.MaybeDeliveredAt),
+		deliveriesView.rdb.Tton(&delivery.CreatedAt),
+	).Suffix("ON CONFLICT(watch_id, event_uuid) DO NOTHING").ToSql()
+	if err != nil {
+		return fmt.Errorf("error building webhook delivery insertion sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	if _, err = deliveriesView.rdb.Exec(sql, sqlArgs...); err != nil {
+		return fmt.Errorf("error inserting webhook delivery into the table: %v: %w", err, rdb.ErrWrite)
+	}
+
+	return nil
+}
+
+// ListDue returns at most limit pending deliveries whose next attempt is due at the time, in the
+// order they are enqueued, together with the URL and encrypted secret of their watches
+func (deliveriesView *Deliveries) ListDue(now utctime.UTCTime, limit uint64) ([]DueDeliveryRow, error) {
+	sql, sqlArgs, err := deliveriesView.rdb.StmtBuilder.Select(
+		fmt.Sprintf("%s.url", WATCHES_TABLE),
+		fmt.Sprintf("%s.encrypted_secret", WATCHES_TABLE),
+		fmt.Sprintf("%s.id", DELIVERIES_TABLE),
+		fmt.Sprintf("%s.watch_id", DELIVERIES_TABLE),
+		fmt.Sprintf("%s.event_uuid", DELIVERIES_TABLE),
+		fmt.Sprintf("%s.event_name", DELIVERIES_TABLE),
+		fmt.Sprintf("%s.block_height", DELIVERIES_TABLE),
+		fmt.Sprintf("%s.payload", DELIVERIES_TABLE),
+		fmt.Sprintf("%s.status", DELIVERIES_TABLE),
+		fmt.Sprintf("%s.attempts", DELIVERIES_TABLE),
+		fmt.Sprintf("%s.next_attempt_at", DELIVERIES_TABLE),
+		fmt.Sprintf("%s.last_error", DELIVERIES_TABLE),
+		fmt.Sprintf("%s.delivered_at", DELIVERIES_TABLE),
+		fmt.Sprintf("%s.created_at", DELIVERIES_TABLE),
+	).From(
+		DELIVERIES_TABLE,
+	).InnerJoin(
+		fmt.Sprintf("%s ON %s.id = %s.watch_id", WATCHES_TABLE, WATCHES_TABLE, DELIVERIES_TABLE),
+	).Where(
+		fmt.Sprintf("%s.status = ? AND %s.next_attempt_at <= ?", DELIVERIES_TABLE, DELIVERIES_TABLE),
+		DELIVERY_STATUS_PENDING, deliveriesView.rdb.Tton(&now),
+	).OrderBy(
+		fmt.Sprintf("%s.next_attempt_at, %s.id", DELIVERIES_TABLE, DELIVERIES_TABLE),
+	).Limit(limit).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building due webhook deliveries select SQL: %v, %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	rowsResult, err := deliveriesView.rdb.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("error executing due webhook deliveries select SQL: %v: %w", err, rdb.ErrQuery)
+	}
+	defer rowsResult.Close()
+
+	deliveries := make([]DueDeliveryRow, 0)
+	for rowsResult.Next() {
+		var delivery DueDeliveryRow
+		if err = deliveriesView.scanDelivery(
+			rowsResult, &delivery.DeliveryRow, &delivery.URL, &delivery.EncryptedSecret,
+		); err != nil {
+			return nil, err
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
+
+// ListDead returns the dead letters, i.e. the deliveries which have failed all the attempts, in the
+// order they are enqueued
+func (deliveriesView *Deliveries) ListDead(
+	pagination *pagination_interface.Pagination,
+) ([]DeliveryRow, *pagination_interface.PaginationResult, error) {
+	stmtBuilder := deliveriesView.rdb.StmtBuilder.Select(
+		"id",
+		"watch_id",
+		"event_uuid",
+		"event_name",
+		"block_height",
+		"payload",
+		"status",
+		"attempts",
+		"next_attempt_at",
+		"last_error",
+		"delivered_at",
+		"created_at",
+	).From(
+		DELIVERIES_TABLE,
+	).Where(
+		"status = ?", DELIVERY_STATUS_DEAD,
+	).OrderBy("id")
+
+	rDbPagination := rdb.NewRDbPaginationBuilder(
+		pagination,
+		deliveriesView.rdb,
+	).BuildStmt(stmtBuilder)
+	sql, sqlArgs, err := rDbPagination.ToStmtBuilder().ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error building dead webhook deliveries select SQL: %v, %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	rowsResult, err := deliveriesView.rdb.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error executing dead webhook deliveries select SQL: %v: %w", err, rdb.ErrQuery)
+	}
+	defer rowsResult.Close()
+
+	deliveries := make([]DeliveryRow, 0)
+	for rowsResult.Next() {
+		var delivery DeliveryRow
+		if err = deliveriesView.scanDelivery(rowsResult, &delivery); err != nil {
+			return nil, nil, err
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	paginationResult, err := rDbPagination.Result()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error preparing pagination result: %v", err)
+	}
+
+	return deliveries, paginationResult, nil
+}
+
+func (deliveriesView *Deliveries) scanDelivery(
+	rowsResult rdb.RowsResult,
+	delivery *DeliveryRow,
+	leadingDest ...interface{},
+) error {
+	nextAttemptAtReader := deliveriesView.rdb.NtotReader()
+	deliveredAtReader := deliveriesView.rdb.NtotReader()
+	createdAtReader := deliveriesView.rdb.NtotReader()
+	dest := append(leadingDest,
+		&delivery.Id,
+		&delivery.WatchId,
+		&delivery.EventUUID,
+		&delivery.EventName,
+		&delivery.BlockHeight,
+		&delivery.Payload,
+		&delivery.Status,
+		&delivery.Attempts,
+		nextAttemptAtReader.ScannableArg(),
+		&delivery.MaybeLastError,
+		deliveredAtReader.ScannableArg(),
+		createdAtReader.ScannableArg(),
+	)
+	if err := rowsResult.Scan(dest...); err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			return rdb.ErrNoRows
+		}
+		return fmt.Errorf("error scanning webhook delivery row: %v: %w", err, rdb.ErrQuery)
+	}
+
+	nextAttemptAt, err := nextAttemptAtReader.Parse()
+	if err != nil {
+		return fmt.Errorf("error parsing webhook delivery next attempt time: %v: %w", err, rdb.ErrQuery)
+	}
+	delivery.NextAttemptAt = *nextAttemptAt
+	delivery.MaybeDeliveredAt, err = deliveredAtReader.Parse()
+	if err != nil {
+		return fmt.Errorf("error parsing webhook delivery delivered time: %v: %w", err, rdb.ErrQuery)
+	}
+	createdAt, err := createdAtReader.Parse()
+	if err != nil {
+		return fmt.Errorf("error parsing webhook delivery created time: %v: %w", err, rdb.ErrQuery)
+	}
+	delivery.CreatedAt = *createdAt
+
+	return nil
+}
+
+// MarkDelivered records the successful attempt of the delivery
+func (deliveriesView *Deliveries) MarkDelivered(id int64, attempts int, deliveredAt utctime.UTCTime) error {
+	return deliveriesView.update(id, map[string]interface{}{
+		"status":       DELIVERY_STATUS_DELIVERED,
+		"attempts":     attempts,
+		"last_error":   nil,
+		"delivered_at": deliveriesView.rdb.Tton(&deliveredAt),
+	})
+}
+
+// MarkRetry records the failed attempt of the delivery and schedules the next attempt
+func (deliveriesView *Deliveries) MarkRetry(
+	id int64,
+	attempts int,
+	nextAttemptAt utctime.UTCTime,
+	lastError string,
+) error {
+	return deliveriesView.update(id, map[string]interface{}{
+		"status":          DELIVERY_STATUS_PENDING,
+		"attempts":        attempts,
+		"next_attempt_at": deliveriesView.rdb.Tton(&nextAttemptAt),
+		"last_error":      lastError,
+	})
+}
+
+// MarkDead records the last failed attempt of the delivery and moves it to the dead letters
+func (deliveriesView *Deliveries) MarkDead(id int64, attempts int, lastError string) error {
+	return deliveriesView.update(id, map[string]interface{}{
+		"status":     DELIVERY_STATUS_DEAD,
+		"attempts":   attempts,
+		"last_error": lastError,
+	})
+}
+
+// Redeliver moves the dead letter back to pending, so that it is attempted again with a fresh
+// number of attempts. Returns rdb.ErrNoRows when the delivery is not a dead letter.
+func (deliveriesView *Deliveries) Redeliver(id int64, now utctime.UTCTime) error {
+	sql, sqlArgs, err := deliveriesView.rdb.StmtBuilder.Update(
+		DELIVERIES_TABLE,
+	).SetMap(map[string]interface{}{
+		"status":          DELIVERY_STATUS_PENDING,
+		"attempts":        0,
+		"next_attempt_at": deliveriesView.rdb.Tton(&now),
+	}).Where(
+		"id = ? AND status = ?", id, DELIVERY_STATUS_DEAD,
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building webhook delivery update sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	result, err := deliveriesView.rdb.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error updating webhook delivery into the table: %v: %w", err, rdb.ErrWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return rdb.ErrNoRows
+	}
+
+	return nil
+}
+
+func (deliveriesView *Deliveries) update(id int64, values map[string]interface{}) error {
+	sql, sqlArgs, err := deliveriesView.rdb.StmtBuilder.Update(
+		DELIVERIES_TABLE,
+	).SetMap(values).Where(
+		"id = ?", id,
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building webhook delivery update sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	result, err := deliveriesView.rdb.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error updating webhook delivery into the table: %v: %w", err, rdb.ErrWrite)
+	}
+	// Delivery is gone when its watch has been deleted during the attempt
+	if result.RowsAffected() != 1 {
+		return rdb.ErrNoRows
+	}
+
+	return nil
+}
+
+type DeliveryRow struct {
+	Id          int64  `json:"id"`
+	WatchId     int64  `json:"watchId"`
+	EventUUID   string `json:"eventUUID"`
+	EventName   string `json:"eventName"`
+	BlockHeight int64  `json:"blockHeight"`
+	// Payload is the JSON request body
+	Payload          string           `json:"payload"`
+	Status           string           `json:"status"`
+	Attempts         int              `json:"attempts"`
+	NextAttemptAt    utctime.UTCTime  `json:"nextAttemptAt"`
+	MaybeLastError   *string          `json:"lastError"`
+	MaybeDeliveredAt *utctime.UTCTime `json:"deliveredAt"`
+	CreatedAt        utctime.UTCTime  `json:"createdAt"`
+}
+
+// DueDeliveryRow is a pending delivery with the endpoint of its watch
+type DueDeliveryRow struct {
+	DeliveryRow
+
+	URL string
+	// Encrypted by webhook.SecretCipher
+	EncryptedSecret string
+}
@@ -0,0 +1,143 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+)
+
+const WATCHES_TABLE = "view_webhook_watches"
+
+// Watches projection view of the webhook watch registry. The watches are managed by the operators
+// rather than projected from events.
+type Watches struct {
+	rdb *rdb.Handle
+}
+
+func NewWatches(handle *rdb.Handle) *Watches {
+	return &Watches{
+		handle,
+	}
+}
+
+// Insert inserts the watch and fills in its id
+func (watchesView *Watches) Insert(watch *WatchRow) error {
+	sql, sqlArgs, err := watchesView.rdb.StmtBuilder.Insert(
+		WATCHES_TABLE,
+	).Columns(
+		"url",
+		"encrypted_secret",
+		"address",
+		"event_name",
+		"from_block_height",
+		"created_at",
+	).Values(
+		watch.URL,
+		watch.EncryptedSecret,
+		watch.MaybeAddress,
+		watch.MaybeEventName,
+		watch.FromBlockHeight,
+		watchesView.rdb.Tton(&watch.CreatedAt),
+	).Suffix("RETURNING id").ToSql()
+	if err != nil {
+		return fmt.Errorf("error building webhook watch insertion sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	if err = watchesView.rdb.QueryRow(sql, sqlArgs...).Scan(&watch.Id); err != nil {
+		return fmt.Errorf("error inserting webhook watch into the table: %v: %w", err, rdb.ErrWrite)
+	}
+
+	return nil
+}
+
+// Delete deletes the watch together with all its deliveries
+func (watchesView *Watches) Delete(id int64) error {
+	sql, sqlArgs, err := watchesView.rdb.StmtBuilder.Delete(
+		WATCHES_TABLE,
+	).Where(
+		"id = ?", id,
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building webhook watch deletion sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	result, err := watchesView.rdb.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error deleting webhook watch from the table: %v: %w", err, rdb.ErrWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return rdb.ErrNoRows
+	}
+
+	return nil
+}
+
+// ListAll returns all the watches ordered by id. The registry is expected to be small enough to be
+// matched against every handled height.
+func (watchesView *Watches) ListAll() ([]WatchRow, error) {
+	sql, sqlArgs, err := watchesView.rdb.StmtBuilder.Select(
+		"id",
+		"url",
+		"encrypted_secret",
+		"address",
+		"event_name",
+		"from_block_height",
+		"created_at",
+	).From(
+		WATCHES_TABLE,
+	).OrderBy("id").ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building webhook watches select SQL: %v, %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	rowsResult, err := watchesView.rdb.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("error executing webhook watches select SQL: %v: %w", err, rdb.ErrQuery)
+	}
+	defer rowsResult.Close()
+
+	watches := make([]WatchRow, 0)
+	for rowsResult.Next() {
+		var watch WatchRow
+		createdAtReader := watchesView.rdb.NtotReader()
+		if err = rowsResult.Scan(
+			&watch.Id,
+			&watch.URL,
+			&watch.EncryptedSecret,
+			&watch.MaybeAddress,
+			&watch.MaybeEventName,
+			&watch.FromBlockHeight,
+			createdAtReader.ScannableArg(),
+		); err != nil {
+			if errors.Is(err, rdb.ErrNoRows) {
+				return nil, rdb.ErrNoRows
+			}
+			return nil, fmt.Errorf("error scanning webhook watch row: %v: %w", err, rdb.ErrQuery)
+		}
+
+		createdAt, parseErr := createdAtReader.Parse()
+		if parseErr != nil {
+			return nil, fmt.Errorf("error parsing webhook watch created time: %v: %w", parseErr, rdb.ErrQuery)
+		}
+		watch.CreatedAt = *createdAt
+
+		watches = append(watches, watch)
+	}
+
+	return watches, nil
+}
+
+// WatchRow is a registered webhook. It matches the events at or after FromBlockHeight with the
+// event name and involving the address. Either condition is skipped when it is nil, but at least
+// one of them must be present. The secret is encrypted by webhook.SecretCipher.
+type WatchRow struct {
+	Id              int64           `json:"id"`
+	URL             string          `json:"url"`
+	EncryptedSecret string          `json:"-"`
+	MaybeAddress    *string         `json:"address"`
+	MaybeEventName  *string         `json:"eventName"`
+	FromBlockHeight int64           `json:"fromBlockHeight"`
+	CreatedAt       utctime.UTCTime `json:"createdAt"`
+}
@@ -0,0 +1,141 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/rdbprojectionbase"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	event_entity "github.com/crypto-com/chain-indexing/entity/event"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/projection/webhook/view"
+)
+
+// Webhook projects the events matching the registered watches into the deliveries outbox, which is
+// sent to the receivers by the Dispatcher. It does not support rebuild because replaying the events
+// would notify the receivers again, and its watches are not projected from events.
+type Webhook struct {
+	*rdbprojectionbase.Base
+
+	rdbConn rdb.Conn
+	logger  applogger.Logger
+}
+
+func NewWebhook(logger applogger.Logger, rdbConn rdb.Conn) *Webhook {
+	return &Webhook{
+		rdbprojectionbase.NewRDbBase(rdbConn.ToHandle(), "Webhook"),
+
+		rdbConn,
+		logger,
+	}
+}
+
+func (_ *Webhook) GetEventsToListen() []string {
+	return WATCHABLE_EVENTS
+}
+
+func (projection *Webhook) OnInit() error {
+	return nil
+}
+
+func (projection *Webhook) HandleEvents(height int64, events []event_entity.Event) error {
+	var err error
+
+	rdbTx, err := projection.rdbConn.Begin()
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %v", err)
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			_ = rdbTx.Rollback()
+		}
+	}()
+
+	rdbTxHandle := rdbTx.ToHandle()
+	watchesView := view.NewWatches(rdbTxHandle)
+	deliveriesView := view.NewDeliveries(rdbTxHandle)
+
+	var watches []view.WatchRow
+	if len(events) > 0 {
+		if watches, err = watchesView.ListAll(); err != nil {
+			return fmt.Errorf("error listing webhook watches: %v", err)
+		}
+	}
+
+	now := utctime.Now()
+	for _, event := range events {
+		for _, watch := range MatchWatches(watches, event) {
+			payload, payloadErr := NewPayload(watch, event)
+			if payloadErr != nil {
+				return fmt.Errorf("error creating webhook payload: %v", payloadErr)
+			}
+			if err = deliveriesView.Insert(&view.DeliveryRow{
+				WatchId:          watch.Id,
+				EventUUID:        event.UUID(),
+				EventName:        event.Name(),
+				BlockHeight:      height,
+				Payload:          payload,
+				Status:           view.DELIVERY_STATUS_PENDING,
+				Attempts:         0,
+				NextAttemptAt:    now,
+				MaybeLastError:   nil,
+				MaybeDeliveredAt: nil,
+				CreatedAt:        now,
+			}); err != nil {
+				return fmt.Errorf("error inserting webhook delivery: %v", err)
+			}
+		}
+	}
+
+	if err = projection.UpdateLastHandledEventHeight(rdbTxHandle, height); err != nil {
+		return fmt.Errorf("error updating last handled event height: %v", err)
+	}
+
+	if err = rdbTx.Commit(); err != nil {
+		return fmt.Errorf("error committing changes: %v", err)
+	}
+	committed = true
+	return nil
+}
+
+// Payload is the request body of the webhook
+type Payload struct {
+	WatchId      int64   `json:"watchId"`
+	MaybeAddress *string `json:"address"`
+	EventName    string  `json:"eventName"`
+	EventVersion int     `json:"eventVersion"`
+	// EventUUID is unique per event. Receivers should use it together with the watch id to ignore
+	// the redelivered payloads.
+	EventUUID   string `json:"eventUUID"`
+	BlockHeight int64  `json:"blockHeight"`
+	// Event is the event as stored in the event store
+	Event json.RawMessage `json:"event"`
+}
+
+// NewPayload returns the JSON request body notifying the watch of the event
+func NewPayload(watch view.WatchRow, event event_entity.Event) (string, error) {
+	eventJSON, err := event.ToJSON()
+	if err != nil {
+		return "", fmt.Errorf("error encoding event %s: %v", event.UUID(), err)
+	}
+
+	encoded, err := jsoniter.Marshal(Payload{
+		WatchId:      watch.Id,
+		MaybeAddress: watch.MaybeAddress,
+		EventName:    event.Name(),
+		EventVersion: event.Version(),
+		EventUUID:    event.UUID(),
+		BlockHeight:  event.Height(),
+		Event:        json.RawMessage(eventJSON),
+	})
+	if err != nil {
+		return "", fmt.Errorf("error encoding payload: %v", err)
+	}
+
+	return string(encoded), nil
+}
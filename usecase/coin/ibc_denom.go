@@ -0,0 +1,167 @@
+package coin
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// IBC_DENOM_PREFIX is the prefix of the denomination of ICS-20 vouchers, i.e. ibc/{hash}
+const IBC_DENOM_PREFIX = "ibc/"
+
+var ibcDenomRegex = regexp.MustCompile(`ibc/[0-9A-Fa-f]{64}`)
+
+// DenomTrace is the trace of an ICS-20 voucher denomination. Path is the port and channel
+// identifiers the token went through, e.g. transfer/channel-0, and BaseDenom is the denomination on
+// its source chain.
+type DenomTrace struct {
+	Path      string `json:"path"`
+	BaseDenom string `json:"baseDenom"`
+}
+
+// ParseDenomTrace parses the full denomination path, e.g. transfer/channel-0/basecro, into its
+// trace. A denomination without path is returned as a trace with empty path.
+func ParseDenomTrace(fullDenomPath string) DenomTrace {
+	denomSplit := strings.Split(fullDenomPath, "/")
+	if len(denomSplit) == 1 {
+		return DenomTrace{
+			Path:      "",
+			BaseDenom: fullDenomPath,
+		}
+	}
+
+	return DenomTrace{
+		Path:      strings.Join(denomSplit[:len(denomSplit)-1], "/"),
+		BaseDenom: denomSplit[len(denomSplit)-1],
+	}
+}
+
+// FullPath returns the full denomination path of the trace, e.g. transfer/channel-0/basecro
+func (trace DenomTrace) FullPath() string {
+	if trace.Path == "" {
+		return trace.BaseDenom
+	}
+	return trace.Path + "/" + trace.BaseDenom
+}
+
+// IsNative returns true when the trace has no path, i.e. the denomination is not a voucher
+func (trace DenomTrace) IsNative() bool {
+	return trace.Path == ""
+}
+
+// Hash returns the upper case hex encoded SHA256 hash of the full denomination path
+func (trace DenomTrace) Hash() string {
+	hash := sha256.Sum256([]byte(trace.FullPath()))
+	return strings.ToUpper(hex.EncodeToString(hash[:]))
+}
+
+// IBCDenom returns the voucher denomination of the trace, i.e. ibc/{hash}, or the base
+// denomination when the trace has no path
+func (trace DenomTrace) IBCDenom() string {
+	if trace.IsNative() {
+		return trace.BaseDenom
+	}
+	return IBC_DENOM_PREFIX + trace.Hash()
+}
+
+// ReceivedDenomTrace returns the trace of the token received on the destination chain from an
+// ICS-20 packet with the denomination in the packet data. Token returning to the destination
+// chain has the source prefix removed, otherwise the destination prefix is added.
+func ReceivedDenomTrace(
+	sourcePort string,
+	sourceChannel string,
+	destinationPort string,
+	destinationChannel string,
+	packetDenom string,
+) DenomTrace {
+	sourcePrefix := sourcePort + "/" + sourceChannel + "/"
+	if strings.HasPrefix(packetDenom, sourcePrefix) {
+		return ParseDenomTrace(strings.TrimPrefix(packetDenom, sourcePrefix))
+	}
+
+	return ParseDenomTrace(destinationPort + "/" + destinationChannel + "/" + packetDenom)
+}
+
+// IsIBCDenom returns true when the denomination is an ICS-20 voucher denomination
+func IsIBCDenom(denom string) bool {
+	return len(denom) == len(IBC_DENOM_PREFIX)+sha256.Size*2 && ibcDenomRegex.MatchString(denom)
+}
+
+// IBCDenomHash returns the upper case hash of an ICS-20 voucher denomination. It returns false when
+// the denomination is not a voucher.
+func IBCDenomHash(denom string) (string, bool) {
+	if !IsIBCDenom(denom) {
+		return "", false
+	}
+	return strings.ToUpper(strings.TrimPrefix(denom, IBC_DENOM_PREFIX)), true
+}
+
+// CollectIBCDenoms returns the distinct ICS-20 voucher denominations of the coins and the `denom`
+// fields in the value, e.g. an API result. Structs, pointers, slices and maps are walked
+// recursively, which includes the JSON decoded `map[string]interface{}` message contents.
+func CollectIBCDenoms(value interface{}) []string {
+	collector := &ibcDenomCollector{
+		denoms: make([]string, 0),
+		seen:   make(map[string]bool),
+	}
+	collector.walk(reflect.ValueOf(value))
+
+	return collector.denoms
+}
+
+var coinType = reflect.TypeOf(Coin{})
+var decCoinType = reflect.TypeOf(DecCoin{})
+
+type ibcDenomCollector struct {
+	denoms []string
+	seen   map[string]bool
+}
+
+func (collector *ibcDenomCollector) walk(value reflect.Value) {
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !value.IsNil() {
+			collector.walk(value.Elem())
+		}
+	case reflect.Struct:
+		switch value.Type() {
+		case coinType:
+			collector.add(value.Interface().(Coin).Denom)
+		case decCoinType:
+			collector.add(value.Interface().(DecCoin).Denom)
+		default:
+			for i := 0; i < value.NumField(); i++ {
+				// Unexported fields cannot be accessed
+				if value.Type().Field(i).PkgPath == "" {
+					collector.walk(value.Field(i))
+				}
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			collector.walk(value.Index(i))
+		}
+	case reflect.Map:
+		iter := value.MapRange()
+		for iter.Next() {
+			key := iter.Key()
+			if key.Kind() == reflect.String && key.String() == "denom" {
+				if denom, ok := iter.Value().Interface().(string); ok {
+					collector.add(denom)
+					continue
+				}
+			}
+			collector.walk(iter.Value())
+		}
+	}
+}
+
+func (collector *ibcDenomCollector) add(denom string) {
+	if !IsIBCDenom(denom) || collector.seen[denom] {
+		return
+	}
+	collector.seen[denom] = true
+	collector.denoms = append(collector.denoms, denom)
+}
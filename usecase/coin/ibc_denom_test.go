@@ -0,0 +1,117 @@
+package coin_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/internal/json"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+)
+
+var _ = Describe("IBC Denom", func() {
+	anyIBCDenom := "ibc/6411AE2ADA1E73DB59DB151A8988F9B7D5E7E233D8414DB6817F8F1A01611F86"
+
+	Describe("ParseDenomTrace", func() {
+		It("should parse full denom path into path and base denom", func() {
+			trace := coin.ParseDenomTrace("transfer/channel-0/basecro")
+
+			Expect(trace).To(Equal(coin.DenomTrace{
+				Path:      "transfer/channel-0",
+				BaseDenom: "basecro",
+			}))
+			Expect(trace.IsNative()).To(BeFalse())
+			Expect(trace.FullPath()).To(Equal("transfer/channel-0/basecro"))
+			Expect(trace.IBCDenom()).To(Equal(anyIBCDenom))
+		})
+
+		It("should parse denom without path as native", func() {
+			trace := coin.ParseDenomTrace("basecro")
+
+			Expect(trace.IsNative()).To(BeTrue())
+			Expect(trace.IBCDenom()).To(Equal("basecro"))
+		})
+	})
+
+	Describe("ReceivedDenomTrace", func() {
+		It("should prefix denom sent from the source chain with destination port and channel", func() {
+			trace := coin.ReceivedDenomTrace("transfer", "channel-3", "transfer", "channel-0", "basecro")
+
+			Expect(trace.FullPath()).To(Equal("transfer/channel-0/basecro"))
+			Expect(trace.IBCDenom()).To(Equal(anyIBCDenom))
+		})
+
+		It("should unprefix denom returning to the destination chain", func() {
+			trace := coin.ReceivedDenomTrace(
+				"transfer", "channel-3", "transfer", "channel-0", "transfer/channel-3/basecro",
+			)
+			Expect(trace.IsNative()).To(BeTrue())
+			Expect(trace.BaseDenom).To(Equal("basecro"))
+
+			trace = coin.ReceivedDenomTrace(
+				"transfer", "channel-3", "transfer", "channel-0", "transfer/channel-3/transfer/channel-9/uatom",
+			)
+			Expect(trace).To(Equal(coin.DenomTrace{
+				Path:      "transfer/channel-9",
+				BaseDenom: "uatom",
+			}))
+		})
+	})
+
+	Describe("IBCDenomHash", func() {
+		It("should return hash of IBC denom", func() {
+			hash, ok := coin.IBCDenomHash(anyIBCDenom)
+
+			Expect(ok).To(BeTrue())
+			Expect(hash).To(Equal("6411AE2ADA1E73DB59DB151A8988F9B7D5E7E233D8414DB6817F8F1A01611F86"))
+		})
+
+		It("should return false for non IBC denom", func() {
+			_, ok := coin.IBCDenomHash("basecro")
+			Expect(ok).To(BeFalse())
+
+			_, ok = coin.IBCDenomHash(anyIBCDenom + "0")
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("CollectIBCDenoms", func() {
+		anotherIBCDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+		It("should return distinct IBC denoms of the coins in structs", func() {
+			value := struct {
+				Fee          coin.Coins
+				MaybeRewards *coin.DecCoins
+				Memo         string
+			}{
+				Fee: coin.Coins{
+					coin.NewCoin(anyIBCDenom, coin.NewInt(1)),
+					coin.NewCoin("basecro", coin.NewInt(2)),
+				},
+				MaybeRewards: &coin.DecCoins{
+					coin.NewDecCoin(anotherIBCDenom, coin.NewInt(3)),
+					coin.NewDecCoin(anyIBCDenom, coin.NewInt(4)),
+				},
+				Memo: "ibc/0000000000000000000000000000000000000000000000000000000000000000",
+			}
+
+			Expect(coin.CollectIBCDenoms(value)).To(Equal([]string{anyIBCDenom, anotherIBCDenom}))
+		})
+
+		It("should return IBC denoms of the denom fields in JSON decoded values", func() {
+			var content map[string]interface{}
+			json.MustUnmarshalFromString(`{
+				"amount": [{"denom": "`+anyIBCDenom+`", "amount": "1"}, {"denom": "basecro", "amount": "2"}],
+				"token": {"denom": "`+anotherIBCDenom+`", "amount": "3"},
+				"memo": "ibc/0000000000000000000000000000000000000000000000000000000000000000"
+			}`, &content)
+			value := []interface{}{content}
+
+			Expect(coin.CollectIBCDenoms(value)).To(ConsistOf(anyIBCDenom, anotherIBCDenom))
+		})
+
+		It("should return empty slice when there is no IBC denom", func() {
+			Expect(coin.CollectIBCDenoms(nil)).To(BeEmpty())
+			Expect(coin.CollectIBCDenoms(coin.Coins{coin.NewCoin("basecro", coin.NewInt(1))})).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,32 @@
+package command
+
+import (
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model/genesis"
+)
+
+type CreateGenesisVestingAccount struct {
+	params genesis.CreateGenesisVestingAccountParams
+}
+
+func NewCreateGenesisVestingAccount(
+	params genesis.CreateGenesisVestingAccountParams,
+) *CreateGenesisVestingAccount {
+	return &CreateGenesisVestingAccount{
+		params,
+	}
+}
+
+func (*CreateGenesisVestingAccount) Name() string {
+	return "CreateGenesisVestingAccount"
+}
+
+func (*CreateGenesisVestingAccount) Version() int {
+	return 1
+}
+
+func (cmd *CreateGenesisVestingAccount) Exec() (entity_event.Event, error) {
+	event := event.NewCreateGenesisVestingAccount(cmd.params)
+	return event, nil
+}
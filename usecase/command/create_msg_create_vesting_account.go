@@ -0,0 +1,37 @@
+package command
+
+import (
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+)
+
+// CreateMsgCreateVestingAccount is a command to create MsgCreateVestingAccount event
+type CreateMsgCreateVestingAccount struct {
+	msgCommonParams event.MsgCommonParams
+	params          model.MsgCreateVestingAccountParams
+}
+
+// NewCreateMsgCreateVestingAccount create a new instance of CreateMsgCreateVestingAccount command
+func NewCreateMsgCreateVestingAccount(msgCommonParams event.MsgCommonParams, params model.MsgCreateVestingAccountParams) *CreateMsgCreateVestingAccount {
+	return &CreateMsgCreateVestingAccount{
+		msgCommonParams,
+		params,
+	}
+}
+
+// Name returns name of command
+func (*CreateMsgCreateVestingAccount) Name() string {
+	return "CreateMsgCreateVestingAccount"
+}
+
+// Version returns version of command
+func (*CreateMsgCreateVestingAccount) Version() int {
+	return 1
+}
+
+// Exec process the command data and return the event accordingly
+func (cmd *CreateMsgCreateVestingAccount) Exec() (entity_event.Event, error) {
+	event := event.NewMsgCreateVestingAccount(cmd.msgCommonParams, cmd.params)
+	return event, nil
+}
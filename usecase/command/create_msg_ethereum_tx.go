@@ -0,0 +1,37 @@
+package command
+
+import (
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+)
+
+// CreateMsgEthereumTx is a command to create MsgEthereumTx event
+type CreateMsgEthereumTx struct {
+	msgCommonParams event.MsgCommonParams
+	params          model.MsgEthereumTxParams
+}
+
+// NewCreateMsgEthereumTx create a new instance of CreateMsgEthereumTx command
+func NewCreateMsgEthereumTx(msgCommonParams event.MsgCommonParams, params model.MsgEthereumTxParams) *CreateMsgEthereumTx {
+	return &CreateMsgEthereumTx{
+		msgCommonParams,
+		params,
+	}
+}
+
+// Name returns name of command
+func (*CreateMsgEthereumTx) Name() string {
+	return "CreateMsgEthereumTx"
+}
+
+// Version returns version of command
+func (*CreateMsgEthereumTx) Version() int {
+	return 1
+}
+
+// Exec process the command data and return the event accordingly
+func (cmd *CreateMsgEthereumTx) Exec() (entity_event.Event, error) {
+	event := event.NewMsgEthereumTx(cmd.msgCommonParams, cmd.params)
+	return event, nil
+}
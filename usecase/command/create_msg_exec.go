@@ -0,0 +1,37 @@
+package command
+
+import (
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+)
+
+// CreateMsgExec is a command to create MsgExec event
+type CreateMsgExec struct {
+	msgCommonParams event.MsgCommonParams
+	params          model.MsgExecParams
+}
+
+// NewCreateMsgExec create a new instance of CreateMsgExec command
+func NewCreateMsgExec(msgCommonParams event.MsgCommonParams, params model.MsgExecParams) *CreateMsgExec {
+	return &CreateMsgExec{
+		msgCommonParams,
+		params,
+	}
+}
+
+// Name returns name of command
+func (*CreateMsgExec) Name() string {
+	return "CreateMsgExec"
+}
+
+// Version returns version of command
+func (*CreateMsgExec) Version() int {
+	return 1
+}
+
+// Exec process the command data and return the event accordingly
+func (cmd *CreateMsgExec) Exec() (entity_event.Event, error) {
+	event := event.NewMsgExec(cmd.msgCommonParams, cmd.params)
+	return event, nil
+}
@@ -0,0 +1,37 @@
+package command
+
+import (
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+)
+
+// CreateMsgGrant is a command to create MsgGrant event
+type CreateMsgGrant struct {
+	msgCommonParams event.MsgCommonParams
+	params          model.MsgGrantParams
+}
+
+// NewCreateMsgGrant create a new instance of CreateMsgGrant command
+func NewCreateMsgGrant(msgCommonParams event.MsgCommonParams, params model.MsgGrantParams) *CreateMsgGrant {
+	return &CreateMsgGrant{
+		msgCommonParams,
+		params,
+	}
+}
+
+// Name returns name of command
+func (*CreateMsgGrant) Name() string {
+	return "CreateMsgGrant"
+}
+
+// Version returns version of command
+func (*CreateMsgGrant) Version() int {
+	return 1
+}
+
+// Exec process the command data and return the event accordingly
+func (cmd *CreateMsgGrant) Exec() (entity_event.Event, error) {
+	event := event.NewMsgGrant(cmd.msgCommonParams, cmd.params)
+	return event, nil
+}
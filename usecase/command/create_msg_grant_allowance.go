@@ -0,0 +1,37 @@
+package command
+
+import (
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+)
+
+// CreateMsgGrantAllowance is a command to create MsgGrantAllowance event
+type CreateMsgGrantAllowance struct {
+	msgCommonParams event.MsgCommonParams
+	params          model.MsgGrantAllowanceParams
+}
+
+// NewCreateMsgGrantAllowance create a new instance of CreateMsgGrantAllowance command
+func NewCreateMsgGrantAllowance(msgCommonParams event.MsgCommonParams, params model.MsgGrantAllowanceParams) *CreateMsgGrantAllowance {
+	return &CreateMsgGrantAllowance{
+		msgCommonParams,
+		params,
+	}
+}
+
+// Name returns name of command
+func (*CreateMsgGrantAllowance) Name() string {
+	return "CreateMsgGrantAllowance"
+}
+
+// Version returns version of command
+func (*CreateMsgGrantAllowance) Version() int {
+	return 1
+}
+
+// Exec process the command data and return the event accordingly
+func (cmd *CreateMsgGrantAllowance) Exec() (entity_event.Event, error) {
+	event := event.NewMsgGrantAllowance(cmd.msgCommonParams, cmd.params)
+	return event, nil
+}
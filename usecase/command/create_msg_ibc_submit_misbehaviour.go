@@ -0,0 +1,35 @@
+package command
+
+import (
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/usecase/event"
+	ibc_model "github.com/crypto-com/chain-indexing/usecase/model/ibc"
+)
+
+type CreateMsgIBCSubmitMisbehaviour struct {
+	msgCommonParams event.MsgCommonParams
+	params          ibc_model.MsgSubmitMisbehaviourParams
+}
+
+func NewCreateMsgIBCSubmitMisbehaviour(
+	msgCommonParams event.MsgCommonParams,
+	params ibc_model.MsgSubmitMisbehaviourParams,
+) *CreateMsgIBCSubmitMisbehaviour {
+	return &CreateMsgIBCSubmitMisbehaviour{
+		msgCommonParams,
+		params,
+	}
+}
+
+func (*CreateMsgIBCSubmitMisbehaviour) Name() string {
+	return "CreateMsgIBCSubmitMisbehaviour"
+}
+
+func (*CreateMsgIBCSubmitMisbehaviour) Version() int {
+	return 1
+}
+
+func (cmd *CreateMsgIBCSubmitMisbehaviour) Exec() (entity_event.Event, error) {
+	event := event.NewMsgIBCSubmitMisbehaviour(cmd.msgCommonParams, cmd.params)
+	return event, nil
+}
@@ -0,0 +1,35 @@
+package command
+
+import (
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/usecase/event"
+	ibc_model "github.com/crypto-com/chain-indexing/usecase/model/ibc"
+)
+
+type CreateMsgIBCUpgradeClient struct {
+	msgCommonParams event.MsgCommonParams
+	params          ibc_model.MsgUpgradeClientParams
+}
+
+func NewCreateMsgIBCUpgradeClient(
+	msgCommonParams event.MsgCommonParams,
+	params ibc_model.MsgUpgradeClientParams,
+) *CreateMsgIBCUpgradeClient {
+	return &CreateMsgIBCUpgradeClient{
+		msgCommonParams,
+		params,
+	}
+}
+
+func (*CreateMsgIBCUpgradeClient) Name() string {
+	return "CreateMsgIBCUpgradeClient"
+}
+
+func (*CreateMsgIBCUpgradeClient) Version() int {
+	return 1
+}
+
+func (cmd *CreateMsgIBCUpgradeClient) Exec() (entity_event.Event, error) {
+	event := event.NewMsgIBCUpgradeClient(cmd.msgCommonParams, cmd.params)
+	return event, nil
+}
@@ -0,0 +1,37 @@
+package command
+
+import (
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+)
+
+// CreateMsgRevoke is a command to create MsgRevoke event
+type CreateMsgRevoke struct {
+	msgCommonParams event.MsgCommonParams
+	params          model.MsgRevokeParams
+}
+
+// NewCreateMsgRevoke create a new instance of CreateMsgRevoke command
+func NewCreateMsgRevoke(msgCommonParams event.MsgCommonParams, params model.MsgRevokeParams) *CreateMsgRevoke {
+	return &CreateMsgRevoke{
+		msgCommonParams,
+		params,
+	}
+}
+
+// Name returns name of command
+func (*CreateMsgRevoke) Name() string {
+	return "CreateMsgRevoke"
+}
+
+// Version returns version of command
+func (*CreateMsgRevoke) Version() int {
+	return 1
+}
+
+// Exec process the command data and return the event accordingly
+func (cmd *CreateMsgRevoke) Exec() (entity_event.Event, error) {
+	event := event.NewMsgRevoke(cmd.msgCommonParams, cmd.params)
+	return event, nil
+}
@@ -0,0 +1,37 @@
+package command
+
+import (
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+)
+
+// CreateMsgRevokeAllowance is a command to create MsgRevokeAllowance event
+type CreateMsgRevokeAllowance struct {
+	msgCommonParams event.MsgCommonParams
+	params          model.MsgRevokeAllowanceParams
+}
+
+// NewCreateMsgRevokeAllowance create a new instance of CreateMsgRevokeAllowance command
+func NewCreateMsgRevokeAllowance(msgCommonParams event.MsgCommonParams, params model.MsgRevokeAllowanceParams) *CreateMsgRevokeAllowance {
+	return &CreateMsgRevokeAllowance{
+		msgCommonParams,
+		params,
+	}
+}
+
+// Name returns name of command
+func (*CreateMsgRevokeAllowance) Name() string {
+	return "CreateMsgRevokeAllowance"
+}
+
+// Version returns version of command
+func (*CreateMsgRevokeAllowance) Version() int {
+	return 1
+}
+
+// Exec process the command data and return the event accordingly
+func (cmd *CreateMsgRevokeAllowance) Exec() (entity_event.Event, error) {
+	event := event.NewMsgRevokeAllowance(cmd.msgCommonParams, cmd.params)
+	return event, nil
+}
@@ -0,0 +1,34 @@
+package command
+
+import (
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+)
+
+type CreateTransactionUndecodable struct {
+	blockHeight int64
+	params      model.CreateTransactionUndecodableParams
+}
+
+func NewCreateTransactionUndecodable(
+	blockHeight int64,
+	params model.CreateTransactionUndecodableParams,
+) *CreateTransactionUndecodable {
+	return &CreateTransactionUndecodable{
+		blockHeight,
+		params,
+	}
+}
+
+func (_ *CreateTransactionUndecodable) Name() string {
+	return "CreateTransactionUndecodable"
+}
+
+func (_ *CreateTransactionUndecodable) Version() int {
+	return 1
+}
+
+func (cmd *CreateTransactionUndecodable) Exec() (entity_event.Event, error) {
+	return event.NewTransactionUndecodable(cmd.blockHeight, cmd.params), nil
+}
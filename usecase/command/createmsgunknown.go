@@ -0,0 +1,37 @@
+package command
+
+import (
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+)
+
+// CreateMsgUnknown is a command to create MsgUnknown event
+type CreateMsgUnknown struct {
+	msgCommonParams event.MsgCommonParams
+	params          model.MsgUnknownParams
+}
+
+// NewCreateMsgUnknown create a new instance of CreateMsgUnknown command
+func NewCreateMsgUnknown(msgCommonParams event.MsgCommonParams, params model.MsgUnknownParams) *CreateMsgUnknown {
+	return &CreateMsgUnknown{
+		msgCommonParams,
+		params,
+	}
+}
+
+// Name returns name of command
+func (*CreateMsgUnknown) Name() string {
+	return "CreateMsgUnknown"
+}
+
+// Version returns version of command
+func (*CreateMsgUnknown) Version() int {
+	return 1
+}
+
+// Exec process the command data and return the event accordingly
+func (cmd *CreateMsgUnknown) Exec() (entity_event.Event, error) {
+	event := event.NewMsgUnknown(cmd.msgCommonParams, cmd.params)
+	return event, nil
+}
@@ -0,0 +1,13 @@
+package continuity_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestContinuity(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Continuity Suite")
+}
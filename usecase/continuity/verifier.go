@@ -0,0 +1,131 @@
+package continuity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+)
+
+// DIVERGENCE_STATUS_KEY_PREFIX prefixes the status view keys which keep the last detected divergence
+// of each event handler
+const DIVERGENCE_STATUS_KEY_PREFIX = "ChainDivergence:"
+
+// ErrBlockNotRecorded is returned when verifying a height whose block has not been recorded
+var ErrBlockNotRecorded = errors.New("block is not recorded")
+
+// DivergenceStatusKey returns the status view key of the divergence detected by the event handler
+func DivergenceStatusKey(eventHandlerName string) string {
+	return DIVERGENCE_STATUS_KEY_PREFIX + eventHandlerName
+}
+
+// Verifier checks that each synchronized block links to the hash of the block indexed at the
+// previous height through its `last_block_id`. Block hashes are recorded by sync workers, which
+// may run concurrently, and verified in height order right before the block is handled.
+type Verifier struct {
+	mutex sync.Mutex
+
+	blocks map[int64]blockLink
+
+	maybeLastVerifiedHeight *int64
+	lastVerifiedHash        string
+}
+
+type blockLink struct {
+	hash       string
+	parentHash string
+}
+
+func NewVerifier() *Verifier {
+	return &Verifier{
+		blocks: make(map[int64]blockLink),
+	}
+}
+
+// Seed sets the hash of the last indexed block, which the next verified block has to link to.
+func (verifier *Verifier) Seed(height int64, hash string) {
+	verifier.mutex.Lock()
+	defer verifier.mutex.Unlock()
+
+	verifier.maybeLastVerifiedHeight = &height
+	verifier.lastVerifiedHash = hash
+}
+
+// IsSeeded returns true when the verifier knows the hash of the block at the provided height.
+func (verifier *Verifier) IsSeeded(height int64) bool {
+	verifier.mutex.Lock()
+	defer verifier.mutex.Unlock()
+
+	return verifier.maybeLastVerifiedHeight != nil && *verifier.maybeLastVerifiedHeight == height
+}
+
+// Record keeps the hash and parent (last block id) hash of a fetched block. It is safe to be
+// called from multiple goroutines.
+func (verifier *Verifier) Record(height int64, hash string, parentHash string) {
+	verifier.mutex.Lock()
+	defer verifier.mutex.Unlock()
+
+	verifier.blocks[height] = blockLink{
+		hash:       hash,
+		parentHash: parentHash,
+	}
+}
+
+// Verify checks the recorded block at height against the last verified block. Returns a
+// *DivergenceError when the parent hash does not match and ErrBlockNotRecorded when the block at
+// height has not been recorded, e.g. it was fetched before a Reset. A block without a known
+// previous block is accepted and becomes the new reference.
+func (verifier *Verifier) Verify(height int64) error {
+	verifier.mutex.Lock()
+	defer verifier.mutex.Unlock()
+
+	link, recorded := verifier.blocks[height]
+	if !recorded {
+		return fmt.Errorf("error verifying block %d: %w", height, ErrBlockNotRecorded)
+	}
+
+	if verifier.maybeLastVerifiedHeight != nil && *verifier.maybeLastVerifiedHeight == height-1 {
+		if !strings.EqualFold(link.parentHash, verifier.lastVerifiedHash) {
+			return &DivergenceError{
+				Height:             height,
+				ExpectedParentHash: verifier.lastVerifiedHash,
+				ActualParentHash:   link.parentHash,
+				DetectedAt:         utctime.Now(),
+			}
+		}
+	}
+
+	delete(verifier.blocks, height)
+	verifier.maybeLastVerifiedHeight = &height
+	verifier.lastVerifiedHash = link.hash
+
+	return nil
+}
+
+// Reset forgets all the recorded and verified blocks
+func (verifier *Verifier) Reset() {
+	verifier.mutex.Lock()
+	defer verifier.mutex.Unlock()
+
+	verifier.blocks = make(map[int64]blockLink)
+	verifier.maybeLastVerifiedHeight = nil
+	verifier.lastVerifiedHash = ""
+}
+
+// DivergenceError is returned when a block does not link to the indexed block at the previous
+// height. It usually means the node is serving a different chain from the indexed one.
+type DivergenceError struct {
+	Height             int64           `json:"height"`
+	ExpectedParentHash string          `json:"expectedParentHash"`
+	ActualParentHash   string          `json:"actualParentHash"`
+	DetectedAt         utctime.UTCTime `json:"detectedAt"`
+}
+
+func (err *DivergenceError) Error() string {
+	return fmt.Sprintf(
+		"chain divergence detected at height %d: expected last block hash %s but got %s",
+		err.Height, err.ExpectedParentHash, err.ActualParentHash,
+	)
+}
@@ -0,0 +1,86 @@
+package continuity_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/usecase/continuity"
+)
+
+var _ = Describe("Verifier", func() {
+	Describe("Verify", func() {
+		It("should accept blocks linking to the previous verified block", func() {
+			verifier := continuity.NewVerifier()
+			verifier.Record(2, "HASH2", "HASH1")
+			verifier.Record(1, "HASH1", "")
+
+			Expect(verifier.Verify(1)).To(BeNil())
+			Expect(verifier.Verify(2)).To(BeNil())
+			Expect(verifier.IsSeeded(2)).To(BeTrue())
+		})
+
+		It("should compare parent hash case-insensitively", func() {
+			verifier := continuity.NewVerifier()
+			verifier.Seed(9, "ABCDEF")
+			verifier.Record(10, "HASH10", "abcdef")
+
+			Expect(verifier.Verify(10)).To(BeNil())
+		})
+
+		It("should accept the first block when previous hash is unknown", func() {
+			verifier := continuity.NewVerifier()
+			verifier.Record(100, "HASH100", "HASH99")
+
+			Expect(verifier.Verify(100)).To(BeNil())
+		})
+
+		It("should return DivergenceError when parent hash mismatches the seeded hash", func() {
+			verifier := continuity.NewVerifier()
+			verifier.Seed(9, "HASH9")
+			verifier.Record(10, "HASH10", "FORKED9")
+
+			err := verifier.Verify(10)
+			Expect(err).NotTo(BeNil())
+			divergenceErr, ok := err.(*continuity.DivergenceError)
+			Expect(ok).To(BeTrue())
+			Expect(divergenceErr.Height).To(Equal(int64(10)))
+			Expect(divergenceErr.ExpectedParentHash).To(Equal("HASH9"))
+			Expect(divergenceErr.ActualParentHash).To(Equal("FORKED9"))
+			Expect(divergenceErr.Error()).To(Equal(
+				"chain divergence detected at height 10: expected last block hash HASH9 but got FORKED9",
+			))
+		})
+
+		It("should keep reporting divergence until reset", func() {
+			verifier := continuity.NewVerifier()
+			verifier.Seed(9, "HASH9")
+			verifier.Record(10, "HASH10", "FORKED9")
+
+			Expect(verifier.Verify(10)).NotTo(BeNil())
+			Expect(verifier.Verify(10)).NotTo(BeNil())
+
+			verifier.Reset()
+			Expect(verifier.IsSeeded(9)).To(BeFalse())
+			Expect(errors.Is(verifier.Verify(10), continuity.ErrBlockNotRecorded)).To(BeTrue())
+
+			verifier.Record(10, "HASH10", "FORKED9")
+			Expect(verifier.Verify(10)).To(BeNil())
+		})
+
+		It("should return ErrBlockNotRecorded and keep the reference when block is not recorded", func() {
+			verifier := continuity.NewVerifier()
+			verifier.Seed(9, "HASH9")
+
+			err := verifier.Verify(10)
+			Expect(errors.Is(err, continuity.ErrBlockNotRecorded)).To(BeTrue())
+			Expect(err.Error()).To(Equal("error verifying block 10: block is not recorded"))
+			Expect(verifier.IsSeeded(9)).To(BeTrue())
+
+			verifier.Record(10, "HASH10", "FORKED9")
+			_, isDivergence := verifier.Verify(10).(*continuity.DivergenceError)
+			Expect(isDivergence).To(BeTrue())
+		})
+	})
+})
@@ -6,11 +6,13 @@ import (
 
 func RegisterEvents(registry *event.Registry) {
 	registry.Register(GENESIS_CREATED, 1, DecodeGenesisCreated)
+	registry.Register(GENESIS_VESTING_ACCOUNT_CREATED, 1, DecodeCreateGenesisVestingAccount)
 
 	registry.Register(BLOCK_CREATED, 1, DecodeBlockCreated)
 	registry.Register(RAW_BLOCK_CREATED, 1, DecodeRawBlockCreated)
 	registry.Register(TRANSACTION_CREATED, 1, DecodeTransactionCreated)
 	registry.Register(TRANSACTION_FAILED, 1, DecodeTransactionFailed)
+	registry.Register(TRANSACTION_UNDECODABLE, 1, DecodeTransactionUndecodable)
 
 	registry.Register(ACCOUNT_TRANSFERRED, 1, DecodeAccountTransferred)
 	registry.Register(BLOCK_PROPOSER_REWARDED, 1, DecodeBlockProposerRewarded)
@@ -118,7 +120,36 @@ func RegisterEvents(registry *event.Registry) {
 	registry.Register(MSG_IBC_TIMEOUT_FAILED, 1, DecodeMsgIBCTimeout)
 	registry.Register(MSG_IBC_TIMEOUT_ON_CLOSE_CREATED, 1, DecodeMsgIBCTimeoutOnClose)
 	registry.Register(MSG_IBC_TIMEOUT_ON_CLOSE_FAILED, 1, DecodeMsgIBCTimeoutOnClose)
+	registry.Register(MSG_IBC_CHANNEL_CLOSE_INIT_CREATED, 1, DecodeMsgIBCChannelCloseInit)
+	registry.Register(MSG_IBC_CHANNEL_CLOSE_INIT_FAILED, 1, DecodeMsgIBCChannelCloseInit)
+	registry.Register(MSG_IBC_CHANNEL_CLOSE_CONFIRM_CREATED, 1, DecodeMsgIBCChannelCloseConfirm)
+	registry.Register(MSG_IBC_CHANNEL_CLOSE_CONFIRM_FAILED, 1, DecodeMsgIBCChannelCloseConfirm)
+	registry.Register(MSG_IBC_SUBMIT_MISBEHAVIOUR_CREATED, 1, DecodeMsgIBCSubmitMisbehaviour)
+	registry.Register(MSG_IBC_SUBMIT_MISBEHAVIOUR_FAILED, 1, DecodeMsgIBCSubmitMisbehaviour)
+	registry.Register(MSG_IBC_UPGRADE_CLIENT_CREATED, 1, DecodeMsgIBCUpgradeClient)
+	registry.Register(MSG_IBC_UPGRADE_CLIENT_FAILED, 1, DecodeMsgIBCUpgradeClient)
 
 	registry.Register(MSG_IBC_TRANSFER_TRANSFER_CREATED, 1, DecodeMsgIBCTransferTransfer)
 	registry.Register(MSG_IBC_TRANSFER_TRANSFER_FAILED, 1, DecodeMsgIBCTransferTransfer)
+
+	registry.Register(MSG_GRANT_CREATED, 1, DecodeMsgGrant)
+	registry.Register(MSG_GRANT_FAILED, 1, DecodeMsgGrant)
+	registry.Register(MSG_REVOKE_CREATED, 1, DecodeMsgRevoke)
+	registry.Register(MSG_REVOKE_FAILED, 1, DecodeMsgRevoke)
+	registry.Register(MSG_EXEC_CREATED, 1, DecodeMsgExec)
+	registry.Register(MSG_EXEC_FAILED, 1, DecodeMsgExec)
+
+	registry.Register(MSG_GRANT_ALLOWANCE_CREATED, 1, DecodeMsgGrantAllowance)
+	registry.Register(MSG_GRANT_ALLOWANCE_FAILED, 1, DecodeMsgGrantAllowance)
+	registry.Register(MSG_REVOKE_ALLOWANCE_CREATED, 1, DecodeMsgRevokeAllowance)
+	registry.Register(MSG_REVOKE_ALLOWANCE_FAILED, 1, DecodeMsgRevokeAllowance)
+
+	registry.Register(MSG_CREATE_VESTING_ACCOUNT_CREATED, 1, DecodeMsgCreateVestingAccount)
+	registry.Register(MSG_CREATE_VESTING_ACCOUNT_FAILED, 1, DecodeMsgCreateVestingAccount)
+
+	registry.Register(MSG_ETHEREUM_TX_CREATED, 1, DecodeMsgEthereumTx)
+	registry.Register(MSG_ETHEREUM_TX_FAILED, 1, DecodeMsgEthereumTx)
+
+	registry.Register(MSG_UNKNOWN_CREATED, 1, DecodeMsgUnknown)
+	registry.Register(MSG_UNKNOWN_FAILED, 1, DecodeMsgUnknown)
 }
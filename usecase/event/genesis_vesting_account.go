@@ -0,0 +1,73 @@
+package event
+
+import (
+	"bytes"
+
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+	"github.com/crypto-com/chain-indexing/usecase/model/genesis"
+
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/luci/go-render/render"
+)
+
+const GENESIS_VESTING_ACCOUNT_CREATED = "GenesisVestingAccountCreated"
+
+// CreateGenesisVestingAccount defines a vesting account created in genesis
+type CreateGenesisVestingAccount struct {
+	entity_event.Base
+
+	Type            string                `json:"type"`
+	Address         string                `json:"address"`
+	OriginalVesting coin.Coins            `json:"originalVesting"`
+	MaybeStartTime  *utctime.UTCTime      `json:"startTime"`
+	EndTime         utctime.UTCTime       `json:"endTime"`
+	VestingPeriods  []model.VestingPeriod `json:"vestingPeriods"`
+}
+
+func NewCreateGenesisVestingAccount(
+	params genesis.CreateGenesisVestingAccountParams,
+) *CreateGenesisVestingAccount {
+	return &CreateGenesisVestingAccount{
+		entity_event.NewBase(entity_event.BaseParams{
+			Name:        GENESIS_VESTING_ACCOUNT_CREATED,
+			Version:     1,
+			BlockHeight: 0,
+		}),
+
+		params.Type,
+		params.Address,
+		params.OriginalVesting,
+		params.MaybeStartTime,
+		params.EndTime,
+		params.VestingPeriods,
+	}
+}
+
+// ToJSON encodes the event into JSON string payload
+func (event *CreateGenesisVestingAccount) ToJSON() (string, error) {
+	encoded, err := jsoniter.Marshal(event)
+	if err != nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
+
+func (event *CreateGenesisVestingAccount) String() string {
+	return render.Render(event)
+}
+
+func DecodeCreateGenesisVestingAccount(encoded []byte) (entity_event.Event, error) {
+	jsonDecoder := jsoniter.NewDecoder(bytes.NewReader(encoded))
+	jsonDecoder.DisallowUnknownFields()
+
+	var event *CreateGenesisVestingAccount
+	if err := jsonDecoder.Decode(&event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
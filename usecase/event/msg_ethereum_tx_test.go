@@ -0,0 +1,108 @@
+package event_test
+
+import (
+	event_entity "github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/internal/primptr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+)
+
+var _ = Describe("Event", func() {
+	registry := event_entity.NewRegistry()
+	event_usecase.RegisterEvents(registry)
+
+	Describe("En/DecodeMsgEthereumTx", func() {
+		It("should able to encode and decode to the same event", func() {
+			anyHeight := int64(1000)
+			anyTxHash := "4936522F7391D425F2A93AD47576F8AEC3947DC907113BE8A2FBCFF8E9F2A416"
+			anyMsgIndex := 0
+			anyParams := model.MsgEthereumTxParams{
+				Hash:           "0x5d3cbfcf7d50e0d6ac9bd4e7e0f4f2fd77a52f1d7a9e3b0c1f6b5bd0a5f1f7f2",
+				TxType:         model.ETHEREUM_DYNAMIC_FEE_TX,
+				From:           "0xb5Ec6d86f8f418F480799447F5c21F1C17c6f8F8",
+				FromAccount:    "tcro1khkxmphc7sv0fqrej3rltsslrstud78c0jl6l6",
+				MaybeTo:        primptr.String("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+				MaybeToAccount: primptr.String("tcro1t2htvpfl862vnwdqnuekd9p4ulh3h6hdpel6aa"),
+				MaybeChainID:   primptr.String("777"),
+				Nonce:          3,
+				Value:          "1000000000000000000",
+				Gas:            21000,
+				MaybeGasTipCap: primptr.String("1000000000"),
+				MaybeGasFeeCap: primptr.String("5000000000000"),
+				Data:           "0x",
+				AccessList: []model.EthereumAccessTuple{{
+					Address:     "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+					StorageKeys: []string{"0x0000000000000000000000000000000000000000000000000000000000000001"},
+				}},
+				MaybeGasUsed: primptr.Uint64(21000),
+				Logs: []model.EthereumTxLog{{
+					Address:  "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+					Topics:   []string{"0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"},
+					Data:     "0x01",
+					LogIndex: 0,
+				}},
+			}
+			event := event_usecase.NewMsgEthereumTx(event_usecase.MsgCommonParams{
+				BlockHeight: anyHeight,
+				TxHash:      anyTxHash,
+				TxSuccess:   true,
+				MsgIndex:    anyMsgIndex,
+			}, anyParams)
+
+			encoded, err := event.ToJSON()
+			Expect(err).To(BeNil())
+
+			decodedEvent, err := registry.DecodeByType(
+				event_usecase.MSG_ETHEREUM_TX_CREATED, 1, []byte(encoded),
+			)
+			Expect(err).To(BeNil())
+			Expect(decodedEvent).To(Equal(event))
+			typedEvent, _ := decodedEvent.(*event_usecase.MsgEthereumTx)
+			Expect(typedEvent.Name()).To(Equal(event_usecase.MSG_ETHEREUM_TX_CREATED))
+			Expect(typedEvent.Version()).To(Equal(1))
+
+			Expect(typedEvent.MsgTxHash).To(Equal(anyTxHash))
+			Expect(typedEvent.MsgIndex).To(Equal(anyMsgIndex))
+			Expect(typedEvent.Params).To(Equal(anyParams))
+		})
+
+		It("should able to encode and decode to failed event", func() {
+			anyHeight := int64(1000)
+			anyTxHash := "4936522F7391D425F2A93AD47576F8AEC3947DC907113BE8A2FBCFF8E9F2A416"
+			anyMsgIndex := 0
+			anyParams := model.MsgEthereumTxParams{
+				Hash:               "0x5d3cbfcf7d50e0d6ac9bd4e7e0f4f2fd77a52f1d7a9e3b0c1f6b5bd0a5f1f7f2",
+				TxType:             model.ETHEREUM_LEGACY_TX,
+				Nonce:              0,
+				Value:              "0",
+				Gas:                100000,
+				MaybeGasPrice:      primptr.String("5000000000000"),
+				Data:               "0x6080",
+				AccessList:         []model.EthereumAccessTuple{},
+				IsContractCreation: true,
+				Logs:               []model.EthereumTxLog{},
+			}
+			event := event_usecase.NewMsgEthereumTx(event_usecase.MsgCommonParams{
+				BlockHeight: anyHeight,
+				TxHash:      anyTxHash,
+				TxSuccess:   false,
+				MsgIndex:    anyMsgIndex,
+			}, anyParams)
+
+			encoded, err := event.ToJSON()
+			Expect(err).To(BeNil())
+
+			decodedEvent, err := registry.DecodeByType(
+				event_usecase.MSG_ETHEREUM_TX_FAILED, 1, []byte(encoded),
+			)
+			Expect(err).To(BeNil())
+			Expect(decodedEvent).To(Equal(event))
+			typedEvent, _ := decodedEvent.(*event_usecase.MsgEthereumTx)
+			Expect(typedEvent.Name()).To(Equal(event_usecase.MSG_ETHEREUM_TX_FAILED))
+			Expect(typedEvent.Version()).To(Equal(1))
+		})
+	})
+})
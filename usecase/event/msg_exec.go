@@ -0,0 +1,63 @@
+package event
+
+import (
+	"bytes"
+
+	"github.com/crypto-com/chain-indexing/usecase/model"
+
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/luci/go-render/render"
+)
+
+const MSG_EXEC = "MsgExec"
+const MSG_EXEC_CREATED = "MsgExecCreated"
+const MSG_EXEC_FAILED = "MsgExecFailed"
+
+// MsgExec defines an authz message for grantee to execute messages on behalf of their granters.
+// Each of the executed messages is parsed into its own event
+type MsgExec struct {
+	MsgBase
+
+	Params model.MsgExecParams `json:"params"`
+}
+
+// NewMsgExec creates a new instance of MsgExec
+func NewMsgExec(msgCommonParams MsgCommonParams, params model.MsgExecParams) *MsgExec {
+	return &MsgExec{
+		NewMsgBase(MsgBaseParams{
+			MsgName:         MSG_EXEC,
+			Version:         1,
+			MsgCommonParams: msgCommonParams,
+		}),
+
+		params,
+	}
+}
+
+// ToJSON encodes the event into JSON string payload
+func (event *MsgExec) ToJSON() (string, error) {
+	encoded, err := jsoniter.Marshal(event)
+	if err != nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
+
+func (event *MsgExec) String() string {
+	return render.Render(event)
+}
+
+// DecodeMsgExec decodes the event from encoded bytes
+func DecodeMsgExec(encoded []byte) (entity_event.Event, error) {
+	jsonDecoder := jsoniter.NewDecoder(bytes.NewReader(encoded))
+	jsonDecoder.DisallowUnknownFields()
+
+	var event *MsgExec
+	if err := jsonDecoder.Decode(&event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
@@ -0,0 +1,90 @@
+package event_test
+
+import (
+	event_entity "github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/internal/primptr"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+)
+
+var _ = Describe("Event", func() {
+	registry := event_entity.NewRegistry()
+	event_usecase.RegisterEvents(registry)
+
+	Describe("En/DecodeMsgGrantAllowance", func() {
+		It("should able to encode and decode to the same event", func() {
+			anyHeight := int64(1000)
+			anyTxHash := "4936522F7391D425F2A93AD47576F8AEC3947DC907113BE8A2FBCFF8E9F2A416"
+			anyMsgIndex := 2
+			anyParams := model.MsgGrantAllowanceParams{
+				Granter: "tcro184lta2lsyu47vwyp2e8zmtca3k5yq85p6c4vp3",
+				Grantee: "tcro1fmprm0sjy6lz9llv7rltn0v2azzwcwzvk2lsyn",
+				Allowance: model.FeeAllowance{
+					AllowanceType:    "/cosmos.feegrant.v1beta1.PeriodicAllowance",
+					SpendLimit:       coin.NewCoins(coin.MustNewCoinFromString("basetcro", "1000")),
+					MaybePeriod:      primptr.Int64(86400),
+					PeriodSpendLimit: coin.NewCoins(coin.MustNewCoinFromString("basetcro", "100")),
+					AllowedMessages:  []string{"/cosmos.staking.v1beta1.MsgDelegate"},
+				},
+			}
+			event := event_usecase.NewMsgGrantAllowance(event_usecase.MsgCommonParams{
+				BlockHeight: anyHeight,
+				TxHash:      anyTxHash,
+				TxSuccess:   true,
+				MsgIndex:    anyMsgIndex,
+			}, anyParams)
+
+			encoded, err := event.ToJSON()
+			Expect(err).To(BeNil())
+
+			decodedEvent, err := registry.DecodeByType(
+				event_usecase.MSG_GRANT_ALLOWANCE_CREATED, 1, []byte(encoded),
+			)
+			Expect(err).To(BeNil())
+			Expect(decodedEvent).To(Equal(event))
+			typedEvent, _ := decodedEvent.(*event_usecase.MsgGrantAllowance)
+			Expect(typedEvent.Name()).To(Equal(event_usecase.MSG_GRANT_ALLOWANCE_CREATED))
+			Expect(typedEvent.Version()).To(Equal(1))
+
+			Expect(typedEvent.MsgTxHash).To(Equal(anyTxHash))
+			Expect(typedEvent.MsgIndex).To(Equal(anyMsgIndex))
+			Expect(typedEvent.Params).To(Equal(anyParams))
+		})
+
+		It("should able to encode and decode to failed event", func() {
+			anyHeight := int64(1000)
+			anyTxHash := "4936522F7391D425F2A93AD47576F8AEC3947DC907113BE8A2FBCFF8E9F2A416"
+			anyMsgIndex := 2
+			anyParams := model.MsgGrantAllowanceParams{
+				Granter: "tcro184lta2lsyu47vwyp2e8zmtca3k5yq85p6c4vp3",
+				Grantee: "tcro1fmprm0sjy6lz9llv7rltn0v2azzwcwzvk2lsyn",
+				Allowance: model.FeeAllowance{
+					AllowanceType: "/cosmos.feegrant.v1beta1.BasicAllowance",
+					SpendLimit:    coin.NewCoins(coin.MustNewCoinFromString("basetcro", "1000")),
+				},
+			}
+			event := event_usecase.NewMsgGrantAllowance(event_usecase.MsgCommonParams{
+				BlockHeight: anyHeight,
+				TxHash:      anyTxHash,
+				TxSuccess:   false,
+				MsgIndex:    anyMsgIndex,
+			}, anyParams)
+
+			encoded, err := event.ToJSON()
+			Expect(err).To(BeNil())
+
+			decodedEvent, err := registry.DecodeByType(
+				event_usecase.MSG_GRANT_ALLOWANCE_FAILED, 1, []byte(encoded),
+			)
+			Expect(err).To(BeNil())
+			Expect(decodedEvent).To(Equal(event))
+			typedEvent, _ := decodedEvent.(*event_usecase.MsgGrantAllowance)
+			Expect(typedEvent.Name()).To(Equal(event_usecase.MSG_GRANT_ALLOWANCE_FAILED))
+			Expect(typedEvent.Version()).To(Equal(1))
+		})
+	})
+})
@@ -0,0 +1,123 @@
+package event_test
+
+import (
+	"time"
+
+	event_entity "github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+)
+
+var _ = Describe("Event", func() {
+	registry := event_entity.NewRegistry()
+	event_usecase.RegisterEvents(registry)
+
+	Describe("En/DecodeMsgGrant", func() {
+		It("should able to encode and decode to the same event", func() {
+			anyHeight := int64(1000)
+			anyTxHash := "4936522F7391D425F2A93AD47576F8AEC3947DC907113BE8A2FBCFF8E9F2A416"
+			anyMsgIndex := 2
+			anyExpiration := utctime.FromUnixNano(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC).UnixNano())
+			anyParams := model.MsgGrantParams{
+				Granter: "tcro184lta2lsyu47vwyp2e8zmtca3k5yq85p6c4vp3",
+				Grantee: "tcro1fmprm0sjy6lz9llv7rltn0v2azzwcwzvk2lsyn",
+				Grant: model.AuthzGrant{
+					AuthorizationType: "/cosmos.authz.v1beta1.GenericAuthorization",
+					MsgTypeURL:        "/cosmos.staking.v1beta1.MsgDelegate",
+					MaybeExpiration:   &anyExpiration,
+				},
+			}
+			event := event_usecase.NewMsgGrant(event_usecase.MsgCommonParams{
+				BlockHeight: anyHeight,
+				TxHash:      anyTxHash,
+				TxSuccess:   true,
+				MsgIndex:    anyMsgIndex,
+			}, anyParams)
+
+			encoded, err := event.ToJSON()
+			Expect(err).To(BeNil())
+
+			decodedEvent, err := registry.DecodeByType(
+				event_usecase.MSG_GRANT_CREATED, 1, []byte(encoded),
+			)
+			Expect(err).To(BeNil())
+			Expect(decodedEvent).To(Equal(event))
+			typedEvent, _ := decodedEvent.(*event_usecase.MsgGrant)
+			Expect(typedEvent.Name()).To(Equal(event_usecase.MSG_GRANT_CREATED))
+			Expect(typedEvent.Version()).To(Equal(1))
+
+			Expect(typedEvent.MsgTxHash).To(Equal(anyTxHash))
+			Expect(typedEvent.MsgIndex).To(Equal(anyMsgIndex))
+			Expect(typedEvent.Params).To(Equal(anyParams))
+		})
+
+		It("should able to encode and decode to failed event", func() {
+			anyHeight := int64(1000)
+			anyTxHash := "4936522F7391D425F2A93AD47576F8AEC3947DC907113BE8A2FBCFF8E9F2A416"
+			anyMsgIndex := 2
+			anyParams := model.MsgGrantParams{
+				Granter: "tcro184lta2lsyu47vwyp2e8zmtca3k5yq85p6c4vp3",
+				Grantee: "tcro1fmprm0sjy6lz9llv7rltn0v2azzwcwzvk2lsyn",
+				Grant: model.AuthzGrant{
+					AuthorizationType: "/cosmos.authz.v1beta1.GenericAuthorization",
+					MsgTypeURL:        "/cosmos.staking.v1beta1.MsgDelegate",
+				},
+			}
+			event := event_usecase.NewMsgGrant(event_usecase.MsgCommonParams{
+				BlockHeight: anyHeight,
+				TxHash:      anyTxHash,
+				TxSuccess:   false,
+				MsgIndex:    anyMsgIndex,
+			}, anyParams)
+
+			encoded, err := event.ToJSON()
+			Expect(err).To(BeNil())
+
+			decodedEvent, err := registry.DecodeByType(
+				event_usecase.MSG_GRANT_FAILED, 1, []byte(encoded),
+			)
+			Expect(err).To(BeNil())
+			Expect(decodedEvent).To(Equal(event))
+			typedEvent, _ := decodedEvent.(*event_usecase.MsgGrant)
+			Expect(typedEvent.Name()).To(Equal(event_usecase.MSG_GRANT_FAILED))
+			Expect(typedEvent.Version()).To(Equal(1))
+		})
+	})
+
+	Describe("En/DecodeMsgExec", func() {
+		It("should able to encode and decode to the same event", func() {
+			anyHeight := int64(1000)
+			anyTxHash := "4936522F7391D425F2A93AD47576F8AEC3947DC907113BE8A2FBCFF8E9F2A416"
+			anyMsgIndex := 0
+			anyParams := model.MsgExecParams{
+				Grantee: "tcro1fmprm0sjy6lz9llv7rltn0v2azzwcwzvk2lsyn",
+				MsgTypeURLs: []string{
+					"/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward",
+					"/cosmos.staking.v1beta1.MsgDelegate",
+				},
+			}
+			event := event_usecase.NewMsgExec(event_usecase.MsgCommonParams{
+				BlockHeight: anyHeight,
+				TxHash:      anyTxHash,
+				TxSuccess:   true,
+				MsgIndex:    anyMsgIndex,
+			}, anyParams)
+
+			encoded, err := event.ToJSON()
+			Expect(err).To(BeNil())
+
+			decodedEvent, err := registry.DecodeByType(
+				event_usecase.MSG_EXEC_CREATED, 1, []byte(encoded),
+			)
+			Expect(err).To(BeNil())
+			Expect(decodedEvent).To(Equal(event))
+			typedEvent, _ := decodedEvent.(*event_usecase.MsgExec)
+			Expect(typedEvent.Name()).To(Equal(event_usecase.MSG_EXEC_CREATED))
+			Expect(typedEvent.Params).To(Equal(anyParams))
+		})
+	})
+})
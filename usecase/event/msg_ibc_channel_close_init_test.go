@@ -0,0 +1,95 @@
+package event_test
+
+import (
+	event_entity "github.com/crypto-com/chain-indexing/entity/event"
+	ibc_model "github.com/crypto-com/chain-indexing/usecase/model/ibc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+)
+
+var _ = Describe("Event", func() {
+	registry := event_entity.NewRegistry()
+	event_usecase.RegisterEvents(registry)
+
+	Describe("En/DecodeMsgIBCChannelCloseInit", func() {
+		It("should able to encode and decode to the same event", func() {
+			anyHeight := int64(1000)
+			anyTxHash := "4936522F7391D425F2A93AD47576F8AEC3947DC907113BE8A2FBCFF8E9F2A416"
+			anyMsgIndex := 2
+			anyParams := ibc_model.MsgChannelCloseInitParams{
+				RawMsgChannelCloseInit: ibc_model.RawMsgChannelCloseInit{
+					PortID:    "transfer",
+					ChannelID: "channel-0",
+					Signer:    "cro1dulwqgcdpemn8c34sjd92fxepz5p0sqpeevw7f",
+				},
+
+				CounterpartyPortID:    "transfer",
+				CounterpartyChannelID: "channel-3",
+				ConnectionID:          "connection-0",
+			}
+
+			event := event_usecase.NewMsgIBCChannelCloseInit(event_usecase.MsgCommonParams{
+				BlockHeight: anyHeight,
+				TxHash:      anyTxHash,
+				TxSuccess:   true,
+				MsgIndex:    anyMsgIndex,
+			}, anyParams)
+
+			encoded, err := event.ToJSON()
+			Expect(err).To(BeNil())
+
+			decodedEvent, err := registry.DecodeByType(
+				event_usecase.MSG_IBC_CHANNEL_CLOSE_INIT_CREATED, 1, []byte(encoded),
+			)
+			Expect(err).To(BeNil())
+			Expect(decodedEvent).To(Equal(event))
+			typedEvent, _ := decodedEvent.(*event_usecase.MsgIBCChannelCloseInit)
+			Expect(typedEvent.Name()).To(Equal(event_usecase.MSG_IBC_CHANNEL_CLOSE_INIT_CREATED))
+			Expect(typedEvent.Version()).To(Equal(1))
+			Expect(typedEvent.TxSuccess()).To(BeTrue())
+			Expect(typedEvent.TxHash()).To(Equal(anyTxHash))
+
+			Expect(typedEvent.MsgIndex).To(Equal(anyMsgIndex))
+			Expect(typedEvent.Params).To(Equal(anyParams))
+		})
+	})
+
+	Describe("En/DecodeMsgIBCSubmitMisbehaviour", func() {
+		It("should able to encode and decode failed event", func() {
+			anyHeight := int64(1000)
+			anyTxHash := "4936522F7391D425F2A93AD47576F8AEC3947DC907113BE8A2FBCFF8E9F2A416"
+			anyMsgIndex := 2
+			anyParams := ibc_model.MsgSubmitMisbehaviourParams{
+				RawMsgSubmitMisbehaviour: ibc_model.RawMsgSubmitMisbehaviour{
+					ClientID: "07-tendermint-0",
+					Misbehaviour: ibc_model.RawMisbehaviour{
+						Type: "/ibc.lightclients.tendermint.v1.Misbehaviour",
+					},
+					Signer: "cro1dulwqgcdpemn8c34sjd92fxepz5p0sqpeevw7f",
+				},
+			}
+
+			event := event_usecase.NewMsgIBCSubmitMisbehaviour(event_usecase.MsgCommonParams{
+				BlockHeight: anyHeight,
+				TxHash:      anyTxHash,
+				TxSuccess:   false,
+				MsgIndex:    anyMsgIndex,
+			}, anyParams)
+
+			encoded, err := event.ToJSON()
+			Expect(err).To(BeNil())
+
+			decodedEvent, err := registry.DecodeByType(
+				event_usecase.MSG_IBC_SUBMIT_MISBEHAVIOUR_FAILED, 1, []byte(encoded),
+			)
+			Expect(err).To(BeNil())
+			Expect(decodedEvent).To(Equal(event))
+			typedEvent, _ := decodedEvent.(*event_usecase.MsgIBCSubmitMisbehaviour)
+			Expect(typedEvent.Name()).To(Equal(event_usecase.MSG_IBC_SUBMIT_MISBEHAVIOUR_FAILED))
+			Expect(typedEvent.TxSuccess()).To(BeFalse())
+			Expect(typedEvent.Params).To(Equal(anyParams))
+		})
+	})
+})
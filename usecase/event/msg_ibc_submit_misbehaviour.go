@@ -0,0 +1,61 @@
+package event
+
+import (
+	"bytes"
+
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	ibc_model "github.com/crypto-com/chain-indexing/usecase/model/ibc"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/luci/go-render/render"
+)
+
+const MSG_IBC_SUBMIT_MISBEHAVIOUR = "MsgSubmitMisbehaviour"
+const MSG_IBC_SUBMIT_MISBEHAVIOUR_CREATED = "MsgSubmitMisbehaviourCreated"
+const MSG_IBC_SUBMIT_MISBEHAVIOUR_FAILED = "MsgSubmitMisbehaviourFailed"
+
+type MsgIBCSubmitMisbehaviour struct {
+	MsgBase
+
+	Params ibc_model.MsgSubmitMisbehaviourParams `json:"params"`
+}
+
+func NewMsgIBCSubmitMisbehaviour(
+	msgCommonParams MsgCommonParams,
+	params ibc_model.MsgSubmitMisbehaviourParams,
+) *MsgIBCSubmitMisbehaviour {
+	return &MsgIBCSubmitMisbehaviour{
+		NewMsgBase(MsgBaseParams{
+			MsgName:         MSG_IBC_SUBMIT_MISBEHAVIOUR,
+			Version:         1,
+			MsgCommonParams: msgCommonParams,
+		}),
+
+		params,
+	}
+}
+
+// ToJSON encodes the event into JSON string payload
+func (event *MsgIBCSubmitMisbehaviour) ToJSON() (string, error) {
+	encoded, err := jsoniter.Marshal(event)
+	if err != nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
+
+func (event *MsgIBCSubmitMisbehaviour) String() string {
+	return render.Render(event)
+}
+
+func DecodeMsgIBCSubmitMisbehaviour(encoded []byte) (entity_event.Event, error) {
+	jsonDecoder := jsoniter.NewDecoder(bytes.NewReader(encoded))
+	jsonDecoder.DisallowUnknownFields()
+
+	var event *MsgIBCSubmitMisbehaviour
+	if err := jsonDecoder.Decode(&event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
@@ -0,0 +1,61 @@
+package event
+
+import (
+	"bytes"
+
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	ibc_model "github.com/crypto-com/chain-indexing/usecase/model/ibc"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/luci/go-render/render"
+)
+
+const MSG_IBC_UPGRADE_CLIENT = "MsgUpgradeClient"
+const MSG_IBC_UPGRADE_CLIENT_CREATED = "MsgUpgradeClientCreated"
+const MSG_IBC_UPGRADE_CLIENT_FAILED = "MsgUpgradeClientFailed"
+
+type MsgIBCUpgradeClient struct {
+	MsgBase
+
+	Params ibc_model.MsgUpgradeClientParams `json:"params"`
+}
+
+func NewMsgIBCUpgradeClient(
+	msgCommonParams MsgCommonParams,
+	params ibc_model.MsgUpgradeClientParams,
+) *MsgIBCUpgradeClient {
+	return &MsgIBCUpgradeClient{
+		NewMsgBase(MsgBaseParams{
+			MsgName:         MSG_IBC_UPGRADE_CLIENT,
+			Version:         1,
+			MsgCommonParams: msgCommonParams,
+		}),
+
+		params,
+	}
+}
+
+// ToJSON encodes the event into JSON string payload
+func (event *MsgIBCUpgradeClient) ToJSON() (string, error) {
+	encoded, err := jsoniter.Marshal(event)
+	if err != nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
+
+func (event *MsgIBCUpgradeClient) String() string {
+	return render.Render(event)
+}
+
+func DecodeMsgIBCUpgradeClient(encoded []byte) (entity_event.Event, error) {
+	jsonDecoder := jsoniter.NewDecoder(bytes.NewReader(encoded))
+	jsonDecoder.DisallowUnknownFields()
+
+	var event *MsgIBCUpgradeClient
+	if err := jsonDecoder.Decode(&event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
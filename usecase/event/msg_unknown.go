@@ -0,0 +1,63 @@
+package event
+
+import (
+	"bytes"
+
+	"github.com/crypto-com/chain-indexing/usecase/model"
+
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/luci/go-render/render"
+)
+
+const MSG_UNKNOWN = "MsgUnknown"
+const MSG_UNKNOWN_CREATED = "MsgUnknownCreated"
+const MSG_UNKNOWN_FAILED = "MsgUnknownFailed"
+
+// MsgUnknown records a transaction message of type without registered parser. It keeps the raw
+// message so that the message can be re-parsed once its type is supported.
+type MsgUnknown struct {
+	MsgBase
+
+	Params model.MsgUnknownParams `json:"params"`
+}
+
+// NewMsgUnknown creates a new instance of MsgUnknown
+func NewMsgUnknown(msgCommonParams MsgCommonParams, params model.MsgUnknownParams) *MsgUnknown {
+	return &MsgUnknown{
+		NewMsgBase(MsgBaseParams{
+			MsgName:         MSG_UNKNOWN,
+			Version:         1,
+			MsgCommonParams: msgCommonParams,
+		}),
+
+		params,
+	}
+}
+
+// ToJSON encodes the event into JSON string payload
+func (event *MsgUnknown) ToJSON() (string, error) {
+	encoded, err := jsoniter.Marshal(event)
+	if err != nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
+
+func (event *MsgUnknown) String() string {
+	return render.Render(event)
+}
+
+// DecodeMsgUnknown decodes the event from encoded bytes
+func DecodeMsgUnknown(encoded []byte) (entity_event.Event, error) {
+	jsonDecoder := jsoniter.NewDecoder(bytes.NewReader(encoded))
+	jsonDecoder.DisallowUnknownFields()
+
+	var event *MsgUnknown
+	if err := jsonDecoder.Decode(&event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
@@ -96,4 +96,33 @@ var MSG_EVENTS = []string{
 	MSG_IBC_TIMEOUT_FAILED,
 	MSG_IBC_TIMEOUT_ON_CLOSE_CREATED,
 	MSG_IBC_TIMEOUT_ON_CLOSE_FAILED,
+	MSG_IBC_CHANNEL_CLOSE_INIT_CREATED,
+	MSG_IBC_CHANNEL_CLOSE_INIT_FAILED,
+	MSG_IBC_CHANNEL_CLOSE_CONFIRM_CREATED,
+	MSG_IBC_CHANNEL_CLOSE_CONFIRM_FAILED,
+	MSG_IBC_SUBMIT_MISBEHAVIOUR_CREATED,
+	MSG_IBC_SUBMIT_MISBEHAVIOUR_FAILED,
+	MSG_IBC_UPGRADE_CLIENT_CREATED,
+	MSG_IBC_UPGRADE_CLIENT_FAILED,
+
+	MSG_GRANT_CREATED,
+	MSG_GRANT_FAILED,
+	MSG_REVOKE_CREATED,
+	MSG_REVOKE_FAILED,
+	MSG_EXEC_CREATED,
+	MSG_EXEC_FAILED,
+
+	MSG_GRANT_ALLOWANCE_CREATED,
+	MSG_GRANT_ALLOWANCE_FAILED,
+	MSG_REVOKE_ALLOWANCE_CREATED,
+	MSG_REVOKE_ALLOWANCE_FAILED,
+
+	MSG_CREATE_VESTING_ACCOUNT_CREATED,
+	MSG_CREATE_VESTING_ACCOUNT_FAILED,
+
+	MSG_ETHEREUM_TX_CREATED,
+	MSG_ETHEREUM_TX_FAILED,
+
+	MSG_UNKNOWN_CREATED,
+	MSG_UNKNOWN_FAILED,
 }
@@ -0,0 +1,71 @@
+package event
+
+import (
+	"bytes"
+
+	"github.com/luci/go-render/render"
+
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+	jsoniter "github.com/json-iterator/go"
+)
+
+const TRANSACTION_UNDECODABLE = "TransactionUndecodable"
+
+// TransactionUndecodable records a transaction the transaction decoder failed to decode, with the
+// raw bytes so that it can be backfilled once the decoder supports it.
+type TransactionUndecodable struct {
+	entity_event.Base
+
+	TxHash      string `json:"txHash"`
+	Index       int    `json:"index"`
+	Code        int    `json:"code"`
+	Log         string `json:"log"`
+	RawTx       string `json:"rawTx"`
+	DecodeError string `json:"decodeError"`
+}
+
+func NewTransactionUndecodable(
+	blockHeight int64,
+	params model.CreateTransactionUndecodableParams,
+) *TransactionUndecodable {
+	return &TransactionUndecodable{
+		Base: entity_event.NewBase(entity_event.BaseParams{
+			Name:        TRANSACTION_UNDECODABLE,
+			Version:     1,
+			BlockHeight: blockHeight,
+		}),
+
+		TxHash:      params.TxHash,
+		Index:       params.Index,
+		Code:        params.Code,
+		Log:         params.Log,
+		RawTx:       params.RawTx,
+		DecodeError: params.DecodeError,
+	}
+}
+
+func (event *TransactionUndecodable) ToJSON() (string, error) {
+	encoded, err := jsoniter.Marshal(event)
+	if err != nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
+
+func (event *TransactionUndecodable) String() string {
+	return render.Render(event)
+}
+
+func DecodeTransactionUndecodable(encoded []byte) (entity_event.Event, error) {
+	jsonDecoder := jsoniter.NewDecoder(bytes.NewReader(encoded))
+	jsonDecoder.DisallowUnknownFields()
+
+	var event *TransactionUndecodable
+	if err := jsonDecoder.Decode(&event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
@@ -0,0 +1,47 @@
+package event_test
+
+import (
+	event_entity "github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/test/factory"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+)
+
+var _ = Describe("Event", func() {
+	registry := event_entity.NewRegistry()
+	event_usecase.RegisterEvents(registry)
+
+	Describe("En/DecodeTransactionUndecodable", func() {
+		It("should able to encode and decode to the same Event", func() {
+			anyTxHash := factory.RandomTxHash()
+			anyHeight := int64(1000)
+			anyParams := model.CreateTransactionUndecodableParams{
+				TxHash:      anyTxHash,
+				Index:       1,
+				Code:        0,
+				Log:         "{\"events\":[]}",
+				RawTx:       "CpIBCo8BChwvY29zbW9zLmJhbmsudjFiZXRhMS5Nc2dTZW5k",
+				DecodeError: "error decoding transaction: unknown sign mode",
+			}
+			event := event_usecase.NewTransactionUndecodable(anyHeight, anyParams)
+
+			encoded, err := event.ToJSON()
+			Expect(err).To(BeNil())
+
+			decodedEvent, err := registry.DecodeByType(
+				event_usecase.TRANSACTION_UNDECODABLE, 1, []byte(encoded),
+			)
+			Expect(err).To(BeNil())
+			Expect(decodedEvent).To(Equal(event))
+			typedEvent, _ := decodedEvent.(*event_usecase.TransactionUndecodable)
+			Expect(typedEvent.Name()).To(Equal(event_usecase.TRANSACTION_UNDECODABLE))
+			Expect(typedEvent.Version()).To(Equal(1))
+
+			Expect(typedEvent.TxHash).To(Equal(anyTxHash))
+			Expect(typedEvent.RawTx).To(Equal(anyParams.RawTx))
+		})
+	})
+})
@@ -0,0 +1,19 @@
+package genesis
+
+import (
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+)
+
+type CreateGenesisVestingAccountParams struct {
+	// Type URL of the vesting account, e.g. /cosmos.vesting.v1beta1.DelayedVestingAccount
+	Type            string     `json:"type"`
+	Address         string     `json:"address"`
+	OriginalVesting coin.Coins `json:"originalVesting"`
+	// Not available in DelayedVestingAccount
+	MaybeStartTime *utctime.UTCTime `json:"startTime"`
+	EndTime        utctime.UTCTime  `json:"endTime"`
+	// Only available in PeriodicVestingAccount
+	VestingPeriods []model.VestingPeriod `json:"vestingPeriods"`
+}
@@ -45,6 +45,15 @@ type Account struct {
 	BaseAccount              *BaseAccount        `json:"base_account,omitempty"`
 	ModuleAccountName        *string             `json:"name,omitempty"`
 	ModuleAccountPermissions []string            `json:"permissions,omitempty"`
+	// Only available in ContinuousVestingAccount and PeriodicVestingAccount
+	StartTime *string `json:"start_time,omitempty"`
+	// Only available in PeriodicVestingAccount
+	VestingPeriods []VestingPeriod `json:"vesting_periods,omitempty"`
+}
+
+type VestingPeriod struct {
+	Length string       `json:"length"`
+	Amount []MinDeposit `json:"amount"`
 }
 
 type BaseVestingAccount struct {
@@ -0,0 +1,19 @@
+package ibc
+
+type MsgSubmitMisbehaviourParams struct {
+	RawMsgSubmitMisbehaviour
+
+	ClientType string `json:"clientType"`
+}
+
+type RawMsgSubmitMisbehaviour struct {
+	ClientID     string          `mapstructure:"client_id" json:"clientId"`
+	Misbehaviour RawMisbehaviour `mapstructure:"misbehaviour" json:"misbehaviour"`
+	Signer       string          `mapstructure:"signer" json:"signer"`
+}
+
+// RawMisbehaviour only keeps the type of the misbehaviour evidence. The evidence
+// itself is verified on-chain and the client is frozen once it is accepted.
+type RawMisbehaviour struct {
+	Type string `mapstructure:"@type" json:"@type"`
+}
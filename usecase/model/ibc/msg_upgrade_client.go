@@ -0,0 +1,24 @@
+package ibc
+
+type MsgUpgradeClientParams struct {
+	MaybeTendermintLightClient *TendermintLightClient `json:"maybeTendermintLightClient"`
+	// TODO: SoloMachine and Localhost LightClient
+
+	ClientID                   string `json:"clientId"`
+	ProofUpgradeClient         []byte `json:"proofUpgradeClient"`
+	ProofUpgradeConsensusState []byte `json:"proofUpgradeConsensusState"`
+	Signer                     string `json:"signer"`
+
+	ClientType      string `json:"clientType"`
+	ConsensusHeight Height `json:"consensusHeight"`
+}
+
+type RawMsgUpgradeTendermintLightClient struct {
+	Type                       string                              `mapstructure:"@type" json:"@type"`
+	ClientID                   string                              `mapstructure:"client_id" json:"clientId"`
+	ClientState                TendermintLightClientState          `mapstructure:"client_state" json:"clientState"`
+	ConsensusState             TendermintLightClientConsensusState `mapstructure:"consensus_state" json:"consensusState"`
+	ProofUpgradeClient         []byte                              `mapstructure:"proof_upgrade_client" json:"proofUpgradeClient"`
+	ProofUpgradeConsensusState []byte                              `mapstructure:"proof_upgrade_consensus_state" json:"proofUpgradeConsensusState"`
+	Signer                     string                              `mapstructure:"signer" json:"signer"`
+}
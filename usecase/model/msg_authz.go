@@ -0,0 +1,35 @@
+package model
+
+import (
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+)
+
+type MsgGrantParams struct {
+	Granter string     `json:"granter"`
+	Grantee string     `json:"grantee"`
+	Grant   AuthzGrant `json:"grant"`
+}
+
+type AuthzGrant struct {
+	// Type URL of the authorization, e.g. /cosmos.authz.v1beta1.GenericAuthorization
+	AuthorizationType string `json:"authorizationType"`
+	// Type URL of the message the authorization allows the grantee to execute
+	MsgTypeURL string `json:"msgTypeUrl"`
+	// Only available in SendAuthorization
+	SpendLimit coin.Coins `json:"spendLimit"`
+	// Only available in StakeAuthorization
+	MaybeMaxTokens  *coin.Coin       `json:"maxTokens"`
+	MaybeExpiration *utctime.UTCTime `json:"expiration"`
+}
+
+type MsgRevokeParams struct {
+	Granter    string `json:"granter"`
+	Grantee    string `json:"grantee"`
+	MsgTypeURL string `json:"msgTypeUrl"`
+}
+
+type MsgExecParams struct {
+	Grantee     string   `json:"grantee"`
+	MsgTypeURLs []string `json:"msgTypeUrls"`
+}
@@ -0,0 +1,63 @@
+package model
+
+// Type URLs of the Ethereum transaction data embedded in Ethermint MsgEthereumTx
+const ETHEREUM_LEGACY_TX = "/ethermint.evm.v1.LegacyTx"
+const ETHEREUM_ACCESS_LIST_TX = "/ethermint.evm.v1.AccessListTx"
+const ETHEREUM_DYNAMIC_FEE_TX = "/ethermint.evm.v1.DynamicFeeTx"
+
+type MsgEthereumTxParams struct {
+	// Ethereum transaction hash, 0x-prefixed
+	Hash string `json:"hash"`
+	// Type URL of the embedded transaction data, e.g. /ethermint.evm.v1.LegacyTx
+	TxType string `json:"txType"`
+
+	// EIP-55 checksummed hex address of the sender. Empty when it is unknown, i.e. the transaction
+	// failed and the message does not carry the sender
+	From string `json:"from"`
+	// Bech32 account address of the sender
+	FromAccount string `json:"fromAccount"`
+	// Recipient hex address. Nil on contract creation
+	MaybeTo *string `json:"to"`
+	// Bech32 account address of the recipient. Nil on contract creation
+	MaybeToAccount *string `json:"toAccount"`
+
+	// Only available in AccessListTx and DynamicFeeTx
+	MaybeChainID *string `json:"chainId"`
+	Nonce        uint64  `json:"nonce"`
+	// Amount in wei, as decimal string
+	Value string `json:"value"`
+	Gas   uint64 `json:"gas"`
+	// Only available in LegacyTx and AccessListTx
+	MaybeGasPrice *string `json:"gasPrice"`
+	// Only available in DynamicFeeTx
+	MaybeGasTipCap *string `json:"gasTipCap"`
+	MaybeGasFeeCap *string `json:"gasFeeCap"`
+	// Call data or contract creation code, 0x-prefixed hex
+	Data       string                `json:"data"`
+	AccessList []EthereumAccessTuple `json:"accessList"`
+
+	IsContractCreation bool `json:"isContractCreation"`
+	// Address of the created contract. Only available on contract creation with known sender
+	MaybeContractAddress *string `json:"contractAddress"`
+
+	// Only available when the transaction is successful
+	MaybeGasUsed *uint64 `json:"gasUsed"`
+	// EVM execution error, e.g. execution reverted. The Cosmos transaction can still be successful
+	// when the EVM execution failed
+	MaybeVMError *string `json:"vmError"`
+	// EVM logs emitted by the transaction
+	Logs []EthereumTxLog `json:"logs"`
+}
+
+type EthereumAccessTuple struct {
+	Address     string   `json:"address"`
+	StorageKeys []string `json:"storageKeys"`
+}
+
+type EthereumTxLog struct {
+	Address string   `json:"address"`
+	Topics  []string `json:"topics"`
+	// 0x-prefixed hex
+	Data     string `json:"data"`
+	LogIndex uint64 `json:"logIndex"`
+}
@@ -0,0 +1,30 @@
+package model
+
+import (
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+)
+
+type MsgGrantAllowanceParams struct {
+	Granter   string       `json:"granter"`
+	Grantee   string       `json:"grantee"`
+	Allowance FeeAllowance `json:"allowance"`
+}
+
+type FeeAllowance struct {
+	// Type URL of the basic or periodic allowance, e.g. /cosmos.feegrant.v1beta1.BasicAllowance
+	AllowanceType string `json:"allowanceType"`
+	// Empty when there is no spend limit
+	SpendLimit      coin.Coins       `json:"spendLimit"`
+	MaybeExpiration *utctime.UTCTime `json:"expiration"`
+	// Only available in PeriodicAllowance. Period duration in seconds
+	MaybePeriod      *int64     `json:"period"`
+	PeriodSpendLimit coin.Coins `json:"periodSpendLimit"`
+	// Only available when the allowance is wrapped in AllowedMsgAllowance
+	AllowedMessages []string `json:"allowedMessages"`
+}
+
+type MsgRevokeAllowanceParams struct {
+	Granter string `json:"granter"`
+	Grantee string `json:"grantee"`
+}
@@ -0,0 +1,7 @@
+package model
+
+type MsgUnknownParams struct {
+	TypeURL string                 `json:"typeUrl"`
+	Signers []string               `json:"signers"`
+	RawMsg  map[string]interface{} `json:"rawMsg"`
+}
@@ -31,3 +31,13 @@ type TransactionSignerInfo struct {
 	MaybeThreshold  *int     `json:"threshold,omitempty"`
 	AccountSequence uint64   `json:"accountSequence"`
 }
+
+type CreateTransactionUndecodableParams struct {
+	TxHash string
+	Index  int
+	Code   int
+	Log    string
+	// Base64 encoded transaction bytes
+	RawTx       string
+	DecodeError string
+}
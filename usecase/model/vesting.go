@@ -0,0 +1,24 @@
+package model
+
+import (
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+)
+
+const VESTING_ACCOUNT_CONTINUOUS = "/cosmos.vesting.v1beta1.ContinuousVestingAccount"
+const VESTING_ACCOUNT_DELAYED = "/cosmos.vesting.v1beta1.DelayedVestingAccount"
+const VESTING_ACCOUNT_PERIODIC = "/cosmos.vesting.v1beta1.PeriodicVestingAccount"
+
+type MsgCreateVestingAccountParams struct {
+	FromAddress string          `json:"fromAddress"`
+	ToAddress   string          `json:"toAddress"`
+	Amount      coin.Coins      `json:"amount"`
+	EndTime     utctime.UTCTime `json:"endTime"`
+	Delayed     bool            `json:"delayed"`
+}
+
+type VestingPeriod struct {
+	// Length of the period in seconds
+	Length int64      `json:"length"`
+	Amount coin.Coins `json:"amount"`
+}
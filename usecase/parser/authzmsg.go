@@ -0,0 +1,225 @@
+package parser
+
+import (
+	"sort"
+	"time"
+
+	"github.com/crypto-com/chain-indexing/entity/command"
+	"github.com/crypto-com/chain-indexing/internal/tmcosmosutils"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	command_usecase "github.com/crypto-com/chain-indexing/usecase/command"
+	"github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+)
+
+const AUTHZ_GENERIC_AUTHORIZATION = "/cosmos.authz.v1beta1.GenericAuthorization"
+const AUTHZ_SEND_AUTHORIZATION = "/cosmos.bank.v1beta1.SendAuthorization"
+const AUTHZ_STAKE_AUTHORIZATION = "/cosmos.staking.v1beta1.StakeAuthorization"
+
+// stakeAuthorizationMsgTypeURLs maps StakeAuthorization authorization type to the message it allows
+var stakeAuthorizationMsgTypeURLs = map[string]string{
+	"AUTHORIZATION_TYPE_DELEGATE":   "/cosmos.staking.v1beta1.MsgDelegate",
+	"AUTHORIZATION_TYPE_UNDELEGATE": "/cosmos.staking.v1beta1.MsgUndelegate",
+	"AUTHORIZATION_TYPE_REDELEGATE": "/cosmos.staking.v1beta1.MsgBeginRedelegate",
+}
+
+func parseMsgGrant(
+	msgCommonParams event.MsgCommonParams,
+	msg map[string]interface{},
+) []command.Command {
+	grant, _ := msg["grant"].(map[string]interface{})
+	authorization, _ := grant["authorization"].(map[string]interface{})
+
+	authzGrant := model.AuthzGrant{
+		AuthorizationType: authorization["@type"].(string),
+	}
+	switch authzGrant.AuthorizationType {
+	case AUTHZ_GENERIC_AUTHORIZATION:
+		authzGrant.MsgTypeURL = authorization["msg"].(string)
+	case AUTHZ_SEND_AUTHORIZATION:
+		authzGrant.MsgTypeURL = "/cosmos.bank.v1beta1.MsgSend"
+		spendLimit, _ := authorization["spend_limit"].([]interface{})
+		authzGrant.SpendLimit = tmcosmosutils.MustNewCoinsFromAmountInterface(spendLimit)
+	case AUTHZ_STAKE_AUTHORIZATION:
+		authorizationType, _ := authorization["authorization_type"].(string)
+		authzGrant.MsgTypeURL = stakeAuthorizationMsgTypeURLs[authorizationType]
+		if maxTokens, ok := authorization["max_tokens"].(map[string]interface{}); ok {
+			maxTokensCoin := tmcosmosutils.MustNewCoinFromAmountInterface(maxTokens)
+			authzGrant.MaybeMaxTokens = &maxTokensCoin
+		}
+	}
+	if rawExpiration, ok := grant["expiration"].(string); ok && rawExpiration != "" {
+		expiration := utctime.MustParse(time.RFC3339, rawExpiration)
+		authzGrant.MaybeExpiration = &expiration
+	}
+
+	return []command.Command{command_usecase.NewCreateMsgGrant(
+		msgCommonParams,
+
+		model.MsgGrantParams{
+			Granter: msg["granter"].(string),
+			Grantee: msg["grantee"].(string),
+			Grant:   authzGrant,
+		},
+	)}
+}
+
+func parseMsgRevoke(
+	msgCommonParams event.MsgCommonParams,
+	msg map[string]interface{},
+) []command.Command {
+	return []command.Command{command_usecase.NewCreateMsgRevoke(
+		msgCommonParams,
+
+		model.MsgRevokeParams{
+			Granter:    msg["granter"].(string),
+			Grantee:    msg["grantee"].(string),
+			MsgTypeURL: msg["msg_type_url"].(string),
+		},
+	)}
+}
+
+// parseMsgExec parses MsgExec followed by its executed messages with the parsers in registry. The
+// executed messages are signed by their granters, so they are attributed to the granters by their
+// own parsers. Executed messages share the message index of MsgExec, and each of them is parsed
+// with its own log split from the events of MsgExec (see splitMsgExecLogs). Executed messages of
+// unregistered types, or whose logs cannot be split, are parsed into MsgUnknown signed by the
+// grantee.
+func parseMsgExec(registry *MsgParserRegistry, params MsgParserParams) []command.Command {
+	grantee := params.Msg["grantee"].(string)
+	rawMsgs, _ := params.Msg["msgs"].([]interface{})
+
+	var execMsgLogs []model.BlockResultsTxsResultLog
+	logsSplit := true
+	if params.MsgCommonParams.TxSuccess {
+		execMsgLogs, logsSplit = splitMsgExecLogs(params.TxsResult, params.MsgCommonParams.MsgIndex, len(rawMsgs))
+	}
+
+	msgTypeURLs := make([]string, 0, len(rawMsgs))
+	execMsgCommands := make([]command.Command, 0, len(rawMsgs))
+	for i, rawMsg := range rawMsgs {
+		msg, _ := rawMsg.(map[string]interface{})
+		typeURL, _ := msg["@type"].(string)
+		msgTypeURLs = append(msgTypeURLs, typeURL)
+
+		var msgCommands []command.Command
+		parsed := false
+		if logsSplit {
+			execMsgParams := params
+			execMsgParams.Msg = msg
+			if execMsgLogs != nil {
+				execMsgParams.TxsResult = txsResultWithMsgLog(
+					params.TxsResult, params.MsgCommonParams.MsgIndex, execMsgLogs[i],
+				)
+			}
+			msgCommands, parsed = registry.Parse(typeURL, execMsgParams)
+		}
+		if !parsed {
+			msgCommands = parseMsgUnknown(params.MsgCommonParams, typeURL, msg, []string{grantee})
+		}
+		execMsgCommands = append(execMsgCommands, msgCommands...)
+	}
+
+	return append([]command.Command{command_usecase.NewCreateMsgExec(
+		params.MsgCommonParams,
+
+		model.MsgExecParams{
+			Grantee:     grantee,
+			MsgTypeURLs: msgTypeURLs,
+		},
+	)}, execMsgCommands...)
+}
+
+// splitMsgExecLogs splits the events of the MsgExec at msgIndex into the logs of its executed
+// messages. In the transaction events, events of each message start with the `message` event with
+// `action` attribute, and the events of each executed message end with the `message` event with
+// `module` attribute emitted by its handler. Like the transaction log, events of the same type in a
+// split log are merged and sorted by type. It returns false when the events do not match the
+// executed messages.
+func splitMsgExecLogs(
+	txsResult model.BlockResultsTxsResult,
+	msgIndex int,
+	execMsgCount int,
+) ([]model.BlockResultsTxsResultLog, bool) {
+	msgEvents := make([][]model.BlockResultsEvent, 0, len(txsResult.Log))
+	for _, event := range txsResult.Events {
+		if event.Type == "message" && hasEventAttribute(event, "action") {
+			msgEvents = append(msgEvents, make([]model.BlockResultsEvent, 0))
+			continue
+		}
+		// Events before the first message are emitted by the ante handler
+		if len(msgEvents) > 0 {
+			msgEvents[len(msgEvents)-1] = append(msgEvents[len(msgEvents)-1], event)
+		}
+	}
+	if len(msgEvents) != len(txsResult.Log) || msgIndex >= len(msgEvents) {
+		return nil, false
+	}
+
+	execMsgEvents := make([][]model.BlockResultsEvent, 0, execMsgCount)
+	events := make([]model.BlockResultsEvent, 0)
+	for _, event := range msgEvents[msgIndex] {
+		events = append(events, event)
+		if event.Type == "message" && hasEventAttribute(event, "module") {
+			execMsgEvents = append(execMsgEvents, events)
+			events = make([]model.BlockResultsEvent, 0)
+		}
+	}
+	if len(events) > 0 || len(execMsgEvents) != execMsgCount {
+		return nil, false
+	}
+
+	logs := make([]model.BlockResultsTxsResultLog, 0, execMsgCount)
+	for _, events := range execMsgEvents {
+		logs = append(logs, model.BlockResultsTxsResultLog{
+			MsgIndex: msgIndex,
+			Events:   flattenEvents(events),
+		})
+	}
+	return logs, true
+}
+
+// txsResultWithMsgLog returns a copy of the transaction result with the log at msgIndex replaced
+func txsResultWithMsgLog(
+	txsResult model.BlockResultsTxsResult,
+	msgIndex int,
+	log model.BlockResultsTxsResultLog,
+) model.BlockResultsTxsResult {
+	logs := make([]model.BlockResultsTxsResultLog, len(txsResult.Log))
+	copy(logs, txsResult.Log)
+	logs[msgIndex] = log
+
+	txsResult.Log = logs
+	return txsResult
+}
+
+// flattenEvents merges the attributes of events of the same type and sorts the events by type
+func flattenEvents(events []model.BlockResultsEvent) []model.BlockResultsEvent {
+	flattenedEvents := make([]model.BlockResultsEvent, 0)
+	typeIndex := make(map[string]int)
+	for _, event := range events {
+		index, exist := typeIndex[event.Type]
+		if !exist {
+			index = len(flattenedEvents)
+			typeIndex[event.Type] = index
+			flattenedEvents = append(flattenedEvents, model.BlockResultsEvent{
+				Type:       event.Type,
+				Attributes: make([]model.BlockResultsEventAttribute, 0),
+			})
+		}
+		flattenedEvents[index].Attributes = append(flattenedEvents[index].Attributes, event.Attributes...)
+	}
+	sort.SliceStable(flattenedEvents, func(i, j int) bool {
+		return flattenedEvents[i].Type < flattenedEvents[j].Type
+	})
+	return flattenedEvents
+}
+
+func hasEventAttribute(event model.BlockResultsEvent, key string) bool {
+	for _, attribute := range event.Attributes {
+		if attribute.Key == key {
+			return true
+		}
+	}
+	return false
+}
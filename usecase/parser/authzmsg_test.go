@@ -0,0 +1,282 @@
+package parser_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/entity/command"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+	command_usecase "github.com/crypto-com/chain-indexing/usecase/command"
+	"github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+	"github.com/crypto-com/chain-indexing/usecase/parser"
+	usecase_parser_test "github.com/crypto-com/chain-indexing/usecase/parser/test"
+	"github.com/crypto-com/chain-indexing/usecase/parser/utils"
+)
+
+var _ = Describe("ParseMsgCommands", func() {
+	Describe("Authz", func() {
+		granter := "tcro1dh69fldcsfrz4uhvn64s66jdf3zpl9mh3zpknr"
+		grantee := "tcro1pejrhg2slpxzaekhvcj86067ya4ew7wcuqtpgd"
+
+		It("should parse MsgGrant of Generic, Send and Stake authorizations in the transaction", func() {
+			txDecoder := utils.NewTxDecoder()
+			block, _ := mustParseBlockResp(usecase_parser_test.TX_MSG_GRANT_BLOCK_RESP)
+			blockResults := mustParseBlockResultsResp(usecase_parser_test.TX_MSG_GRANT_BLOCK_RESULTS_RESP)
+			accountAddressPrefix := "tcro"
+			bondingDenom := "basetcro"
+
+			cmds, err := parser.ParseBlockResultsTxsMsgToCommands(
+				txDecoder,
+				block,
+				blockResults,
+				accountAddressPrefix,
+				bondingDenom,
+			)
+			Expect(err).To(BeNil())
+			Expect(cmds).To(HaveLen(3))
+
+			txHash := "27586CA6692A9FBDFDAEDE703CCDF77114C9FBE8E2478D5FEFEAB014E19F5A4B"
+			expectedExpiration := utctime.FromTime(time.Date(2022, 11, 22, 8, 0, 12, 0, time.UTC))
+			expectedMaxTokens := coin.MustNewCoinFromString("basetcro", "100000000")
+			Expect(cmds).To(Equal([]command.Command{
+				command_usecase.NewCreateMsgGrant(
+					event.MsgCommonParams{
+						BlockHeight: int64(4),
+						TxHash:      txHash,
+						TxSuccess:   true,
+						MsgIndex:    0,
+					},
+					model.MsgGrantParams{
+						Granter: granter,
+						Grantee: grantee,
+						Grant: model.AuthzGrant{
+							AuthorizationType: "/cosmos.authz.v1beta1.GenericAuthorization",
+							MsgTypeURL:        "/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward",
+							MaybeExpiration:   &expectedExpiration,
+						},
+					},
+				),
+				command_usecase.NewCreateMsgGrant(
+					event.MsgCommonParams{
+						BlockHeight: int64(4),
+						TxHash:      txHash,
+						TxSuccess:   true,
+						MsgIndex:    1,
+					},
+					model.MsgGrantParams{
+						Granter: granter,
+						Grantee: grantee,
+						Grant: model.AuthzGrant{
+							AuthorizationType: "/cosmos.bank.v1beta1.SendAuthorization",
+							MsgTypeURL:        "/cosmos.bank.v1beta1.MsgSend",
+							SpendLimit:        coin.MustParseCoinsNormalized("500000000basetcro"),
+							MaybeExpiration:   &expectedExpiration,
+						},
+					},
+				),
+				command_usecase.NewCreateMsgGrant(
+					event.MsgCommonParams{
+						BlockHeight: int64(4),
+						TxHash:      txHash,
+						TxSuccess:   true,
+						MsgIndex:    2,
+					},
+					model.MsgGrantParams{
+						Granter: granter,
+						Grantee: grantee,
+						Grant: model.AuthzGrant{
+							AuthorizationType: "/cosmos.staking.v1beta1.StakeAuthorization",
+							MsgTypeURL:        "/cosmos.staking.v1beta1.MsgDelegate",
+							MaybeMaxTokens:    &expectedMaxTokens,
+							MaybeExpiration:   &expectedExpiration,
+						},
+					},
+				),
+			}))
+		})
+
+		It("should parse MsgRevoke in the transaction", func() {
+			txDecoder := utils.NewTxDecoder()
+			block, _ := mustParseBlockResp(usecase_parser_test.TX_MSG_REVOKE_BLOCK_RESP)
+			blockResults := mustParseBlockResultsResp(usecase_parser_test.TX_MSG_REVOKE_BLOCK_RESULTS_RESP)
+			accountAddressPrefix := "tcro"
+			bondingDenom := "basetcro"
+
+			cmds, err := parser.ParseBlockResultsTxsMsgToCommands(
+				txDecoder,
+				block,
+				blockResults,
+				accountAddressPrefix,
+				bondingDenom,
+			)
+			Expect(err).To(BeNil())
+			Expect(cmds).To(Equal([]command.Command{
+				command_usecase.NewCreateMsgRevoke(
+					event.MsgCommonParams{
+						BlockHeight: int64(7),
+						TxHash:      "634207932574292D7B7F57B716D54F4F0260158556B58C5AFAFAF4755E9B50DA",
+						TxSuccess:   true,
+						MsgIndex:    0,
+					},
+					model.MsgRevokeParams{
+						Granter:    granter,
+						Grantee:    grantee,
+						MsgTypeURL: "/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward",
+					},
+				),
+			}))
+		})
+
+		It("should parse MsgExec followed by its executed messages with their own logs", func() {
+			txDecoder := utils.NewTxDecoder()
+			block, _ := mustParseBlockResp(usecase_parser_test.TX_MSG_EXEC_BLOCK_RESP)
+			blockResults := mustParseBlockResultsResp(usecase_parser_test.TX_MSG_EXEC_BLOCK_RESULTS_RESP)
+			accountAddressPrefix := "tcro"
+			bondingDenom := "basetcro"
+
+			cmds, err := parser.ParseBlockResultsTxsMsgToCommands(
+				txDecoder,
+				block,
+				blockResults,
+				accountAddressPrefix,
+				bondingDenom,
+			)
+			Expect(err).To(BeNil())
+
+			msgCommonParams := event.MsgCommonParams{
+				BlockHeight: int64(6),
+				TxHash:      "E40643BBC5856B9042E4735968B1A64E5C4523D94E69825B64068FA7B03153B3",
+				TxSuccess:   true,
+				MsgIndex:    0,
+			}
+			Expect(cmds).To(Equal([]command.Command{
+				command_usecase.NewCreateMsgExec(
+					msgCommonParams,
+					model.MsgExecParams{
+						Grantee: grantee,
+						MsgTypeURLs: []string{
+							"/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward",
+							"/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward",
+							"/cosmos.bank.v1beta1.MsgSend",
+						},
+					},
+				),
+				command_usecase.NewCreateMsgWithdrawDelegatorReward(
+					msgCommonParams,
+					model.MsgWithdrawDelegatorRewardParams{
+						DelegatorAddress: granter,
+						ValidatorAddress: "tcrocncl1gw4ftm38tamrhyp3run68f232wrqmrpeue95ga",
+						RecipientAddress: granter,
+						Amount:           coin.MustParseCoinsNormalized("296819basetcro"),
+					},
+				),
+				command_usecase.NewCreateMsgWithdrawDelegatorReward(
+					msgCommonParams,
+					model.MsgWithdrawDelegatorRewardParams{
+						DelegatorAddress: granter,
+						ValidatorAddress: "tcrocncl1rygm005yw7286kd5m3k597g80vy6dcghkj8d0t",
+						RecipientAddress: granter,
+						Amount:           coin.MustParseCoinsNormalized("268002basetcro"),
+					},
+				),
+				command_usecase.NewCreateMsgSend(
+					msgCommonParams,
+					event.MsgSendCreatedParams{
+						FromAddress: granter,
+						ToAddress:   "tcro19wptss46kztepa0zstwd3hlq3tju8cqvhp3umg",
+						Amount:      coin.MustParseCoinsNormalized("100000000basetcro"),
+					},
+				),
+			}))
+		})
+
+		It("should parse executed messages into MsgUnknown when their logs cannot be split from MsgExec events", func() {
+			txDecoder := utils.NewTxDecoder()
+			block, _ := mustParseBlockResp(usecase_parser_test.TX_MSG_EXEC_BLOCK_RESP)
+			blockResults := mustParseBlockResultsResp(usecase_parser_test.TX_MSG_EXEC_BLOCK_RESULTS_RESP)
+			accountAddressPrefix := "tcro"
+			bondingDenom := "basetcro"
+
+			// Drop the `message` event with `module` attribute emitted by the last executed message
+			txsResultEvents := blockResults.TxsResults[0].Events
+			Expect(txsResultEvents[len(txsResultEvents)-1].Type).To(Equal("message"))
+			blockResults.TxsResults[0].Events = txsResultEvents[:len(txsResultEvents)-1]
+
+			cmds, err := parser.ParseBlockResultsTxsMsgToCommands(
+				txDecoder,
+				block,
+				blockResults,
+				accountAddressPrefix,
+				bondingDenom,
+			)
+			Expect(err).To(BeNil())
+			Expect(cmds).To(HaveLen(4))
+			for _, cmd := range cmds[1:] {
+				Expect(cmd.Name()).To(Equal("CreateMsgUnknown"))
+			}
+			Expect(cmds[1]).To(Equal(command_usecase.NewCreateMsgUnknown(
+				event.MsgCommonParams{
+					BlockHeight: int64(6),
+					TxHash:      "E40643BBC5856B9042E4735968B1A64E5C4523D94E69825B64068FA7B03153B3",
+					TxSuccess:   true,
+					MsgIndex:    0,
+				},
+				model.MsgUnknownParams{
+					TypeURL: "/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward",
+					Signers: []string{grantee},
+					RawMsg: map[string]interface{}{
+						"@type":             "/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward",
+						"delegator_address": granter,
+						"validator_address": "tcrocncl1gw4ftm38tamrhyp3run68f232wrqmrpeue95ga",
+					},
+				},
+			)))
+		})
+
+		It("should parse failed MsgExec followed by its executed messages", func() {
+			txDecoder := utils.NewTxDecoder()
+			block, _ := mustParseBlockResp(usecase_parser_test.TX_FAILED_MSG_EXEC_BLOCK_RESP)
+			blockResults := mustParseBlockResultsResp(usecase_parser_test.TX_FAILED_MSG_EXEC_BLOCK_RESULTS_RESP)
+			accountAddressPrefix := "tcro"
+			bondingDenom := "basetcro"
+
+			cmds, err := parser.ParseBlockResultsTxsMsgToCommands(
+				txDecoder,
+				block,
+				blockResults,
+				accountAddressPrefix,
+				bondingDenom,
+			)
+			Expect(err).To(BeNil())
+
+			msgCommonParams := event.MsgCommonParams{
+				BlockHeight: int64(8),
+				TxHash:      "B4832824B6EE7E1C5A621503B91E4007BC7CA41ABF5F9A249DDB2B8713C4C924",
+				TxSuccess:   false,
+				MsgIndex:    0,
+			}
+			Expect(cmds).To(Equal([]command.Command{
+				command_usecase.NewCreateMsgExec(
+					msgCommonParams,
+					model.MsgExecParams{
+						Grantee:     grantee,
+						MsgTypeURLs: []string{"/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward"},
+					},
+				),
+				command_usecase.NewCreateMsgWithdrawDelegatorReward(
+					msgCommonParams,
+					model.MsgWithdrawDelegatorRewardParams{
+						DelegatorAddress: granter,
+						ValidatorAddress: "tcrocncl1gw4ftm38tamrhyp3run68f232wrqmrpeue95ga",
+						RecipientAddress: granter,
+						Amount:           coin.NewEmptyCoins(),
+					},
+				),
+			}))
+		})
+	})
+})
@@ -12,6 +12,8 @@ import (
 )
 
 func ParseBlockToCommands(
+	msgParserRegistry *MsgParserRegistry,
+	undecodableTxPolicy string,
 	txDecoder *utils.TxDecoder,
 	block *usecase_model.Block,
 	rawBlock *usecase_model.RawBlock,
@@ -35,14 +37,28 @@ func ParseBlockToCommands(
 	commands = append(commands, createBlockCommand)
 
 	if len(blockResults.TxsResults) > 0 {
-		transactionCommands, parseErr := ParseTransactionCommands(txDecoder, block, blockResults, accountAddressPrefix)
+		// Transactions are decoded once and shared by the transaction and message parsers
+		decodedTxs := txDecoder.DecodeAll(block.Txs)
+
+		undecodableTransactionCommands, parseErr := parseUndecodableTransactionCommands(
+			undecodableTxPolicy, decodedTxs, block, blockResults,
+		)
+		if parseErr != nil {
+			return nil, fmt.Errorf("error parsing undecodable transaction commands: %v", parseErr)
+		}
+		commands = append(commands, undecodableTransactionCommands...)
+
+		transactionCommands, parseErr := parseTransactionCommands(
+			txDecoder, decodedTxs, block, blockResults, accountAddressPrefix,
+		)
 		if parseErr != nil {
 			return nil, fmt.Errorf("error parsing transaction commands: %v", parseErr)
 		}
 		commands = append(commands, transactionCommands...)
 
-		msgCommands, parseErr := ParseBlockResultsTxsMsgToCommands(
-			txDecoder,
+		msgCommands, parseErr := parseBlockResultsTxsMsgToCommands(
+			msgParserRegistry,
+			decodedTxs,
 			block,
 			blockResults,
 			accountAddressPrefix,
@@ -0,0 +1,198 @@
+package parser
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/crypto-com/chain-indexing/entity/command"
+	"github.com/crypto-com/chain-indexing/internal/tmcosmosutils"
+	command_usecase "github.com/crypto-com/chain-indexing/usecase/command"
+	"github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+	"github.com/crypto-com/chain-indexing/usecase/parser/utils"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// rawEthereumTxLog is the EVM log serialized in the `txLog` attribute of the `tx_log` event
+type rawEthereumTxLog struct {
+	Address  string   `json:"address"`
+	Topics   []string `json:"topics"`
+	Data     []byte   `json:"data"`
+	LogIndex uint64   `json:"logIndex"`
+}
+
+func parseMsgEthereumTx(
+	msgCommonParams event.MsgCommonParams,
+	txsResult model.BlockResultsTxsResult,
+	msgIndex int,
+	msg map[string]interface{},
+	accountAddressPrefix string,
+) []command.Command {
+	txData, _ := msg["data"].(map[string]interface{})
+	params := parseEthereumTxData(txData)
+	params.Hash, _ = msg["hash"].(string)
+	params.Logs = make([]model.EthereumTxLog, 0)
+	if from, ok := msg["from"].(string); ok && from != "" {
+		params.From = tmcosmosutils.MustChecksumHexAddress(from)
+	}
+
+	if msgCommonParams.TxSuccess {
+		log := utils.NewParsedTxsResultLog(&txsResult.Log[msgIndex])
+		ethereumTxEvent := log.GetEventByType("ethereum_tx")
+		if ethereumTxEvent == nil {
+			panic("missing `ethereum_tx` event in TxsResult log")
+		}
+
+		params.Hash = ethereumTxEvent.MustGetAttributeByKey("ethereumTxHash")
+		if rawGasUsed := ethereumTxEvent.GetAttributeByKey("txGasUsed"); rawGasUsed != nil {
+			gasUsed, err := strconv.ParseUint(*rawGasUsed, 10, 64)
+			if err != nil {
+				panic(fmt.Sprintf("error parsing ethereum_tx txGasUsed: %v", err))
+			}
+			params.MaybeGasUsed = &gasUsed
+		}
+		params.MaybeVMError = ethereumTxEvent.GetAttributeByKey("ethereumTxFailed")
+
+		// The EVM module emits the hex address of the sender in the message event, which is grouped
+		// with the bech32 senders emitted by other modules
+		for _, messageEvent := range log.GetEventsByType("message") {
+			if sender := messageEvent.GetAttributeByKey("sender"); sender != nil &&
+				tmcosmosutils.IsValidHexAddress(*sender) {
+				params.From = tmcosmosutils.MustChecksumHexAddress(*sender)
+			}
+		}
+
+		for _, txLogEvent := range log.GetEventsByType("tx_log") {
+			rawTxLog := txLogEvent.GetAttributeByKey("txLog")
+			if rawTxLog == nil {
+				continue
+			}
+			var txLog rawEthereumTxLog
+			if err := jsoniter.UnmarshalFromString(*rawTxLog, &txLog); err != nil {
+				panic(fmt.Sprintf("error decoding tx_log txLog: %v", err))
+			}
+			topics := txLog.Topics
+			if topics == nil {
+				topics = make([]string, 0)
+			}
+			params.Logs = append(params.Logs, model.EthereumTxLog{
+				Address:  txLog.Address,
+				Topics:   topics,
+				Data:     "0x" + hex.EncodeToString(txLog.Data),
+				LogIndex: txLog.LogIndex,
+			})
+		}
+	}
+
+	if params.From != "" {
+		params.FromAccount = tmcosmosutils.MustAccountAddressFromHexAddress(accountAddressPrefix, params.From)
+		if params.IsContractCreation {
+			contractAddress, err := tmcosmosutils.ContractAddressFromCreator(params.From, params.Nonce)
+			if err != nil {
+				panic(fmt.Sprintf("error computing created contract address: %v", err))
+			}
+			params.MaybeContractAddress = &contractAddress
+		}
+	}
+	if params.MaybeTo != nil {
+		toAccount := tmcosmosutils.MustAccountAddressFromHexAddress(accountAddressPrefix, *params.MaybeTo)
+		params.MaybeToAccount = &toAccount
+	}
+
+	return []command.Command{command_usecase.NewCreateMsgEthereumTx(
+		msgCommonParams,
+
+		params,
+	)}
+}
+
+// parseEthereumTxData parses the legacy, access list or dynamic fee transaction data embedded in
+// MsgEthereumTx
+func parseEthereumTxData(txData map[string]interface{}) model.MsgEthereumTxParams {
+	params := model.MsgEthereumTxParams{
+		TxType:     txData["@type"].(string),
+		Nonce:      mustParseEthereumTxUint64(txData, "nonce"),
+		Value:      "0",
+		Gas:        mustParseEthereumTxUint64(txData, "gas"),
+		Data:       "0x",
+		AccessList: make([]model.EthereumAccessTuple, 0),
+	}
+
+	if value, ok := txData["value"].(string); ok && value != "" {
+		params.Value = value
+	}
+	if to, ok := txData["to"].(string); ok && to != "" {
+		checksumTo := tmcosmosutils.MustChecksumHexAddress(to)
+		params.MaybeTo = &checksumTo
+	} else {
+		params.IsContractCreation = true
+	}
+	if rawData, ok := txData["data"].(string); ok && rawData != "" {
+		data, err := base64.StdEncoding.DecodeString(rawData)
+		if err != nil {
+			panic(fmt.Sprintf("error decoding Ethereum transaction data: %v", err))
+		}
+		params.Data = "0x" + hex.EncodeToString(data)
+	}
+
+	switch params.TxType {
+	case model.ETHEREUM_LEGACY_TX:
+		params.MaybeGasPrice = maybeEthereumTxString(txData, "gas_price")
+	case model.ETHEREUM_ACCESS_LIST_TX:
+		params.MaybeChainID = maybeEthereumTxString(txData, "chain_id")
+		params.MaybeGasPrice = maybeEthereumTxString(txData, "gas_price")
+		params.AccessList = parseEthereumAccessList(txData)
+	case model.ETHEREUM_DYNAMIC_FEE_TX:
+		params.MaybeChainID = maybeEthereumTxString(txData, "chain_id")
+		params.MaybeGasTipCap = maybeEthereumTxString(txData, "gas_tip_cap")
+		params.MaybeGasFeeCap = maybeEthereumTxString(txData, "gas_fee_cap")
+		params.AccessList = parseEthereumAccessList(txData)
+	}
+
+	return params
+}
+
+func parseEthereumAccessList(txData map[string]interface{}) []model.EthereumAccessTuple {
+	rawAccesses, _ := txData["accesses"].([]interface{})
+	accessList := make([]model.EthereumAccessTuple, 0, len(rawAccesses))
+	for _, rawAccess := range rawAccesses {
+		access := rawAccess.(map[string]interface{})
+		rawStorageKeys, _ := access["storage_keys"].([]interface{})
+		storageKeys := make([]string, 0, len(rawStorageKeys))
+		for _, storageKey := range rawStorageKeys {
+			storageKeys = append(storageKeys, storageKey.(string))
+		}
+		accessList = append(accessList, model.EthereumAccessTuple{
+			Address:     access["address"].(string),
+			StorageKeys: storageKeys,
+		})
+	}
+
+	return accessList
+}
+
+// mustParseEthereumTxUint64 parses the uint64 field, which is encoded as string in JSON. Missing
+// field is treated as zero.
+func mustParseEthereumTxUint64(txData map[string]interface{}, key string) uint64 {
+	rawValue, ok := txData[key].(string)
+	if !ok || rawValue == "" {
+		return 0
+	}
+	value, err := strconv.ParseUint(rawValue, 10, 64)
+	if err != nil {
+		panic(fmt.Sprintf("error parsing Ethereum transaction %s: %v", key, err))
+	}
+
+	return value
+}
+
+func maybeEthereumTxString(txData map[string]interface{}, key string) *string {
+	value, ok := txData[key].(string)
+	if !ok || value == "" {
+		return nil
+	}
+
+	return &value
+}
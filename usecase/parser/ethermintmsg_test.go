@@ -0,0 +1,194 @@
+package parser_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/entity/command"
+	"github.com/crypto-com/chain-indexing/internal/primptr"
+	command_usecase "github.com/crypto-com/chain-indexing/usecase/command"
+	"github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+	"github.com/crypto-com/chain-indexing/usecase/parser"
+	usecase_parser_test "github.com/crypto-com/chain-indexing/usecase/parser/test"
+	"github.com/crypto-com/chain-indexing/usecase/parser/utils"
+)
+
+var _ = Describe("ParseMsgCommands", func() {
+	Describe("MsgEthereumTx", func() {
+		accountAddressPrefix := "tcro"
+		bondingDenom := "basetcro"
+
+		from := "0x4349c42EEcCD560AE63f2d5DDBcF0d8B6c7549c1"
+		fromAccount := "tcro1gdyugthve4tq4e3l94wahncd3dk82jwpv2l5lt"
+		contractAddress := "0xB2d2df8EC48c6DB0Ad230B6486EE26DDaf1dEaf0"
+		contractAccount := "tcro1ktfdlrky33kmptfrpdjgdm3xmkh3m6hs4eyjpr"
+
+		It("should parse LegacyTx contract creation with the created contract address", func() {
+			txDecoder := utils.NewTxDecoder()
+			block, _ := mustParseBlockResp(usecase_parser_test.TX_MSG_ETHEREUM_TX_CONTRACT_CREATION_BLOCK_RESP)
+			blockResults := mustParseBlockResultsResp(
+				usecase_parser_test.TX_MSG_ETHEREUM_TX_CONTRACT_CREATION_BLOCK_RESULTS_RESP,
+			)
+
+			cmds, err := parser.ParseBlockResultsTxsMsgToCommands(
+				txDecoder,
+				block,
+				blockResults,
+				accountAddressPrefix,
+				bondingDenom,
+			)
+			Expect(err).To(BeNil())
+			Expect(cmds).To(Equal([]command.Command{command_usecase.NewCreateMsgEthereumTx(
+				event.MsgCommonParams{
+					BlockHeight: int64(2),
+					TxHash:      "469C97CA74FE9D6EA1D9586BE6BAC4B8C5AEBC9A063CB362AA1644D151A23BB1",
+					TxSuccess:   true,
+					MsgIndex:    0,
+				},
+				model.MsgEthereumTxParams{
+					Hash:                 "0xab4964d247c4e5872f779fc14ef0621a54b12640eabc1087fefbf32ed37a3335",
+					TxType:               model.ETHEREUM_LEGACY_TX,
+					From:                 from,
+					FromAccount:          fromAccount,
+					Nonce:                0,
+					Value:                "0",
+					Gas:                  100000,
+					MaybeGasPrice:        primptr.String("5000000000"),
+					Data:                 "0x600d600c600039600d6000f3602a600052600160206000a100",
+					AccessList:           []model.EthereumAccessTuple{},
+					IsContractCreation:   true,
+					MaybeContractAddress: primptr.String(contractAddress),
+					Logs:                 []model.EthereumTxLog{},
+				},
+			)}))
+		})
+
+		It("should parse AccessListTx contract call with EVM logs", func() {
+			txDecoder := utils.NewTxDecoder()
+			block, _ := mustParseBlockResp(usecase_parser_test.TX_MSG_ETHEREUM_TX_CONTRACT_CALL_BLOCK_RESP)
+			blockResults := mustParseBlockResultsResp(
+				usecase_parser_test.TX_MSG_ETHEREUM_TX_CONTRACT_CALL_BLOCK_RESULTS_RESP,
+			)
+
+			cmds, err := parser.ParseBlockResultsTxsMsgToCommands(
+				txDecoder,
+				block,
+				blockResults,
+				accountAddressPrefix,
+				bondingDenom,
+			)
+			Expect(err).To(BeNil())
+			Expect(cmds).To(Equal([]command.Command{command_usecase.NewCreateMsgEthereumTx(
+				event.MsgCommonParams{
+					BlockHeight: int64(3),
+					TxHash:      "8ED77F69C213CE8934665AEADBE138AEA3B2FD491C000B783AA354C58D17B184",
+					TxSuccess:   true,
+					MsgIndex:    0,
+				},
+				model.MsgEthereumTxParams{
+					Hash:           "0x8bd11eece02e3f18ab82b6de1cd144352379a28d378cd02e53ae874e43996b29",
+					TxType:         model.ETHEREUM_ACCESS_LIST_TX,
+					From:           from,
+					FromAccount:    fromAccount,
+					MaybeTo:        primptr.String(contractAddress),
+					MaybeToAccount: primptr.String(contractAccount),
+					MaybeChainID:   primptr.String("777"),
+					Nonce:          1,
+					Value:          "1000",
+					Gas:            60000,
+					MaybeGasPrice:  primptr.String("5000000000"),
+					Data:           "0xa9059cbb",
+					AccessList: []model.EthereumAccessTuple{{
+						Address:     contractAddress,
+						StorageKeys: []string{"0x0000000000000000000000000000000000000000000000000000000000000001"},
+					}},
+					Logs: []model.EthereumTxLog{{
+						Address:  contractAddress,
+						Topics:   []string{"0x0000000000000000000000000000000000000000000000000000000000000001"},
+						Data:     "0x000000000000000000000000000000000000000000000000000000000000002a",
+						LogIndex: 0,
+					}},
+				},
+			)}))
+		})
+
+		It("should parse LegacyTx contract creation with reverted EVM execution", func() {
+			txDecoder := utils.NewTxDecoder()
+			block, _ := mustParseBlockResp(usecase_parser_test.TX_MSG_ETHEREUM_TX_REVERTED_BLOCK_RESP)
+			blockResults := mustParseBlockResultsResp(usecase_parser_test.TX_MSG_ETHEREUM_TX_REVERTED_BLOCK_RESULTS_RESP)
+
+			cmds, err := parser.ParseBlockResultsTxsMsgToCommands(
+				txDecoder,
+				block,
+				blockResults,
+				accountAddressPrefix,
+				bondingDenom,
+			)
+			Expect(err).To(BeNil())
+			Expect(cmds).To(Equal([]command.Command{command_usecase.NewCreateMsgEthereumTx(
+				event.MsgCommonParams{
+					BlockHeight: int64(4),
+					TxHash:      "E8134B33255ED2170DAA809B818F73D3C7BB390B66BAD72A7768C52863C1CCB3",
+					TxSuccess:   true,
+					MsgIndex:    0,
+				},
+				model.MsgEthereumTxParams{
+					Hash:                 "0x1fd6c848d448a3aa42184b5a0b78db35f5198f7838ded7ec41f1909f6e18baa7",
+					TxType:               model.ETHEREUM_LEGACY_TX,
+					From:                 from,
+					FromAccount:          fromAccount,
+					Nonce:                2,
+					Value:                "0",
+					Gas:                  100000,
+					MaybeGasPrice:        primptr.String("5000000000"),
+					Data:                 "0x60006000fd",
+					AccessList:           []model.EthereumAccessTuple{},
+					IsContractCreation:   true,
+					MaybeContractAddress: primptr.String("0x089F4e89a84E3aaE9f80231927dfAcD47DA532cE"),
+					MaybeVMError:         primptr.String("execution reverted"),
+					Logs:                 []model.EthereumTxLog{},
+				},
+			)}))
+		})
+
+		It("should parse failed AccessListTx from the message only", func() {
+			txDecoder := utils.NewTxDecoder()
+			block, _ := mustParseBlockResp(usecase_parser_test.TX_FAILED_MSG_ETHEREUM_TX_BLOCK_RESP)
+			blockResults := mustParseBlockResultsResp(usecase_parser_test.TX_FAILED_MSG_ETHEREUM_TX_BLOCK_RESULTS_RESP)
+
+			cmds, err := parser.ParseBlockResultsTxsMsgToCommands(
+				txDecoder,
+				block,
+				blockResults,
+				accountAddressPrefix,
+				bondingDenom,
+			)
+			Expect(err).To(BeNil())
+			Expect(cmds).To(Equal([]command.Command{command_usecase.NewCreateMsgEthereumTx(
+				event.MsgCommonParams{
+					BlockHeight: int64(5),
+					TxHash:      "3161D6E068FA8B3CE9EEE93C3EDEB948BD1554FF2BC1645FCE1B3D2FC5374E72",
+					TxSuccess:   false,
+					MsgIndex:    0,
+				},
+				model.MsgEthereumTxParams{
+					Hash:           "0xb3b6c5bf2e49ff723dc3e89c501ffff326ce7a7ba8c4c524c68e85dd0cbcd718",
+					TxType:         model.ETHEREUM_ACCESS_LIST_TX,
+					From:           from,
+					FromAccount:    fromAccount,
+					MaybeTo:        primptr.String("0x46294c0aFdFb2c047083ec61E392a2Cff854b11E"),
+					MaybeToAccount: primptr.String("tcro1gc55czhalvkqguyra3s78y4zelu9fvg7qy8fef"),
+					MaybeChainID:   primptr.String("777"),
+					Nonce:          3,
+					Value:          "1000",
+					Gas:            20000,
+					MaybeGasPrice:  primptr.String("5000000000"),
+					Data:           "0x",
+					AccessList:     []model.EthereumAccessTuple{},
+					Logs:           []model.EthereumTxLog{},
+				},
+			)}))
+		})
+	})
+})
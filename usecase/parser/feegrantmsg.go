@@ -0,0 +1,97 @@
+package parser
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/crypto-com/chain-indexing/entity/command"
+	"github.com/crypto-com/chain-indexing/internal/tmcosmosutils"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	command_usecase "github.com/crypto-com/chain-indexing/usecase/command"
+	"github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+)
+
+const FEEGRANT_BASIC_ALLOWANCE = "/cosmos.feegrant.v1beta1.BasicAllowance"
+const FEEGRANT_PERIODIC_ALLOWANCE = "/cosmos.feegrant.v1beta1.PeriodicAllowance"
+const FEEGRANT_ALLOWED_MSG_ALLOWANCE = "/cosmos.feegrant.v1beta1.AllowedMsgAllowance"
+
+func parseMsgGrantAllowance(
+	msgCommonParams event.MsgCommonParams,
+	msg map[string]interface{},
+) []command.Command {
+	allowance, _ := msg["allowance"].(map[string]interface{})
+
+	return []command.Command{command_usecase.NewCreateMsgGrantAllowance(
+		msgCommonParams,
+
+		model.MsgGrantAllowanceParams{
+			Granter:   msg["granter"].(string),
+			Grantee:   msg["grantee"].(string),
+			Allowance: parseFeeAllowance(allowance),
+		},
+	)}
+}
+
+// parseFeeAllowance flattens the basic or periodic allowance, which may be wrapped in
+// AllowedMsgAllowance, into FeeAllowance
+func parseFeeAllowance(allowance map[string]interface{}) model.FeeAllowance {
+	allowanceType := allowance["@type"].(string)
+	switch allowanceType {
+	case FEEGRANT_ALLOWED_MSG_ALLOWANCE:
+		innerAllowance, _ := allowance["allowance"].(map[string]interface{})
+		feeAllowance := parseFeeAllowance(innerAllowance)
+		rawAllowedMessages, _ := allowance["allowed_messages"].([]interface{})
+		feeAllowance.AllowedMessages = make([]string, 0, len(rawAllowedMessages))
+		for _, allowedMessage := range rawAllowedMessages {
+			feeAllowance.AllowedMessages = append(feeAllowance.AllowedMessages, allowedMessage.(string))
+		}
+		return feeAllowance
+	case FEEGRANT_PERIODIC_ALLOWANCE:
+		basicAllowance, _ := allowance["basic"].(map[string]interface{})
+		feeAllowance := parseBasicFeeAllowance(basicAllowance)
+		feeAllowance.AllowanceType = FEEGRANT_PERIODIC_ALLOWANCE
+
+		period, err := time.ParseDuration(allowance["period"].(string))
+		if err != nil {
+			panic(fmt.Sprintf("error parsing PeriodicAllowance period: %v", err))
+		}
+		periodSeconds := int64(period / time.Second)
+		feeAllowance.MaybePeriod = &periodSeconds
+		periodSpendLimit, _ := allowance["period_spend_limit"].([]interface{})
+		feeAllowance.PeriodSpendLimit = tmcosmosutils.MustNewCoinsFromAmountInterface(periodSpendLimit)
+		return feeAllowance
+	default:
+		feeAllowance := parseBasicFeeAllowance(allowance)
+		feeAllowance.AllowanceType = allowanceType
+		return feeAllowance
+	}
+}
+
+func parseBasicFeeAllowance(allowance map[string]interface{}) model.FeeAllowance {
+	spendLimit, _ := allowance["spend_limit"].([]interface{})
+	feeAllowance := model.FeeAllowance{
+		AllowanceType: FEEGRANT_BASIC_ALLOWANCE,
+		SpendLimit:    tmcosmosutils.MustNewCoinsFromAmountInterface(spendLimit),
+	}
+	if rawExpiration, ok := allowance["expiration"].(string); ok && rawExpiration != "" {
+		expiration := utctime.MustParse(time.RFC3339, rawExpiration)
+		feeAllowance.MaybeExpiration = &expiration
+	}
+
+	return feeAllowance
+}
+
+func parseMsgRevokeAllowance(
+	msgCommonParams event.MsgCommonParams,
+	msg map[string]interface{},
+) []command.Command {
+	return []command.Command{command_usecase.NewCreateMsgRevokeAllowance(
+		msgCommonParams,
+
+		model.MsgRevokeAllowanceParams{
+			Granter: msg["granter"].(string),
+			Grantee: msg["grantee"].(string),
+		},
+	)}
+}
@@ -0,0 +1,162 @@
+package parser_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/entity/command"
+	"github.com/crypto-com/chain-indexing/internal/primptr"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+	command_usecase "github.com/crypto-com/chain-indexing/usecase/command"
+	"github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+	"github.com/crypto-com/chain-indexing/usecase/parser"
+	usecase_parser_test "github.com/crypto-com/chain-indexing/usecase/parser/test"
+	"github.com/crypto-com/chain-indexing/usecase/parser/utils"
+)
+
+var _ = Describe("ParseMsgCommands", func() {
+	Describe("Feegrant", func() {
+		granter := "tcro14k0snrtja2zle5jn3vr7nftwepsfgca8fa6ral"
+		grantee := "tcro1tg3z7sf99uu3ma34xfsphrwmqnm3k6uumvvzkw"
+
+		It("should parse MsgGrantAllowance with BasicAllowance and PeriodicAllowance wrapped in AllowedMsgAllowance", func() {
+			txDecoder := utils.NewTxDecoder()
+			block, _ := mustParseBlockResp(usecase_parser_test.TX_MSG_GRANT_ALLOWANCE_BLOCK_RESP)
+			blockResults := mustParseBlockResultsResp(usecase_parser_test.TX_MSG_GRANT_ALLOWANCE_BLOCK_RESULTS_RESP)
+			accountAddressPrefix := "tcro"
+			bondingDenom := "basetcro"
+
+			cmds, err := parser.ParseBlockResultsTxsMsgToCommands(
+				txDecoder,
+				block,
+				blockResults,
+				accountAddressPrefix,
+				bondingDenom,
+			)
+			Expect(err).To(BeNil())
+			Expect(cmds).To(HaveLen(2))
+
+			txHash := "F26A5F8A5C4163FF4834062DD9E13CB00B46230F34CD590A221733875515A130"
+			expectedExpiration := utctime.FromTime(time.Date(2022, 11, 22, 8, 0, 0, 0, time.UTC))
+			Expect(cmds).To(Equal([]command.Command{
+				command_usecase.NewCreateMsgGrantAllowance(
+					event.MsgCommonParams{
+						BlockHeight: int64(2),
+						TxHash:      txHash,
+						TxSuccess:   true,
+						MsgIndex:    0,
+					},
+					model.MsgGrantAllowanceParams{
+						Granter: granter,
+						Grantee: grantee,
+						Allowance: model.FeeAllowance{
+							AllowanceType:   "/cosmos.feegrant.v1beta1.BasicAllowance",
+							SpendLimit:      coin.MustParseCoinsNormalized("1000000basetcro"),
+							MaybeExpiration: &expectedExpiration,
+						},
+					},
+				),
+				command_usecase.NewCreateMsgGrantAllowance(
+					event.MsgCommonParams{
+						BlockHeight: int64(2),
+						TxHash:      txHash,
+						TxSuccess:   true,
+						MsgIndex:    1,
+					},
+					model.MsgGrantAllowanceParams{
+						Granter: granter,
+						Grantee: "tcro1fg4rlx8raydk8z7evl796w2t9vpmgarljj0rlp",
+						Allowance: model.FeeAllowance{
+							AllowanceType:    "/cosmos.feegrant.v1beta1.PeriodicAllowance",
+							SpendLimit:       coin.NewEmptyCoins(),
+							MaybePeriod:      primptr.Int64(86400),
+							PeriodSpendLimit: coin.MustParseCoinsNormalized("100000basetcro"),
+							AllowedMessages:  []string{"/cosmos.bank.v1beta1.MsgSend"},
+						},
+					},
+				),
+			}))
+		})
+
+		It("should parse MsgRevokeAllowance in the transaction", func() {
+			txDecoder := utils.NewTxDecoder()
+			block, _ := mustParseBlockResp(usecase_parser_test.TX_MSG_REVOKE_ALLOWANCE_BLOCK_RESP)
+			blockResults := mustParseBlockResultsResp(usecase_parser_test.TX_MSG_REVOKE_ALLOWANCE_BLOCK_RESULTS_RESP)
+			accountAddressPrefix := "tcro"
+			bondingDenom := "basetcro"
+
+			cmds, err := parser.ParseBlockResultsTxsMsgToCommands(
+				txDecoder,
+				block,
+				blockResults,
+				accountAddressPrefix,
+				bondingDenom,
+			)
+			Expect(err).To(BeNil())
+			Expect(cmds).To(Equal([]command.Command{
+				command_usecase.NewCreateMsgRevokeAllowance(
+					event.MsgCommonParams{
+						BlockHeight: int64(4),
+						TxHash:      "A73AD84101ECF4D233ED0D189516403B1C47C6E109292FB1BFEE862C16D6B717",
+						TxSuccess:   true,
+						MsgIndex:    0,
+					},
+					model.MsgRevokeAllowanceParams{
+						Granter: granter,
+						Grantee: grantee,
+					},
+				),
+			}))
+		})
+
+		It("should parse fee granter of the transaction paying fee with the allowance", func() {
+			txDecoder := utils.NewTxDecoder()
+			block, _ := mustParseBlockResp(usecase_parser_test.TX_WITH_FEE_GRANTER_BLOCK_RESP)
+			blockResults := mustParseBlockResultsResp(usecase_parser_test.TX_WITH_FEE_GRANTER_BLOCK_RESULTS_RESP)
+			accountAddressPrefix := "tcro"
+
+			cmds, err := parser.ParseTransactionCommands(
+				txDecoder,
+				block,
+				blockResults,
+				accountAddressPrefix,
+			)
+			Expect(err).To(BeNil())
+			Expect(cmds).To(Equal([]command.Command{command_usecase.NewCreateTransaction(
+				int64(3),
+				model.CreateTransactionParams{
+					TxHash:   "60E5BB03246EBC8D96DA40FB7EDDE82A00DF9216A00E0B97609291B6B656EEBE",
+					Index:    0,
+					Code:     0,
+					Log:      "[{\"msgIndex\":0,\"events\":[{\"type\":\"coin_received\",\"attributes\":[{\"key\":\"receiver\",\"value\":\"tcro14k0snrtja2zle5jn3vr7nftwepsfgca8fa6ral\"},{\"key\":\"amount\",\"value\":\"1000basetcro\"}]},{\"type\":\"coin_spent\",\"attributes\":[{\"key\":\"spender\",\"value\":\"tcro1tg3z7sf99uu3ma34xfsphrwmqnm3k6uumvvzkw\"},{\"key\":\"amount\",\"value\":\"1000basetcro\"}]},{\"type\":\"message\",\"attributes\":[{\"key\":\"action\",\"value\":\"/cosmos.bank.v1beta1.MsgSend\"},{\"key\":\"sender\",\"value\":\"tcro1tg3z7sf99uu3ma34xfsphrwmqnm3k6uumvvzkw\"},{\"key\":\"module\",\"value\":\"bank\"}]},{\"type\":\"transfer\",\"attributes\":[{\"key\":\"recipient\",\"value\":\"tcro14k0snrtja2zle5jn3vr7nftwepsfgca8fa6ral\"},{\"key\":\"sender\",\"value\":\"tcro1tg3z7sf99uu3ma34xfsphrwmqnm3k6uumvvzkw\"},{\"key\":\"amount\",\"value\":\"1000basetcro\"}]}]}]",
+					MsgCount: 1,
+					Signers: []model.TransactionSigner{
+						{
+							TransactionSignerInfo: model.TransactionSignerInfo{
+								Type:       "/cosmos.crypto.secp256k1.PubKey",
+								IsMultiSig: false,
+								Pubkeys: []string{
+									"AzQ2l3pdnfhj/pc13IibmtRA4Y07rkJwB3UOvwmS5zCx",
+								},
+								MaybeThreshold:  nil,
+								AccountSequence: 0,
+							},
+							Address: grantee,
+						},
+					},
+					Fee:           coin.MustParseCoinsNormalized("20000basetcro"),
+					FeePayer:      "",
+					FeeGranter:    granter,
+					GasWanted:     400000,
+					GasUsed:       78946,
+					Memo:          "",
+					TimeoutHeight: 0,
+				},
+			)}))
+		})
+	})
+})
@@ -2,9 +2,13 @@ package parser
 
 import (
 	"errors"
+	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/crypto-com/chain-indexing/entity/command"
 	"github.com/crypto-com/chain-indexing/internal/tmcosmosutils"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
 	"github.com/crypto-com/chain-indexing/projection/validator/constants"
 	"github.com/crypto-com/chain-indexing/usecase/coin"
 	command_usecase "github.com/crypto-com/chain-indexing/usecase/command"
@@ -69,5 +73,78 @@ func ParseGenesisCommands(
 			},
 		))
 	}
+	for _, account := range rawGenesis.AppState.Auth.Accounts {
+		if account.BaseVestingAccount == nil {
+			continue
+		}
+		params, err := parseGenesisVestingAccount(account)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing genesis vesting account: %v", err)
+		}
+		commands = append(commands, command_usecase.NewCreateGenesisVestingAccount(*params))
+	}
 	return commands, nil
 }
+
+func parseGenesisVestingAccount(account genesis.Account) (*genesis.CreateGenesisVestingAccountParams, error) {
+	baseVestingAccount := account.BaseVestingAccount
+
+	originalVesting, err := parseGenesisCoins(baseVestingAccount.OriginalVesting)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing original vesting: %v", err)
+	}
+	endTime, err := parseUnixSecondsString(baseVestingAccount.EndTime)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing end time: %v", err)
+	}
+	params := genesis.CreateGenesisVestingAccountParams{
+		Type:            account.Type,
+		Address:         baseVestingAccount.BaseAccount.Address,
+		OriginalVesting: originalVesting,
+		EndTime:         endTime,
+		VestingPeriods:  make([]model.VestingPeriod, 0, len(account.VestingPeriods)),
+	}
+	if account.StartTime != nil {
+		startTime, parseErr := parseUnixSecondsString(*account.StartTime)
+		if parseErr != nil {
+			return nil, fmt.Errorf("error parsing start time: %v", parseErr)
+		}
+		params.MaybeStartTime = &startTime
+	}
+	for _, period := range account.VestingPeriods {
+		length, parseErr := strconv.ParseInt(period.Length, 10, 64)
+		if parseErr != nil {
+			return nil, fmt.Errorf("error parsing vesting period length: %v", parseErr)
+		}
+		amount, parseErr := parseGenesisCoins(period.Amount)
+		if parseErr != nil {
+			return nil, fmt.Errorf("error parsing vesting period amount: %v", parseErr)
+		}
+		params.VestingPeriods = append(params.VestingPeriods, model.VestingPeriod{
+			Length: length,
+			Amount: amount,
+		})
+	}
+
+	return &params, nil
+}
+
+func parseGenesisCoins(amounts []genesis.MinDeposit) (coin.Coins, error) {
+	coins := coin.NewCoins()
+	for _, amount := range amounts {
+		parsedCoin, err := coin.NewCoinFromString(amount.Denom, amount.Amount)
+		if err != nil {
+			return nil, err
+		}
+		coins = coins.Add(parsedCoin)
+	}
+	return coins, nil
+}
+
+func parseUnixSecondsString(seconds string) (utctime.UTCTime, error) {
+	unixSeconds, err := strconv.ParseInt(seconds, 10, 64)
+	if err != nil {
+		return utctime.UTCTime{}, err
+	}
+	return utctime.FromUnixNano(unixSeconds * int64(time.Second)), nil
+}
@@ -2,12 +2,14 @@ package parser_test
 
 import (
 	"strings"
+	"time"
 
 	"github.com/crypto-com/chain-indexing/projection/validator/constants"
 
 	"github.com/crypto-com/chain-indexing/usecase/model/genesis"
 
 	"github.com/crypto-com/chain-indexing/infrastructure/tendermint"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
 	"github.com/crypto-com/chain-indexing/usecase/coin"
 	"github.com/crypto-com/chain-indexing/usecase/model"
 	. "github.com/onsi/ginkgo"
@@ -42,7 +44,7 @@ var _ = Describe("Parse Genesis", func() {
 		accountAddressPrefix := "tcro"
 		cmds, err := parser.ParseGenesisCommands(rawGenesis, accountAddressPrefix)
 		Expect(err).To(BeNil())
-		Expect(cmds).To(HaveLen(4))
+		Expect(cmds).To(HaveLen(5))
 		Expect(cmds[0]).To(Equal(command_usecase.NewCreateGenesis(*rawGenesis)))
 		Expect(cmds[1]).To(Equal(
 			command_usecase.NewCreateGenesisValidator(
@@ -119,6 +121,19 @@ var _ = Describe("Parse Genesis", func() {
 				},
 			),
 		))
+		Expect(cmds[4]).To(Equal(
+			command_usecase.NewCreateGenesisVestingAccount(
+				genesis.CreateGenesisVestingAccountParams{
+					Type:    "/cosmos.vesting.v1beta1.DelayedVestingAccount",
+					Address: "tcro1j8cceflhjj203j7v44pumfymktvr70kkpm85r3",
+					OriginalVesting: coin.NewCoins(
+						coin.MustNewCoinFromString("basetcro", "2000000000000000000"),
+					),
+					EndTime:        utctime.FromUnixNano(int64(1609918228) * int64(time.Second)),
+					VestingPeriods: []model.VestingPeriod{},
+				},
+			),
+		))
 	})
 
 	It("should return genesis command corresponding to genesis response", func() {
@@ -128,7 +143,7 @@ var _ = Describe("Parse Genesis", func() {
 		accountAddressPrefix := "tcro"
 		cmds, err := parser.ParseGenesisCommands(rawGenesis, accountAddressPrefix)
 		Expect(err).To(BeNil())
-		Expect(cmds).To(HaveLen(4))
+		Expect(cmds).To(HaveLen(5))
 		Expect(cmds[0]).To(Equal(command_usecase.NewCreateGenesis(*rawGenesis)))
 		Expect(cmds[1]).To(Equal(
 			command_usecase.NewCreateGenesisValidator(
@@ -205,5 +220,18 @@ var _ = Describe("Parse Genesis", func() {
 				},
 			),
 		))
+		Expect(cmds[4]).To(Equal(
+			command_usecase.NewCreateGenesisVestingAccount(
+				genesis.CreateGenesisVestingAccountParams{
+					Type:    "/cosmos.vesting.v1beta1.DelayedVestingAccount",
+					Address: "tcro1j8cceflhjj203j7v44pumfymktvr70kkpm85r3",
+					OriginalVesting: coin.NewCoins(
+						coin.MustNewCoinFromString("basetcro", "2000000000000000000"),
+					),
+					EndTime:        utctime.FromUnixNano(int64(1609918228) * int64(time.Second)),
+					VestingPeriods: []model.VestingPeriod{},
+				},
+			),
+		))
 	})
 })
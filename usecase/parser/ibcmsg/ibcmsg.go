@@ -543,6 +543,109 @@ func ParseMsgChannelOpenConfirm(
 	)}
 }
 
+func ParseMsgChannelCloseInit(
+	msgCommonParams event.MsgCommonParams,
+	txsResult model.BlockResultsTxsResult,
+	msgIndex int,
+	msg map[string]interface{},
+) []command.Command {
+	var rawMsg ibc_model.RawMsgChannelCloseInit
+	decoderConfig := &mapstructure.DecoderConfig{
+		WeaklyTypedInput: true,
+		Result:           &rawMsg,
+	}
+	decoder, decoderErr := mapstructure.NewDecoder(decoderConfig)
+	if decoderErr != nil {
+		panic(fmt.Errorf("error creating RawMsgChannelCloseInit decoder: %v", decoderErr))
+	}
+	if err := decoder.Decode(msg); err != nil {
+		panic(fmt.Errorf("error decoding RawMsgChannelCloseInit: %v", err))
+	}
+
+	if !msgCommonParams.TxSuccess {
+		return []command.Command{command_usecase.NewCreateMsgIBCChannelCloseInit(
+			msgCommonParams,
+
+			ibc_model.MsgChannelCloseInitParams{
+				RawMsgChannelCloseInit: rawMsg,
+			},
+		)}
+	}
+
+	log := utils.NewParsedTxsResultLog(&txsResult.Log[msgIndex])
+	event := log.GetEventByType("channel_close_init")
+	if event == nil {
+		panic("missing `channel_close_init` event in TxsResult log")
+	}
+
+	params := ibc_model.MsgChannelCloseInitParams{
+		RawMsgChannelCloseInit: rawMsg,
+
+		CounterpartyPortID:    event.MustGetAttributeByKey("counterparty_port_id"),
+		CounterpartyChannelID: event.MustGetAttributeByKey("counterparty_channel_id"),
+		ConnectionID:          event.MustGetAttributeByKey("connection_id"),
+	}
+
+	return []command.Command{command_usecase.NewCreateMsgIBCChannelCloseInit(
+		msgCommonParams,
+
+		params,
+	)}
+}
+
+func ParseMsgChannelCloseConfirm(
+	msgCommonParams event.MsgCommonParams,
+	txsResult model.BlockResultsTxsResult,
+	msgIndex int,
+	msg map[string]interface{},
+) []command.Command {
+	var rawMsg ibc_model.RawMsgChannelCloseConfirm
+	decoderConfig := &mapstructure.DecoderConfig{
+		WeaklyTypedInput: true,
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(
+			StringToByteSliceHookFunc(),
+		),
+		Result: &rawMsg,
+	}
+	decoder, decoderErr := mapstructure.NewDecoder(decoderConfig)
+	if decoderErr != nil {
+		panic(fmt.Errorf("error creating RawMsgChannelCloseConfirm decoder: %v", decoderErr))
+	}
+	if err := decoder.Decode(msg); err != nil {
+		panic(fmt.Errorf("error decoding RawMsgChannelCloseConfirm: %v", err))
+	}
+
+	if !msgCommonParams.TxSuccess {
+		return []command.Command{command_usecase.NewCreateMsgIBCChannelCloseConfirm(
+			msgCommonParams,
+
+			ibc_model.MsgChannelCloseConfirmParams{
+				RawMsgChannelCloseConfirm: rawMsg,
+			},
+		)}
+	}
+
+	log := utils.NewParsedTxsResultLog(&txsResult.Log[msgIndex])
+	event := log.GetEventByType("channel_close_confirm")
+	if event == nil {
+		panic("missing `channel_close_confirm` event in TxsResult log")
+	}
+
+	params := ibc_model.MsgChannelCloseConfirmParams{
+		RawMsgChannelCloseConfirm: rawMsg,
+
+		CounterpartyPortID:    event.MustGetAttributeByKey("counterparty_port_id"),
+		CounterpartyChannelID: event.MustGetAttributeByKey("counterparty_channel_id"),
+		ConnectionID:          event.MustGetAttributeByKey("connection_id"),
+	}
+
+	return []command.Command{command_usecase.NewCreateMsgIBCChannelCloseConfirm(
+		msgCommonParams,
+
+		params,
+	)}
+}
+
 func ParseMsgUpdateClient(
 	msgCommonParams event.MsgCommonParams,
 	txsResult model.BlockResultsTxsResult,
@@ -611,6 +714,121 @@ func ParseMsgUpdateClient(
 	)}
 }
 
+func ParseMsgSubmitMisbehaviour(
+	msgCommonParams event.MsgCommonParams,
+	txsResult model.BlockResultsTxsResult,
+	msgIndex int,
+	msg map[string]interface{},
+) []command.Command {
+	var rawMsg ibc_model.RawMsgSubmitMisbehaviour
+	decoderConfig := &mapstructure.DecoderConfig{
+		WeaklyTypedInput: true,
+		Result:           &rawMsg,
+	}
+	decoder, decoderErr := mapstructure.NewDecoder(decoderConfig)
+	if decoderErr != nil {
+		panic(fmt.Errorf("error creating MsgSubmitMisbehaviour decoder: %v", decoderErr))
+	}
+	if err := decoder.Decode(msg); err != nil {
+		panic(fmt.Errorf("error decoding MsgSubmitMisbehaviour: %v", err))
+	}
+
+	if !msgCommonParams.TxSuccess {
+		return []command.Command{command_usecase.NewCreateMsgIBCSubmitMisbehaviour(
+			msgCommonParams,
+
+			ibc_model.MsgSubmitMisbehaviourParams{
+				RawMsgSubmitMisbehaviour: rawMsg,
+			},
+		)}
+	}
+
+	log := utils.NewParsedTxsResultLog(&txsResult.Log[msgIndex])
+	event := log.GetEventByType("client_misbehaviour")
+	if event == nil {
+		panic("missing `client_misbehaviour` event in TxsResult log")
+	}
+
+	params := ibc_model.MsgSubmitMisbehaviourParams{
+		RawMsgSubmitMisbehaviour: rawMsg,
+
+		ClientType: event.MustGetAttributeByKey("client_type"),
+	}
+
+	return []command.Command{command_usecase.NewCreateMsgIBCSubmitMisbehaviour(
+		msgCommonParams,
+
+		params,
+	)}
+}
+
+func ParseMsgUpgradeClient(
+	msgCommonParams event.MsgCommonParams,
+	txsResult model.BlockResultsTxsResult,
+	msgIndex int,
+	msg map[string]interface{},
+) []command.Command {
+	clientStateType := msg["client_state"].(map[string]interface{})["@type"]
+	if clientStateType != "/ibc.lightclients.tendermint.v1.ClientState" {
+		// TODO: SoloMachine and Localhost LightClient
+		return []command.Command{}
+	}
+
+	var rawMsg ibc_model.RawMsgUpgradeTendermintLightClient
+	decoderConfig := &mapstructure.DecoderConfig{
+		WeaklyTypedInput: true,
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(
+			mapstructure.StringToTimeDurationHookFunc(),
+			mapstructure.StringToTimeHookFunc(time.RFC3339),
+			StringToDurationHookFunc(),
+			StringToByteSliceHookFunc(),
+		),
+		Result: &rawMsg,
+	}
+	decoder, decoderErr := mapstructure.NewDecoder(decoderConfig)
+	if decoderErr != nil {
+		panic(fmt.Errorf("error creating MsgUpgradeClient decoder: %v", decoderErr))
+	}
+	if err := decoder.Decode(msg); err != nil {
+		panic(fmt.Errorf("error decoding MsgUpgradeClient: %v", err))
+	}
+
+	params := ibc_model.MsgUpgradeClientParams{
+		MaybeTendermintLightClient: &ibc_model.TendermintLightClient{
+			TendermintClientState:               rawMsg.ClientState,
+			TendermintLightClientConsensusState: rawMsg.ConsensusState,
+		},
+
+		ClientID:                   rawMsg.ClientID,
+		ProofUpgradeClient:         rawMsg.ProofUpgradeClient,
+		ProofUpgradeConsensusState: rawMsg.ProofUpgradeConsensusState,
+		Signer:                     rawMsg.Signer,
+	}
+
+	if !msgCommonParams.TxSuccess {
+		return []command.Command{command_usecase.NewCreateMsgIBCUpgradeClient(
+			msgCommonParams,
+
+			params,
+		)}
+	}
+
+	log := utils.NewParsedTxsResultLog(&txsResult.Log[msgIndex])
+	event := log.GetEventByType("upgrade_client")
+	if event == nil {
+		panic("missing `upgrade_client` event in TxsResult log")
+	}
+
+	params.ClientType = event.MustGetAttributeByKey("client_type")
+	params.ConsensusHeight = mustParseHeight(event.MustGetAttributeByKey("consensus_height"))
+
+	return []command.Command{command_usecase.NewCreateMsgIBCUpgradeClient(
+		msgCommonParams,
+
+		params,
+	)}
+}
+
 func mustParseHeight(height string) ibc_model.Height {
 	heightTokens := strings.Split(height, "-")
 	if len(heightTokens) != 2 {
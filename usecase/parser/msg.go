@@ -27,12 +27,208 @@ import (
 	"github.com/crypto-com/chain-indexing/usecase/model"
 )
 
+// builtinMsgParserRegistry is used when parsing without a registry provided
+var builtinMsgParserRegistry = newBuiltinMsgParserRegistry()
+
+func newBuiltinMsgParserRegistry() *MsgParserRegistry {
+	registry := NewMsgParserRegistry()
+	RegisterBuiltinMsgParsers(registry)
+	return registry
+}
+
+// RegisterBuiltinMsgParsers registers parsers of the Cosmos SDK, IBC, Ethermint and Crypto.org
+// Chain NFT messages to the registry
+func RegisterBuiltinMsgParsers(registry *MsgParserRegistry) {
+	// Bank
+	registerMsgOnlyParser(registry, "/cosmos.bank.v1beta1.MsgSend", parseMsgSend)
+	registerMsgOnlyParser(registry, "/cosmos.bank.v1beta1.MsgMultiSend", parseMsgMultiSend)
+
+	// Distribution
+	registerMsgOnlyParser(registry, "/cosmos.distribution.v1beta1.MsgSetWithdrawAddress", parseMsgSetWithdrawAddress)
+	registry.Register("/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward", func(params MsgParserParams) []command.Command {
+		return parseMsgWithdrawDelegatorReward(
+			params.MsgCommonParams.TxSuccess, params.TxsResult, params.MsgCommonParams.MsgIndex,
+			params.MsgCommonParams, params.Msg,
+		)
+	})
+	registry.Register("/cosmos.distribution.v1beta1.MsgWithdrawValidatorCommission", func(params MsgParserParams) []command.Command {
+		return parseMsgWithdrawValidatorCommission(
+			params.MsgCommonParams.TxSuccess, params.TxsResult, params.MsgCommonParams.MsgIndex,
+			params.MsgCommonParams, params.Msg,
+		)
+	})
+	registerMsgOnlyParser(registry, "/cosmos.distribution.v1beta1.MsgFundCommunityPool", parseMsgFundCommunityPool)
+
+	// Gov
+	registry.Register("/cosmos.gov.v1beta1.MsgSubmitProposal", func(params MsgParserParams) []command.Command {
+		return parseMsgSubmitProposal(
+			params.MsgCommonParams.TxSuccess, params.TxsResult, params.MsgCommonParams.MsgIndex,
+			params.MsgCommonParams, params.Msg,
+		)
+	})
+	registerMsgOnlyParser(registry, "/cosmos.gov.v1beta1.MsgVote", parseMsgVote)
+	registerTxsResultMsgParser(registry, "/cosmos.gov.v1beta1.MsgDeposit", parseMsgDeposit)
+
+	// Staking
+	registerMsgOnlyParser(registry, "/cosmos.staking.v1beta1.MsgDelegate", parseMsgDelegate)
+	registry.Register("/cosmos.staking.v1beta1.MsgUndelegate", func(params MsgParserParams) []command.Command {
+		return parseMsgUndelegate(
+			params.AccountAddressPrefix,
+			params.StakingDenom,
+			params.MsgCommonParams.TxSuccess, params.TxsResult, params.MsgCommonParams.MsgIndex,
+			params.MsgCommonParams, params.Msg,
+		)
+	})
+	registry.Register("/cosmos.staking.v1beta1.MsgBeginRedelegate", func(params MsgParserParams) []command.Command {
+		return parseMsgBeginRedelegate(
+			params.AccountAddressPrefix,
+			params.StakingDenom,
+			params.MsgCommonParams.TxSuccess, params.TxsResult, params.MsgCommonParams.MsgIndex,
+			params.MsgCommonParams, params.Msg,
+		)
+	})
+	registerMsgOnlyParser(registry, "/cosmos.staking.v1beta1.MsgCreateValidator", parseMsgCreateValidator)
+	registerMsgOnlyParser(registry, "/cosmos.staking.v1beta1.MsgEditValidator", parseMsgEditValidator)
+
+	// Slashing
+	registerMsgOnlyParser(registry, "/cosmos.slashing.v1beta1.MsgUnjail", parseMsgUnjail)
+
+	// Vesting
+	registerMsgOnlyParser(registry, "/cosmos.vesting.v1beta1.MsgCreateVestingAccount", parseMsgCreateVestingAccount)
+
+	// Authz
+	registerMsgOnlyParser(registry, "/cosmos.authz.v1beta1.MsgGrant", parseMsgGrant)
+	registerMsgOnlyParser(registry, "/cosmos.authz.v1beta1.MsgRevoke", parseMsgRevoke)
+	registry.Register("/cosmos.authz.v1beta1.MsgExec", func(params MsgParserParams) []command.Command {
+		return parseMsgExec(registry, params)
+	})
+
+	// Feegrant
+	registerMsgOnlyParser(registry, "/cosmos.feegrant.v1beta1.MsgGrantAllowance", parseMsgGrantAllowance)
+	registerMsgOnlyParser(registry, "/cosmos.feegrant.v1beta1.MsgRevokeAllowance", parseMsgRevokeAllowance)
+
+	// Ethermint
+	registry.Register("/ethermint.evm.v1.MsgEthereumTx", func(params MsgParserParams) []command.Command {
+		return parseMsgEthereumTx(
+			params.MsgCommonParams, params.TxsResult, params.MsgCommonParams.MsgIndex,
+			params.Msg, params.AccountAddressPrefix,
+		)
+	})
+
+	// NFT
+	registerMsgOnlyParser(registry, "/chainmain.nft.v1.MsgIssueDenom", parseMsgNFTIssueDenom)
+	registerMsgOnlyParser(registry, "/chainmain.nft.v1.MsgMintNFT", parseMsgNFTMintNFT)
+	registerMsgOnlyParser(registry, "/chainmain.nft.v1.MsgTransferNFT", parseMsgNFTTransferNFT)
+	registerMsgOnlyParser(registry, "/chainmain.nft.v1.MsgEditNFT", parseMsgNFTEditNFT)
+	registerMsgOnlyParser(registry, "/chainmain.nft.v1.MsgBurnNFT", parseMsgNFTBurnNFT)
+
+	// IBC
+	registerTxsResultMsgParser(registry, "/ibc.core.client.v1.MsgCreateClient", ibcmsg.ParseMsgCreateClient)
+	registerTxsResultMsgParser(registry, "/ibc.core.client.v1.MsgUpdateClient", ibcmsg.ParseMsgUpdateClient)
+	registerTxsResultMsgParser(registry, "/ibc.core.client.v1.MsgUpgradeClient", ibcmsg.ParseMsgUpgradeClient)
+	registerTxsResultMsgParser(
+		registry, "/ibc.core.client.v1.MsgSubmitMisbehaviour", ibcmsg.ParseMsgSubmitMisbehaviour,
+	)
+	registerTxsResultMsgParser(
+		registry, "/ibc.core.connection.v1.MsgConnectionOpenInit", ibcmsg.ParseMsgConnectionOpenInit,
+	)
+	registerTxsResultMsgParser(
+		registry, "/ibc.core.connection.v1.MsgConnectionOpenTry", ibcmsg.ParseMsgConnectionOpenTry,
+	)
+	registerTxsResultMsgParser(
+		registry, "/ibc.core.connection.v1.MsgConnectionOpenAck", ibcmsg.ParseMsgConnectionOpenAck,
+	)
+	registerTxsResultMsgParser(
+		registry, "/ibc.core.connection.v1.MsgConnectionOpenConfirm", ibcmsg.ParseMsgConnectionOpenConfirm,
+	)
+	registerTxsResultMsgParser(registry, "/ibc.core.channel.v1.MsgChannelOpenInit", ibcmsg.ParseMsgChannelOpenInit)
+	registerTxsResultMsgParser(registry, "/ibc.core.channel.v1.MsgChannelOpenTry", ibcmsg.ParseMsgChannelOpenTry)
+	registerTxsResultMsgParser(registry, "/ibc.core.channel.v1.MsgChannelOpenAck", ibcmsg.ParseMsgChannelOpenAck)
+	registerTxsResultMsgParser(
+		registry, "/ibc.core.channel.v1.MsgChannelOpenConfirm", ibcmsg.ParseMsgChannelOpenConfirm,
+	)
+	registerTxsResultMsgParser(registry, "/ibc.applications.transfer.v1.MsgTransfer", ibcmsg.ParseMsgTransfer)
+	registerTxsResultMsgParser(registry, "/ibc.core.channel.v1.MsgRecvPacket", ibcmsg.ParseMsgRecvPacket)
+	registerTxsResultMsgParser(registry, "/ibc.core.channel.v1.MsgAcknowledgement", ibcmsg.ParseMsgAcknowledgement)
+	registerTxsResultMsgParser(registry, "/ibc.core.channel.v1.MsgTimeout", ibcmsg.ParseMsgTimeout)
+	registerTxsResultMsgParser(registry, "/ibc.core.channel.v1.MsgTimeoutOnClose", ibcmsg.ParseMsgTimeoutOnClose)
+	registerTxsResultMsgParser(registry, "/ibc.core.channel.v1.MsgChannelCloseInit", ibcmsg.ParseMsgChannelCloseInit)
+	registerTxsResultMsgParser(
+		registry, "/ibc.core.channel.v1.MsgChannelCloseConfirm", ibcmsg.ParseMsgChannelCloseConfirm,
+	)
+}
+
+// registerMsgOnlyParser registers parser which only requires the message itself
+func registerMsgOnlyParser(
+	registry *MsgParserRegistry,
+	typeURL string,
+	parser func(event.MsgCommonParams, map[string]interface{}) []command.Command,
+) {
+	registry.Register(typeURL, func(params MsgParserParams) []command.Command {
+		return parser(params.MsgCommonParams, params.Msg)
+	})
+}
+
+// registerTxsResultMsgParser registers parser which reads the events emitted by the message from
+// the transaction result
+func registerTxsResultMsgParser(
+	registry *MsgParserRegistry,
+	typeURL string,
+	parser func(event.MsgCommonParams, model.BlockResultsTxsResult, int, map[string]interface{}) []command.Command,
+) {
+	registry.Register(typeURL, func(params MsgParserParams) []command.Command {
+		return parser(params.MsgCommonParams, params.TxsResult, params.MsgCommonParams.MsgIndex, params.Msg)
+	})
+}
+
+// ParseBlockResultsTxsMsgToCommands parses the messages of all transactions in the block with the
+// built-in message parsers
 func ParseBlockResultsTxsMsgToCommands(
 	txDecoder *utils.TxDecoder,
 	block *model.Block,
 	blockResults *model.BlockResults,
 	accountAddressPrefix string,
 	stakingDenom string,
+) ([]command.Command, error) {
+	return ParseBlockResultsTxsMsgToCommandsWithRegistry(
+		builtinMsgParserRegistry,
+		txDecoder,
+		block,
+		blockResults,
+		accountAddressPrefix,
+		stakingDenom,
+	)
+}
+
+// ParseBlockResultsTxsMsgToCommandsWithRegistry parses the messages of all transactions in the block
+// with the parsers in registry. Messages of unregistered types are parsed into MsgUnknown with the
+// raw message, so that they are not lost from the message history. Undecodable transactions are
+// skipped.
+func ParseBlockResultsTxsMsgToCommandsWithRegistry(
+	msgParserRegistry *MsgParserRegistry,
+	txDecoder *utils.TxDecoder,
+	block *model.Block,
+	blockResults *model.BlockResults,
+	accountAddressPrefix string,
+	stakingDenom string,
+) ([]command.Command, error) {
+	return parseBlockResultsTxsMsgToCommands(
+		msgParserRegistry,
+		txDecoder.DecodeAll(block.Txs),
+		block,
+		blockResults,
+		accountAddressPrefix,
+		stakingDenom,
+	)
+}
+
+func parseBlockResultsTxsMsgToCommands(
+	msgParserRegistry *MsgParserRegistry,
+	decodedTxs []utils.DecodedTx,
+	block *model.Block,
+	blockResults *model.BlockResults,
+	accountAddressPrefix string,
+	stakingDenom string,
 ) ([]command.Command, error) {
 	commands := make([]command.Command, 0)
 
@@ -45,11 +241,14 @@ func ParseBlockResultsTxsMsgToCommands(
 		if txsResult.Code != 0 {
 			txSuccess = false
 		}
-		tx, err := txDecoder.Decode(txHex)
-		if err != nil {
-			panic(fmt.Sprintf("error decoding transaction: %v", err))
+		tx := decodedTxs[i].MaybeTx
+		if tx == nil {
+			// Reported by ParseUndecodableTransactionCommands
+			continue
 		}
 
+		var err error
+		var txSigners []string
 		for msgIndex, msg := range tx.Body.Messages {
 			msgCommonParams := event.MsgCommonParams{
 				BlockHeight: blockHeight,
@@ -58,86 +257,23 @@ func ParseBlockResultsTxsMsgToCommands(
 				MsgIndex:    msgIndex,
 			}
 
-			var msgCommands []command.Command
-			switch msg["@type"] {
-			case "/cosmos.bank.v1beta1.MsgSend":
-				msgCommands = parseMsgSend(msgCommonParams, msg)
-			case "/cosmos.bank.v1beta1.MsgMultiSend":
-				msgCommands = parseMsgMultiSend(msgCommonParams, msg)
-			case "/cosmos.distribution.v1beta1.MsgSetWithdrawAddress":
-				msgCommands = parseMsgSetWithdrawAddress(msgCommonParams, msg)
-			case "/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward":
-				msgCommands = parseMsgWithdrawDelegatorReward(txSuccess, txsResult, msgIndex, msgCommonParams, msg)
-			case "/cosmos.distribution.v1beta1.MsgWithdrawValidatorCommission":
-				msgCommands = parseMsgWithdrawValidatorCommission(txSuccess, txsResult, msgIndex, msgCommonParams, msg)
-			case "/cosmos.distribution.v1beta1.MsgFundCommunityPool":
-				msgCommands = parseMsgFundCommunityPool(msgCommonParams, msg)
-			case "/cosmos.gov.v1beta1.MsgSubmitProposal":
-				msgCommands = parseMsgSubmitProposal(txSuccess, txsResult, msgIndex, msgCommonParams, msg)
-			case "/cosmos.gov.v1beta1.MsgVote":
-				msgCommands = parseMsgVote(msgCommonParams, msg)
-			case "/cosmos.gov.v1beta1.MsgDeposit":
-				msgCommands = parseMsgDeposit(msgCommonParams, txsResult, msgIndex, msg)
-			case "/cosmos.staking.v1beta1.MsgDelegate":
-				msgCommands = parseMsgDelegate(msgCommonParams, msg)
-			case "/cosmos.staking.v1beta1.MsgUndelegate":
-				msgCommands = parseMsgUndelegate(
-					accountAddressPrefix,
-					stakingDenom,
-					txSuccess, txsResult, msgIndex, msgCommonParams, msg,
-				)
-			case "/cosmos.staking.v1beta1.MsgBeginRedelegate":
-				msgCommands = parseMsgBeginRedelegate(
-					accountAddressPrefix,
-					stakingDenom,
-					txSuccess, txsResult, msgIndex, msgCommonParams, msg,
-				)
-			case "/cosmos.slashing.v1beta1.MsgUnjail":
-				msgCommands = parseMsgUnjail(msgCommonParams, msg)
-			case "/cosmos.staking.v1beta1.MsgCreateValidator":
-				msgCommands = parseMsgCreateValidator(msgCommonParams, msg)
-			case "/cosmos.staking.v1beta1.MsgEditValidator":
-				msgCommands = parseMsgEditValidator(msgCommonParams, msg)
-			case "/chainmain.nft.v1.MsgIssueDenom":
-				msgCommands = parseMsgNFTIssueDenom(msgCommonParams, msg)
-			case "/chainmain.nft.v1.MsgMintNFT":
-				msgCommands = parseMsgNFTMintNFT(msgCommonParams, msg)
-			case "/chainmain.nft.v1.MsgTransferNFT":
-				msgCommands = parseMsgNFTTransferNFT(msgCommonParams, msg)
-			case "/chainmain.nft.v1.MsgEditNFT":
-				msgCommands = parseMsgNFTEditNFT(msgCommonParams, msg)
-			case "/chainmain.nft.v1.MsgBurnNFT":
-				msgCommands = parseMsgNFTBurnNFT(msgCommonParams, msg)
-			case "/ibc.core.client.v1.MsgCreateClient":
-				msgCommands = ibcmsg.ParseMsgCreateClient(msgCommonParams, txsResult, msgIndex, msg)
-			case "/ibc.core.client.v1.MsgUpdateClient":
-				msgCommands = ibcmsg.ParseMsgUpdateClient(msgCommonParams, txsResult, msgIndex, msg)
-			case "/ibc.core.connection.v1.MsgConnectionOpenInit":
-				msgCommands = ibcmsg.ParseMsgConnectionOpenInit(msgCommonParams, txsResult, msgIndex, msg)
-			case "/ibc.core.connection.v1.MsgConnectionOpenTry":
-				msgCommands = ibcmsg.ParseMsgConnectionOpenTry(msgCommonParams, txsResult, msgIndex, msg)
-			case "/ibc.core.connection.v1.MsgConnectionOpenAck":
-				msgCommands = ibcmsg.ParseMsgConnectionOpenAck(msgCommonParams, txsResult, msgIndex, msg)
-			case "/ibc.core.connection.v1.MsgConnectionOpenConfirm":
-				msgCommands = ibcmsg.ParseMsgConnectionOpenConfirm(msgCommonParams, txsResult, msgIndex, msg)
-			case "/ibc.core.channel.v1.MsgChannelOpenInit":
-				msgCommands = ibcmsg.ParseMsgChannelOpenInit(msgCommonParams, txsResult, msgIndex, msg)
-			case "/ibc.core.channel.v1.MsgChannelOpenTry":
-				msgCommands = ibcmsg.ParseMsgChannelOpenTry(msgCommonParams, txsResult, msgIndex, msg)
-			case "/ibc.core.channel.v1.MsgChannelOpenAck":
-				msgCommands = ibcmsg.ParseMsgChannelOpenAck(msgCommonParams, txsResult, msgIndex, msg)
-			case "/ibc.core.channel.v1.MsgChannelOpenConfirm":
-				msgCommands = ibcmsg.ParseMsgChannelOpenConfirm(msgCommonParams, txsResult, msgIndex, msg)
-			case "/ibc.applications.transfer.v1.MsgTransfer":
-				msgCommands = ibcmsg.ParseMsgTransfer(msgCommonParams, txsResult, msgIndex, msg)
-			case "/ibc.core.channel.v1.MsgRecvPacket":
-				msgCommands = ibcmsg.ParseMsgRecvPacket(msgCommonParams, txsResult, msgIndex, msg)
-			case "/ibc.core.channel.v1.MsgAcknowledgement":
-				msgCommands = ibcmsg.ParseMsgAcknowledgement(msgCommonParams, txsResult, msgIndex, msg)
-			case "/ibc.core.channel.v1.MsgTimeout":
-				msgCommands = ibcmsg.ParseMsgTimeout(msgCommonParams, txsResult, msgIndex, msg)
-			case "/ibc.core.channel.v1.MsgTimeoutOnClose":
-				msgCommands = ibcmsg.ParseMsgTimeoutOnClose(msgCommonParams, txsResult, msgIndex, msg)
+			typeURL, _ := msg["@type"].(string)
+			msgCommands, parsed := msgParserRegistry.Parse(typeURL, MsgParserParams{
+				MsgCommonParams: msgCommonParams,
+				Msg:             msg,
+				TxsResult:       txsResult,
+
+				AccountAddressPrefix: accountAddressPrefix,
+				StakingDenom:         stakingDenom,
+			})
+			if !parsed {
+				if txSigners == nil {
+					txSigners, err = parseTxSignerAddresses(tx, accountAddressPrefix)
+					if err != nil {
+						return nil, fmt.Errorf("error parsing signers of transaction %s: %v", txHash, err)
+					}
+				}
+				msgCommands = parseMsgUnknown(msgCommonParams, typeURL, msg, txSigners)
 			}
 
 			commands = append(commands, msgCommands...)
@@ -147,6 +283,39 @@ func ParseBlockResultsTxsMsgToCommands(
 	return commands, nil
 }
 
+// parseTxSignerAddresses returns the addresses of the transaction signers
+func parseTxSignerAddresses(tx *utils.CosmosTx, accountAddressPrefix string) ([]string, error) {
+	signers, err := ParseSignerInfosToTransactionSigners(tx.AuthInfo.SignerInfos, accountAddressPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]string, 0, len(signers))
+	for _, signer := range signers {
+		addresses = append(addresses, signer.Address)
+	}
+	return addresses, nil
+}
+
+// parseMsgUnknown keeps the raw message of unsupported type. Signers of the message cannot be
+// determined without knowing its type, so the transaction signers are recorded instead.
+func parseMsgUnknown(
+	msgCommonParams event.MsgCommonParams,
+	typeURL string,
+	msg map[string]interface{},
+	txSigners []string,
+) []command.Command {
+	return []command.Command{command_usecase.NewCreateMsgUnknown(
+		msgCommonParams,
+
+		model.MsgUnknownParams{
+			TypeURL: typeURL,
+			Signers: txSigners,
+			RawMsg:  msg,
+		},
+	)}
+}
+
 func parseMsgSend(
 	msgCommonParams event.MsgCommonParams,
 	msg map[string]interface{},
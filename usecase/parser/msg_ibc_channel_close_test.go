@@ -0,0 +1,128 @@
+package parser_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/entity/command"
+	command_usecase "github.com/crypto-com/chain-indexing/usecase/command"
+	"github.com/crypto-com/chain-indexing/usecase/event"
+	ibc_model "github.com/crypto-com/chain-indexing/usecase/model/ibc"
+	"github.com/crypto-com/chain-indexing/usecase/parser"
+	usecase_parser_test "github.com/crypto-com/chain-indexing/usecase/parser/test"
+	"github.com/crypto-com/chain-indexing/usecase/parser/utils"
+)
+
+var _ = Describe("ParseMsgCommands", func() {
+	Describe("MsgIBCChannelClose", func() {
+		accountAddressPrefix := "tcro"
+		bondingDenom := "basetcro"
+
+		It("should parse MsgChannelCloseInit with counterparty from the log", func() {
+			txDecoder := utils.NewTxDecoder()
+			block, _ := mustParseBlockResp(usecase_parser_test.TX_MSG_IBC_CHANNEL_CLOSE_INIT_BLOCK_RESP)
+			blockResults := mustParseBlockResultsResp(
+				usecase_parser_test.TX_MSG_IBC_CHANNEL_CLOSE_INIT_BLOCK_RESULTS_RESP,
+			)
+
+			cmds, err := parser.ParseBlockResultsTxsMsgToCommands(
+				txDecoder,
+				block,
+				blockResults,
+				accountAddressPrefix,
+				bondingDenom,
+			)
+			Expect(err).To(BeNil())
+			Expect(cmds).To(Equal([]command.Command{command_usecase.NewCreateMsgIBCChannelCloseInit(
+				event.MsgCommonParams{
+					BlockHeight: int64(17),
+					TxHash:      "F722D09622636C7A3CB8124ECAFADFD53FE8AB6C821F75465DA607C20844D62D",
+					TxSuccess:   true,
+					MsgIndex:    0,
+				},
+				ibc_model.MsgChannelCloseInitParams{
+					RawMsgChannelCloseInit: ibc_model.RawMsgChannelCloseInit{
+						PortID:    "mock",
+						ChannelID: "channel-0",
+						Signer:    "tcro100kxfj78cpf98phts8m8aw8dvrqym3gul6aerc",
+					},
+
+					CounterpartyPortID:    "mock",
+					CounterpartyChannelID: "channel-0",
+					ConnectionID:          "connection-0",
+				},
+			)}))
+		})
+
+		It("should parse failed MsgChannelCloseInit without counterparty", func() {
+			txDecoder := utils.NewTxDecoder()
+			block, _ := mustParseBlockResp(usecase_parser_test.TX_FAILED_MSG_IBC_CHANNEL_CLOSE_INIT_BLOCK_RESP)
+			blockResults := mustParseBlockResultsResp(
+				usecase_parser_test.TX_FAILED_MSG_IBC_CHANNEL_CLOSE_INIT_BLOCK_RESULTS_RESP,
+			)
+
+			cmds, err := parser.ParseBlockResultsTxsMsgToCommands(
+				txDecoder,
+				block,
+				blockResults,
+				accountAddressPrefix,
+				bondingDenom,
+			)
+			Expect(err).To(BeNil())
+			Expect(cmds).To(Equal([]command.Command{command_usecase.NewCreateMsgIBCChannelCloseInit(
+				event.MsgCommonParams{
+					BlockHeight: int64(18),
+					TxHash:      "7EFFE693BA2F600B507B656220E897FF3A83D1700DAEA6BE1DF7B96DAABA1854",
+					TxSuccess:   false,
+					MsgIndex:    0,
+				},
+				ibc_model.MsgChannelCloseInitParams{
+					RawMsgChannelCloseInit: ibc_model.RawMsgChannelCloseInit{
+						PortID:    "mock",
+						ChannelID: "channel-0",
+						Signer:    "tcro100kxfj78cpf98phts8m8aw8dvrqym3gul6aerc",
+					},
+				},
+			)}))
+		})
+
+		It("should parse MsgChannelCloseConfirm relayed after MsgUpdateClient in the transaction", func() {
+			txDecoder := utils.NewTxDecoder()
+			block, _ := mustParseBlockResp(usecase_parser_test.TX_MSG_IBC_CHANNEL_CLOSE_CONFIRM_BLOCK_RESP)
+			blockResults := mustParseBlockResultsResp(
+				usecase_parser_test.TX_MSG_IBC_CHANNEL_CLOSE_CONFIRM_BLOCK_RESULTS_RESP,
+			)
+
+			cmds, err := parser.ParseBlockResultsTxsMsgToCommands(
+				txDecoder,
+				block,
+				blockResults,
+				accountAddressPrefix,
+				bondingDenom,
+			)
+			Expect(err).To(BeNil())
+			Expect(cmds).To(HaveLen(2))
+			Expect(cmds[0].Name()).To(Equal("CreateMsgIBCUpdateClient"))
+			Expect(cmds[1].Name()).To(Equal("CreateMsgIBCChannelCloseConfirm"))
+
+			untypedEvent, _ := cmds[1].Exec()
+			typedEvent := untypedEvent.(*event.MsgIBCChannelCloseConfirm)
+			Expect(typedEvent.BlockHeight).To(Equal(int64(17)))
+			Expect(typedEvent.TxHash()).To(Equal("F77500466059C00E80CD5D0815169391949ECC477E79CA91FA65C11F4432B2F0"))
+			Expect(typedEvent.TxSuccess()).To(BeTrue())
+			Expect(typedEvent.MsgIndex).To(Equal(1))
+
+			Expect(typedEvent.Params.PortID).To(Equal("mock"))
+			Expect(typedEvent.Params.ChannelID).To(Equal("channel-0"))
+			Expect(typedEvent.Params.ProofInit).NotTo(BeEmpty())
+			Expect(typedEvent.Params.ProofHeight).To(Equal(ibc_model.Height{
+				RevisionNumber: 0,
+				RevisionHeight: 19,
+			}))
+			Expect(typedEvent.Params.Signer).To(Equal("tcro1w2zver0wg7vhl45meuxwts73a306r35y5zx25a"))
+			Expect(typedEvent.Params.CounterpartyPortID).To(Equal("mock"))
+			Expect(typedEvent.Params.CounterpartyChannelID).To(Equal("channel-0"))
+			Expect(typedEvent.Params.ConnectionID).To(Equal("connection-0"))
+		})
+	})
+})
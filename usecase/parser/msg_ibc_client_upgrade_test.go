@@ -0,0 +1,104 @@
+package parser_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/entity/command"
+	command_usecase "github.com/crypto-com/chain-indexing/usecase/command"
+	"github.com/crypto-com/chain-indexing/usecase/event"
+	ibc_model "github.com/crypto-com/chain-indexing/usecase/model/ibc"
+	"github.com/crypto-com/chain-indexing/usecase/parser"
+	usecase_parser_test "github.com/crypto-com/chain-indexing/usecase/parser/test"
+	"github.com/crypto-com/chain-indexing/usecase/parser/utils"
+)
+
+var _ = Describe("ParseMsgCommands", func() {
+	Describe("MsgIBCClientMisbehaviourAndUpgrade", func() {
+		accountAddressPrefix := "tcro"
+		bondingDenom := "basetcro"
+
+		It("should parse MsgSubmitMisbehaviour with client type from the log", func() {
+			txDecoder := utils.NewTxDecoder()
+			block, _ := mustParseBlockResp(usecase_parser_test.TX_MSG_IBC_SUBMIT_MISBEHAVIOUR_BLOCK_RESP)
+			blockResults := mustParseBlockResultsResp(
+				usecase_parser_test.TX_MSG_IBC_SUBMIT_MISBEHAVIOUR_BLOCK_RESULTS_RESP,
+			)
+
+			cmds, err := parser.ParseBlockResultsTxsMsgToCommands(
+				txDecoder,
+				block,
+				blockResults,
+				accountAddressPrefix,
+				bondingDenom,
+			)
+			Expect(err).To(BeNil())
+			Expect(cmds).To(Equal([]command.Command{command_usecase.NewCreateMsgIBCSubmitMisbehaviour(
+				event.MsgCommonParams{
+					BlockHeight: int64(5),
+					TxHash:      "3CB49A46A85E747B237319252CBC9D3E4A6DDBC36B0FAF8B73F83CA8752E0E30",
+					TxSuccess:   true,
+					MsgIndex:    0,
+				},
+				ibc_model.MsgSubmitMisbehaviourParams{
+					RawMsgSubmitMisbehaviour: ibc_model.RawMsgSubmitMisbehaviour{
+						ClientID: "07-tendermint-0",
+						Misbehaviour: ibc_model.RawMisbehaviour{
+							Type: "/ibc.lightclients.tendermint.v1.Misbehaviour",
+						},
+						Signer: "tcro1qwwf74j6l033xzhyvpqllwk4hgh80gw2qjg5cf",
+					},
+
+					ClientType: "07-tendermint",
+				},
+			)}))
+		})
+
+		It("should parse MsgUpgradeClient with client type and consensus height from the log", func() {
+			txDecoder := utils.NewTxDecoder()
+			block, _ := mustParseBlockResp(usecase_parser_test.TX_MSG_IBC_UPGRADE_CLIENT_BLOCK_RESP)
+			blockResults := mustParseBlockResultsResp(
+				usecase_parser_test.TX_MSG_IBC_UPGRADE_CLIENT_BLOCK_RESULTS_RESP,
+			)
+
+			cmds, err := parser.ParseBlockResultsTxsMsgToCommands(
+				txDecoder,
+				block,
+				blockResults,
+				accountAddressPrefix,
+				bondingDenom,
+			)
+			Expect(err).To(BeNil())
+			Expect(cmds).To(HaveLen(1))
+			Expect(cmds[0].Name()).To(Equal("CreateMsgIBCUpgradeClient"))
+
+			untypedEvent, _ := cmds[0].Exec()
+			typedEvent := untypedEvent.(*event.MsgIBCUpgradeClient)
+			Expect(typedEvent.BlockHeight).To(Equal(int64(6)))
+			Expect(typedEvent.TxHash()).To(Equal("C06BE947F4EC2D3DA9C3BE442B0DCA4866EDB95698D357BBDD37A9675DBEC25F"))
+			Expect(typedEvent.TxSuccess()).To(BeTrue())
+
+			Expect(typedEvent.Params.ClientID).To(Equal("07-tendermint-0"))
+			Expect(typedEvent.Params.MaybeTendermintLightClient).NotTo(BeNil())
+			upgradedClient := typedEvent.Params.MaybeTendermintLightClient
+			Expect(upgradedClient.TendermintClientState.ChainID).To(Equal("testchain1-1"))
+			Expect(upgradedClient.TendermintClientState.LatestHeight).To(Equal(ibc_model.TendermintLightClientHeight{
+				RevisionNumber: 1,
+				RevisionHeight: 1,
+			}))
+			Expect(upgradedClient.TendermintClientState.UpgradePath).To(Equal([]string{"upgrade", "upgradedIBCState"}))
+			Expect(upgradedClient.TendermintLightClientConsensusState.Timestamp).To(Equal("2020-01-02T00:00:30Z"))
+			Expect(upgradedClient.TendermintLightClientConsensusState.NextValidatorsHash).To(Equal(
+				"9B3E8A562A3704D3F13D600612DF88165F6F4F9C263715B71F94F6B9AB834C05",
+			))
+			Expect(typedEvent.Params.ProofUpgradeClient).NotTo(BeEmpty())
+			Expect(typedEvent.Params.ProofUpgradeConsensusState).NotTo(BeEmpty())
+			Expect(typedEvent.Params.Signer).To(Equal("tcro1pvfukr4r588m275qtfj8sesvh7fwsps2n7sgf3"))
+			Expect(typedEvent.Params.ClientType).To(Equal("07-tendermint"))
+			Expect(typedEvent.Params.ConsensusHeight).To(Equal(ibc_model.Height{
+				RevisionNumber: 1,
+				RevisionHeight: 1,
+			}))
+		})
+	})
+})
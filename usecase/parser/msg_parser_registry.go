@@ -0,0 +1,57 @@
+package parser
+
+import (
+	"github.com/crypto-com/chain-indexing/entity/command"
+	"github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+)
+
+// MsgParserParams is the message to parse and its context in the transaction
+type MsgParserParams struct {
+	MsgCommonParams event.MsgCommonParams
+	Msg             map[string]interface{}
+	// Result of the transaction containing the message. When the transaction succeeded, its log at
+	// MsgCommonParams.MsgIndex contains the events emitted by the message
+	TxsResult model.BlockResultsTxsResult
+
+	AccountAddressPrefix string
+	StakingDenom         string
+}
+
+// MsgParser parses a transaction message to commands. It panics when the message is malformed
+type MsgParser = func(params MsgParserParams) []command.Command
+
+// MsgParserRegistry maps message type URLs (e.g. `/cosmos.bank.v1beta1.MsgSend`) to their parsers.
+// Parsers of custom modules are registered together with their events in `event.Registry`.
+type MsgParserRegistry struct {
+	parsers map[string]MsgParser
+}
+
+func NewMsgParserRegistry() *MsgParserRegistry {
+	return &MsgParserRegistry{
+		parsers: make(map[string]MsgParser),
+	}
+}
+
+// Register adds the parser of the message type URL to the registry. It will overwrite existing
+// registration if any.
+func (registry *MsgParserRegistry) Register(typeURL string, parser MsgParser) {
+	registry.parsers[typeURL] = parser
+}
+
+// IsRegistered returns true when a parser of the message type URL is registered
+func (registry *MsgParserRegistry) IsRegistered(typeURL string) bool {
+	_, exist := registry.parsers[typeURL]
+	return exist
+}
+
+// Parse parses the message with the parser registered for its type URL. Returns false when no
+// parser is registered.
+func (registry *MsgParserRegistry) Parse(typeURL string, params MsgParserParams) ([]command.Command, bool) {
+	parser, exist := registry.parsers[typeURL]
+	if !exist {
+		return nil, false
+	}
+
+	return parser(params), true
+}
@@ -0,0 +1,114 @@
+package parser_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/entity/command"
+	command_usecase "github.com/crypto-com/chain-indexing/usecase/command"
+	"github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+	"github.com/crypto-com/chain-indexing/usecase/parser"
+	usecase_parser_test "github.com/crypto-com/chain-indexing/usecase/parser/test"
+	"github.com/crypto-com/chain-indexing/usecase/parser/utils"
+)
+
+var _ = Describe("MsgParserRegistry", func() {
+	It("should parse message with the parser registered for its type URL", func() {
+		txDecoder := utils.NewTxDecoder()
+		block, _ := mustParseBlockResp(usecase_parser_test.TX_MSG_SEND_BLOCK_RESP)
+		blockResults := mustParseBlockResultsResp(usecase_parser_test.TX_MSG_SEND_BLOCK_RESULTS_RESP)
+
+		registry := parser.NewMsgParserRegistry()
+		var actualParams *parser.MsgParserParams
+		registry.Register("/cosmos.bank.v1beta1.MsgSend", func(params parser.MsgParserParams) []command.Command {
+			actualParams = &params
+			return []command.Command{command_usecase.NewCreateMsgSetWithdrawAddress(
+				params.MsgCommonParams,
+				model.MsgSetWithdrawAddressParams{
+					DelegatorAddress: params.Msg["from_address"].(string),
+					WithdrawAddress:  params.Msg["to_address"].(string),
+				},
+			)}
+		})
+
+		cmds, err := parser.ParseBlockResultsTxsMsgToCommandsWithRegistry(
+			registry,
+			txDecoder,
+			block,
+			blockResults,
+			"tcro",
+			"basetcro",
+		)
+		Expect(err).To(BeNil())
+
+		expectedMsgCommonParams := event.MsgCommonParams{
+			BlockHeight: int64(377673),
+			TxHash:      "2A2A64A310B3D0E84C9831F4353E188A6E63BF451975C859DF40C54047AC6324",
+			TxSuccess:   true,
+			MsgIndex:    0,
+		}
+		Expect(cmds).To(Equal([]command.Command{command_usecase.NewCreateMsgSetWithdrawAddress(
+			expectedMsgCommonParams,
+			model.MsgSetWithdrawAddressParams{
+				DelegatorAddress: "tcro1feqh6ad9ytjkr79kjk5nhnl4un3wez0ynurrwv",
+				WithdrawAddress:  "tcro1feqh6ad9ytjkr79kjk5nhnl4un3wez0ynurrwv",
+			},
+		)}))
+		Expect(actualParams.MsgCommonParams).To(Equal(expectedMsgCommonParams))
+		Expect(actualParams.AccountAddressPrefix).To(Equal("tcro"))
+		Expect(actualParams.StakingDenom).To(Equal("basetcro"))
+		Expect(actualParams.TxsResult).To(Equal(blockResults.TxsResults[0]))
+	})
+
+	It("should parse message of type without registered parser into MsgUnknown", func() {
+		txDecoder := utils.NewTxDecoder()
+		block, _ := mustParseBlockResp(usecase_parser_test.TX_MSG_SEND_BLOCK_RESP)
+		blockResults := mustParseBlockResultsResp(usecase_parser_test.TX_MSG_SEND_BLOCK_RESULTS_RESP)
+
+		registry := parser.NewMsgParserRegistry()
+		Expect(registry.IsRegistered("/cosmos.bank.v1beta1.MsgSend")).To(BeFalse())
+
+		cmds, err := parser.ParseBlockResultsTxsMsgToCommandsWithRegistry(
+			registry,
+			txDecoder,
+			block,
+			blockResults,
+			"tcro",
+			"basetcro",
+		)
+		Expect(err).To(BeNil())
+		Expect(cmds).To(Equal([]command.Command{command_usecase.NewCreateMsgUnknown(
+			event.MsgCommonParams{
+				BlockHeight: int64(377673),
+				TxHash:      "2A2A64A310B3D0E84C9831F4353E188A6E63BF451975C859DF40C54047AC6324",
+				TxSuccess:   true,
+				MsgIndex:    0,
+			},
+			model.MsgUnknownParams{
+				TypeURL: "/cosmos.bank.v1beta1.MsgSend",
+				Signers: []string{"tcro1feqh6ad9ytjkr79kjk5nhnl4un3wez0ynurrwv"},
+				RawMsg: map[string]interface{}{
+					"@type":        "/cosmos.bank.v1beta1.MsgSend",
+					"from_address": "tcro1feqh6ad9ytjkr79kjk5nhnl4un3wez0ynurrwv",
+					"to_address":   "tcro1feqh6ad9ytjkr79kjk5nhnl4un3wez0ynurrwv",
+					"amount": []interface{}{
+						map[string]interface{}{
+							"denom":  "basetcro",
+							"amount": "1000000000",
+						},
+					},
+				},
+			},
+		)}))
+	})
+
+	It("should register all built-in message parsers", func() {
+		registry := parser.NewMsgParserRegistry()
+		parser.RegisterBuiltinMsgParsers(registry)
+
+		Expect(registry.IsRegistered("/cosmos.bank.v1beta1.MsgSend")).To(BeTrue())
+		Expect(registry.IsRegistered("/chainmain.nft.v1.MsgMintNFT")).To(BeTrue())
+		Expect(registry.IsRegistered("/ibc.applications.transfer.v1.MsgTransfer")).To(BeTrue())
+	})
+})
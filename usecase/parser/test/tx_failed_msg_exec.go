@@ -0,0 +1,487 @@
+package usecase_parser_test
+
+// Generated by delivering the transactions to an in-process Cosmos SDK v0.44.5 simapp with the
+// Crypto.org Chain address prefixes and denom, and encoding its responses as Tendermint v0.34 RPC.
+const TX_FAILED_MSG_EXEC_BLOCK_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "block_id": {
+      "hash": "7DB7EB887EFD6521B120AA7C00636FB26EA220CF70318B25A9E87459D7C80F2E",
+      "parts": {
+        "total": 1,
+        "hash": "B7B26F5036A2FE51B437D2D4FBA31A70AB5F7F0D164AD07C775FD768C1DE4C14"
+      }
+    },
+    "block": {
+      "header": {
+        "version": {
+          "block": "11"
+        },
+        "chain_id": "testnet-croeseid-4",
+        "height": "8",
+        "time": "2021-11-22T08:00:42Z",
+        "last_block_id": {
+          "hash": "E57ABBC8483806A2184BE40B033406CC531F2EC4657D1D6C54555D018A0AF262",
+          "parts": {
+            "total": 1,
+            "hash": "883980CBC6F718E3F512B9A900791494EF0A633F75D60BB0802F91E420EF80D1"
+          }
+        },
+        "last_commit_hash": "89A020830384B4D993D44CEA57F80DAB6CADF37E55E0797319C750B5DEA59571",
+        "data_hash": "3B72B1CB4FEDC7EF678FF7850535B93ABAB3F8B9A474FC37712FD4FF8FC68FC9",
+        "validators_hash": "E000B2CE263CED3E3E37A1A4EB362BCD1E5C422471E6A88C2DA60A3D69B9922E",
+        "next_validators_hash": "E000B2CE263CED3E3E37A1A4EB362BCD1E5C422471E6A88C2DA60A3D69B9922E",
+        "consensus_hash": "048091BC7DDC283F77BFBF91D73C44DA58C3DF8A9CBC867405D8B7F3DAADA22F",
+        "app_hash": "B6DB5D16469B6A99101462C5969DEB5152B759252BE79A7B9D4AD9E7CD31C257",
+        "last_results_hash": "7F07BE7EF5ED40DE9AF1CADC926E1E25277E3B1B093F9DDEB02E9E3811DA3C50",
+        "evidence_hash": "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855",
+        "proposer_address": "1CD16F44A4E0502144D9D49A4CDB8748A57E334C"
+      },
+      "data": {
+        "txs": [
+          "Cu4BCusBCh0vY29zbW9zLmF1dGh6LnYxYmV0YTEuTXNnRXhlYxLJAQordGNybzFwZWpyaGcyc2xweHphZWtodmNqODYwNjd5YTRldzd3Y3VxdHBnZBKZAQo3L2Nvc21vcy5kaXN0cmlidXRpb24udjFiZXRhMS5Nc2dXaXRoZHJhd0RlbGVnYXRvclJld2FyZBJeCit0Y3JvMWRoNjlmbGRjc2ZyejR1aHZuNjRzNjZqZGYzenBsOW1oM3pwa25yEi90Y3JvY25jbDFndzRmdG0zOHRhbXJoeXAzcnVuNjhmMjMyd3JxbXJwZXVlOTVnYRJrClAKRgofL2Nvc21vcy5jcnlwdG8uc2VjcDI1NmsxLlB1YktleRIjCiEC0QZh4PcGAXDqkU0m7+cUS7K/MJe+psrEjFHyrWrTJucSBAoCCAEYARIXChEKCGJhc2V0Y3JvEgUyMDAwMBCAtRgaQHyOGQYmg03WUK5/l6EBLgWIgk2uVner+mC69IY9UxXhfit7wtfl7iCUFBMjeDpx6Hph8OZW3+OQLZjNQ+psxJQ="
+        ]
+      },
+      "evidence": {
+        "evidence": null
+      },
+      "last_commit": {
+        "height": "7",
+        "round": 0,
+        "block_id": {
+          "hash": "E57ABBC8483806A2184BE40B033406CC531F2EC4657D1D6C54555D018A0AF262",
+          "parts": {
+            "total": 1,
+            "hash": "883980CBC6F718E3F512B9A900791494EF0A633F75D60BB0802F91E420EF80D1"
+          }
+        },
+        "signatures": [
+          {
+            "block_id_flag": 2,
+            "validator_address": "1CD16F44A4E0502144D9D49A4CDB8748A57E334C",
+            "timestamp": "2021-11-22T08:00:39Z",
+            "signature": "NhofmtX8MQlMMQjnld/7/VQaw3G6w8IhVPIwXiT4hPgP6zOuOHBarAASvDrmMWdvow/bI7Kszu6pIKt05NLMBA=="
+          },
+          {
+            "block_id_flag": 2,
+            "validator_address": "838043E73426378E3A87858E3B39AF58AF330752",
+            "timestamp": "2021-11-22T08:00:39Z",
+            "signature": "9REO/vY8pgjr1nBF6zr9Rd/da8lp4cNMYmFFuA7D1cfJ0uQCUuc2HFnB29wIVcvpK5KahoZvj2xmC+/uYrZrBQ=="
+          }
+        ]
+      }
+    }
+  }
+}`
+
+const TX_FAILED_MSG_EXEC_BLOCK_RESULTS_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "height": "8",
+    "txs_results": [
+      {
+        "code": 4,
+        "data": null,
+        "log": "failed to execute message; message index: 0: authorization not found: unauthorized",
+        "info": "",
+        "gas_wanted": "400000",
+        "gas_used": "51886",
+        "events": [
+          {
+            "type": "coin_spent",
+            "attributes": [
+              {
+                "key": "c3BlbmRlcg==",
+                "value": "dGNybzFwZWpyaGcyc2xweHphZWtodmNqODYwNjd5YTRldzd3Y3VxdHBnZA==",
+                "index": false
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjAwMDBiYXNldGNybw==",
+                "index": false
+              }
+            ]
+          },
+          {
+            "type": "coin_received",
+            "attributes": [
+              {
+                "key": "cmVjZWl2ZXI=",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": false
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjAwMDBiYXNldGNybw==",
+                "index": false
+              }
+            ]
+          },
+          {
+            "type": "transfer",
+            "attributes": [
+              {
+                "key": "cmVjaXBpZW50",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": false
+              },
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzFwZWpyaGcyc2xweHphZWtodmNqODYwNjd5YTRldzd3Y3VxdHBnZA==",
+                "index": false
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjAwMDBiYXNldGNybw==",
+                "index": false
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzFwZWpyaGcyc2xweHphZWtodmNqODYwNjd5YTRldzd3Y3VxdHBnZA==",
+                "index": false
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "ZmVl",
+                "value": "MjAwMDBiYXNldGNybw==",
+                "index": false
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "YWNjX3NlcQ==",
+                "value": "dGNybzFwZWpyaGcyc2xweHphZWtodmNqODYwNjd5YTRldzd3Y3VxdHBnZC8x",
+                "index": false
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "c2lnbmF0dXJl",
+                "value": "Zkk0WkJpYURUZFpRcm4rWG9RRXVCWWlDVGE1V2Q2djZZTHIwaGoxVEZlRitLM3ZDMStYdUlKUVVFeU40T25Ib2VtSHc1bGJmNDVBdG1NMUQ2bXpFbEE9PQ==",
+                "index": false
+              }
+            ]
+          }
+        ],
+        "codespace": "sdk"
+      }
+    ],
+    "begin_block_events": [
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coinbase",
+        "attributes": [
+          {
+            "key": "bWludGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "mint",
+        "attributes": [
+          {
+            "key": "Ym9uZGVkX3JhdGlv",
+            "value": "MC4wMDM5ODQwNjMxNzA1OTU3ODc=",
+            "index": true
+          },
+          {
+            "key": "aW5mbGF0aW9u",
+            "value": "MC4xMzAwMDAxNjM3OTgyMjY0MzI=",
+            "index": true
+          },
+          {
+            "key": "YW5udWFsX3Byb3Zpc2lvbnM=",
+            "value": "NjUyNjAwOTE2MzU5LjM5NTI0MzUwMzExNjMxMTU1Mg==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTIzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTIzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTIzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "proposer_reward",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NjE2OS45MDAwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ3c0ZnRtMzh0YW1yaHlwM3J1bjY4ZjIzMndycW1ycGV1ZTk1Z2E=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "commission",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NjE2Ljk5MDAwMDAwMDAwMDAwMDAwMGJhc2V0Y3Jv",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ3c0ZnRtMzh0YW1yaHlwM3J1bjY4ZjIzMndycW1ycGV1ZTk1Z2E=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "rewards",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NjE2OS45MDAwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ3c0ZnRtMzh0YW1yaHlwM3J1bjY4ZjIzMndycW1ycGV1ZTk1Z2E=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "commission",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NTczOC4wMDcwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ3c0ZnRtMzh0YW1yaHlwM3J1bjY4ZjIzMndycW1ycGV1ZTk1Z2E=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "rewards",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NTczODAuMDcwMDAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ3c0ZnRtMzh0YW1yaHlwM3J1bjY4ZjIzMndycW1ycGV1ZTk1Z2E=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "commission",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NTczOC4wMDcwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxcnlnbTAwNXl3NzI4NmtkNW0zazU5N2c4MHZ5NmRjZ2hrajhkMHQ=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "rewards",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NTczODAuMDcwMDAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxcnlnbTAwNXl3NzI4NmtkNW0zazU5N2c4MHZ5NmRjZ2hrajhkMHQ=",
+            "index": true
+          }
+        ]
+      }
+    ],
+    "end_block_events": [],
+    "validator_updates": [],
+    "consensus_param_updates": {
+      "block": {
+        "max_bytes": "22020096",
+        "max_gas": "-1"
+      },
+      "evidence": {
+        "max_age_num_blocks": "100000",
+        "max_age_duration": "172800000000000",
+        "max_bytes": "1048576"
+      },
+      "validator": {
+        "pub_key_types": [
+          "ed25519"
+        ]
+      }
+    }
+  }
+}`
@@ -0,0 +1,2297 @@
+package usecase_parser_test
+
+// Generated by delivering the transactions signed by an eth_secp256k1 key to the in-process
+// Ethermint v0.7.2 application, and encoding its responses as Tendermint v0.34 RPC.
+const TX_MSG_ETHEREUM_TX_CONTRACT_CREATION_BLOCK_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "block_id": {
+      "hash": "947F97BDAC6866DF22CAD44FD99FC6E301FE90B4001C3112BC20AC54E52A8CA4",
+      "parts": {
+        "total": 1,
+        "hash": "FBE1648C300621DFCF41F979D06A09E08F13D1164BA455873C5E54BD0187222C"
+      }
+    },
+    "block": {
+      "header": {
+        "version": {
+          "block": "11"
+        },
+        "chain_id": "cronos_777-1",
+        "height": "2",
+        "time": "2021-11-22T08:00:06Z",
+        "last_block_id": {
+          "hash": "40E88D7E603016E61F436E1B1E15ACB301AF39878ABE5D74D438273C6F8CA697",
+          "parts": {
+            "total": 1,
+            "hash": "359EF56F3624F1A587F4D049EDF9BFB24F34CF6567A590886D3ABF503504BDDA"
+          }
+        },
+        "last_commit_hash": "5F831E7D00FDEA7255FF05C813FC74FF5D977C5A369A5F0FD4812523790BE180",
+        "data_hash": "EFDF7A5B2B01EEC1226E727E26BAA000673E5103FA8CCC8364471883F43520C3",
+        "validators_hash": "CFCAA02297A37C1486020AFC85954E053209049C1E2B47CC42987CC1F6F6E05B",
+        "next_validators_hash": "CFCAA02297A37C1486020AFC85954E053209049C1E2B47CC42987CC1F6F6E05B",
+        "consensus_hash": "048091BC7DDC283F77BFBF91D73C44DA58C3DF8A9CBC867405D8B7F3DAADA22F",
+        "app_hash": "9586002D49AE6680CEDBB412A4BE457D0487FF37B38EEDD5BB2C7C68BA414E09",
+        "last_results_hash": "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855",
+        "evidence_hash": "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855",
+        "proposer_address": "B4AA6AD581B7B68A081332C3DCBEB042192C945C"
+      },
+      "data": {
+        "txs": [
+          "CugCCrQCCh8vZXRoZXJtaW50LmV2bS52MS5Nc2dFdGhlcmV1bVR4EpACCpQBChovZXRoZXJtaW50LmV2bS52MS5MZWdhY3lUeBJ2Ego1MDAwMDAwMDAwGKCNBioBMDIZYA1gDGAAOWANYADzYCpgAFJgAWAgYAChADoCBjVCILjIYANDyAQfUeQGpz65Dd8c7FBqn3TkfAXHpd1IoZVQSiBwaKknSgdP+W5lvpGGFwU1VxkDaFZkelGN5iTcW0qW0xEAAAAAAIBbQBpCMHhhYjQ5NjRkMjQ3YzRlNTg3MmY3NzlmYzE0ZWYwNjIxYTU0YjEyNjQwZWFiYzEwODdmZWZiZjMyZWQzN2EzMzM1IioweDQzNDljNDJFRWNDRDU2MEFFNjNmMmQ1RERCY0YwZDhCNmM3NTQ5YzH6Py4KLC9ldGhlcm1pbnQuZXZtLnYxLkV4dGVuc2lvbk9wdGlvbnNFdGhlcmV1bVR4EiMSIQobCghiYXNldGNybxIPNTAwMDAwMDAwMDAwMDAwEKCNBg=="
+        ]
+      },
+      "evidence": {
+        "evidence": null
+      },
+      "last_commit": {
+        "height": "1",
+        "round": 0,
+        "block_id": {
+          "hash": "40E88D7E603016E61F436E1B1E15ACB301AF39878ABE5D74D438273C6F8CA697",
+          "parts": {
+            "total": 1,
+            "hash": "359EF56F3624F1A587F4D049EDF9BFB24F34CF6567A590886D3ABF503504BDDA"
+          }
+        },
+        "signatures": [
+          {
+            "block_id_flag": 2,
+            "validator_address": "B4AA6AD581B7B68A081332C3DCBEB042192C945C",
+            "timestamp": "2021-11-22T08:00:03Z",
+            "signature": "pavnGefpJJix3LomKloI7g4KPOhYtcjQddedqcDj+JqN6DqpgvttaPkEprdAdn8AXU/14X0cELrXMfy2c/gXCA=="
+          }
+        ]
+      }
+    }
+  }
+}`
+
+const TX_MSG_ETHEREUM_TX_CONTRACT_CREATION_BLOCK_RESULTS_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "height": "2",
+    "txs_results": [
+      {
+        "code": 0,
+        "data": "CnoKHy9ldGhlcm1pbnQuZXZtLnYxLk1zZ0V0aGVyZXVtVHgSVwpCMHhhYjQ5NjRkMjQ3YzRlNTg3MmY3NzlmYzE0ZWYwNjIxYTU0YjEyNjQwZWFiYzEwODdmZWZiZjMyZWQzN2EzMzM1Gg1gKmAAUmABYCBgAKEAKJy1Aw==",
+        "log": "[{\"events\":[{\"type\":\"coin_received\",\"attributes\":[{\"key\":\"receiver\",\"value\":\"tcro1gdyugthve4tq4e3l94wahncd3dk82jwpv2l5lt\"},{\"key\":\"amount\",\"value\":\"220180000000000basetcro\"}]},{\"type\":\"coin_spent\",\"attributes\":[{\"key\":\"spender\",\"value\":\"tcro17xpfvakm2amg962yls6f84z3kell8c5lxhzaha\"},{\"key\":\"amount\",\"value\":\"220180000000000basetcro\"}]},{\"type\":\"ethereum_tx\",\"attributes\":[{\"key\":\"amount\",\"value\":\"0\"},{\"key\":\"ethereumTxHash\",\"value\":\"0xab4964d247c4e5872f779fc14ef0621a54b12640eabc1087fefbf32ed37a3335\"},{\"key\":\"txHash\",\"value\":\"469C97CA74FE9D6EA1D9586BE6BAC4B8C5AEBC9A063CB362AA1644D151A23BB1\"}]},{\"type\":\"message\",\"attributes\":[{\"key\":\"action\",\"value\":\"ethereum_tx\"},{\"key\":\"sender\",\"value\":\"tcro17xpfvakm2amg962yls6f84z3kell8c5lxhzaha\"},{\"key\":\"module\",\"value\":\"evm\"},{\"key\":\"sender\",\"value\":\"0x4349c42EEcCD560AE63f2d5DDBcF0d8B6c7549c1\"},{\"key\":\"txType\",\"value\":\"0\"}]},{\"type\":\"transfer\",\"attributes\":[{\"key\":\"recipient\",\"value\":\"tcro1gdyugthve4tq4e3l94wahncd3dk82jwpv2l5lt\"},{\"key\":\"sender\",\"value\":\"tcro17xpfvakm2amg962yls6f84z3kell8c5lxhzaha\"},{\"key\":\"amount\",\"value\":\"220180000000000basetcro\"}]},{\"type\":\"tx_log\",\"attributes\":null}]}]",
+        "info": "",
+        "gas_wanted": "0",
+        "gas_used": "55964",
+        "events": [
+          {
+            "type": "coin_spent",
+            "attributes": [
+              {
+                "key": "c3BlbmRlcg==",
+                "value": "dGNybzFnZHl1Z3RodmU0dHE0ZTNsOTR3YWhuY2QzZGs4Mmp3cHYybDVsdA==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "NTAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_received",
+            "attributes": [
+              {
+                "key": "cmVjZWl2ZXI=",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "NTAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "transfer",
+            "attributes": [
+              {
+                "key": "cmVjaXBpZW50",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": true
+              },
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzFnZHl1Z3RodmU0dHE0ZTNsOTR3YWhuY2QzZGs4Mmp3cHYybDVsdA==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "NTAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzFnZHl1Z3RodmU0dHE0ZTNsOTR3YWhuY2QzZGs4Mmp3cHYybDVsdA==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "ZmVl",
+                "value": "NTAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "YWN0aW9u",
+                "value": "ZXRoZXJldW1fdHg=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_spent",
+            "attributes": [
+              {
+                "key": "c3BlbmRlcg==",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjIwMTgwMDAwMDAwMDAwYmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_received",
+            "attributes": [
+              {
+                "key": "cmVjZWl2ZXI=",
+                "value": "dGNybzFnZHl1Z3RodmU0dHE0ZTNsOTR3YWhuY2QzZGs4Mmp3cHYybDVsdA==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjIwMTgwMDAwMDAwMDAwYmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "transfer",
+            "attributes": [
+              {
+                "key": "cmVjaXBpZW50",
+                "value": "dGNybzFnZHl1Z3RodmU0dHE0ZTNsOTR3YWhuY2QzZGs4Mmp3cHYybDVsdA==",
+                "index": true
+              },
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjIwMTgwMDAwMDAwMDAwYmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "ethereum_tx",
+            "attributes": [
+              {
+                "key": "YW1vdW50",
+                "value": "MA==",
+                "index": true
+              },
+              {
+                "key": "ZXRoZXJldW1UeEhhc2g=",
+                "value": "MHhhYjQ5NjRkMjQ3YzRlNTg3MmY3NzlmYzE0ZWYwNjIxYTU0YjEyNjQwZWFiYzEwODdmZWZiZjMyZWQzN2EzMzM1",
+                "index": true
+              },
+              {
+                "key": "dHhIYXNo",
+                "value": "NDY5Qzk3Q0E3NEZFOUQ2RUExRDk1ODZCRTZCQUM0QjhDNUFFQkM5QTA2M0NCMzYyQUExNjQ0RDE1MUEyM0JCMQ==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx_log",
+            "attributes": []
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "bW9kdWxl",
+                "value": "ZXZt",
+                "index": true
+              },
+              {
+                "key": "c2VuZGVy",
+                "value": "MHg0MzQ5YzQyRUVjQ0Q1NjBBRTYzZjJkNUREQmNGMGQ4QjZjNzU0OWMx",
+                "index": true
+              },
+              {
+                "key": "dHhUeXBl",
+                "value": "MA==",
+                "index": true
+              }
+            ]
+          }
+        ],
+        "codespace": ""
+      }
+    ],
+    "begin_block_events": [
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "NDExOTQ1MjgyNjZiYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coinbase",
+        "attributes": [
+          {
+            "key": "bWludGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "NDExOTQ1MjgyNjZiYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "NDExOTQ1MjgyNjZiYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "NDExOTQ1MjgyNjZiYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "NDExOTQ1MjgyNjZiYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "mint",
+        "attributes": [
+          {
+            "key": "Ym9uZGVkX3JhdGlv",
+            "value": "MC4wMDAwMDAwMDQ5OTk5OTk4NzI=",
+            "index": true
+          },
+          {
+            "key": "aW5mbGF0aW9u",
+            "value": "MC4xMzAwMDAwNDExOTQ1MTM4NTA=",
+            "index": true
+          },
+          {
+            "key": "YW5udWFsX3Byb3Zpc2lvbnM=",
+            "value": "MjYwMDAwMDg5MDQ0MzE3NTI0Ljc2MzM5OTg4ODQxMzg0MDM1MA==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "NDExOTQ1MjgyNjY=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "ODIzODkwNDkxNTdiYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "ODIzODkwNDkxNTdiYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "ODIzODkwNDkxNTdiYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "proposer_reward",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NDExOTQ1MjQ1Ny44NTAwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ2M1NWN6aGFsdmtxZ3V5cmEzczc4eTR6ZWx1OWZ2Zzc0bXlzcDI=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "commission",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NDExOTQ1MjQ1Ljc4NTAwMDAwMDAwMDAwMDAwMGJhc2V0Y3Jv",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ2M1NWN6aGFsdmtxZ3V5cmEzczc4eTR6ZWx1OWZ2Zzc0bXlzcDI=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "rewards",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NDExOTQ1MjQ1Ny44NTAwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ2M1NWN6aGFsdmtxZ3V5cmEzczc4eTR6ZWx1OWZ2Zzc0bXlzcDI=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "commission",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NzY2MjE4MTU3MS42MDEwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ2M1NWN6aGFsdmtxZ3V5cmEzczc4eTR6ZWx1OWZ2Zzc0bXlzcDI=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "rewards",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NzY2MjE4MTU3MTYuMDEwMDAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ2M1NWN6aGFsdmtxZ3V5cmEzczc4eTR6ZWx1OWZ2Zzc0bXlzcDI=",
+            "index": true
+          }
+        ]
+      }
+    ],
+    "end_block_events": [
+      {
+        "type": "block_bloom",
+        "attributes": [
+          {
+            "key": "Ymxvb20=",
+            "value": "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA==",
+            "index": true
+          }
+        ]
+      }
+    ],
+    "validator_updates": [],
+    "consensus_param_updates": {
+      "block": {
+        "max_bytes": "22020096",
+        "max_gas": "-1"
+      },
+      "evidence": {
+        "max_age_num_blocks": "100000",
+        "max_age_duration": "172800000000000",
+        "max_bytes": "1048576"
+      },
+      "validator": {
+        "pub_key_types": [
+          "ed25519"
+        ]
+      }
+    }
+  }
+}`
+
+const TX_MSG_ETHEREUM_TX_CONTRACT_CALL_BLOCK_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "block_id": {
+      "hash": "293BEB475EA26E7DC53A327085095AAA703E36ECECD66D4ADE08AE44C1968795",
+      "parts": {
+        "total": 1,
+        "hash": "CC5919ECF99552FED4EB51147954120AA2F0321063C6BAE8F4866518D97BEC14"
+      }
+    },
+    "block": {
+      "header": {
+        "version": {
+          "block": "11"
+        },
+        "chain_id": "cronos_777-1",
+        "height": "3",
+        "time": "2021-11-22T08:00:12Z",
+        "last_block_id": {
+          "hash": "947F97BDAC6866DF22CAD44FD99FC6E301FE90B4001C3112BC20AC54E52A8CA4",
+          "parts": {
+            "total": 1,
+            "hash": "FBE1648C300621DFCF41F979D06A09E08F13D1164BA455873C5E54BD0187222C"
+          }
+        },
+        "last_commit_hash": "8811667ED7802E58E28A605DE1295776B159F91513AAA056AEA9F06C3554747D",
+        "data_hash": "7A35144FD3FEF4A3C2CAEA5BECEACAFEDF9806B4B8E7F5E2884492C704B29270",
+        "validators_hash": "CFCAA02297A37C1486020AFC85954E053209049C1E2B47CC42987CC1F6F6E05B",
+        "next_validators_hash": "CFCAA02297A37C1486020AFC85954E053209049C1E2B47CC42987CC1F6F6E05B",
+        "consensus_hash": "048091BC7DDC283F77BFBF91D73C44DA58C3DF8A9CBC867405D8B7F3DAADA22F",
+        "app_hash": "A4647CD52B002ABC4C22B8F77420792FCCE9C179DD196045B8D8BDE7A48C1BC2",
+        "last_results_hash": "2C5EAEB4B9826E323A9B0BC773D8BDD952A19B727EC8345FDF77BC15F811842E",
+        "evidence_hash": "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855",
+        "proposer_address": "B4AA6AD581B7B68A081332C3DCBEB042192C945C"
+      },
+      "data": {
+        "txs": [
+          "CvwDCsgDCh8vZXRoZXJtaW50LmV2bS52MS5Nc2dFdGhlcmV1bVR4EqQDCqgCCh4vZXRoZXJtaW50LmV2bS52MS5BY2Nlc3NMaXN0VHgShQIKAzc3NxABGgo1MDAwMDAwMDAwIODUAyoqMHhCMmQyZGY4RUM0OGM2REIwQWQyMzBCNjQ4NkVFMjZERGFmMWRFYWYwMgQxMDAwOgSpBZy7QnAKKjB4QjJkMmRmOEVDNDhjNkRCMEFkMjMwQjY0ODZFRTI2RERhZjFkRWFmMBJCMHgwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAxUiCKaa+n61+O9DMPovbFePn3mgNlT/kv+z8cPQan8mPXYVogL9EhjI8U0tuOXVK+ypQwqyTLTW6t5EjQHY39eKqoqsERAAAAAAAgZUAaQjB4OGJkMTFlZWNlMDJlM2YxOGFiODJiNmRlMWNkMTQ0MzUyMzc5YTI4ZDM3OGNkMDJlNTNhZTg3NGU0Mzk5NmIyOSIqMHg0MzQ5YzQyRUVjQ0Q1NjBBRTYzZjJkNUREQmNGMGQ4QjZjNzU0OWMx+j8uCiwvZXRoZXJtaW50LmV2bS52MS5FeHRlbnNpb25PcHRpb25zRXRoZXJldW1UeBIjEiEKGwoIYmFzZXRjcm8SDzMwMDAwMDAwMDAwMDAwMBDg1AM="
+        ]
+      },
+      "evidence": {
+        "evidence": null
+      },
+      "last_commit": {
+        "height": "2",
+        "round": 0,
+        "block_id": {
+          "hash": "947F97BDAC6866DF22CAD44FD99FC6E301FE90B4001C3112BC20AC54E52A8CA4",
+          "parts": {
+            "total": 1,
+            "hash": "FBE1648C300621DFCF41F979D06A09E08F13D1164BA455873C5E54BD0187222C"
+          }
+        },
+        "signatures": [
+          {
+            "block_id_flag": 2,
+            "validator_address": "B4AA6AD581B7B68A081332C3DCBEB042192C945C",
+            "timestamp": "2021-11-22T08:00:09Z",
+            "signature": "LJEmEqrhwk6lXC05mfBXyq8BFI62LTVbF+U88rVcTc/3hbggvyUgX75tBgsZcc+6fdTJCnjJ6P6yaDAZJMRHAA=="
+          }
+        ]
+      }
+    }
+  }
+}`
+
+const TX_MSG_ETHEREUM_TX_CONTRACT_CALL_BLOCK_RESULTS_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "height": "3",
+    "txs_results": [
+      {
+        "code": 0,
+        "data": "CosDCh8vZXRoZXJtaW50LmV2bS52MS5Nc2dFdGhlcmV1bVR4EucCCkIweDhiZDExZWVjZTAyZTNmMThhYjgyYjZkZTFjZDE0NDM1MjM3OWEyOGQzNzhjZDAyZTUzYWU4NzRlNDM5OTZiMjkSnAIKKjB4QjJkMmRmOEVDNDhjNkRCMEFkMjMwQjY0ODZFRTI2RERhZjFkRWFmMBJCMHgwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAxGiAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAKiADKkIweDhiZDExZWVjZTAyZTNmMThhYjgyYjZkZTFjZDE0NDM1MjM3OWEyOGQzNzhjZDAyZTUzYWU4NzRlNDM5OTZiMjk6QjB4MjkzYmViNDc1ZWEyNmU3ZGM1M2EzMjcwODUwOTVhYWE3MDNlMzZlY2VjZDY2ZDRhZGUwOGFlNDRjMTk2ODc5NSiXzgE=",
+        "log": "[{\"events\":[{\"type\":\"burn\",\"attributes\":[{\"key\":\"burner\",\"value\":\"tcro1vqu8rska6swzdmnhf90zuv0xmelej4lqmuyfgg\"},{\"key\":\"amount\",\"value\":\"1000basetcro\"}]},{\"type\":\"coin_received\",\"attributes\":[{\"key\":\"receiver\",\"value\":\"tcro1vqu8rska6swzdmnhf90zuv0xmelej4lqmuyfgg\"},{\"key\":\"amount\",\"value\":\"1000basetcro\"},{\"key\":\"receiver\",\"value\":\"tcro1vqu8rska6swzdmnhf90zuv0xmelej4lqmuyfgg\"},{\"key\":\"amount\",\"value\":\"1000basetcro\"},{\"key\":\"receiver\",\"value\":\"tcro1ktfdlrky33kmptfrpdjgdm3xmkh3m6hs4eyjpr\"},{\"key\":\"amount\",\"value\":\"1000basetcro\"},{\"key\":\"receiver\",\"value\":\"tcro1gdyugthve4tq4e3l94wahncd3dk82jwpv2l5lt\"},{\"key\":\"amount\",\"value\":\"168045000000000basetcro\"}]},{\"type\":\"coin_spent\",\"attributes\":[{\"key\":\"spender\",\"value\":\"tcro1gdyugthve4tq4e3l94wahncd3dk82jwpv2l5lt\"},{\"key\":\"amount\",\"value\":\"1000basetcro\"},{\"key\":\"spender\",\"value\":\"tcro1vqu8rska6swzdmnhf90zuv0xmelej4lqmuyfgg\"},{\"key\":\"amount\",\"value\":\"1000basetcro\"},{\"key\":\"spender\",\"value\":\"tcro1vqu8rska6swzdmnhf90zuv0xmelej4lqmuyfgg\"},{\"key\":\"amount\",\"value\":\"1000basetcro\"},{\"key\":\"spender\",\"value\":\"tcro17xpfvakm2amg962yls6f84z3kell8c5lxhzaha\"},{\"key\":\"amount\",\"value\":\"168045000000000basetcro\"}]},{\"type\":\"coinbase\",\"attributes\":[{\"key\":\"minter\",\"value\":\"tcro1vqu8rska6swzdmnhf90zuv0xmelej4lqmuyfgg\"},{\"key\":\"amount\",\"value\":\"1000basetcro\"}]},{\"type\":\"ethereum_tx\",\"attributes\":[{\"key\":\"amount\",\"value\":\"1000\"},{\"key\":\"ethereumTxHash\",\"value\":\"0x8bd11eece02e3f18ab82b6de1cd144352379a28d378cd02e53ae874e43996b29\"},{\"key\":\"txHash\",\"value\":\"8ED77F69C213CE8934665AEADBE138AEA3B2FD491C000B783AA354C58D17B184\"},{\"key\":\"recipient\",\"value\":\"0xB2d2df8EC48c6DB0Ad230B6486EE26DDaf1dEaf0\"}]},{\"type\":\"message\",\"attributes\":[{\"key\":\"action\",\"value\":\"ethereum_tx\"},{\"key\":\"sender\",\"value\":\"tcro1gdyugthve4tq4e3l94wahncd3dk82jwpv2l5lt\"},{\"key\":\"sender\",\"value\":\"tcro1vqu8rska6swzdmnhf90zuv0xmelej4lqmuyfgg\"},{\"key\":\"sender\",\"value\":\"tcro17xpfvakm2amg962yls6f84z3kell8c5lxhzaha\"},{\"key\":\"module\",\"value\":\"evm\"},{\"key\":\"sender\",\"value\":\"0x4349c42EEcCD560AE63f2d5DDBcF0d8B6c7549c1\"},{\"key\":\"txType\",\"value\":\"1\"}]},{\"type\":\"transfer\",\"attributes\":[{\"key\":\"recipient\",\"value\":\"tcro1vqu8rska6swzdmnhf90zuv0xmelej4lqmuyfgg\"},{\"key\":\"sender\",\"value\":\"tcro1gdyugthve4tq4e3l94wahncd3dk82jwpv2l5lt\"},{\"key\":\"amount\",\"value\":\"1000basetcro\"},{\"key\":\"recipient\",\"value\":\"tcro1ktfdlrky33kmptfrpdjgdm3xmkh3m6hs4eyjpr\"},{\"key\":\"sender\",\"value\":\"tcro1vqu8rska6swzdmnhf90zuv0xmelej4lqmuyfgg\"},{\"key\":\"amount\",\"value\":\"1000basetcro\"},{\"key\":\"recipient\",\"value\":\"tcro1gdyugthve4tq4e3l94wahncd3dk82jwpv2l5lt\"},{\"key\":\"sender\",\"value\":\"tcro17xpfvakm2amg962yls6f84z3kell8c5lxhzaha\"},{\"key\":\"amount\",\"value\":\"168045000000000basetcro\"}]},{\"type\":\"tx_log\",\"attributes\":[{\"key\":\"txLog\",\"value\":\"{\\\"address\\\":\\\"0xB2d2df8EC48c6DB0Ad230B6486EE26DDaf1dEaf0\\\",\\\"topics\\\":[\\\"0x0000000000000000000000000000000000000000000000000000000000000001\\\"],\\\"data\\\":\\\"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACo=\\\",\\\"blockNumber\\\":3,\\\"transactionHash\\\":\\\"0x8bd11eece02e3f18ab82b6de1cd144352379a28d378cd02e53ae874e43996b29\\\",\\\"transactionIndex\\\":0,\\\"blockHash\\\":\\\"0x293beb475ea26e7dc53a327085095aaa703e36ececd66d4ade08ae44c1968795\\\",\\\"logIndex\\\":0}\"}]}]}]",
+        "info": "",
+        "gas_wanted": "0",
+        "gas_used": "26391",
+        "events": [
+          {
+            "type": "coin_spent",
+            "attributes": [
+              {
+                "key": "c3BlbmRlcg==",
+                "value": "dGNybzFnZHl1Z3RodmU0dHE0ZTNsOTR3YWhuY2QzZGs4Mmp3cHYybDVsdA==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MzAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_received",
+            "attributes": [
+              {
+                "key": "cmVjZWl2ZXI=",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MzAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "transfer",
+            "attributes": [
+              {
+                "key": "cmVjaXBpZW50",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": true
+              },
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzFnZHl1Z3RodmU0dHE0ZTNsOTR3YWhuY2QzZGs4Mmp3cHYybDVsdA==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MzAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzFnZHl1Z3RodmU0dHE0ZTNsOTR3YWhuY2QzZGs4Mmp3cHYybDVsdA==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "ZmVl",
+                "value": "MzAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "YWN0aW9u",
+                "value": "ZXRoZXJldW1fdHg=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_spent",
+            "attributes": [
+              {
+                "key": "c3BlbmRlcg==",
+                "value": "dGNybzFnZHl1Z3RodmU0dHE0ZTNsOTR3YWhuY2QzZGs4Mmp3cHYybDVsdA==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MTAwMGJhc2V0Y3Jv",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_received",
+            "attributes": [
+              {
+                "key": "cmVjZWl2ZXI=",
+                "value": "dGNybzF2cXU4cnNrYTZzd3pkbW5oZjkwenV2MHhtZWxlajRscW11eWZnZw==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MTAwMGJhc2V0Y3Jv",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "transfer",
+            "attributes": [
+              {
+                "key": "cmVjaXBpZW50",
+                "value": "dGNybzF2cXU4cnNrYTZzd3pkbW5oZjkwenV2MHhtZWxlajRscW11eWZnZw==",
+                "index": true
+              },
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzFnZHl1Z3RodmU0dHE0ZTNsOTR3YWhuY2QzZGs4Mmp3cHYybDVsdA==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MTAwMGJhc2V0Y3Jv",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzFnZHl1Z3RodmU0dHE0ZTNsOTR3YWhuY2QzZGs4Mmp3cHYybDVsdA==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_spent",
+            "attributes": [
+              {
+                "key": "c3BlbmRlcg==",
+                "value": "dGNybzF2cXU4cnNrYTZzd3pkbW5oZjkwenV2MHhtZWxlajRscW11eWZnZw==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MTAwMGJhc2V0Y3Jv",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "burn",
+            "attributes": [
+              {
+                "key": "YnVybmVy",
+                "value": "dGNybzF2cXU4cnNrYTZzd3pkbW5oZjkwenV2MHhtZWxlajRscW11eWZnZw==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MTAwMGJhc2V0Y3Jv",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_received",
+            "attributes": [
+              {
+                "key": "cmVjZWl2ZXI=",
+                "value": "dGNybzF2cXU4cnNrYTZzd3pkbW5oZjkwenV2MHhtZWxlajRscW11eWZnZw==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MTAwMGJhc2V0Y3Jv",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coinbase",
+            "attributes": [
+              {
+                "key": "bWludGVy",
+                "value": "dGNybzF2cXU4cnNrYTZzd3pkbW5oZjkwenV2MHhtZWxlajRscW11eWZnZw==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MTAwMGJhc2V0Y3Jv",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_spent",
+            "attributes": [
+              {
+                "key": "c3BlbmRlcg==",
+                "value": "dGNybzF2cXU4cnNrYTZzd3pkbW5oZjkwenV2MHhtZWxlajRscW11eWZnZw==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MTAwMGJhc2V0Y3Jv",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_received",
+            "attributes": [
+              {
+                "key": "cmVjZWl2ZXI=",
+                "value": "dGNybzFrdGZkbHJreTMza21wdGZycGRqZ2RtM3hta2gzbTZoczRleWpwcg==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MTAwMGJhc2V0Y3Jv",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "transfer",
+            "attributes": [
+              {
+                "key": "cmVjaXBpZW50",
+                "value": "dGNybzFrdGZkbHJreTMza21wdGZycGRqZ2RtM3hta2gzbTZoczRleWpwcg==",
+                "index": true
+              },
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzF2cXU4cnNrYTZzd3pkbW5oZjkwenV2MHhtZWxlajRscW11eWZnZw==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MTAwMGJhc2V0Y3Jv",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzF2cXU4cnNrYTZzd3pkbW5oZjkwenV2MHhtZWxlajRscW11eWZnZw==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_spent",
+            "attributes": [
+              {
+                "key": "c3BlbmRlcg==",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MTY4MDQ1MDAwMDAwMDAwYmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_received",
+            "attributes": [
+              {
+                "key": "cmVjZWl2ZXI=",
+                "value": "dGNybzFnZHl1Z3RodmU0dHE0ZTNsOTR3YWhuY2QzZGs4Mmp3cHYybDVsdA==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MTY4MDQ1MDAwMDAwMDAwYmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "transfer",
+            "attributes": [
+              {
+                "key": "cmVjaXBpZW50",
+                "value": "dGNybzFnZHl1Z3RodmU0dHE0ZTNsOTR3YWhuY2QzZGs4Mmp3cHYybDVsdA==",
+                "index": true
+              },
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MTY4MDQ1MDAwMDAwMDAwYmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "ethereum_tx",
+            "attributes": [
+              {
+                "key": "YW1vdW50",
+                "value": "MTAwMA==",
+                "index": true
+              },
+              {
+                "key": "ZXRoZXJldW1UeEhhc2g=",
+                "value": "MHg4YmQxMWVlY2UwMmUzZjE4YWI4MmI2ZGUxY2QxNDQzNTIzNzlhMjhkMzc4Y2QwMmU1M2FlODc0ZTQzOTk2YjI5",
+                "index": true
+              },
+              {
+                "key": "dHhIYXNo",
+                "value": "OEVENzdGNjlDMjEzQ0U4OTM0NjY1QUVBREJFMTM4QUVBM0IyRkQ0OTFDMDAwQjc4M0FBMzU0QzU4RDE3QjE4NA==",
+                "index": true
+              },
+              {
+                "key": "cmVjaXBpZW50",
+                "value": "MHhCMmQyZGY4RUM0OGM2REIwQWQyMzBCNjQ4NkVFMjZERGFmMWRFYWYw",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx_log",
+            "attributes": [
+              {
+                "key": "dHhMb2c=",
+                "value": "eyJhZGRyZXNzIjoiMHhCMmQyZGY4RUM0OGM2REIwQWQyMzBCNjQ4NkVFMjZERGFmMWRFYWYwIiwidG9waWNzIjpbIjB4MDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMSJdLCJkYXRhIjoiQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFDbz0iLCJibG9ja051bWJlciI6MywidHJhbnNhY3Rpb25IYXNoIjoiMHg4YmQxMWVlY2UwMmUzZjE4YWI4MmI2ZGUxY2QxNDQzNTIzNzlhMjhkMzc4Y2QwMmU1M2FlODc0ZTQzOTk2YjI5IiwidHJhbnNhY3Rpb25JbmRleCI6MCwiYmxvY2tIYXNoIjoiMHgyOTNiZWI0NzVlYTI2ZTdkYzUzYTMyNzA4NTA5NWFhYTcwM2UzNmVjZWNkNjZkNGFkZTA4YWU0NGMxOTY4Nzk1IiwibG9nSW5kZXgiOjB9",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "bW9kdWxl",
+                "value": "ZXZt",
+                "index": true
+              },
+              {
+                "key": "c2VuZGVy",
+                "value": "MHg0MzQ5YzQyRUVjQ0Q1NjBBRTYzZjJkNUREQmNGMGQ4QjZjNzU0OWMx",
+                "index": true
+              },
+              {
+                "key": "dHhUeXBl",
+                "value": "MQ==",
+                "index": true
+              }
+            ]
+          }
+        ],
+        "codespace": ""
+      }
+    ],
+    "begin_block_events": [
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "NDExOTQ1MzU2NDFiYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coinbase",
+        "attributes": [
+          {
+            "key": "bWludGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "NDExOTQ1MzU2NDFiYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "NDExOTQ1MzU2NDFiYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "NDExOTQ1MzU2NDFiYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "NDExOTQ1MzU2NDFiYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "mint",
+        "attributes": [
+          {
+            "key": "Ym9uZGVkX3JhdGlv",
+            "value": "MC4wMDAwMDAwMDQ5OTk5OTk3Njk=",
+            "index": true
+          },
+          {
+            "key": "aW5mbGF0aW9u",
+            "value": "MC4xMzAwMDAwNjE3OTE3NzA3NzU=",
+            "index": true
+          },
+          {
+            "key": "YW5udWFsX3Byb3Zpc2lvbnM=",
+            "value": "MjYwMDAwMTM1NTk0MTIzNjQ5LjI5Mjk0NzYyOTU1MDk4NjY3NQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "NDExOTQ1MzU2NDE=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "Mjc5ODYxMTk0NTM1NjQxYmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "Mjc5ODYxMTk0NTM1NjQxYmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "Mjc5ODYxMTk0NTM1NjQxYmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "proposer_reward",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "MTM5OTMwNTk3MjY3ODIuMDUwMDAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ2M1NWN6aGFsdmtxZ3V5cmEzczc4eTR6ZWx1OWZ2Zzc0bXlzcDI=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "commission",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "MTM5OTMwNTk3MjY3OC4yMDUwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ2M1NWN6aGFsdmtxZ3V5cmEzczc4eTR6ZWx1OWZ2Zzc0bXlzcDI=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "rewards",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "MTM5OTMwNTk3MjY3ODIuMDUwMDAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ2M1NWN6aGFsdmtxZ3V5cmEzczc4eTR6ZWx1OWZ2Zzc0bXlzcDI=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "commission",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "MjYwMjcwOTEwOTE4MTQuNjEzMDAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ2M1NWN6aGFsdmtxZ3V5cmEzczc4eTR6ZWx1OWZ2Zzc0bXlzcDI=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "rewards",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "MjYwMjcwOTEwOTE4MTQ2LjEzMDAwMDAwMDAwMDAwMDAwMGJhc2V0Y3Jv",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ2M1NWN6aGFsdmtxZ3V5cmEzczc4eTR6ZWx1OWZ2Zzc0bXlzcDI=",
+            "index": true
+          }
+        ]
+      }
+    ],
+    "end_block_events": [
+      {
+        "type": "block_bloom",
+        "attributes": [
+          {
+            "key": "Ymxvb20=",
+            "value": "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAIAAAAAAAAAAAAAAAAAAAAQAAAAAAAAAAAAAAAAAgAAAAQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA==",
+            "index": true
+          }
+        ]
+      }
+    ],
+    "validator_updates": [],
+    "consensus_param_updates": {
+      "block": {
+        "max_bytes": "22020096",
+        "max_gas": "-1"
+      },
+      "evidence": {
+        "max_age_num_blocks": "100000",
+        "max_age_duration": "172800000000000",
+        "max_bytes": "1048576"
+      },
+      "validator": {
+        "pub_key_types": [
+          "ed25519"
+        ]
+      }
+    }
+  }
+}`
+
+const TX_MSG_ETHEREUM_TX_REVERTED_BLOCK_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "block_id": {
+      "hash": "9678E9D044D669375AF82C7D91C2F4474DDAB62F8277999278015431B1606F5D",
+      "parts": {
+        "total": 1,
+        "hash": "0FCAC9D54B17464D05792EB6BDAD34CA748270FB58A88018C26E34455F3F31EF"
+      }
+    },
+    "block": {
+      "header": {
+        "version": {
+          "block": "11"
+        },
+        "chain_id": "cronos_777-1",
+        "height": "4",
+        "time": "2021-11-22T08:00:18Z",
+        "last_block_id": {
+          "hash": "293BEB475EA26E7DC53A327085095AAA703E36ECECD66D4ADE08AE44C1968795",
+          "parts": {
+            "total": 1,
+            "hash": "CC5919ECF99552FED4EB51147954120AA2F0321063C6BAE8F4866518D97BEC14"
+          }
+        },
+        "last_commit_hash": "C0F19B10595DCE58147AC971783027F1990AB34968F9814B7330A93BD813E5D9",
+        "data_hash": "C55FD4B83B1D85FA4A92E7D7609F79707708713756D80AF562D3B00A1194D591",
+        "validators_hash": "CFCAA02297A37C1486020AFC85954E053209049C1E2B47CC42987CC1F6F6E05B",
+        "next_validators_hash": "CFCAA02297A37C1486020AFC85954E053209049C1E2B47CC42987CC1F6F6E05B",
+        "consensus_hash": "048091BC7DDC283F77BFBF91D73C44DA58C3DF8A9CBC867405D8B7F3DAADA22F",
+        "app_hash": "E1D169BA5C8A081C24619721682EB8751A6F33C450B263FFFD3F7B067D4D0502",
+        "last_results_hash": "671E0CE4502DCC33B58908D631B070E53D535AA31DE2E9C431AED92489EC64CB",
+        "evidence_hash": "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855",
+        "proposer_address": "B4AA6AD581B7B68A081332C3DCBEB042192C945C"
+      },
+      "data": {
+        "txs": [
+          "CtYCCqICCh8vZXRoZXJtaW50LmV2bS52MS5Nc2dFdGhlcmV1bVR4Ev4BCoIBChovZXRoZXJtaW50LmV2bS52MS5MZWdhY3lUeBJkCAISCjUwMDAwMDAwMDAYoI0GKgEwMgVgAGAA/ToCBjVCIJ1xgUr+Y688K8l+NXOLR6cT/ohmad3XCXH4WLKXEFjBSiB/y8NkQh4wDsWrW9uZ4k5wJcK+tIq08uOcTY/kaZgUAREAAAAAAIBWQBpCMHgxZmQ2Yzg0OGQ0NDhhM2FhNDIxODRiNWEwYjc4ZGIzNWY1MTk4Zjc4MzhkZWQ3ZWM0MWYxOTA5ZjZlMThiYWE3IioweDQzNDljNDJFRWNDRDU2MEFFNjNmMmQ1RERCY0YwZDhCNmM3NTQ5YzH6Py4KLC9ldGhlcm1pbnQuZXZtLnYxLkV4dGVuc2lvbk9wdGlvbnNFdGhlcmV1bVR4EiMSIQobCghiYXNldGNybxIPNTAwMDAwMDAwMDAwMDAwEKCNBg=="
+        ]
+      },
+      "evidence": {
+        "evidence": null
+      },
+      "last_commit": {
+        "height": "3",
+        "round": 0,
+        "block_id": {
+          "hash": "293BEB475EA26E7DC53A327085095AAA703E36ECECD66D4ADE08AE44C1968795",
+          "parts": {
+            "total": 1,
+            "hash": "CC5919ECF99552FED4EB51147954120AA2F0321063C6BAE8F4866518D97BEC14"
+          }
+        },
+        "signatures": [
+          {
+            "block_id_flag": 2,
+            "validator_address": "B4AA6AD581B7B68A081332C3DCBEB042192C945C",
+            "timestamp": "2021-11-22T08:00:15Z",
+            "signature": "XasmszK7codB7yQPnSostlI686hglT5qvmMiYZ9GMuobTqk1Mdu9HW6ict53D54Ll53xpIsanl5HImPlzbMUBA=="
+          }
+        ]
+      }
+    }
+  }
+}`
+
+const TX_MSG_ETHEREUM_TX_REVERTED_BLOCK_RESULTS_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "height": "4",
+    "txs_results": [
+      {
+        "code": 0,
+        "data": "Cn8KHy9ldGhlcm1pbnQuZXZtLnYxLk1zZ0V0aGVyZXVtVHgSXApCMHgxZmQ2Yzg0OGQ0NDhhM2FhNDIxODRiNWEwYjc4ZGIzNWY1MTk4Zjc4MzhkZWQ3ZWM0MWYxOTA5ZjZlMThiYWE3IhJleGVjdXRpb24gcmV2ZXJ0ZWQoxp4D",
+        "log": "[{\"events\":[{\"type\":\"coin_received\",\"attributes\":[{\"key\":\"receiver\",\"value\":\"tcro1gdyugthve4tq4e3l94wahncd3dk82jwpv2l5lt\"},{\"key\":\"amount\",\"value\":\"234690000000000basetcro\"}]},{\"type\":\"coin_spent\",\"attributes\":[{\"key\":\"spender\",\"value\":\"tcro17xpfvakm2amg962yls6f84z3kell8c5lxhzaha\"},{\"key\":\"amount\",\"value\":\"234690000000000basetcro\"}]},{\"type\":\"ethereum_tx\",\"attributes\":[{\"key\":\"amount\",\"value\":\"0\"},{\"key\":\"ethereumTxHash\",\"value\":\"0x1fd6c848d448a3aa42184b5a0b78db35f5198f7838ded7ec41f1909f6e18baa7\"},{\"key\":\"txHash\",\"value\":\"E8134B33255ED2170DAA809B818F73D3C7BB390B66BAD72A7768C52863C1CCB3\"},{\"key\":\"ethereumTxFailed\",\"value\":\"execution reverted\"}]},{\"type\":\"message\",\"attributes\":[{\"key\":\"action\",\"value\":\"ethereum_tx\"},{\"key\":\"sender\",\"value\":\"tcro17xpfvakm2amg962yls6f84z3kell8c5lxhzaha\"},{\"key\":\"module\",\"value\":\"evm\"},{\"key\":\"sender\",\"value\":\"0x4349c42EEcCD560AE63f2d5DDBcF0d8B6c7549c1\"},{\"key\":\"txType\",\"value\":\"0\"}]},{\"type\":\"transfer\",\"attributes\":[{\"key\":\"recipient\",\"value\":\"tcro1gdyugthve4tq4e3l94wahncd3dk82jwpv2l5lt\"},{\"key\":\"sender\",\"value\":\"tcro17xpfvakm2amg962yls6f84z3kell8c5lxhzaha\"},{\"key\":\"amount\",\"value\":\"234690000000000basetcro\"}]},{\"type\":\"tx_log\",\"attributes\":null}]}]",
+        "info": "",
+        "gas_wanted": "0",
+        "gas_used": "53062",
+        "events": [
+          {
+            "type": "coin_spent",
+            "attributes": [
+              {
+                "key": "c3BlbmRlcg==",
+                "value": "dGNybzFnZHl1Z3RodmU0dHE0ZTNsOTR3YWhuY2QzZGs4Mmp3cHYybDVsdA==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "NTAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_received",
+            "attributes": [
+              {
+                "key": "cmVjZWl2ZXI=",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "NTAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "transfer",
+            "attributes": [
+              {
+                "key": "cmVjaXBpZW50",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": true
+              },
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzFnZHl1Z3RodmU0dHE0ZTNsOTR3YWhuY2QzZGs4Mmp3cHYybDVsdA==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "NTAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzFnZHl1Z3RodmU0dHE0ZTNsOTR3YWhuY2QzZGs4Mmp3cHYybDVsdA==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "ZmVl",
+                "value": "NTAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "YWN0aW9u",
+                "value": "ZXRoZXJldW1fdHg=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_spent",
+            "attributes": [
+              {
+                "key": "c3BlbmRlcg==",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjM0NjkwMDAwMDAwMDAwYmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_received",
+            "attributes": [
+              {
+                "key": "cmVjZWl2ZXI=",
+                "value": "dGNybzFnZHl1Z3RodmU0dHE0ZTNsOTR3YWhuY2QzZGs4Mmp3cHYybDVsdA==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjM0NjkwMDAwMDAwMDAwYmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "transfer",
+            "attributes": [
+              {
+                "key": "cmVjaXBpZW50",
+                "value": "dGNybzFnZHl1Z3RodmU0dHE0ZTNsOTR3YWhuY2QzZGs4Mmp3cHYybDVsdA==",
+                "index": true
+              },
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjM0NjkwMDAwMDAwMDAwYmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "ethereum_tx",
+            "attributes": [
+              {
+                "key": "YW1vdW50",
+                "value": "MA==",
+                "index": true
+              },
+              {
+                "key": "ZXRoZXJldW1UeEhhc2g=",
+                "value": "MHgxZmQ2Yzg0OGQ0NDhhM2FhNDIxODRiNWEwYjc4ZGIzNWY1MTk4Zjc4MzhkZWQ3ZWM0MWYxOTA5ZjZlMThiYWE3",
+                "index": true
+              },
+              {
+                "key": "dHhIYXNo",
+                "value": "RTgxMzRCMzMyNTVFRDIxNzBEQUE4MDlCODE4RjczRDNDN0JCMzkwQjY2QkFENzJBNzc2OEM1Mjg2M0MxQ0NCMw==",
+                "index": true
+              },
+              {
+                "key": "ZXRoZXJldW1UeEZhaWxlZA==",
+                "value": "ZXhlY3V0aW9uIHJldmVydGVk",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx_log",
+            "attributes": []
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "bW9kdWxl",
+                "value": "ZXZt",
+                "index": true
+              },
+              {
+                "key": "c2VuZGVy",
+                "value": "MHg0MzQ5YzQyRUVjQ0Q1NjBBRTYzZjJkNUREQmNGMGQ4QjZjNzU0OWMx",
+                "index": true
+              },
+              {
+                "key": "dHhUeXBl",
+                "value": "MA==",
+                "index": true
+              }
+            ]
+          }
+        ],
+        "codespace": ""
+      }
+    ],
+    "begin_block_events": [
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "NDExOTQ1NDMwMTdiYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coinbase",
+        "attributes": [
+          {
+            "key": "bWludGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "NDExOTQ1NDMwMTdiYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "NDExOTQ1NDMwMTdiYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "NDExOTQ1NDMwMTdiYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "NDExOTQ1NDMwMTdiYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "mint",
+        "attributes": [
+          {
+            "key": "Ym9uZGVkX3JhdGlv",
+            "value": "MC4wMDAwMDAwMDQ5OTk5OTk2NjY=",
+            "index": true
+          },
+          {
+            "key": "aW5mbGF0aW9u",
+            "value": "MC4xMzAwMDAwODIzODkwMjc3MDA=",
+            "index": true
+          },
+          {
+            "key": "YW5udWFsX3Byb3Zpc2lvbnM=",
+            "value": "MjYwMDAwMTgyMTQzOTMyNDI5LjU2MTY2ODE4NzcyMDkwNDYwMA==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "NDExOTQ1NDMwMTc=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTMxOTk2MTk0NTQzMDE3YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTMxOTk2MTk0NTQzMDE3YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTMxOTk2MTk0NTQzMDE3YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "proposer_reward",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NjU5OTgwOTcyNzE1MC44NTAwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ2M1NWN6aGFsdmtxZ3V5cmEzczc4eTR6ZWx1OWZ2Zzc0bXlzcDI=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "commission",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NjU5OTgwOTcyNzE1LjA4NTAwMDAwMDAwMDAwMDAwMGJhc2V0Y3Jv",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ2M1NWN6aGFsdmtxZ3V5cmEzczc4eTR6ZWx1OWZ2Zzc0bXlzcDI=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "rewards",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NjU5OTgwOTcyNzE1MC44NTAwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ2M1NWN6aGFsdmtxZ3V5cmEzczc4eTR6ZWx1OWZ2Zzc0bXlzcDI=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "commission",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "MTIyNzU2NDYwOTI1MDAuNTgxMDAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ2M1NWN6aGFsdmtxZ3V5cmEzczc4eTR6ZWx1OWZ2Zzc0bXlzcDI=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "rewards",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "MTIyNzU2NDYwOTI1MDA1LjgxMDAwMDAwMDAwMDAwMDAwMGJhc2V0Y3Jv",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ2M1NWN6aGFsdmtxZ3V5cmEzczc4eTR6ZWx1OWZ2Zzc0bXlzcDI=",
+            "index": true
+          }
+        ]
+      }
+    ],
+    "end_block_events": [
+      {
+        "type": "block_bloom",
+        "attributes": [
+          {
+            "key": "Ymxvb20=",
+            "value": "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA==",
+            "index": true
+          }
+        ]
+      }
+    ],
+    "validator_updates": [],
+    "consensus_param_updates": {
+      "block": {
+        "max_bytes": "22020096",
+        "max_gas": "-1"
+      },
+      "evidence": {
+        "max_age_num_blocks": "100000",
+        "max_age_duration": "172800000000000",
+        "max_bytes": "1048576"
+      },
+      "validator": {
+        "pub_key_types": [
+          "ed25519"
+        ]
+      }
+    }
+  }
+}`
+
+const TX_FAILED_MSG_ETHEREUM_TX_BLOCK_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "block_id": {
+      "hash": "374BF5B4D7CFEE0962CD1EF3418C2675D67906D371E344385E329E68A8049C5A",
+      "parts": {
+        "total": 1,
+        "hash": "54A47D8B6E439EF9D315B59A443DB5C9B34B5B56C7F4FD8504B785B5417F4864"
+      }
+    },
+    "block": {
+      "header": {
+        "version": {
+          "block": "11"
+        },
+        "chain_id": "cronos_777-1",
+        "height": "5",
+        "time": "2021-11-22T08:00:24Z",
+        "last_block_id": {
+          "hash": "9678E9D044D669375AF82C7D91C2F4474DDAB62F8277999278015431B1606F5D",
+          "parts": {
+            "total": 1,
+            "hash": "0FCAC9D54B17464D05792EB6BDAD34CA748270FB58A88018C26E34455F3F31EF"
+          }
+        },
+        "last_commit_hash": "08E22B037A07087F3EBFAB9858EBF543FFF651C3DF444856AE76E84926195F8A",
+        "data_hash": "7353AF69DB669D8FB62C429E3CE64EE279100EE0F8021B69735ABDC6D372684F",
+        "validators_hash": "CFCAA02297A37C1486020AFC85954E053209049C1E2B47CC42987CC1F6F6E05B",
+        "next_validators_hash": "CFCAA02297A37C1486020AFC85954E053209049C1E2B47CC42987CC1F6F6E05B",
+        "consensus_hash": "048091BC7DDC283F77BFBF91D73C44DA58C3DF8A9CBC867405D8B7F3DAADA22F",
+        "app_hash": "12286E6BF1A8755DED4842F768BA5B0E8462664058E0DC8769CD7082F53079AB",
+        "last_results_hash": "5A03D507DA35FFD29E910F0B37EB94CB421D63EB798E986F6DDCDE5E359A7AA1",
+        "evidence_hash": "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855",
+        "proposer_address": "B4AA6AD581B7B68A081332C3DCBEB042192C945C"
+      },
+      "data": {
+        "txs": [
+          "CoQDCtACCh8vZXRoZXJtaW50LmV2bS52MS5Nc2dFdGhlcmV1bVR4EqwCCrABCh4vZXRoZXJtaW50LmV2bS52MS5BY2Nlc3NMaXN0VHgSjQEKAzc3NxADGgo1MDAwMDAwMDAwIKCcASoqMHg0NjI5NGMwYUZkRmIyYzA0NzA4M2VjNjFFMzkyYTJDZmY4NTRiMTFFMgQxMDAwUiBG+N/emD3BYWUxSQ6/cSy9JiOviHi2v1xj2RjYylwMDFogVleeISrlFl2euyfbwnBgjXDCXeRiFo/MU3i+ZklbvakRAAAAAAAAW0AaQjB4YjNiNmM1YmYyZTQ5ZmY3MjNkYzNlODljNTAxZmZmZjMyNmNlN2E3YmE4YzRjNTI0YzY4ZTg1ZGQwY2JjZDcxOCIqMHg0MzQ5YzQyRUVjQ0Q1NjBBRTYzZjJkNUREQmNGMGQ4QjZjNzU0OWMx+j8uCiwvZXRoZXJtaW50LmV2bS52MS5FeHRlbnNpb25PcHRpb25zRXRoZXJldW1UeBIjEiEKGwoIYmFzZXRjcm8SDzEwMDAwMDAwMDAwMDAwMBCgnAE="
+        ]
+      },
+      "evidence": {
+        "evidence": null
+      },
+      "last_commit": {
+        "height": "4",
+        "round": 0,
+        "block_id": {
+          "hash": "9678E9D044D669375AF82C7D91C2F4474DDAB62F8277999278015431B1606F5D",
+          "parts": {
+            "total": 1,
+            "hash": "0FCAC9D54B17464D05792EB6BDAD34CA748270FB58A88018C26E34455F3F31EF"
+          }
+        },
+        "signatures": [
+          {
+            "block_id_flag": 2,
+            "validator_address": "B4AA6AD581B7B68A081332C3DCBEB042192C945C",
+            "timestamp": "2021-11-22T08:00:21Z",
+            "signature": "jfOjZ35DSsaca9FKcvXdgn6lcnOvknlnpMRhKWPIiuXwZw75hxpgcf+vydsA/QlX1OJlAf7L/5nknPH3coHhBg=="
+          }
+        ]
+      }
+    }
+  }
+}`
+
+const TX_FAILED_MSG_ETHEREUM_TX_BLOCK_RESULTS_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "height": "5",
+    "txs_results": [
+      {
+        "code": 1,
+        "data": null,
+        "log": "internal",
+        "info": "",
+        "gas_wanted": "0",
+        "gas_used": "60159",
+        "events": [
+          {
+            "type": "coin_spent",
+            "attributes": [
+              {
+                "key": "c3BlbmRlcg==",
+                "value": "dGNybzFnZHl1Z3RodmU0dHE0ZTNsOTR3YWhuY2QzZGs4Mmp3cHYybDVsdA==",
+                "index": false
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MTAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+                "index": false
+              }
+            ]
+          },
+          {
+            "type": "coin_received",
+            "attributes": [
+              {
+                "key": "cmVjZWl2ZXI=",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": false
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MTAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+                "index": false
+              }
+            ]
+          },
+          {
+            "type": "transfer",
+            "attributes": [
+              {
+                "key": "cmVjaXBpZW50",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": false
+              },
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzFnZHl1Z3RodmU0dHE0ZTNsOTR3YWhuY2QzZGs4Mmp3cHYybDVsdA==",
+                "index": false
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MTAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+                "index": false
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzFnZHl1Z3RodmU0dHE0ZTNsOTR3YWhuY2QzZGs4Mmp3cHYybDVsdA==",
+                "index": false
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "ZmVl",
+                "value": "MTAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+                "index": false
+              }
+            ]
+          }
+        ],
+        "codespace": "undefined"
+      }
+    ],
+    "begin_block_events": [
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "NDExOTQ1NTAzOTJiYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coinbase",
+        "attributes": [
+          {
+            "key": "bWludGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "NDExOTQ1NTAzOTJiYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "NDExOTQ1NTAzOTJiYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "NDExOTQ1NTAzOTJiYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "NDExOTQ1NTAzOTJiYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "mint",
+        "attributes": [
+          {
+            "key": "Ym9uZGVkX3JhdGlv",
+            "value": "MC4wMDAwMDAwMDQ5OTk5OTk1NjM=",
+            "index": true
+          },
+          {
+            "key": "aW5mbGF0aW9u",
+            "value": "MC4xMzAwMDAxMDI5ODYyODQ2MjU=",
+            "index": true
+          },
+          {
+            "key": "YW5udWFsX3Byb3Zpc2lvbnM=",
+            "value": "MjYwMDAwMjI4NjkzNzQzODY1LjcwMDAxNzM4MDIxOTM0NDM3NQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "NDExOTQ1NTAzOTI=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjY1MzUxMTk0NTUwMzkyYmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjY1MzUxMTk0NTUwMzkyYmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjY1MzUxMTk0NTUwMzkyYmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "proposer_reward",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "MTMyNjc1NTk3Mjc1MTkuNjAwMDAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ2M1NWN6aGFsdmtxZ3V5cmEzczc4eTR6ZWx1OWZ2Zzc0bXlzcDI=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "commission",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "MTMyNjc1NTk3Mjc1MS45NjAwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ2M1NWN6aGFsdmtxZ3V5cmEzczc4eTR6ZWx1OWZ2Zzc0bXlzcDI=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "rewards",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "MTMyNjc1NTk3Mjc1MTkuNjAwMDAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ2M1NWN6aGFsdmtxZ3V5cmEzczc4eTR6ZWx1OWZ2Zzc0bXlzcDI=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "commission",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "MjQ2Nzc2NjEwOTMxODYuNDU2MDAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ2M1NWN6aGFsdmtxZ3V5cmEzczc4eTR6ZWx1OWZ2Zzc0bXlzcDI=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "rewards",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "MjQ2Nzc2NjEwOTMxODY0LjU2MDAwMDAwMDAwMDAwMDAwMGJhc2V0Y3Jv",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ2M1NWN6aGFsdmtxZ3V5cmEzczc4eTR6ZWx1OWZ2Zzc0bXlzcDI=",
+            "index": true
+          }
+        ]
+      }
+    ],
+    "end_block_events": [
+      {
+        "type": "block_bloom",
+        "attributes": [
+          {
+            "key": "Ymxvb20=",
+            "value": "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA==",
+            "index": true
+          }
+        ]
+      }
+    ],
+    "validator_updates": [],
+    "consensus_param_updates": {
+      "block": {
+        "max_bytes": "22020096",
+        "max_gas": "-1"
+      },
+      "evidence": {
+        "max_age_num_blocks": "100000",
+        "max_age_duration": "172800000000000",
+        "max_bytes": "1048576"
+      },
+      "validator": {
+        "pub_key_types": [
+          "ed25519"
+        ]
+      }
+    }
+  }
+}`
@@ -0,0 +1,747 @@
+package usecase_parser_test
+
+// Generated by delivering the transactions to an in-process Cosmos SDK v0.44.5 simapp with the
+// Crypto.org Chain address prefixes and denom, and encoding its responses as Tendermint v0.34 RPC.
+const TX_MSG_EXEC_BLOCK_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "block_id": {
+      "hash": "C718F8A56BE32C48452575C8E45913FB369B3072C5E7AB4178FD6EC788569A97",
+      "parts": {
+        "total": 1,
+        "hash": "A3E7152187B060E1B43CEE3B7F1E904EA0847831B141AFF30324977923EBCFAD"
+      }
+    },
+    "block": {
+      "header": {
+        "version": {
+          "block": "11"
+        },
+        "chain_id": "testnet-croeseid-4",
+        "height": "6",
+        "time": "2021-11-22T08:00:30Z",
+        "last_block_id": {
+          "hash": "6CD612A099F96D983B5D3969A8BFB6D6D1A8DB8F508E5481C8DE622B47A32D3C",
+          "parts": {
+            "total": 1,
+            "hash": "CDF1B63A436C3DAB21D06F6DBCDD2CA34FE365CB7F9423C8EE1DC2E9D1E9AB15"
+          }
+        },
+        "last_commit_hash": "F09CD6C64B1F1987A72BA2CE2CCCAC6F13642205B8823308389E1ECEB2F7DA4C",
+        "data_hash": "FF644921E7D9D1C02743B3AEDA0A9D4550D19728CDA44277C7070826779DE27D",
+        "validators_hash": "E000B2CE263CED3E3E37A1A4EB362BCD1E5C422471E6A88C2DA60A3D69B9922E",
+        "next_validators_hash": "E000B2CE263CED3E3E37A1A4EB362BCD1E5C422471E6A88C2DA60A3D69B9922E",
+        "consensus_hash": "048091BC7DDC283F77BFBF91D73C44DA58C3DF8A9CBC867405D8B7F3DAADA22F",
+        "app_hash": "3C9D54650DE493B789E628D0209DD9D2394A2F665B821551F58E0D280FF392EB",
+        "last_results_hash": "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855",
+        "evidence_hash": "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855",
+        "proposer_address": "1CD16F44A4E0502144D9D49A4CDB8748A57E334C"
+      },
+      "data": {
+        "txs": [
+          "Cp4ECpsECh0vY29zbW9zLmF1dGh6LnYxYmV0YTEuTXNnRXhlYxL5AwordGNybzFwZWpyaGcyc2xweHphZWtodmNqODYwNjd5YTRldzd3Y3VxdHBnZBKZAQo3L2Nvc21vcy5kaXN0cmlidXRpb24udjFiZXRhMS5Nc2dXaXRoZHJhd0RlbGVnYXRvclJld2FyZBJeCit0Y3JvMWRoNjlmbGRjc2ZyejR1aHZuNjRzNjZqZGYzenBsOW1oM3pwa25yEi90Y3JvY25jbDFndzRmdG0zOHRhbXJoeXAzcnVuNjhmMjMyd3JxbXJwZXVlOTVnYRKZAQo3L2Nvc21vcy5kaXN0cmlidXRpb24udjFiZXRhMS5Nc2dXaXRoZHJhd0RlbGVnYXRvclJld2FyZBJeCit0Y3JvMWRoNjlmbGRjc2ZyejR1aHZuNjRzNjZqZGYzenBsOW1oM3pwa25yEi90Y3JvY25jbDFyeWdtMDA1eXc3Mjg2a2Q1bTNrNTk3Zzgwdnk2ZGNnaGtqOGQwdBKRAQocL2Nvc21vcy5iYW5rLnYxYmV0YTEuTXNnU2VuZBJxCit0Y3JvMWRoNjlmbGRjc2ZyejR1aHZuNjRzNjZqZGYzenBsOW1oM3pwa25yEit0Y3JvMTl3cHRzczQ2a3p0ZXBhMHpzdHdkM2hscTN0anU4Y3F2aHAzdW1nGhUKCGJhc2V0Y3JvEgkxMDAwMDAwMDASaQpOCkYKHy9jb3Ntb3MuY3J5cHRvLnNlY3AyNTZrMS5QdWJLZXkSIwohAtEGYeD3BgFw6pFNJu/nFEuyvzCXvqbKxIxR8q1q0ybnEgQKAggBEhcKEQoIYmFzZXRjcm8SBTIwMDAwEIC1GBpA7fjgCNGFP21sBbf+sv70tWufgGtzm9jZNePhjZ3uAuRciDzy5okNGwMMIvpak/Jy1ASHJexyZFEYl/RLp4vvOw=="
+        ]
+      },
+      "evidence": {
+        "evidence": null
+      },
+      "last_commit": {
+        "height": "5",
+        "round": 0,
+        "block_id": {
+          "hash": "6CD612A099F96D983B5D3969A8BFB6D6D1A8DB8F508E5481C8DE622B47A32D3C",
+          "parts": {
+            "total": 1,
+            "hash": "CDF1B63A436C3DAB21D06F6DBCDD2CA34FE365CB7F9423C8EE1DC2E9D1E9AB15"
+          }
+        },
+        "signatures": [
+          {
+            "block_id_flag": 2,
+            "validator_address": "1CD16F44A4E0502144D9D49A4CDB8748A57E334C",
+            "timestamp": "2021-11-22T08:00:27Z",
+            "signature": "MZ88lRYTrcyIFYnJNd0y3wCAQqwQfWYY2hZo/pohDqvKzQUeIDejo8eXe7nqwEWGfdywBHPij6GP86mjtbQrCQ=="
+          },
+          {
+            "block_id_flag": 2,
+            "validator_address": "838043E73426378E3A87858E3B39AF58AF330752",
+            "timestamp": "2021-11-22T08:00:27Z",
+            "signature": "RPxwFhH4GJ6tftkxBqdZ9NA9oOn1Hv/EBIi2UBSwPdNAQ45s6c6iC9pfistXoUHShlrJWjxKqag1ePjTW6cXAA=="
+          }
+        ]
+      }
+    }
+  }
+}`
+
+const TX_MSG_EXEC_BLOCK_RESULTS_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "height": "6",
+    "txs_results": [
+      {
+        "code": 0,
+        "data": "CicKHS9jb3Ntb3MuYXV0aHoudjFiZXRhMS5Nc2dFeGVjEgYKAAoACgA=",
+        "log": "[{\"events\":[{\"type\":\"coin_received\",\"attributes\":[{\"key\":\"receiver\",\"value\":\"tcro1dh69fldcsfrz4uhvn64s66jdf3zpl9mh3zpknr\"},{\"key\":\"amount\",\"value\":\"296819basetcro\"},{\"key\":\"receiver\",\"value\":\"tcro1dh69fldcsfrz4uhvn64s66jdf3zpl9mh3zpknr\"},{\"key\":\"amount\",\"value\":\"268002basetcro\"},{\"key\":\"receiver\",\"value\":\"tcro19wptss46kztepa0zstwd3hlq3tju8cqvhp3umg\"},{\"key\":\"amount\",\"value\":\"100000000basetcro\"}]},{\"type\":\"coin_spent\",\"attributes\":[{\"key\":\"spender\",\"value\":\"tcro1jv65s3grqf6v6jl3dp4t6c9t9rk99cd8339p4l\"},{\"key\":\"amount\",\"value\":\"296819basetcro\"},{\"key\":\"spender\",\"value\":\"tcro1jv65s3grqf6v6jl3dp4t6c9t9rk99cd8339p4l\"},{\"key\":\"amount\",\"value\":\"268002basetcro\"},{\"key\":\"spender\",\"value\":\"tcro1dh69fldcsfrz4uhvn64s66jdf3zpl9mh3zpknr\"},{\"key\":\"amount\",\"value\":\"100000000basetcro\"}]},{\"type\":\"message\",\"attributes\":[{\"key\":\"action\",\"value\":\"/cosmos.authz.v1beta1.MsgExec\"},{\"key\":\"sender\",\"value\":\"tcro1jv65s3grqf6v6jl3dp4t6c9t9rk99cd8339p4l\"},{\"key\":\"module\",\"value\":\"distribution\"},{\"key\":\"sender\",\"value\":\"tcro1dh69fldcsfrz4uhvn64s66jdf3zpl9mh3zpknr\"},{\"key\":\"sender\",\"value\":\"tcro1jv65s3grqf6v6jl3dp4t6c9t9rk99cd8339p4l\"},{\"key\":\"module\",\"value\":\"distribution\"},{\"key\":\"sender\",\"value\":\"tcro1dh69fldcsfrz4uhvn64s66jdf3zpl9mh3zpknr\"},{\"key\":\"sender\",\"value\":\"tcro1dh69fldcsfrz4uhvn64s66jdf3zpl9mh3zpknr\"},{\"key\":\"module\",\"value\":\"bank\"}]},{\"type\":\"transfer\",\"attributes\":[{\"key\":\"recipient\",\"value\":\"tcro1dh69fldcsfrz4uhvn64s66jdf3zpl9mh3zpknr\"},{\"key\":\"sender\",\"value\":\"tcro1jv65s3grqf6v6jl3dp4t6c9t9rk99cd8339p4l\"},{\"key\":\"amount\",\"value\":\"296819basetcro\"},{\"key\":\"recipient\",\"value\":\"tcro1dh69fldcsfrz4uhvn64s66jdf3zpl9mh3zpknr\"},{\"key\":\"sender\",\"value\":\"tcro1jv65s3grqf6v6jl3dp4t6c9t9rk99cd8339p4l\"},{\"key\":\"amount\",\"value\":\"268002basetcro\"},{\"key\":\"recipient\",\"value\":\"tcro19wptss46kztepa0zstwd3hlq3tju8cqvhp3umg\"},{\"key\":\"sender\",\"value\":\"tcro1dh69fldcsfrz4uhvn64s66jdf3zpl9mh3zpknr\"},{\"key\":\"amount\",\"value\":\"100000000basetcro\"}]},{\"type\":\"withdraw_rewards\",\"attributes\":[{\"key\":\"amount\",\"value\":\"296819basetcro\"},{\"key\":\"validator\",\"value\":\"tcrocncl1gw4ftm38tamrhyp3run68f232wrqmrpeue95ga\"},{\"key\":\"amount\",\"value\":\"268002basetcro\"},{\"key\":\"validator\",\"value\":\"tcrocncl1rygm005yw7286kd5m3k597g80vy6dcghkj8d0t\"}]}]}]",
+        "info": "",
+        "gas_wanted": "400000",
+        "gas_used": "186380",
+        "events": [
+          {
+            "type": "coin_spent",
+            "attributes": [
+              {
+                "key": "c3BlbmRlcg==",
+                "value": "dGNybzFwZWpyaGcyc2xweHphZWtodmNqODYwNjd5YTRldzd3Y3VxdHBnZA==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjAwMDBiYXNldGNybw==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_received",
+            "attributes": [
+              {
+                "key": "cmVjZWl2ZXI=",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjAwMDBiYXNldGNybw==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "transfer",
+            "attributes": [
+              {
+                "key": "cmVjaXBpZW50",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": true
+              },
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzFwZWpyaGcyc2xweHphZWtodmNqODYwNjd5YTRldzd3Y3VxdHBnZA==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjAwMDBiYXNldGNybw==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzFwZWpyaGcyc2xweHphZWtodmNqODYwNjd5YTRldzd3Y3VxdHBnZA==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "ZmVl",
+                "value": "MjAwMDBiYXNldGNybw==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "YWNjX3NlcQ==",
+                "value": "dGNybzFwZWpyaGcyc2xweHphZWtodmNqODYwNjd5YTRldzd3Y3VxdHBnZC8w",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "c2lnbmF0dXJl",
+                "value": "N2ZqZ0NOR0ZQMjFzQmJmK3N2NzB0V3VmZ0d0em05alpOZVBoalozdUF1UmNpRHp5NW9rTkd3TU1JdnBhay9KeTFBU0hKZXh5WkZFWWwvUkxwNHZ2T3c9PQ==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "YWN0aW9u",
+                "value": "L2Nvc21vcy5hdXRoei52MWJldGExLk1zZ0V4ZWM=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_spent",
+            "attributes": [
+              {
+                "key": "c3BlbmRlcg==",
+                "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "Mjk2ODE5YmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_received",
+            "attributes": [
+              {
+                "key": "cmVjZWl2ZXI=",
+                "value": "dGNybzFkaDY5ZmxkY3Nmcno0dWh2bjY0czY2amRmM3pwbDltaDN6cGtucg==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "Mjk2ODE5YmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "transfer",
+            "attributes": [
+              {
+                "key": "cmVjaXBpZW50",
+                "value": "dGNybzFkaDY5ZmxkY3Nmcno0dWh2bjY0czY2amRmM3pwbDltaDN6cGtucg==",
+                "index": true
+              },
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "Mjk2ODE5YmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "withdraw_rewards",
+            "attributes": [
+              {
+                "key": "YW1vdW50",
+                "value": "Mjk2ODE5YmFzZXRjcm8=",
+                "index": true
+              },
+              {
+                "key": "dmFsaWRhdG9y",
+                "value": "dGNyb2NuY2wxZ3c0ZnRtMzh0YW1yaHlwM3J1bjY4ZjIzMndycW1ycGV1ZTk1Z2E=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "bW9kdWxl",
+                "value": "ZGlzdHJpYnV0aW9u",
+                "index": true
+              },
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzFkaDY5ZmxkY3Nmcno0dWh2bjY0czY2amRmM3pwbDltaDN6cGtucg==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_spent",
+            "attributes": [
+              {
+                "key": "c3BlbmRlcg==",
+                "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjY4MDAyYmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_received",
+            "attributes": [
+              {
+                "key": "cmVjZWl2ZXI=",
+                "value": "dGNybzFkaDY5ZmxkY3Nmcno0dWh2bjY0czY2amRmM3pwbDltaDN6cGtucg==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjY4MDAyYmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "transfer",
+            "attributes": [
+              {
+                "key": "cmVjaXBpZW50",
+                "value": "dGNybzFkaDY5ZmxkY3Nmcno0dWh2bjY0czY2amRmM3pwbDltaDN6cGtucg==",
+                "index": true
+              },
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjY4MDAyYmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "withdraw_rewards",
+            "attributes": [
+              {
+                "key": "YW1vdW50",
+                "value": "MjY4MDAyYmFzZXRjcm8=",
+                "index": true
+              },
+              {
+                "key": "dmFsaWRhdG9y",
+                "value": "dGNyb2NuY2wxcnlnbTAwNXl3NzI4NmtkNW0zazU5N2c4MHZ5NmRjZ2hrajhkMHQ=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "bW9kdWxl",
+                "value": "ZGlzdHJpYnV0aW9u",
+                "index": true
+              },
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzFkaDY5ZmxkY3Nmcno0dWh2bjY0czY2amRmM3pwbDltaDN6cGtucg==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_spent",
+            "attributes": [
+              {
+                "key": "c3BlbmRlcg==",
+                "value": "dGNybzFkaDY5ZmxkY3Nmcno0dWh2bjY0czY2amRmM3pwbDltaDN6cGtucg==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MTAwMDAwMDAwYmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_received",
+            "attributes": [
+              {
+                "key": "cmVjZWl2ZXI=",
+                "value": "dGNybzE5d3B0c3M0Nmt6dGVwYTB6c3R3ZDNobHEzdGp1OGNxdmhwM3VtZw==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MTAwMDAwMDAwYmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "transfer",
+            "attributes": [
+              {
+                "key": "cmVjaXBpZW50",
+                "value": "dGNybzE5d3B0c3M0Nmt6dGVwYTB6c3R3ZDNobHEzdGp1OGNxdmhwM3VtZw==",
+                "index": true
+              },
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzFkaDY5ZmxkY3Nmcno0dWh2bjY0czY2amRmM3pwbDltaDN6cGtucg==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MTAwMDAwMDAwYmFzZXRjcm8=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzFkaDY5ZmxkY3Nmcno0dWh2bjY0czY2amRmM3pwbDltaDN6cGtucg==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "bW9kdWxl",
+                "value": "YmFuaw==",
+                "index": true
+              }
+            ]
+          }
+        ],
+        "codespace": ""
+      }
+    ],
+    "begin_block_events": [
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coinbase",
+        "attributes": [
+          {
+            "key": "bWludGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "mint",
+        "attributes": [
+          {
+            "key": "Ym9uZGVkX3JhdGlv",
+            "value": "MC4wMDM5ODQwNjMzMzQ3MTY5NTE=",
+            "index": true
+          },
+          {
+            "key": "aW5mbGF0aW9u",
+            "value": "MC4xMzAwMDAxMjI4NDg2Njk4MDk=",
+            "index": true
+          },
+          {
+            "key": "YW5udWFsX3Byb3Zpc2lvbnM=",
+            "value": "NjUyNjAwNjgzOTA5LjA4NTk1MjcxMzgwNDU1NDkxMA==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "proposer_reward",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NTE2OS45MDAwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ3c0ZnRtMzh0YW1yaHlwM3J1bjY4ZjIzMndycW1ycGV1ZTk1Z2E=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "commission",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NTE2Ljk5MDAwMDAwMDAwMDAwMDAwMGJhc2V0Y3Jv",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ3c0ZnRtMzh0YW1yaHlwM3J1bjY4ZjIzMndycW1ycGV1ZTk1Z2E=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "rewards",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NTE2OS45MDAwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ3c0ZnRtMzh0YW1yaHlwM3J1bjY4ZjIzMndycW1ycGV1ZTk1Z2E=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "commission",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NDgwOC4wMDcwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ3c0ZnRtMzh0YW1yaHlwM3J1bjY4ZjIzMndycW1ycGV1ZTk1Z2E=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "rewards",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NDgwODAuMDcwMDAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ3c0ZnRtMzh0YW1yaHlwM3J1bjY4ZjIzMndycW1ycGV1ZTk1Z2E=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "commission",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NDgwOC4wMDcwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxcnlnbTAwNXl3NzI4NmtkNW0zazU5N2c4MHZ5NmRjZ2hrajhkMHQ=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "rewards",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NDgwODAuMDcwMDAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxcnlnbTAwNXl3NzI4NmtkNW0zazU5N2c4MHZ5NmRjZ2hrajhkMHQ=",
+            "index": true
+          }
+        ]
+      }
+    ],
+    "end_block_events": [],
+    "validator_updates": [],
+    "consensus_param_updates": {
+      "block": {
+        "max_bytes": "22020096",
+        "max_gas": "-1"
+      },
+      "evidence": {
+        "max_age_num_blocks": "100000",
+        "max_age_duration": "172800000000000",
+        "max_bytes": "1048576"
+      },
+      "validator": {
+        "pub_key_types": [
+          "ed25519"
+        ]
+      }
+    }
+  }
+}`
@@ -0,0 +1,577 @@
+package usecase_parser_test
+
+// Generated by delivering the transactions to an in-process Cosmos SDK v0.44.5 simapp with the
+// Crypto.org Chain address prefixes and denom, and encoding its responses as Tendermint v0.34 RPC.
+const TX_MSG_GRANT_BLOCK_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "block_id": {
+      "hash": "10BA6B61A116EC70964C2EA0D1079C72FE50FD5DC3445FA564BC271190B53CF6",
+      "parts": {
+        "total": 1,
+        "hash": "4B054E404C78A7E3E7C37D0C08EBD2EA5D46400F186FAB63665E42C9C0D942ED"
+      }
+    },
+    "block": {
+      "header": {
+        "version": {
+          "block": "11"
+        },
+        "chain_id": "testnet-croeseid-4",
+        "height": "4",
+        "time": "2021-11-22T08:00:18Z",
+        "last_block_id": {
+          "hash": "714F72F9E9E4ECF76789DCFA435DCD12831F54BBBD81A1832CFB9D58F436B00F",
+          "parts": {
+            "total": 1,
+            "hash": "C8B8666BE116A271D1263B58AF70FF8362DC945297C62EB0E88BFFA0C17473CD"
+          }
+        },
+        "last_commit_hash": "22D3C0328C1F605CDE4A5229D3179BECBE542523559A0C6419D40349B76D62B9",
+        "data_hash": "2CC66511765B4CAAB3851B9CF8623BFE779D1CF5FAC56A1ADB6E98BB75101DCB",
+        "validators_hash": "E000B2CE263CED3E3E37A1A4EB362BCD1E5C422471E6A88C2DA60A3D69B9922E",
+        "next_validators_hash": "E000B2CE263CED3E3E37A1A4EB362BCD1E5C422471E6A88C2DA60A3D69B9922E",
+        "consensus_hash": "048091BC7DDC283F77BFBF91D73C44DA58C3DF8A9CBC867405D8B7F3DAADA22F",
+        "app_hash": "E6624CCEA9424846C43F5ABF2CEEAD3D809AF05AEA79A03D7936C332A34F01E4",
+        "last_results_hash": "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855",
+        "evidence_hash": "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855",
+        "proposer_address": "1CD16F44A4E0502144D9D49A4CDB8748A57E334C"
+      },
+      "data": {
+        "txs": [
+          "CscFCvABCh4vY29zbW9zLmF1dGh6LnYxYmV0YTEuTXNnR3JhbnQSzQEKK3Rjcm8xZGg2OWZsZGNzZnJ6NHVodm42NHM2NmpkZjN6cGw5bWgzenBrbnISK3Rjcm8xcGVqcmhnMnNscHh6YWVraHZjajg2MDY3eWE0ZXc3d2N1cXRwZ2QacQpnCiovY29zbW9zLmF1dGh6LnYxYmV0YTEuR2VuZXJpY0F1dGhvcml6YXRpb24SOQo3L2Nvc21vcy5kaXN0cmlidXRpb24udjFiZXRhMS5Nc2dXaXRoZHJhd0RlbGVnYXRvclJld2FyZBIGCIyD8psGCsoBCh4vY29zbW9zLmF1dGh6LnYxYmV0YTEuTXNnR3JhbnQSpwEKK3Rjcm8xZGg2OWZsZGNzZnJ6NHVodm42NHM2NmpkZjN6cGw5bWgzenBrbnISK3Rjcm8xcGVqcmhnMnNscHh6YWVraHZjajg2MDY3eWE0ZXc3d2N1cXRwZ2QaSwpBCiYvY29zbW9zLmJhbmsudjFiZXRhMS5TZW5kQXV0aG9yaXphdGlvbhIXChUKCGJhc2V0Y3JvEgk1MDAwMDAwMDASBgiMg/KbBgqEAgoeL2Nvc21vcy5hdXRoei52MWJldGExLk1zZ0dyYW50EuEBCit0Y3JvMWRoNjlmbGRjc2ZyejR1aHZuNjRzNjZqZGYzenBsOW1oM3pwa25yEit0Y3JvMXBlanJoZzJzbHB4emFla2h2Y2o4NjA2N3lhNGV3N3djdXF0cGdkGoQBCnoKKi9jb3Ntb3Muc3Rha2luZy52MWJldGExLlN0YWtlQXV0aG9yaXphdGlvbhJMChUKCGJhc2V0Y3JvEgkxMDAwMDAwMDASMQovdGNyb2NuY2wxZ3c0ZnRtMzh0YW1yaHlwM3J1bjY4ZjIzMndycW1ycGV1ZTk1Z2EgARIGCIyD8psGEmkKTgpGCh8vY29zbW9zLmNyeXB0by5zZWNwMjU2azEuUHViS2V5EiMKIQI00Fe/+mp/2F/1a6Cv5rkymkmX/VAd3cJrjRJaegxh+xIECgIIARIXChEKCGJhc2V0Y3JvEgUyMDAwMBCAtRgaQPWa05zb3m6vDTXLvzr0tGOsMINublu5NWRcsN3IVXB2ZGf+znXFPE/V0X/wyHr9ZwG5ksVagHUOzpFEgQvcY5I="
+        ]
+      },
+      "evidence": {
+        "evidence": null
+      },
+      "last_commit": {
+        "height": "3",
+        "round": 0,
+        "block_id": {
+          "hash": "714F72F9E9E4ECF76789DCFA435DCD12831F54BBBD81A1832CFB9D58F436B00F",
+          "parts": {
+            "total": 1,
+            "hash": "C8B8666BE116A271D1263B58AF70FF8362DC945297C62EB0E88BFFA0C17473CD"
+          }
+        },
+        "signatures": [
+          {
+            "block_id_flag": 2,
+            "validator_address": "1CD16F44A4E0502144D9D49A4CDB8748A57E334C",
+            "timestamp": "2021-11-22T08:00:15Z",
+            "signature": "fh+jXqy6ZqLAZ9NPjwWrPy8AbPHbCfpQ0PW2vybwWlIo5kgrRDps9idoY544aG4B4ln/uP5P/zA1BEX/S/OWDQ=="
+          },
+          {
+            "block_id_flag": 2,
+            "validator_address": "838043E73426378E3A87858E3B39AF58AF330752",
+            "timestamp": "2021-11-22T08:00:15Z",
+            "signature": "Qe2tV3BEb4uYN34v4XAiGBKy+Ol2xA6GNLwKXEiYXUBETTZNxaAGMOTY9g50jErmdp2DWiWo7RQn05feEhsRDQ=="
+          }
+        ]
+      }
+    }
+  }
+}`
+
+const TX_MSG_GRANT_BLOCK_RESULTS_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "height": "4",
+    "txs_results": [
+      {
+        "code": 0,
+        "data": "CiAKHi9jb3Ntb3MuYXV0aHoudjFiZXRhMS5Nc2dHcmFudAogCh4vY29zbW9zLmF1dGh6LnYxYmV0YTEuTXNnR3JhbnQKIAoeL2Nvc21vcy5hdXRoei52MWJldGExLk1zZ0dyYW50",
+        "log": "[{\"events\":[{\"type\":\"cosmos.authz.v1beta1.EventGrant\",\"attributes\":[{\"key\":\"msg_type_url\",\"value\":\"\\\"/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward\\\"\"},{\"key\":\"granter\",\"value\":\"\\\"tcro1dh69fldcsfrz4uhvn64s66jdf3zpl9mh3zpknr\\\"\"},{\"key\":\"grantee\",\"value\":\"\\\"tcro1pejrhg2slpxzaekhvcj86067ya4ew7wcuqtpgd\\\"\"}]},{\"type\":\"message\",\"attributes\":[{\"key\":\"action\",\"value\":\"/cosmos.authz.v1beta1.MsgGrant\"}]}]},{\"msg_index\":1,\"events\":[{\"type\":\"cosmos.authz.v1beta1.EventGrant\",\"attributes\":[{\"key\":\"grantee\",\"value\":\"\\\"tcro1pejrhg2slpxzaekhvcj86067ya4ew7wcuqtpgd\\\"\"},{\"key\":\"msg_type_url\",\"value\":\"\\\"/cosmos.bank.v1beta1.MsgSend\\\"\"},{\"key\":\"granter\",\"value\":\"\\\"tcro1dh69fldcsfrz4uhvn64s66jdf3zpl9mh3zpknr\\\"\"}]},{\"type\":\"message\",\"attributes\":[{\"key\":\"action\",\"value\":\"/cosmos.authz.v1beta1.MsgGrant\"}]}]},{\"msg_index\":2,\"events\":[{\"type\":\"cosmos.authz.v1beta1.EventGrant\",\"attributes\":[{\"key\":\"msg_type_url\",\"value\":\"\\\"/cosmos.staking.v1beta1.MsgDelegate\\\"\"},{\"key\":\"granter\",\"value\":\"\\\"tcro1dh69fldcsfrz4uhvn64s66jdf3zpl9mh3zpknr\\\"\"},{\"key\":\"grantee\",\"value\":\"\\\"tcro1pejrhg2slpxzaekhvcj86067ya4ew7wcuqtpgd\\\"\"}]},{\"type\":\"message\",\"attributes\":[{\"key\":\"action\",\"value\":\"/cosmos.authz.v1beta1.MsgGrant\"}]}]}]",
+        "info": "",
+        "gas_wanted": "400000",
+        "gas_used": "77009",
+        "events": [
+          {
+            "type": "coin_spent",
+            "attributes": [
+              {
+                "key": "c3BlbmRlcg==",
+                "value": "dGNybzFkaDY5ZmxkY3Nmcno0dWh2bjY0czY2amRmM3pwbDltaDN6cGtucg==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjAwMDBiYXNldGNybw==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_received",
+            "attributes": [
+              {
+                "key": "cmVjZWl2ZXI=",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjAwMDBiYXNldGNybw==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "transfer",
+            "attributes": [
+              {
+                "key": "cmVjaXBpZW50",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": true
+              },
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzFkaDY5ZmxkY3Nmcno0dWh2bjY0czY2amRmM3pwbDltaDN6cGtucg==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjAwMDBiYXNldGNybw==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzFkaDY5ZmxkY3Nmcno0dWh2bjY0czY2amRmM3pwbDltaDN6cGtucg==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "ZmVl",
+                "value": "MjAwMDBiYXNldGNybw==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "YWNjX3NlcQ==",
+                "value": "dGNybzFkaDY5ZmxkY3Nmcno0dWh2bjY0czY2amRmM3pwbDltaDN6cGtuci8w",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "c2lnbmF0dXJl",
+                "value": "OVpyVG5OdmVicThOTmN1L092UzBZNnd3ZzI1dVc3azFaRnl3M2NoVmNIWmtaLzdPZGNVOFQ5WFJmL0RJZXYxbkFibVN4VnFBZFE3T2tVU0JDOXhqa2c9PQ==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "YWN0aW9u",
+                "value": "L2Nvc21vcy5hdXRoei52MWJldGExLk1zZ0dyYW50",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "cosmos.authz.v1beta1.EventGrant",
+            "attributes": [
+              {
+                "key": "bXNnX3R5cGVfdXJs",
+                "value": "Ii9jb3Ntb3MuZGlzdHJpYnV0aW9uLnYxYmV0YTEuTXNnV2l0aGRyYXdEZWxlZ2F0b3JSZXdhcmQi",
+                "index": true
+              },
+              {
+                "key": "Z3JhbnRlcg==",
+                "value": "InRjcm8xZGg2OWZsZGNzZnJ6NHVodm42NHM2NmpkZjN6cGw5bWgzenBrbnIi",
+                "index": true
+              },
+              {
+                "key": "Z3JhbnRlZQ==",
+                "value": "InRjcm8xcGVqcmhnMnNscHh6YWVraHZjajg2MDY3eWE0ZXc3d2N1cXRwZ2Qi",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "YWN0aW9u",
+                "value": "L2Nvc21vcy5hdXRoei52MWJldGExLk1zZ0dyYW50",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "cosmos.authz.v1beta1.EventGrant",
+            "attributes": [
+              {
+                "key": "Z3JhbnRlZQ==",
+                "value": "InRjcm8xcGVqcmhnMnNscHh6YWVraHZjajg2MDY3eWE0ZXc3d2N1cXRwZ2Qi",
+                "index": true
+              },
+              {
+                "key": "bXNnX3R5cGVfdXJs",
+                "value": "Ii9jb3Ntb3MuYmFuay52MWJldGExLk1zZ1NlbmQi",
+                "index": true
+              },
+              {
+                "key": "Z3JhbnRlcg==",
+                "value": "InRjcm8xZGg2OWZsZGNzZnJ6NHVodm42NHM2NmpkZjN6cGw5bWgzenBrbnIi",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "YWN0aW9u",
+                "value": "L2Nvc21vcy5hdXRoei52MWJldGExLk1zZ0dyYW50",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "cosmos.authz.v1beta1.EventGrant",
+            "attributes": [
+              {
+                "key": "bXNnX3R5cGVfdXJs",
+                "value": "Ii9jb3Ntb3Muc3Rha2luZy52MWJldGExLk1zZ0RlbGVnYXRlIg==",
+                "index": true
+              },
+              {
+                "key": "Z3JhbnRlcg==",
+                "value": "InRjcm8xZGg2OWZsZGNzZnJ6NHVodm42NHM2NmpkZjN6cGw5bWgzenBrbnIi",
+                "index": true
+              },
+              {
+                "key": "Z3JhbnRlZQ==",
+                "value": "InRjcm8xcGVqcmhnMnNscHh6YWVraHZjajg2MDY3eWE0ZXc3d2N1cXRwZ2Qi",
+                "index": true
+              }
+            ]
+          }
+        ],
+        "codespace": ""
+      }
+    ],
+    "begin_block_events": [
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coinbase",
+        "attributes": [
+          {
+            "key": "bWludGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "mint",
+        "attributes": [
+          {
+            "key": "Ym9uZGVkX3JhdGlv",
+            "value": "MC4wMDM5ODQwNjM0OTg4MzgxMjg=",
+            "index": true
+          },
+          {
+            "key": "aW5mbGF0aW9u",
+            "value": "MC4xMzAwMDAwODE4OTkxMTMxOTY=",
+            "index": true
+          },
+          {
+            "key": "YW5udWFsX3Byb3Zpc2lvbnM=",
+            "value": "NjUyNjAwNDUxNDU4Ljc5MzY0ODUzMzUxODcyMDAyNA==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "proposer_reward",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NTE2OS45MDAwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ3c0ZnRtMzh0YW1yaHlwM3J1bjY4ZjIzMndycW1ycGV1ZTk1Z2E=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "commission",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NTE2Ljk5MDAwMDAwMDAwMDAwMDAwMGJhc2V0Y3Jv",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ3c0ZnRtMzh0YW1yaHlwM3J1bjY4ZjIzMndycW1ycGV1ZTk1Z2E=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "rewards",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NTE2OS45MDAwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ3c0ZnRtMzh0YW1yaHlwM3J1bjY4ZjIzMndycW1ycGV1ZTk1Z2E=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "commission",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NDgwOC4wMDcwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ3c0ZnRtMzh0YW1yaHlwM3J1bjY4ZjIzMndycW1ycGV1ZTk1Z2E=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "rewards",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NDgwODAuMDcwMDAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ3c0ZnRtMzh0YW1yaHlwM3J1bjY4ZjIzMndycW1ycGV1ZTk1Z2E=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "commission",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NDgwOC4wMDcwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxcnlnbTAwNXl3NzI4NmtkNW0zazU5N2c4MHZ5NmRjZ2hrajhkMHQ=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "rewards",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NDgwODAuMDcwMDAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxcnlnbTAwNXl3NzI4NmtkNW0zazU5N2c4MHZ5NmRjZ2hrajhkMHQ=",
+            "index": true
+          }
+        ]
+      }
+    ],
+    "end_block_events": [],
+    "validator_updates": [],
+    "consensus_param_updates": {
+      "block": {
+        "max_bytes": "22020096",
+        "max_gas": "-1"
+      },
+      "evidence": {
+        "max_age_num_blocks": "100000",
+        "max_age_duration": "172800000000000",
+        "max_bytes": "1048576"
+      },
+      "validator": {
+        "pub_key_types": [
+          "ed25519"
+        ]
+      }
+    }
+  }
+}`
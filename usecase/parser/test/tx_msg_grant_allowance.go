@@ -0,0 +1,501 @@
+package usecase_parser_test
+
+// Generated by delivering the transactions to an in-process Cosmos SDK v0.44.5 simapp with the
+// Crypto.org Chain address prefixes and denom, and encoding its responses as Tendermint v0.34 RPC.
+const TX_MSG_GRANT_ALLOWANCE_BLOCK_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "block_id": {
+      "hash": "CBE03EAE5ECA4212A56F5576609008157B710D008A2FEF101E48C1FC8B39016A",
+      "parts": {
+        "total": 1,
+        "hash": "C01599DE815CF7299D444772AEE11DEF575674519EE28DABBAB00173D22811CA"
+      }
+    },
+    "block": {
+      "header": {
+        "version": {
+          "block": "11"
+        },
+        "chain_id": "testnet-croeseid-4",
+        "height": "2",
+        "time": "2021-11-22T08:00:06Z",
+        "last_block_id": {
+          "hash": "A415C83A834DA6D980E6CC97FA45D7E7F4D9DE6D139BD49061D0F96F786E4F5A",
+          "parts": {
+            "total": 1,
+            "hash": "423969678360D544FB3642BCB2FB82391B9E70A697859B85106B08DFC187822D"
+          }
+        },
+        "last_commit_hash": "876BAEF38A63C6263F0E03EA2867CDD7C529CD51EF497EA230EAB88F5D18CDDB",
+        "data_hash": "C9E449E9C6F7D9821E72286EC55EDABB524CA99001FBFCBEA3CB1884D9E5C090",
+        "validators_hash": "E9D694855819994A74BC3024811B12BE451E1089122280B5DD8EE135FE2B874D",
+        "next_validators_hash": "E9D694855819994A74BC3024811B12BE451E1089122280B5DD8EE135FE2B874D",
+        "consensus_hash": "048091BC7DDC283F77BFBF91D73C44DA58C3DF8A9CBC867405D8B7F3DAADA22F",
+        "app_hash": "876A48E6F0C4DAF3C2C2BA9B1CEB377D81AC9D016DDC891CDBFE8610FE56FA14",
+        "last_results_hash": "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855",
+        "evidence_hash": "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855",
+        "proposer_address": "D5D7193CC42D507BBDA9FF38FEC0BD8343C4DEC5"
+      },
+      "data": {
+        "txs": [
+          "CowECtMBCiovY29zbW9zLmZlZWdyYW50LnYxYmV0YTEuTXNnR3JhbnRBbGxvd2FuY2USpAEKK3Rjcm8xNGswc25ydGphMnpsZTVqbjN2cjduZnR3ZXBzZmdjYThmYTZyYWwSK3Rjcm8xdGczejdzZjk5dXUzbWEzNHhmc3BocndtcW5tM2s2dXVtdnZ6a3caSAonL2Nvc21vcy5mZWVncmFudC52MWJldGExLkJhc2ljQWxsb3dhbmNlEh0KEwoIYmFzZXRjcm8SBzEwMDAwMDASBgiAg/KbBgqzAgoqL2Nvc21vcy5mZWVncmFudC52MWJldGExLk1zZ0dyYW50QWxsb3dhbmNlEoQCCit0Y3JvMTRrMHNucnRqYTJ6bGU1am4zdnI3bmZ0d2Vwc2ZnY2E4ZmE2cmFsEit0Y3JvMWZnNHJseDhyYXlkazh6N2V2bDc5NncydDl2cG1nYXJsamowcmxwGqcBCiwvY29zbW9zLmZlZWdyYW50LnYxYmV0YTEuQWxsb3dlZE1zZ0FsbG93YW5jZRJ3ClcKKi9jb3Ntb3MuZmVlZ3JhbnQudjFiZXRhMS5QZXJpb2RpY0FsbG93YW5jZRIpCgASBAiAowUaEgoIYmFzZXRjcm8SBjEwMDAwMCoLCICSuMOY/v///wESHC9jb3Ntb3MuYmFuay52MWJldGExLk1zZ1NlbmQSaQpOCkYKHy9jb3Ntb3MuY3J5cHRvLnNlY3AyNTZrMS5QdWJLZXkSIwohAuYc7NOAZpJ9D6luK61xSdbAMYGD/p0tHDYaINnufiRNEgQKAggBEhcKEQoIYmFzZXRjcm8SBTIwMDAwEIC1GBpAAmhYS8MhVCE1Rsapjw4IJqzqZFJlxX/dKChLo8FXnNUiqz0D3gcsEQRDDcTK+9kD+8fmvVh4GEonQpamwWkq/g=="
+        ]
+      },
+      "evidence": {
+        "evidence": null
+      },
+      "last_commit": {
+        "height": "1",
+        "round": 0,
+        "block_id": {
+          "hash": "A415C83A834DA6D980E6CC97FA45D7E7F4D9DE6D139BD49061D0F96F786E4F5A",
+          "parts": {
+            "total": 1,
+            "hash": "423969678360D544FB3642BCB2FB82391B9E70A697859B85106B08DFC187822D"
+          }
+        },
+        "signatures": [
+          {
+            "block_id_flag": 2,
+            "validator_address": "D5D7193CC42D507BBDA9FF38FEC0BD8343C4DEC5",
+            "timestamp": "2021-11-22T08:00:03Z",
+            "signature": "5/93q1rxXUCmpX8VNoyQ4VQ1aVeWlSEItmiKvW2LwcBf2MYZxnjgNasqddfaXT3cyx/J4pE1AHCDcbBn5n8uAQ=="
+          }
+        ]
+      }
+    }
+  }
+}`
+
+const TX_MSG_GRANT_ALLOWANCE_BLOCK_RESULTS_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "height": "2",
+    "txs_results": [
+      {
+        "code": 0,
+        "data": "CiwKKi9jb3Ntb3MuZmVlZ3JhbnQudjFiZXRhMS5Nc2dHcmFudEFsbG93YW5jZQosCiovY29zbW9zLmZlZWdyYW50LnYxYmV0YTEuTXNnR3JhbnRBbGxvd2FuY2U=",
+        "log": "[{\"events\":[{\"type\":\"message\",\"attributes\":[{\"key\":\"action\",\"value\":\"/cosmos.feegrant.v1beta1.MsgGrantAllowance\"}]},{\"type\":\"set_feegrant\",\"attributes\":[{\"key\":\"granter\",\"value\":\"tcro14k0snrtja2zle5jn3vr7nftwepsfgca8fa6ral\"},{\"key\":\"grantee\",\"value\":\"tcro1tg3z7sf99uu3ma34xfsphrwmqnm3k6uumvvzkw\"}]}]},{\"msg_index\":1,\"events\":[{\"type\":\"message\",\"attributes\":[{\"key\":\"action\",\"value\":\"/cosmos.feegrant.v1beta1.MsgGrantAllowance\"}]},{\"type\":\"set_feegrant\",\"attributes\":[{\"key\":\"granter\",\"value\":\"tcro14k0snrtja2zle5jn3vr7nftwepsfgca8fa6ral\"},{\"key\":\"grantee\",\"value\":\"tcro1fg4rlx8raydk8z7evl796w2t9vpmgarljj0rlp\"}]}]}]",
+        "info": "",
+        "gas_wanted": "400000",
+        "gas_used": "80757",
+        "events": [
+          {
+            "type": "coin_spent",
+            "attributes": [
+              {
+                "key": "c3BlbmRlcg==",
+                "value": "dGNybzE0azBzbnJ0amEyemxlNWpuM3ZyN25mdHdlcHNmZ2NhOGZhNnJhbA==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjAwMDBiYXNldGNybw==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_received",
+            "attributes": [
+              {
+                "key": "cmVjZWl2ZXI=",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjAwMDBiYXNldGNybw==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "transfer",
+            "attributes": [
+              {
+                "key": "cmVjaXBpZW50",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": true
+              },
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzE0azBzbnJ0amEyemxlNWpuM3ZyN25mdHdlcHNmZ2NhOGZhNnJhbA==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjAwMDBiYXNldGNybw==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzE0azBzbnJ0amEyemxlNWpuM3ZyN25mdHdlcHNmZ2NhOGZhNnJhbA==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "ZmVl",
+                "value": "MjAwMDBiYXNldGNybw==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "YWNjX3NlcQ==",
+                "value": "dGNybzE0azBzbnJ0amEyemxlNWpuM3ZyN25mdHdlcHNmZ2NhOGZhNnJhbC8w",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "c2lnbmF0dXJl",
+                "value": "QW1oWVM4TWhWQ0UxUnNhcGp3NElKcXpxWkZKbHhYL2RLQ2hMbzhGWG5OVWlxejBEM2djc0VRUkREY1RLKzlrRCs4Zm12Vmg0R0VvblFwYW13V2txL2c9PQ==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "YWN0aW9u",
+                "value": "L2Nvc21vcy5mZWVncmFudC52MWJldGExLk1zZ0dyYW50QWxsb3dhbmNl",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "set_feegrant",
+            "attributes": [
+              {
+                "key": "Z3JhbnRlcg==",
+                "value": "dGNybzE0azBzbnJ0amEyemxlNWpuM3ZyN25mdHdlcHNmZ2NhOGZhNnJhbA==",
+                "index": true
+              },
+              {
+                "key": "Z3JhbnRlZQ==",
+                "value": "dGNybzF0ZzN6N3NmOTl1dTNtYTM0eGZzcGhyd21xbm0zazZ1dW12dnprdw==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "YWN0aW9u",
+                "value": "L2Nvc21vcy5mZWVncmFudC52MWJldGExLk1zZ0dyYW50QWxsb3dhbmNl",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "set_feegrant",
+            "attributes": [
+              {
+                "key": "Z3JhbnRlcg==",
+                "value": "dGNybzE0azBzbnJ0amEyemxlNWpuM3ZyN25mdHdlcHNmZ2NhOGZhNnJhbA==",
+                "index": true
+              },
+              {
+                "key": "Z3JhbnRlZQ==",
+                "value": "dGNybzFmZzRybHg4cmF5ZGs4ejdldmw3OTZ3MnQ5dnBtZ2FybGpqMHJscA==",
+                "index": true
+              }
+            ]
+          }
+        ],
+        "codespace": ""
+      }
+    ],
+    "begin_block_events": [
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "ODI1OTViYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coinbase",
+        "attributes": [
+          {
+            "key": "bWludGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "ODI1OTViYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "ODI1OTViYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "ODI1OTViYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "ODI1OTViYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "mint",
+        "attributes": [
+          {
+            "key": "Ym9uZGVkX3JhdGlv",
+            "value": "MC4wMDI0OTM3NjU1MzQ2NzAxODM=",
+            "index": true
+          },
+          {
+            "key": "aW5mbGF0aW9u",
+            "value": "MC4xMzAwMDAwNDEwNDExODY2MDU=",
+            "index": true
+          },
+          {
+            "key": "YW5udWFsX3Byb3Zpc2lvbnM=",
+            "value": "NTIxMzAwMTc1MzEyLjUxMTY3NTg0NjgwNzYzOTk3NQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "ODI1OTU=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTY1MTkwYmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTY1MTkwYmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTY1MTkwYmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "proposer_reward",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "ODI1OS41MDAwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxazgzeXk2eDJkd3o2cGEzMjU5bWZ3eTh5cWg0bDZud3l3engwdHo=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "commission",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "ODI1Ljk1MDAwMDAwMDAwMDAwMDAwMGJhc2V0Y3Jv",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxazgzeXk2eDJkd3o2cGEzMjU5bWZ3eTh5cWg0bDZud3l3engwdHo=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "rewards",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "ODI1OS41MDAwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxazgzeXk2eDJkd3o2cGEzMjU5bWZ3eTh5cWg0bDZud3l3engwdHo=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "commission",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "MTUzNjIuNjcwMDAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxazgzeXk2eDJkd3o2cGEzMjU5bWZ3eTh5cWg0bDZud3l3engwdHo=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "rewards",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "MTUzNjI2LjcwMDAwMDAwMDAwMDAwMDAwMGJhc2V0Y3Jv",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxazgzeXk2eDJkd3o2cGEzMjU5bWZ3eTh5cWg0bDZud3l3engwdHo=",
+            "index": true
+          }
+        ]
+      }
+    ],
+    "end_block_events": [],
+    "validator_updates": [],
+    "consensus_param_updates": {
+      "block": {
+        "max_bytes": "22020096",
+        "max_gas": "-1"
+      },
+      "evidence": {
+        "max_age_num_blocks": "100000",
+        "max_age_duration": "172800000000000",
+        "max_bytes": "1048576"
+      },
+      "validator": {
+        "pub_key_types": [
+          "ed25519"
+        ]
+      }
+    }
+  }
+}`
@@ -0,0 +1,404 @@
+package usecase_parser_test
+
+// Generated by delivering the transactions to the in-process chains of the IBC-Go v1.2.2 testing
+// package (Cosmos SDK v0.44.5 simapp), and encoding their responses as Tendermint v0.34 RPC.
+const TX_MSG_IBC_CHANNEL_CLOSE_CONFIRM_BLOCK_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "block_id": {
+      "hash": "E4C6C1C1AD522833D2F6437421BF018BEBC326B6DC101F384AE10547075C34F4",
+      "parts": {
+        "total": 1,
+        "hash": "47E6BA01EF600190EA941CABF303F0AE6FE2F9F1098066B073304FC5B865010E"
+      }
+    },
+    "block": {
+      "header": {
+        "version": {
+          "block": "11"
+        },
+        "chain_id": "testchain1",
+        "height": "17",
+        "time": "2020-01-02T00:02:45Z",
+        "last_block_id": {
+          "hash": "",
+          "parts": {
+            "total": 0,
+            "hash": ""
+          }
+        },
+        "last_commit_hash": "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855",
+        "data_hash": "F10C487829174CC9DA3C9123B55E55802BB2029FDBF85D6AE2292AA1111198B7",
+        "validators_hash": "672DBDDBDCB50D6ADEDA1DC34D9D25FAA802AAA5237610F56F03029C3C453BCF",
+        "next_validators_hash": "672DBDDBDCB50D6ADEDA1DC34D9D25FAA802AAA5237610F56F03029C3C453BCF",
+        "consensus_hash": "048091BC7DDC283F77BFBF91D73C44DA58C3DF8A9CBC867405D8B7F3DAADA22F",
+        "app_hash": "2DABEB76BB1F613B93727193648AA31453435DFE391E5D31635CB37757AB9A81",
+        "last_results_hash": "",
+        "evidence_hash": "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855",
+        "proposer_address": "981C062A879101DC640AD29F8ACF1B0B1E939912"
+      },
+      "data": {
+        "txs": [
+          "Cq0NCtwHCiMvaWJjLmNvcmUuY2xpZW50LnYxLk1zZ1VwZGF0ZUNsaWVudBK0BwoPMDctdGVuZGVybWludC0wEvMGCiYvaWJjLmxpZ2h0Y2xpZW50cy50ZW5kZXJtaW50LnYxLkhlYWRlchLIBgrFBAqNAwoECAsQAhIKdGVzdGNoYWluMBgTIgYIoOa08AUqSQogAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAASJQiQThIgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAyIJDwBDGemJV3SQ32GjK4XsP83dCYbJZkE7ql23cb2wbtOiBtbii4uYtTJwQupQpX3UbmzIUccuUoveqm797u/maguEIgFRZlqCvaB4dMG8bdHxi6xdt89HEGerfa1ujrwXFKyLVKIBUWZagr2geHTBvG3R8YusXbfPRxBnq32tbo68FxSsi1UiDl5WbEHtV+P/jMEPGEF4eIuPqmArB88fQlIXvYF58fJFogIXxGo31+/0DURX4cOcqW3SNPX2sk7oeQOCpdbXmCDCJiIAkuBYYwJH7WAJhjoS7uEX0mzZ0Ita3KqzfyqzXbR1o3aiBzhl2wj0nVhCiQXTiatMpLluRaMgbHpp1DpdxzcuYHFHIU8ccCmcG17VzyFNLXftRmECbqtwoSsgEIExABGkgKIFWzriet27m8NwqJrA/yPYXNFuyNnmoXAPcn914mO+VOEiQIAxIghwgKOc/jNqZj6lwNm97vSTq9wYt8Pj4MjmYTVMGDFDIiYggCEhTxxwKZwbXtXPIU0td+1GYQJuq3ChoGCKDmtPAFIkAQKQaekiuhbgUQkLc4mjmPbUBKsNhdEboTAV3GwkkP/bosEc72WCxJnWWTkMaePbXIEzyds6RB79lLrEasG7cBEnwKPAoU8ccCmcG17VzyFNLXftRmECbqtwoSIgogLUCc9Y+R8Wl7iRoKzxvYoBxC6kwhstcizDx7bw4NZq4YARI8ChTxxwKZwbXtXPIU0td+1GYQJuq3ChIiCiAtQJz1j5HxaXuJGgrPG9igHELqTCGy1yLMPHtvDg1mrhgBGgIQDyJ8CjwKFPHHApnBte1c8hTS137UZhAm6rcKEiIKIC1AnPWPkfFpe4kaCs8b2KAcQupMIbLXIsw8e28ODWauGAESPAoU8ccCmcG17VzyFNLXftRmECbqtwoSIgogLUCc9Y+R8Wl7iRoKzxvYoBxC6kwhstcizDx7bw4NZq4YARordGNybzF3Mnp2ZXIwd2c3dmhsNDVtZXV4d3RzNzNhMzA2cjM1eTV6eDI1YQrLBQorL2liYy5jb3JlLmNoYW5uZWwudjEuTXNnQ2hhbm5lbENsb3NlQ29uZmlybRKbBQoEbW9jaxIJY2hhbm5lbC0wGtYECvkCCvYCCiljaGFubmVsRW5kcy9wb3J0cy9tb2NrL2NoYW5uZWxzL2NoYW5uZWwtMBIuCAQQARoRCgRtb2NrEgljaGFubmVsLTAiDGNvbm5lY3Rpb24tMCoHaWNzMjAtMRoLCAEYASABKgMAAiIiKwgBEgQCBCIgGiEgpA7vK9oUo68t20hubvwQanEz3tLMMYw6Iy+bYDCbDi8iKwgBEgQEBiIgGiEggBk5lH5usQUgq7ScpcpoghPwlncq6653O173Gg3c/gMiKwgBEgQGDiIgGiEgC+6vkgGee7OcF9bnU2ca9bPvTcF9ZLKZyXBKVC4lv0AiKwgBEgQIGiIgGiEgK7x1Sa9002UqORAYX8LV0yGm36Q73ZKklMkyJ+aRv7IiKwgBEgQKJCIgGiEgCqjHFrR2mtDRSyFRvbRBv819PbfaontaA1OwwHQNDm0iKwgBEgQMPCIgGiEgVzBwjai7glysfFKRID7NPaNytrtXuW4De91nVn4avAMK1wEK1AEKA2liYxIgwG25ZQ3mLGURuka5yHtGIRDOC3t+pEuOyf7Gxiggp+EaCQgBGAEgASoBACInCAESAQEaIPlW3n8EGa9BCcwZdOHxNyMKvSuMufUnd17syhqIFw8iIicIARIBARogEzEk7iEswd8nNYzlPkrX5dv/p8KSy1sFB6oEDQfgKHYiJwgBEgEBGiBXR5yATj9UmrGGAkxFHiGdXbRcrjHQQTX66P8jMuRiyCIlCAESIQEvlCT3Rs+XzFKTVLfHlAhRSMbxUx1sdc/rVYq5qv6JCCICEBMqK3Rjcm8xdzJ6dmVyMHdnN3ZobDQ1bWV1eHd0czczYTMwNnIzNXk1engyNWESWQpQCkYKHy9jb3Ntb3MuY3J5cHRvLnNlY3AyNTZrMS5QdWJLZXkSIwohApizb0StaEpe5kEWqWB8mU0BdrWTFNmS8W6MjZXgGENmEgQKAggBGAkSBRDAlrECGkCxG0IqDPXc34joH3xsVYqAKO92oBTP/8XDorcmqlsDXgc6O8dBRPhtAKdOi4WTg+2Rzkrkt045DMCmJK4kenxp"
+        ]
+      },
+      "evidence": {
+        "evidence": null
+      },
+      "last_commit": {
+        "height": "0",
+        "round": 0,
+        "block_id": {
+          "hash": "",
+          "parts": {
+            "total": 0,
+            "hash": ""
+          }
+        },
+        "signatures": null
+      }
+    }
+  }
+}`
+
+const TX_MSG_IBC_CHANNEL_CLOSE_CONFIRM_BLOCK_RESULTS_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "height": "17",
+    "txs_results": [
+      {
+        "code": 0,
+        "data": "CiUKIy9pYmMuY29yZS5jbGllbnQudjEuTXNnVXBkYXRlQ2xpZW50Ci0KKy9pYmMuY29yZS5jaGFubmVsLnYxLk1zZ0NoYW5uZWxDbG9zZUNvbmZpcm0=",
+        "log": "[{\"events\":[{\"type\":\"message\",\"attributes\":[{\"key\":\"action\",\"value\":\"/ibc.core.client.v1.MsgUpdateClient\"},{\"key\":\"module\",\"value\":\"ibc_client\"}]},{\"type\":\"update_client\",\"attributes\":[{\"key\":\"client_id\",\"value\":\"07-tendermint-0\"},{\"key\":\"client_type\",\"value\":\"07-tendermint\"},{\"key\":\"consensus_height\",\"value\":\"0-19\"},{\"key\":\"header\",\"value\":\"0a262f6962632e6c69676874636c69656e74732e74656e6465726d696e742e76312e48656164657212c8060ac5040a8d030a04080b1002120a74657374636861696e301813220608a0e6b4f0052a490a200000000000000000000000000000000000000000000000000000000000000000122508904e12200000000000000000000000000000000000000000000000000000000000000000322090f004319e989577490df61a32b85ec3fcddd0986c966413baa5db771bdb06ed3a206d6e28b8b98b5327042ea50a57dd46e6cc851c72e528bdeaa6efdeeefe66a0b84220151665a82bda07874c1bc6dd1f18bac5db7cf471067ab7dad6e8ebc1714ac8b54a20151665a82bda07874c1bc6dd1f18bac5db7cf471067ab7dad6e8ebc1714ac8b55220e5e566c41ed57e3ff8cc10f184178788b8faa602b07cf1f425217bd8179f1f245a20217c46a37d7eff40d4457e1c39ca96dd234f5f6b24ee8790382a5d6d79820c226220092e058630247ed6009863a12eee117d26cd9d08b5adcaab37f2ab35db475a376a2073865db08f49d58428905d389ab4ca4b96e45a3206c7a69d43a5dc7372e607147214f1c70299c1b5ed5cf214d2d77ed4661026eab70a12b201081310011a480a2055b3ae27addbb9bc370a89ac0ff23d85cd16ec8d9e6a1700f727f75e263be54e12240803122087080a39cfe336a663ea5c0d9bdeef493abdc18b7c3e3e0c8e661354c1831432226208021214f1c70299c1b5ed5cf214d2d77ed4661026eab70a1a0608a0e6b4f00522401029069e922ba16e051090b7389a398f6d404ab0d85d11ba13015dc6c2490ffdba2c11cef6582c499d659390c69e3db5c8133c9db3a441efd94bac46ac1bb701127c0a3c0a14f1c70299c1b5ed5cf214d2d77ed4661026eab70a12220a202d409cf58f91f1697b891a0acf1bd8a01c42ea4c21b2d722cc3c7b6f0e0d66ae1801123c0a14f1c70299c1b5ed5cf214d2d77ed4661026eab70a12220a202d409cf58f91f1697b891a0acf1bd8a01c42ea4c21b2d722cc3c7b6f0e0d66ae18011a02100f227c0a3c0a14f1c70299c1b5ed5cf214d2d77ed4661026eab70a12220a202d409cf58f91f1697b891a0acf1bd8a01c42ea4c21b2d722cc3c7b6f0e0d66ae1801123c0a14f1c70299c1b5ed5cf214d2d77ed4661026eab70a12220a202d409cf58f91f1697b891a0acf1bd8a01c42ea4c21b2d722cc3c7b6f0e0d66ae1801\"}]}]},{\"msg_index\":1,\"events\":[{\"type\":\"channel_close_confirm\",\"attributes\":[{\"key\":\"port_id\",\"value\":\"mock\"},{\"key\":\"channel_id\",\"value\":\"channel-0\"},{\"key\":\"counterparty_port_id\",\"value\":\"mock\"},{\"key\":\"counterparty_channel_id\",\"value\":\"channel-0\"},{\"key\":\"connection_id\",\"value\":\"connection-0\"}]},{\"type\":\"message\",\"attributes\":[{\"key\":\"action\",\"value\":\"/ibc.core.channel.v1.MsgChannelCloseConfirm\"},{\"key\":\"module\",\"value\":\"ibc_channel\"}]}]}]",
+        "info": "",
+        "gas_wanted": "5000000",
+        "gas_used": "98847",
+        "events": [
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "ZmVl",
+                "value": "",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "YWNjX3NlcQ==",
+                "value": "dGNybzF3Mnp2ZXIwd2c3dmhsNDVtZXV4d3RzNzNhMzA2cjM1eTV6eDI1YS85",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "c2lnbmF0dXJl",
+                "value": "c1J0Q0tnejEzTitJNkI5OGJGV0tnQ2p2ZHFBVXovL0Z3NkszSnFwYkExNEhPanZIUVVUNGJRQ25Ub3VGazRQdGtjNUs1TGRPT1F6QXBpU3VKSHA4YVE9PQ==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "YWN0aW9u",
+                "value": "L2liYy5jb3JlLmNsaWVudC52MS5Nc2dVcGRhdGVDbGllbnQ=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "update_client",
+            "attributes": [
+              {
+                "key": "Y2xpZW50X2lk",
+                "value": "MDctdGVuZGVybWludC0w",
+                "index": true
+              },
+              {
+                "key": "Y2xpZW50X3R5cGU=",
+                "value": "MDctdGVuZGVybWludA==",
+                "index": true
+              },
+              {
+                "key": "Y29uc2Vuc3VzX2hlaWdodA==",
+                "value": "MC0xOQ==",
+                "index": true
+              },
+              {
+                "key": "aGVhZGVy",
+                "value": "MGEyNjJmNjk2MjYzMmU2YzY5Njc2ODc0NjM2YzY5NjU2ZTc0NzMyZTc0NjU2ZTY0NjU3MjZkNjk2ZTc0MmU3NjMxMmU0ODY1NjE2NDY1NzIxMmM4MDYwYWM1MDQwYThkMDMwYTA0MDgwYjEwMDIxMjBhNzQ2NTczNzQ2MzY4NjE2OTZlMzAxODEzMjIwNjA4YTBlNmI0ZjAwNTJhNDkwYTIwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDEyMjUwODkwNGUxMjIwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDMyMjA5MGYwMDQzMTllOTg5NTc3NDkwZGY2MWEzMmI4NWVjM2ZjZGRkMDk4NmM5NjY0MTNiYWE1ZGI3NzFiZGIwNmVkM2EyMDZkNmUyOGI4Yjk4YjUzMjcwNDJlYTUwYTU3ZGQ0NmU2Y2M4NTFjNzJlNTI4YmRlYWE2ZWZkZWVlZmU2NmEwYjg0MjIwMTUxNjY1YTgyYmRhMDc4NzRjMWJjNmRkMWYxOGJhYzVkYjdjZjQ3MTA2N2FiN2RhZDZlOGViYzE3MTRhYzhiNTRhMjAxNTE2NjVhODJiZGEwNzg3NGMxYmM2ZGQxZjE4YmFjNWRiN2NmNDcxMDY3YWI3ZGFkNmU4ZWJjMTcxNGFjOGI1NTIyMGU1ZTU2NmM0MWVkNTdlM2ZmOGNjMTBmMTg0MTc4Nzg4YjhmYWE2MDJiMDdjZjFmNDI1MjE3YmQ4MTc5ZjFmMjQ1YTIwMjE3YzQ2YTM3ZDdlZmY0MGQ0NDU3ZTFjMzljYTk2ZGQyMzRmNWY2YjI0ZWU4NzkwMzgyYTVkNmQ3OTgyMGMyMjYyMjAwOTJlMDU4NjMwMjQ3ZWQ2MDA5ODYzYTEyZWVlMTE3ZDI2Y2Q5ZDA4YjVhZGNhYWIzN2YyYWIzNWRiNDc1YTM3NmEyMDczODY1ZGIwOGY0OWQ1ODQyODkwNWQzODlhYjRjYTRiOTZlNDVhMzIwNmM3YTY5ZDQzYTVkYzczNzJlNjA3MTQ3MjE0ZjFjNzAyOTljMWI1ZWQ1Y2YyMTRkMmQ3N2VkNDY2MTAyNmVhYjcwYTEyYjIwMTA4MTMxMDAxMWE0ODBhMjA1NWIzYWUyN2FkZGJiOWJjMzcwYTg5YWMwZmYyM2Q4NWNkMTZlYzhkOWU2YTE3MDBmNzI3Zjc1ZTI2M2JlNTRlMTIyNDA4MDMxMjIwODcwODBhMzljZmUzMzZhNjYzZWE1YzBkOWJkZWVmNDkzYWJkYzE4YjdjM2UzZTBjOGU2NjEzNTRjMTgzMTQzMjIyNjIwODAyMTIxNGYxYzcwMjk5YzFiNWVkNWNmMjE0ZDJkNzdlZDQ2NjEwMjZlYWI3MGExYTA2MDhhMGU2YjRmMDA1MjI0MDEwMjkwNjllOTIyYmExNmUwNTEwOTBiNzM4OWEzOThmNmQ0MDRhYjBkODVkMTFiYTEzMDE1ZGM2YzI0OTBmZmRiYTJjMTFjZWY2NTgyYzQ5OWQ2NTkzOTBjNjllM2RiNWM4MTMzYzlkYjNhNDQxZWZkOTRiYWM0NmFjMWJiNzAxMTI3YzBhM2MwYTE0ZjFjNzAyOTljMWI1ZWQ1Y2YyMTRkMmQ3N2VkNDY2MTAyNmVhYjcwYTEyMjIwYTIwMmQ0MDljZjU4ZjkxZjE2OTdiODkxYTBhY2YxYmQ4YTAxYzQyZWE0YzIxYjJkNzIyY2MzYzdiNmYwZTBkNjZhZTE4MDExMjNjMGExNGYxYzcwMjk5YzFiNWVkNWNmMjE0ZDJkNzdlZDQ2NjEwMjZlYWI3MGExMjIyMGEyMDJkNDA5Y2Y1OGY5MWYxNjk3Yjg5MWEwYWNmMWJkOGEwMWM0MmVhNGMyMWIyZDcyMmNjM2M3YjZmMGUwZDY2YWUxODAxMWEwMjEwMGYyMjdjMGEzYzBhMTRmMWM3MDI5OWMxYjVlZDVjZjIxNGQyZDc3ZWQ0NjYxMDI2ZWFiNzBhMTIyMjBhMjAyZDQwOWNmNThmOTFmMTY5N2I4OTFhMGFjZjFiZDhhMDFjNDJlYTRjMjFiMmQ3MjJjYzNjN2I2ZjBlMGQ2NmFlMTgwMTEyM2MwYTE0ZjFjNzAyOTljMWI1ZWQ1Y2YyMTRkMmQ3N2VkNDY2MTAyNmVhYjcwYTEyMjIwYTIwMmQ0MDljZjU4ZjkxZjE2OTdiODkxYTBhY2YxYmQ4YTAxYzQyZWE0YzIxYjJkNzIyY2MzYzdiNmYwZTBkNjZhZTE4MDE=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "bW9kdWxl",
+                "value": "aWJjX2NsaWVudA==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "YWN0aW9u",
+                "value": "L2liYy5jb3JlLmNoYW5uZWwudjEuTXNnQ2hhbm5lbENsb3NlQ29uZmlybQ==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "channel_close_confirm",
+            "attributes": [
+              {
+                "key": "cG9ydF9pZA==",
+                "value": "bW9jaw==",
+                "index": true
+              },
+              {
+                "key": "Y2hhbm5lbF9pZA==",
+                "value": "Y2hhbm5lbC0w",
+                "index": true
+              },
+              {
+                "key": "Y291bnRlcnBhcnR5X3BvcnRfaWQ=",
+                "value": "bW9jaw==",
+                "index": true
+              },
+              {
+                "key": "Y291bnRlcnBhcnR5X2NoYW5uZWxfaWQ=",
+                "value": "Y2hhbm5lbC0w",
+                "index": true
+              },
+              {
+                "key": "Y29ubmVjdGlvbl9pZA==",
+                "value": "Y29ubmVjdGlvbi0w",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "bW9kdWxl",
+                "value": "aWJjX2NoYW5uZWw=",
+                "index": true
+              }
+            ]
+          }
+        ],
+        "codespace": ""
+      }
+    ],
+    "begin_block_events": [
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTc1MHN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coinbase",
+        "attributes": [
+          {
+            "key": "bWludGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTc1MHN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTc1MHN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTc1MHN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTc1MHN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "mint",
+        "attributes": [
+          {
+            "key": "Ym9uZGVkX3JhdGlv",
+            "value": "MC4wMDAwMDAwMDk5OTk5ODYzMDU=",
+            "index": true
+          },
+          {
+            "key": "aW5mbGF0aW9u",
+            "value": "MC4xMzAwMDEzODAwMTYyMDM3MjQ=",
+            "index": true
+          },
+          {
+            "key": "YW5udWFsX3Byb3Zpc2lvbnM=",
+            "value": "MTMwMDAxNTU4MDQzNTcuNjI1NTE2MDAxMzc0MzM2NTk2",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTc1MA==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTc1MHN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTc1MHN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTc1MHN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          }
+        ]
+      }
+    ],
+    "end_block_events": [],
+    "validator_updates": [],
+    "consensus_param_updates": {
+      "block": {
+        "max_bytes": "200000",
+        "max_gas": "2000000"
+      },
+      "evidence": {
+        "max_age_num_blocks": "302400",
+        "max_age_duration": "1814400000000000",
+        "max_bytes": "10000"
+      },
+      "validator": {
+        "pub_key_types": [
+          "ed25519"
+        ]
+      }
+    }
+  }
+}`
@@ -0,0 +1,665 @@
+package usecase_parser_test
+
+// Generated by delivering the transactions to the in-process chains of the IBC-Go v1.2.2 testing
+// package (Cosmos SDK v0.44.5 simapp), and encoding their responses as Tendermint v0.34 RPC.
+const TX_MSG_IBC_CHANNEL_CLOSE_INIT_BLOCK_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "block_id": {
+      "hash": "E70DAF6E8FDE84FA4ED0DAC4DD60C21A02A6ED9EC8F5C910AEB97A9F70FC2F43",
+      "parts": {
+        "total": 1,
+        "hash": "49FDAD6075848038501AF781E070BD40043990DEF942E24E608925541BD909A1"
+      }
+    },
+    "block": {
+      "header": {
+        "version": {
+          "block": "11"
+        },
+        "chain_id": "testchain0",
+        "height": "17",
+        "time": "2020-01-02T00:02:30Z",
+        "last_block_id": {
+          "hash": "",
+          "parts": {
+            "total": 0,
+            "hash": ""
+          }
+        },
+        "last_commit_hash": "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855",
+        "data_hash": "44A9594E66A689417630EDAFC551A41D4117356E39F6EEAE510CFC6EBD24854B",
+        "validators_hash": "151665A82BDA07874C1BC6DD1F18BAC5DB7CF471067AB7DAD6E8EBC1714AC8B5",
+        "next_validators_hash": "151665A82BDA07874C1BC6DD1F18BAC5DB7CF471067AB7DAD6E8EBC1714AC8B5",
+        "consensus_hash": "048091BC7DDC283F77BFBF91D73C44DA58C3DF8A9CBC867405D8B7F3DAADA22F",
+        "app_hash": "5E868F4711CD840F8028BC010832454B9A49DD08B341AAA028566C4B4C6D6E55",
+        "last_results_hash": "",
+        "evidence_hash": "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855",
+        "proposer_address": "F1C70299C1B5ED5CF214D2D77ED4661026EAB70A"
+      },
+      "data": {
+        "txs": [
+          "CmwKagooL2liYy5jb3JlLmNoYW5uZWwudjEuTXNnQ2hhbm5lbENsb3NlSW5pdBI+CgRtb2NrEgljaGFubmVsLTAaK3Rjcm8xMDBreGZqNzhjcGY5OHBodHM4bThhdzhkdnJxeW0zZ3VsNmFlcmMSWQpQCkYKHy9jb3Ntb3MuY3J5cHRvLnNlY3AyNTZrMS5QdWJLZXkSIwohA2OE9mIC2kUzCNRyghU/HRXRmXQh4dEK075ClVFsPaZ1EgQKAggBGAkSBRDAlrECGkCxyqerMkT2suD7h45TBDjIOXFbk3iG0RW7qXiLF4ZgWS8ZXgjo6nIvu+blWjDkLtXyuIrUhTDFgn8nt3ivLwRe"
+        ]
+      },
+      "evidence": {
+        "evidence": null
+      },
+      "last_commit": {
+        "height": "0",
+        "round": 0,
+        "block_id": {
+          "hash": "",
+          "parts": {
+            "total": 0,
+            "hash": ""
+          }
+        },
+        "signatures": null
+      }
+    }
+  }
+}`
+
+const TX_MSG_IBC_CHANNEL_CLOSE_INIT_BLOCK_RESULTS_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "height": "17",
+    "txs_results": [
+      {
+        "code": 0,
+        "data": "CioKKC9pYmMuY29yZS5jaGFubmVsLnYxLk1zZ0NoYW5uZWxDbG9zZUluaXQ=",
+        "log": "[{\"events\":[{\"type\":\"channel_close_init\",\"attributes\":[{\"key\":\"port_id\",\"value\":\"mock\"},{\"key\":\"channel_id\",\"value\":\"channel-0\"},{\"key\":\"counterparty_port_id\",\"value\":\"mock\"},{\"key\":\"counterparty_channel_id\",\"value\":\"channel-0\"},{\"key\":\"connection_id\",\"value\":\"connection-0\"}]},{\"type\":\"message\",\"attributes\":[{\"key\":\"action\",\"value\":\"/ibc.core.channel.v1.MsgChannelCloseInit\"},{\"key\":\"module\",\"value\":\"ibc_channel\"}]}]}]",
+        "info": "",
+        "gas_wanted": "5000000",
+        "gas_used": "48210",
+        "events": [
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "ZmVl",
+                "value": "",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "YWNjX3NlcQ==",
+                "value": "dGNybzEwMGt4Zmo3OGNwZjk4cGh0czhtOGF3OGR2cnF5bTNndWw2YWVyYy85",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "c2lnbmF0dXJl",
+                "value": "c2NxbnF6SkU5ckxnKzRlT1V3UTR5RGx4VzVONGh0RVZ1Nmw0aXhlR1lGa3ZHVjRJNk9weUw3dm01Vm93NUM3VjhyaUsxSVV3eFlKL0o3ZDRyeThFWGc9PQ==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "YWN0aW9u",
+                "value": "L2liYy5jb3JlLmNoYW5uZWwudjEuTXNnQ2hhbm5lbENsb3NlSW5pdA==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "channel_close_init",
+            "attributes": [
+              {
+                "key": "cG9ydF9pZA==",
+                "value": "bW9jaw==",
+                "index": true
+              },
+              {
+                "key": "Y2hhbm5lbF9pZA==",
+                "value": "Y2hhbm5lbC0w",
+                "index": true
+              },
+              {
+                "key": "Y291bnRlcnBhcnR5X3BvcnRfaWQ=",
+                "value": "bW9jaw==",
+                "index": true
+              },
+              {
+                "key": "Y291bnRlcnBhcnR5X2NoYW5uZWxfaWQ=",
+                "value": "Y2hhbm5lbC0w",
+                "index": true
+              },
+              {
+                "key": "Y29ubmVjdGlvbl9pZA==",
+                "value": "Y29ubmVjdGlvbi0w",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "bW9kdWxl",
+                "value": "aWJjX2NoYW5uZWw=",
+                "index": true
+              }
+            ]
+          }
+        ],
+        "codespace": ""
+      }
+    ],
+    "begin_block_events": [
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTc0OXN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coinbase",
+        "attributes": [
+          {
+            "key": "bWludGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTc0OXN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTc0OXN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTc0OXN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTc0OXN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "mint",
+        "attributes": [
+          {
+            "key": "Ym9uZGVkX3JhdGlv",
+            "value": "MC4wMDAwMDAwMDk5OTk5ODY5MjM=",
+            "index": true
+          },
+          {
+            "key": "aW5mbGF0aW9u",
+            "value": "MC4xMzAwMDEzMTgyMjQ0MzM0MDg=",
+            "index": true
+          },
+          {
+            "key": "YW5udWFsX3Byb3Zpc2lvbnM=",
+            "value": "MTMwMDAxNDg4MjE4NjEuNzQ2MzQ5NzQwNDYxMTAyODQ4",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTc0OQ==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTc0OXN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTc0OXN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTc0OXN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          }
+        ]
+      }
+    ],
+    "end_block_events": [],
+    "validator_updates": [],
+    "consensus_param_updates": {
+      "block": {
+        "max_bytes": "200000",
+        "max_gas": "2000000"
+      },
+      "evidence": {
+        "max_age_num_blocks": "302400",
+        "max_age_duration": "1814400000000000",
+        "max_bytes": "10000"
+      },
+      "validator": {
+        "pub_key_types": [
+          "ed25519"
+        ]
+      }
+    }
+  }
+}`
+
+const TX_FAILED_MSG_IBC_CHANNEL_CLOSE_INIT_BLOCK_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "block_id": {
+      "hash": "4F074363AFBC73A9A333F633E9A29380813ED4E3C0322E5E759648227FDFB874",
+      "parts": {
+        "total": 1,
+        "hash": "F479B99089D1ACAA188C7E7AEC662A31CEC0AA282A8782C489592B5EFBA5AEF9"
+      }
+    },
+    "block": {
+      "header": {
+        "version": {
+          "block": "11"
+        },
+        "chain_id": "testchain0",
+        "height": "18",
+        "time": "2020-01-02T00:02:35Z",
+        "last_block_id": {
+          "hash": "",
+          "parts": {
+            "total": 0,
+            "hash": ""
+          }
+        },
+        "last_commit_hash": "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855",
+        "data_hash": "1E38332D97C4549AB075F59B7A4D0A8C7302DC5344F6CC3777A197502E2EDDD7",
+        "validators_hash": "151665A82BDA07874C1BC6DD1F18BAC5DB7CF471067AB7DAD6E8EBC1714AC8B5",
+        "next_validators_hash": "151665A82BDA07874C1BC6DD1F18BAC5DB7CF471067AB7DAD6E8EBC1714AC8B5",
+        "consensus_hash": "048091BC7DDC283F77BFBF91D73C44DA58C3DF8A9CBC867405D8B7F3DAADA22F",
+        "app_hash": "E6612115E20C733377B5F32D15B10DB74E17FCB449AD85F39EB3FD67FE595A9D",
+        "last_results_hash": "",
+        "evidence_hash": "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855",
+        "proposer_address": "F1C70299C1B5ED5CF214D2D77ED4661026EAB70A"
+      },
+      "data": {
+        "txs": [
+          "CmwKagooL2liYy5jb3JlLmNoYW5uZWwudjEuTXNnQ2hhbm5lbENsb3NlSW5pdBI+CgRtb2NrEgljaGFubmVsLTAaK3Rjcm8xMDBreGZqNzhjcGY5OHBodHM4bThhdzhkdnJxeW0zZ3VsNmFlcmMSWQpQCkYKHy9jb3Ntb3MuY3J5cHRvLnNlY3AyNTZrMS5QdWJLZXkSIwohA2OE9mIC2kUzCNRyghU/HRXRmXQh4dEK075ClVFsPaZ1EgQKAggBGAoSBRDAlrECGkDHHdxceHwB1FPXhY9AvpxRoZcqr6JdvDWGGUz3huyysX0vW8u4BPoLt1JeiqlidTxMpgcxS7FQD7byrsKv8oBC"
+        ]
+      },
+      "evidence": {
+        "evidence": null
+      },
+      "last_commit": {
+        "height": "0",
+        "round": 0,
+        "block_id": {
+          "hash": "",
+          "parts": {
+            "total": 0,
+            "hash": ""
+          }
+        },
+        "signatures": null
+      }
+    }
+  }
+}`
+
+const TX_FAILED_MSG_IBC_CHANNEL_CLOSE_INIT_BLOCK_RESULTS_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "height": "18",
+    "txs_results": [
+      {
+        "code": 5,
+        "data": null,
+        "log": "failed to execute message; message index: 0: channel handshake close init failed: channel is already CLOSED: invalid channel state",
+        "info": "",
+        "gas_wanted": "5000000",
+        "gas_used": "43542",
+        "events": [
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "ZmVl",
+                "value": "",
+                "index": false
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "YWNjX3NlcQ==",
+                "value": "dGNybzEwMGt4Zmo3OGNwZjk4cGh0czhtOGF3OGR2cnF5bTNndWw2YWVyYy8xMA==",
+                "index": false
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "c2lnbmF0dXJl",
+                "value": "eHgzY1hIaDhBZFJUMTRXUFFMNmNVYUdYS3EraVhidzFoaGxNOTRic3NyRjlMMXZMdUFUNkM3ZFNYb3FwWW5VOFRLWUhNVXV4VUErMjhxN0NyL0tBUWc9PQ==",
+                "index": false
+              }
+            ]
+          }
+        ],
+        "codespace": "channel"
+      }
+    ],
+    "begin_block_events": [
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTc1MHN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coinbase",
+        "attributes": [
+          {
+            "key": "bWludGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTc1MHN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTc1MHN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTc1MHN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTc1MHN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "mint",
+        "attributes": [
+          {
+            "key": "Ym9uZGVkX3JhdGlv",
+            "value": "MC4wMDAwMDAwMDk5OTk5ODYwOTk=",
+            "index": true
+          },
+          {
+            "key": "aW5mbGF0aW9u",
+            "value": "MC4xMzAwMDE0MDA2MTM0NjA0OTY=",
+            "index": true
+          },
+          {
+            "key": "YW5udWFsX3Byb3Zpc2lvbnM=",
+            "value": "MTMwMDAxNTgxMzE4NTYuNTA4MjczMDk5MDM5NTQ0Nzg0",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTc1MA==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTc1MHN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTc1MHN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTc1MHN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          }
+        ]
+      }
+    ],
+    "end_block_events": [],
+    "validator_updates": [],
+    "consensus_param_updates": {
+      "block": {
+        "max_bytes": "200000",
+        "max_gas": "2000000"
+      },
+      "evidence": {
+        "max_age_num_blocks": "302400",
+        "max_age_duration": "1814400000000000",
+        "max_bytes": "10000"
+      },
+      "validator": {
+        "pub_key_types": [
+          "ed25519"
+        ]
+      }
+    }
+  }
+}`
@@ -0,0 +1,334 @@
+package usecase_parser_test
+
+// Generated by delivering the transactions to the in-process chains of the IBC-Go v1.2.2 testing
+// package (Cosmos SDK v0.44.5 simapp), and encoding their responses as Tendermint v0.34 RPC.
+const TX_MSG_IBC_SUBMIT_MISBEHAVIOUR_BLOCK_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "block_id": {
+      "hash": "52C005300BD776D771C7BCEFA9F437CE59E0C3C9A72386D822A9DE9D01DB0C43",
+      "parts": {
+        "total": 1,
+        "hash": "25393FED5892BFB1E298F1E3AE459ABECE3E00E7323201C705DCDC502250EBB5"
+      }
+    },
+    "block": {
+      "header": {
+        "version": {
+          "block": "11"
+        },
+        "chain_id": "testchain0",
+        "height": "5",
+        "time": "2020-01-02T00:00:30Z",
+        "last_block_id": {
+          "hash": "",
+          "parts": {
+            "total": 0,
+            "hash": ""
+          }
+        },
+        "last_commit_hash": "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855",
+        "data_hash": "4E2C674A7267BECC441007CD7145BFE2BF5178182E4419045D2F9C9BBF79FC66",
+        "validators_hash": "1F87A159260D2195E0431D9824ADF5B3D72814C0E58F1AFDF23FE9B7BEBDC6FF",
+        "next_validators_hash": "1F87A159260D2195E0431D9824ADF5B3D72814C0E58F1AFDF23FE9B7BEBDC6FF",
+        "consensus_hash": "048091BC7DDC283F77BFBF91D73C44DA58C3DF8A9CBC867405D8B7F3DAADA22F",
+        "app_hash": "99BBCB7618F4EA086FCE25BE570936ACB9233656BC721395A71A88CBBE6777FA",
+        "last_results_hash": "",
+        "evidence_hash": "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855",
+        "proposer_address": "C7023FCCACA2521A308F7CD337DBB3EFD629BB4B"
+      },
+      "data": {
+        "txs": [
+          "CsoOCscOCikvaWJjLmNvcmUuY2xpZW50LnYxLk1zZ1N1Ym1pdE1pc2JlaGF2aW91chKZDgoPMDctdGVuZGVybWludC0wEtgNCiwvaWJjLmxpZ2h0Y2xpZW50cy50ZW5kZXJtaW50LnYxLk1pc2JlaGF2aW91chKnDQoPMDctdGVuZGVybWludC0wEsgGCsUECo0DCgQICxACEgp0ZXN0Y2hhaW4xGAQiBgja5bTwBSpJCiAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABIlCJBOEiAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAADIggy4q+0evhVmjaV2ql/mcdyc0D8KhKEM9thR0V+Q4WOI6IG1uKLi5i1MnBC6lClfdRubMhRxy5Si96qbv3u7+ZqC4QiCWWxD2pVJMlfmQI4kY0YpRNf5gnCt2qM/6G7lfxez0WEogllsQ9qVSTJX5kCOJGNGKUTX+YJwrdqjP+hu5X8Xs9FhSIOXlZsQe1X4/+MwQ8YQXh4i4+qYCsHzx9CUhe9gXnx8kWiCDLir7R6+FWaNpXaqX+Zx3JzQPwqEoQz22FHRX5DhY4mIgCS4FhjAkftYAmGOhLu4RfSbNnQi1rcqrN/KrNdtHWjdqIHOGXbCPSdWEKJBdOJq0ykuW5FoyBsemnUOl3HNy5gcUchRT5w2oQiVLn1e5ir5D0/dUMX9m/xKyAQgEEAEaSAogoNMZQPsLPvBERMoYLXs5qp+pAa4SPnT2b/BaZJEPkbISJAgDEiCHCAo5z+M2pmPqXA2b3u9JOr3Bi3w+PgyOZhNUwYMUMiJiCAISFFPnDahCJUufV7mKvkPT91Qxf2b/GgYI2uW08AUiQP+daH3jfaf1Ce26LrQiDZeTAQq+ZiA80lD2yy0XpygNN1VylKB5FULNviyA6gWwnevN6Ol98fujiAE7aDxMyQsSfAo8ChRT5w2oQiVLn1e5ir5D0/dUMX9m/xIiCiClS7DnOo2B582I9sitj5AVeqnxq1z8Tz/5RhKJ0hcToxgBEjwKFFPnDahCJUufV7mKvkPT91Qxf2b/EiIKIKVLsOc6jYHnzYj2yK2PkBV6qfGrXPxPP/lGEonSFxOjGAEaAhADInwKPAoUU+cNqEIlS59XuYq+Q9P3VDF/Zv8SIgogpUuw5zqNgefNiPbIrY+QFXqp8atc/E8/+UYSidIXE6MYARI8ChRT5w2oQiVLn1e5ir5D0/dUMX9m/xIiCiClS7DnOo2B582I9sitj5AVeqnxq1z8Tz/5RhKJ0hcToxgBGsgGCsUECo0DCgQICxACEgp0ZXN0Y2hhaW4xGAQiBgie5bTwBSpJCiAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABIlCJBOEiAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAADIggy4q+0evhVmjaV2ql/mcdyc0D8KhKEM9thR0V+Q4WOI6IG1uKLi5i1MnBC6lClfdRubMhRxy5Si96qbv3u7+ZqC4QiCWWxD2pVJMlfmQI4kY0YpRNf5gnCt2qM/6G7lfxez0WEogllsQ9qVSTJX5kCOJGNGKUTX+YJwrdqjP+hu5X8Xs9FhSIOXlZsQe1X4/+MwQ8YQXh4i4+qYCsHzx9CUhe9gXnx8kWiCDLir7R6+FWaNpXaqX+Zx3JzQPwqEoQz22FHRX5DhY4mIgCS4FhjAkftYAmGOhLu4RfSbNnQi1rcqrN/KrNdtHWjdqIHOGXbCPSdWEKJBdOJq0ykuW5FoyBsemnUOl3HNy5gcUchRT5w2oQiVLn1e5ir5D0/dUMX9m/xKyAQgEEAEaSAog5m36b2qBStSoxEwiA0oykoeZXrx0Rl3JbxX2uRqH7fsSJAgDEiCHCAo5z+M2pmPqXA2b3u9JOr3Bi3w+PgyOZhNUwYMUMiJiCAISFFPnDahCJUufV7mKvkPT91Qxf2b/GgYInuW08AUiQAfAWpH+YM6F7Z/H69DcTg9wsHQCG892gLYKsNeYg5+lfYYf+0i1GFMu9IYr1hK1CmWgfuH/79EzD3WWhwIe4QwSfAo8ChRT5w2oQiVLn1e5ir5D0/dUMX9m/xIiCiClS7DnOo2B582I9sitj5AVeqnxq1z8Tz/5RhKJ0hcToxgBEjwKFFPnDahCJUufV7mKvkPT91Qxf2b/EiIKIKVLsOc6jYHnzYj2yK2PkBV6qfGrXPxPP/lGEonSFxOjGAEaAhADInwKPAoUU+cNqEIlS59XuYq+Q9P3VDF/Zv8SIgogpUuw5zqNgefNiPbIrY+QFXqp8atc/E8/+UYSidIXE6MYARI8ChRT5w2oQiVLn1e5ir5D0/dUMX9m/xIiCiClS7DnOo2B582I9sitj5AVeqnxq1z8Tz/5RhKJ0hcToxgBGit0Y3JvMXF3d2Y3NGo2bDAzM3h6aHl2cHFsbHdrNGhnaDgwZ3cycWpnNWNmElkKUApGCh8vY29zbW9zLmNyeXB0by5zZWNwMjU2azEuUHViS2V5EiMKIQOcLIQJDzrqEdod7WwRwLxXPWhlfUzN0WFLN12bnbIIHxIECgIIARgBEgUQwJaxAhpAGZVwAGObaK5B4vuC+zSG9i+ZoX5pl39pxzBRJMPeBdlHH2KGsk9Cs+/NSRTMSIcJZVeuUnJSRVHfgr6RDEMJWA=="
+        ]
+      },
+      "evidence": {
+        "evidence": null
+      },
+      "last_commit": {
+        "height": "0",
+        "round": 0,
+        "block_id": {
+          "hash": "",
+          "parts": {
+            "total": 0,
+            "hash": ""
+          }
+        },
+        "signatures": null
+      }
+    }
+  }
+}`
+
+const TX_MSG_IBC_SUBMIT_MISBEHAVIOUR_BLOCK_RESULTS_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "height": "5",
+    "txs_results": [
+      {
+        "code": 0,
+        "data": "CisKKS9pYmMuY29yZS5jbGllbnQudjEuTXNnU3VibWl0TWlzYmVoYXZpb3Vy",
+        "log": "[{\"events\":[{\"type\":\"client_misbehaviour\",\"attributes\":[{\"key\":\"client_id\",\"value\":\"07-tendermint-0\"},{\"key\":\"client_type\",\"value\":\"07-tendermint\"}]},{\"type\":\"message\",\"attributes\":[{\"key\":\"action\",\"value\":\"/ibc.core.client.v1.MsgSubmitMisbehaviour\"}]}]}]",
+        "info": "",
+        "gas_wanted": "5000000",
+        "gas_used": "68267",
+        "events": [
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "ZmVl",
+                "value": "",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "YWNjX3NlcQ==",
+                "value": "dGNybzFxd3dmNzRqNmwwMzN4emh5dnBxbGx3azRoZ2g4MGd3MnFqZzVjZi8x",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "c2lnbmF0dXJl",
+                "value": "R1pWd0FHT2JhSzVCNHZ1Qyt6U0c5aStab1g1cGwzOXB4ekJSSk1QZUJkbEhIMktHc2s5Q3MrL05TUlRNU0ljSlpWZXVVbkpTUlZIZmdyNlJERU1KV0E9PQ==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "YWN0aW9u",
+                "value": "L2liYy5jb3JlLmNsaWVudC52MS5Nc2dTdWJtaXRNaXNiZWhhdmlvdXI=",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "client_misbehaviour",
+            "attributes": [
+              {
+                "key": "Y2xpZW50X2lk",
+                "value": "MDctdGVuZGVybWludC0w",
+                "index": true
+              },
+              {
+                "key": "Y2xpZW50X3R5cGU=",
+                "value": "MDctdGVuZGVybWludA==",
+                "index": true
+              }
+            ]
+          }
+        ],
+        "codespace": ""
+      }
+    ],
+    "begin_block_events": [
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTczMHN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coinbase",
+        "attributes": [
+          {
+            "key": "bWludGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTczMHN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTczMHN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTczMHN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTczMHN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "mint",
+        "attributes": [
+          {
+            "key": "Ym9uZGVkX3JhdGlv",
+            "value": "MC4wMDAwMDAwMDk5OTk5OTc2MzQ=",
+            "index": true
+          },
+          {
+            "key": "aW5mbGF0aW9u",
+            "value": "MC4xMzAwMDAyNDcxNjcwODEyNjQ=",
+            "index": true
+          },
+          {
+            "key": "YW5udWFsX3Byb3Zpc2lvbnM=",
+            "value": "MTMwMDAwMjc3OTIxMjQuMjMzNjMyMTM1Nzk2NjEwNTI4",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTczMA==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTczMHN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTczMHN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTczMHN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          }
+        ]
+      }
+    ],
+    "end_block_events": [],
+    "validator_updates": [],
+    "consensus_param_updates": {
+      "block": {
+        "max_bytes": "200000",
+        "max_gas": "2000000"
+      },
+      "evidence": {
+        "max_age_num_blocks": "302400",
+        "max_age_duration": "1814400000000000",
+        "max_bytes": "10000"
+      },
+      "validator": {
+        "pub_key_types": [
+          "ed25519"
+        ]
+      }
+    }
+  }
+}`
@@ -0,0 +1,349 @@
+package usecase_parser_test
+
+// Generated by delivering the transactions to the in-process chains of the IBC-Go v1.2.2 testing
+// package (Cosmos SDK v0.44.5 simapp), and encoding their responses as Tendermint v0.34 RPC.
+const TX_MSG_IBC_UPGRADE_CLIENT_BLOCK_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "block_id": {
+      "hash": "FC6712FF9628919CA69A6C3F9BBE2458168709E617906AAC2149D10295ACB0EA",
+      "parts": {
+        "total": 1,
+        "hash": "5F6DAE06846DABD0AA8353B49C4E05CE41C525AB09B4F64E40EE37D0EB75742E"
+      }
+    },
+    "block": {
+      "header": {
+        "version": {
+          "block": "11"
+        },
+        "chain_id": "testchain0",
+        "height": "6",
+        "time": "2020-01-02T00:00:45Z",
+        "last_block_id": {
+          "hash": "",
+          "parts": {
+            "total": 0,
+            "hash": ""
+          }
+        },
+        "last_commit_hash": "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855",
+        "data_hash": "1DCB19BD97DA36294F34308968B902BC240449555E942BB81BCF6CCCB1ECAE18",
+        "validators_hash": "DE1536D60F0551E97B7B3D637C441F8C0F1D8AC2981489D68B2BE5751B4987DD",
+        "next_validators_hash": "DE1536D60F0551E97B7B3D637C441F8C0F1D8AC2981489D68B2BE5751B4987DD",
+        "consensus_hash": "048091BC7DDC283F77BFBF91D73C44DA58C3DF8A9CBC867405D8B7F3DAADA22F",
+        "app_hash": "DD94844215298F60EF1A23DE9CE5435FC610D4363D3C479AF48E93A3D45FE523",
+        "last_results_hash": "",
+        "evidence_hash": "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855",
+        "proposer_address": "5CE3C7A6AD755E1AE231684CBEA7138629DF1D6A"
+      },
+      "data": {
+        "txs": [
+          "CvEMCu4MCiQvaWJjLmNvcmUuY2xpZW50LnYxLk1zZ1VwZ3JhZGVDbGllbnQSxQwKDzA3LXRlbmRlcm1pbnQtMBKjAQorL2liYy5saWdodGNsaWVudHMudGVuZGVybWludC52MS5DbGllbnRTdGF0ZRJ0Cgx0ZXN0Y2hhaW4xLTESABoAIgUIgMm4ASoAMgA6BAgBEAFCGQoJCAEYASABKgEAEgwKAgABECEYBCAMMAFCGQoJCAEYASABKgEAEgwKAgABECAYASABMAFKB3VwZ3JhZGVKEHVwZ3JhZGVkSUJDU3RhdGUacQouL2liYy5saWdodGNsaWVudHMudGVuZGVybWludC52MS5Db25zZW5zdXNTdGF0ZRI/CgYInuW08AUSEwoRdXBncmFkZWQgYXBwIGhhc2gaIJs+ilYqNwTT8T1gBhLfiBZfb0+cJjcVtx+U9rmrg0wFIo0FCrIDCq8DCiF1cGdyYWRlZElCQ1N0YXRlLzYvdXBncmFkZWRDbGllbnQSowEKKy9pYmMubGlnaHRjbGllbnRzLnRlbmRlcm1pbnQudjEuQ2xpZW50U3RhdGUSdAoMdGVzdGNoYWluMS0xEgAaACIFCIDJuAEqADIAOgQIARABQhkKCQgBGAEgASoBABIMCgIAARAhGAQgDDABQhkKCQgBGAEgASoBABIMCgIAARAgGAEgATABSgd1cGdyYWRlShB1cGdyYWRlZElCQ1N0YXRlGgsIARgBIAEqAwACCiIrCAESBAIECiAaISBGtKgG8HbVDv17g1TG/MSxwPjBzakZ8UrxQLWZNn/sbyIpCAESJQQGCiDvK1HOpDuEvfVhpPSKpBxd62dA5ZonXDPJWCm+WYH6VyAiKQgBEiUGCgogz3wSf+J2ihhXqmhb1yBPE0UJOyXGeVvrpZx5bJVM/ysgIikIARIlCBoKIBVZdI9SLpqPT+yGlYt9nFH2zx4psJ+bw4/4qG0NUW7RICIpCAESJQoqCiAjyIzBfZp2Xgu5vj3BrGvBjuGqck7t8UE0ZAnJdCntniAK1QEK0gEKB3VwZ3JhZGUSIAaRjyhKPdjHuFJLNCOXz8zkY5rSYUCMouvtIv6ujN0JGgkIARgBIAEqAQAiJQgBEiEBOqsHULjzmZkig3Kxczq2JoCMuiq6iXWpKHea7ZB9gWAiJQgBEiEBbxuktfrnOskC6a9K2Ca/ro2B/YSa61lU7MBs08Qcu4QiJQgBEiEBfxATCcpm/642rVC84sQCR8aEJGCB/HyYIQw3o0x601siJQgBEiEB5QaUbM+5Yuv1JnLRoPFurgX56c+ryRNUwbGptU7onkwq2wQKgAMK/QIKJHVwZ3JhZGVkSUJDU3RhdGUvNi91cGdyYWRlZENvbnNTdGF0ZRJxCi4vaWJjLmxpZ2h0Y2xpZW50cy50ZW5kZXJtaW50LnYxLkNvbnNlbnN1c1N0YXRlEj8KBgie5bTwBRITChF1cGdyYWRlZCBhcHAgaGFzaBogmz6KVio3BNPxPWAGEt+IFl9vT5wmNxW3H5T2uauDTAUaCwgBGAEgASoDAAIKIikIARIlAgQKIHwUNJAOGvtfIM2zL3oaGd+v693FeJZweFwvIFvI/wWAICIpCAESJQQGCiDvK1HOpDuEvfVhpPSKpBxd62dA5ZonXDPJWCm+WYH6VyAiKQgBEiUGCgogz3wSf+J2ihhXqmhb1yBPE0UJOyXGeVvrpZx5bJVM/ysgIikIARIlCBoKIBVZdI9SLpqPT+yGlYt9nFH2zx4psJ+bw4/4qG0NUW7RICIpCAESJQoqCiAjyIzBfZp2Xgu5vj3BrGvBjuGqck7t8UE0ZAnJdCntniAK1QEK0gEKB3VwZ3JhZGUSIAaRjyhKPdjHuFJLNCOXz8zkY5rSYUCMouvtIv6ujN0JGgkIARgBIAEqAQAiJQgBEiEBOqsHULjzmZkig3Kxczq2JoCMuiq6iXWpKHea7ZB9gWAiJQgBEiEBbxuktfrnOskC6a9K2Ca/ro2B/YSa61lU7MBs08Qcu4QiJQgBEiEBfxATCcpm/642rVC84sQCR8aEJGCB/HyYIQw3o0x601siJQgBEiEB5QaUbM+5Yuv1JnLRoPFurgX56c+ryRNUwbGptU7onkwyK3Rjcm8xcHZmdWtyNHI1ODhtMjc1cXRmajhzZXN2aDdmd3NwczJuN3NnZjMSWQpQCkYKHy9jb3Ntb3MuY3J5cHRvLnNlY3AyNTZrMS5QdWJLZXkSIwohA86lhPouSSde1HHzHWpOTm5gibnTIFBdVWUUp0npSILEEgQKAggBGAISBRDAlrECGkB8cQKkhxpH1O47U8dSxhRospO5+25Mupk0z7zgLVjApkIx3kFUqp6Oy8eFHgN2s1+QxvES7dP0JXjD2bUan5gf"
+        ]
+      },
+      "evidence": {
+        "evidence": null
+      },
+      "last_commit": {
+        "height": "0",
+        "round": 0,
+        "block_id": {
+          "hash": "",
+          "parts": {
+            "total": 0,
+            "hash": ""
+          }
+        },
+        "signatures": null
+      }
+    }
+  }
+}`
+
+const TX_MSG_IBC_UPGRADE_CLIENT_BLOCK_RESULTS_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "height": "6",
+    "txs_results": [
+      {
+        "code": 0,
+        "data": "CiYKJC9pYmMuY29yZS5jbGllbnQudjEuTXNnVXBncmFkZUNsaWVudA==",
+        "log": "[{\"events\":[{\"type\":\"message\",\"attributes\":[{\"key\":\"action\",\"value\":\"/ibc.core.client.v1.MsgUpgradeClient\"},{\"key\":\"module\",\"value\":\"ibc_client\"}]},{\"type\":\"upgrade_client\",\"attributes\":[{\"key\":\"client_id\",\"value\":\"07-tendermint-0\"},{\"key\":\"client_type\",\"value\":\"07-tendermint\"},{\"key\":\"consensus_height\",\"value\":\"1-1\"}]}]}]",
+        "info": "",
+        "gas_wanted": "5000000",
+        "gas_used": "76973",
+        "events": [
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "ZmVl",
+                "value": "",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "YWNjX3NlcQ==",
+                "value": "dGNybzFwdmZ1a3I0cjU4OG0yNzVxdGZqOHNlc3ZoN2Z3c3BzMm43c2dmMy8y",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "c2lnbmF0dXJl",
+                "value": "ZkhFQ3BJY2FSOVR1TzFQSFVzWVVhTEtUdWZ0dVRMcVpOTSs4NEMxWXdLWkNNZDVCVktxZWpzdkhoUjREZHJOZmtNYnhFdTNUOUNWNHc5bTFHcCtZSHc9PQ==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "YWN0aW9u",
+                "value": "L2liYy5jb3JlLmNsaWVudC52MS5Nc2dVcGdyYWRlQ2xpZW50",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "upgrade_client",
+            "attributes": [
+              {
+                "key": "Y2xpZW50X2lk",
+                "value": "MDctdGVuZGVybWludC0w",
+                "index": true
+              },
+              {
+                "key": "Y2xpZW50X3R5cGU=",
+                "value": "MDctdGVuZGVybWludA==",
+                "index": true
+              },
+              {
+                "key": "Y29uc2Vuc3VzX2hlaWdodA==",
+                "value": "MS0x",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "bW9kdWxl",
+                "value": "aWJjX2NsaWVudA==",
+                "index": true
+              }
+            ]
+          }
+        ],
+        "codespace": ""
+      }
+    ],
+    "begin_block_events": [
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTczMnN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coinbase",
+        "attributes": [
+          {
+            "key": "bWludGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTczMnN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTczMnN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTczMnN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTczMnN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "mint",
+        "attributes": [
+          {
+            "key": "Ym9uZGVkX3JhdGlv",
+            "value": "MC4wMDAwMDAwMDk5OTk5OTYzOTg=",
+            "index": true
+          },
+          {
+            "key": "aW5mbGF0aW9u",
+            "value": "MC4xMzAwMDAzNzA3NTA2MjE4OTY=",
+            "index": true
+          },
+          {
+            "key": "YW5udWFsX3Byb3Zpc2lvbnM=",
+            "value": "MTMwMDAwNDE3NTcwNzUuNTkyMzI2Mzg2NTczODY5OTYw",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTczMg==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTczMnN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTczMnN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjA1OTczMnN0YWtl",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          }
+        ]
+      }
+    ],
+    "end_block_events": [],
+    "validator_updates": [],
+    "consensus_param_updates": {
+      "block": {
+        "max_bytes": "200000",
+        "max_gas": "2000000"
+      },
+      "evidence": {
+        "max_age_num_blocks": "302400",
+        "max_age_duration": "1814400000000000",
+        "max_bytes": "10000"
+      },
+      "validator": {
+        "pub_key_types": [
+          "ed25519"
+        ]
+      }
+    }
+  }
+}`
@@ -0,0 +1,517 @@
+package usecase_parser_test
+
+// Generated by delivering the transactions to an in-process Cosmos SDK v0.44.5 simapp with the
+// Crypto.org Chain address prefixes and denom, and encoding its responses as Tendermint v0.34 RPC.
+const TX_MSG_REVOKE_BLOCK_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "block_id": {
+      "hash": "E57ABBC8483806A2184BE40B033406CC531F2EC4657D1D6C54555D018A0AF262",
+      "parts": {
+        "total": 1,
+        "hash": "883980CBC6F718E3F512B9A900791494EF0A633F75D60BB0802F91E420EF80D1"
+      }
+    },
+    "block": {
+      "header": {
+        "version": {
+          "block": "11"
+        },
+        "chain_id": "testnet-croeseid-4",
+        "height": "7",
+        "time": "2021-11-22T08:00:36Z",
+        "last_block_id": {
+          "hash": "C718F8A56BE32C48452575C8E45913FB369B3072C5E7AB4178FD6EC788569A97",
+          "parts": {
+            "total": 1,
+            "hash": "A3E7152187B060E1B43CEE3B7F1E904EA0847831B141AFF30324977923EBCFAD"
+          }
+        },
+        "last_commit_hash": "D4C4F54B213EA1EB3F1EFB8EB28104B685EA2523864D4315178AF4F10CCF9892",
+        "data_hash": "6FD691D606A09CF8C803D182EC3093B969F702B3087B1D2832BFB14864465D70",
+        "validators_hash": "E000B2CE263CED3E3E37A1A4EB362BCD1E5C422471E6A88C2DA60A3D69B9922E",
+        "next_validators_hash": "E000B2CE263CED3E3E37A1A4EB362BCD1E5C422471E6A88C2DA60A3D69B9922E",
+        "consensus_hash": "048091BC7DDC283F77BFBF91D73C44DA58C3DF8A9CBC867405D8B7F3DAADA22F",
+        "app_hash": "9B1E638DAF90F426DE872ACE1A6F9AFD223B4585557EE1206820D4BFDE5CE5F7",
+        "last_results_hash": "17B7848BFB8E99EB3C4451998167B8B891308FC0152657F9A9A14C22A2ECA014",
+        "evidence_hash": "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855",
+        "proposer_address": "1CD16F44A4E0502144D9D49A4CDB8748A57E334C"
+      },
+      "data": {
+        "txs": [
+          "CroBCrcBCh8vY29zbW9zLmF1dGh6LnYxYmV0YTEuTXNnUmV2b2tlEpMBCit0Y3JvMWRoNjlmbGRjc2ZyejR1aHZuNjRzNjZqZGYzenBsOW1oM3pwa25yEit0Y3JvMXBlanJoZzJzbHB4emFla2h2Y2o4NjA2N3lhNGV3N3djdXF0cGdkGjcvY29zbW9zLmRpc3RyaWJ1dGlvbi52MWJldGExLk1zZ1dpdGhkcmF3RGVsZWdhdG9yUmV3YXJkEmsKUApGCh8vY29zbW9zLmNyeXB0by5zZWNwMjU2azEuUHViS2V5EiMKIQI00Fe/+mp/2F/1a6Cv5rkymkmX/VAd3cJrjRJaegxh+xIECgIIARgBEhcKEQoIYmFzZXRjcm8SBTIwMDAwEIC1GBpAPDY9TadZv+AQlha/f/09zcjZSP6CgvHjYv73CLA38fA39MwMXszEaJrPeq/WRdTWmJ9/FB0FDnyJS/hNkaYQAw=="
+        ]
+      },
+      "evidence": {
+        "evidence": null
+      },
+      "last_commit": {
+        "height": "6",
+        "round": 0,
+        "block_id": {
+          "hash": "C718F8A56BE32C48452575C8E45913FB369B3072C5E7AB4178FD6EC788569A97",
+          "parts": {
+            "total": 1,
+            "hash": "A3E7152187B060E1B43CEE3B7F1E904EA0847831B141AFF30324977923EBCFAD"
+          }
+        },
+        "signatures": [
+          {
+            "block_id_flag": 2,
+            "validator_address": "1CD16F44A4E0502144D9D49A4CDB8748A57E334C",
+            "timestamp": "2021-11-22T08:00:33Z",
+            "signature": "LLNP0QUfWGdKneN3Jsew+k1tmEWWTlwdCLXzxLAZ67silGdCl1C1zECMritW7H3PMTfgL7fRlscsY44fJI5RDQ=="
+          },
+          {
+            "block_id_flag": 2,
+            "validator_address": "838043E73426378E3A87858E3B39AF58AF330752",
+            "timestamp": "2021-11-22T08:00:33Z",
+            "signature": "aq4Cv5FQtGS/RpaQw+ULf1a6tqjr4OV4WMW82ZiptfVn15KO+rP5EG6ZacGaeJ2Wa6wivULVV5poWmybXVDHDQ=="
+          }
+        ]
+      }
+    }
+  }
+}`
+
+const TX_MSG_REVOKE_BLOCK_RESULTS_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "height": "7",
+    "txs_results": [
+      {
+        "code": 0,
+        "data": "CiEKHy9jb3Ntb3MuYXV0aHoudjFiZXRhMS5Nc2dSZXZva2U=",
+        "log": "[{\"events\":[{\"type\":\"cosmos.authz.v1beta1.EventRevoke\",\"attributes\":[{\"key\":\"msg_type_url\",\"value\":\"\\\"/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward\\\"\"},{\"key\":\"granter\",\"value\":\"\\\"tcro1dh69fldcsfrz4uhvn64s66jdf3zpl9mh3zpknr\\\"\"},{\"key\":\"grantee\",\"value\":\"\\\"tcro1pejrhg2slpxzaekhvcj86067ya4ew7wcuqtpgd\\\"\"}]},{\"type\":\"message\",\"attributes\":[{\"key\":\"action\",\"value\":\"/cosmos.authz.v1beta1.MsgRevoke\"}]}]}]",
+        "info": "",
+        "gas_wanted": "400000",
+        "gas_used": "52609",
+        "events": [
+          {
+            "type": "coin_spent",
+            "attributes": [
+              {
+                "key": "c3BlbmRlcg==",
+                "value": "dGNybzFkaDY5ZmxkY3Nmcno0dWh2bjY0czY2amRmM3pwbDltaDN6cGtucg==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjAwMDBiYXNldGNybw==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_received",
+            "attributes": [
+              {
+                "key": "cmVjZWl2ZXI=",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjAwMDBiYXNldGNybw==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "transfer",
+            "attributes": [
+              {
+                "key": "cmVjaXBpZW50",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": true
+              },
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzFkaDY5ZmxkY3Nmcno0dWh2bjY0czY2amRmM3pwbDltaDN6cGtucg==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjAwMDBiYXNldGNybw==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzFkaDY5ZmxkY3Nmcno0dWh2bjY0czY2amRmM3pwbDltaDN6cGtucg==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "ZmVl",
+                "value": "MjAwMDBiYXNldGNybw==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "YWNjX3NlcQ==",
+                "value": "dGNybzFkaDY5ZmxkY3Nmcno0dWh2bjY0czY2amRmM3pwbDltaDN6cGtuci8x",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "c2lnbmF0dXJl",
+                "value": "UERZOVRhZFp2K0FRbGhhL2YvMDl6Y2paU1A2Q2d2SGpZdjczQ0xBMzhmQTM5TXdNWHN6RWFKclBlcS9XUmRUV21KOS9GQjBGRG55SlMvaE5rYVlRQXc9PQ==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "YWN0aW9u",
+                "value": "L2Nvc21vcy5hdXRoei52MWJldGExLk1zZ1Jldm9rZQ==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "cosmos.authz.v1beta1.EventRevoke",
+            "attributes": [
+              {
+                "key": "bXNnX3R5cGVfdXJs",
+                "value": "Ii9jb3Ntb3MuZGlzdHJpYnV0aW9uLnYxYmV0YTEuTXNnV2l0aGRyYXdEZWxlZ2F0b3JSZXdhcmQi",
+                "index": true
+              },
+              {
+                "key": "Z3JhbnRlcg==",
+                "value": "InRjcm8xZGg2OWZsZGNzZnJ6NHVodm42NHM2NmpkZjN6cGw5bWgzenBrbnIi",
+                "index": true
+              },
+              {
+                "key": "Z3JhbnRlZQ==",
+                "value": "InRjcm8xcGVqcmhnMnNscHh6YWVraHZjajg2MDY3eWE0ZXc3d2N1cXRwZ2Qi",
+                "index": true
+              }
+            ]
+          }
+        ],
+        "codespace": ""
+      }
+    ],
+    "begin_block_events": [
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coinbase",
+        "attributes": [
+          {
+            "key": "bWludGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "mint",
+        "attributes": [
+          {
+            "key": "Ym9uZGVkX3JhdGlv",
+            "value": "MC4wMDM5ODQwNjMyNTI2NTYzNjc=",
+            "index": true
+          },
+          {
+            "key": "aW5mbGF0aW9u",
+            "value": "MC4xMzAwMDAxNDMzMjM0NDgxMTk=",
+            "index": true
+          },
+          {
+            "key": "YW5udWFsX3Byb3Zpc2lvbnM=",
+            "value": "NjUyNjAwODAwMTM0LjIzODQ3MzUyNzMzMTY1MDE3Mg==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAzMzk4",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTIzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTIzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTIzMzk4YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "proposer_reward",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NjE2OS45MDAwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ3c0ZnRtMzh0YW1yaHlwM3J1bjY4ZjIzMndycW1ycGV1ZTk1Z2E=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "commission",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NjE2Ljk5MDAwMDAwMDAwMDAwMDAwMGJhc2V0Y3Jv",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ3c0ZnRtMzh0YW1yaHlwM3J1bjY4ZjIzMndycW1ycGV1ZTk1Z2E=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "rewards",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NjE2OS45MDAwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ3c0ZnRtMzh0YW1yaHlwM3J1bjY4ZjIzMndycW1ycGV1ZTk1Z2E=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "commission",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NTczOC4wMDcwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ3c0ZnRtMzh0YW1yaHlwM3J1bjY4ZjIzMndycW1ycGV1ZTk1Z2E=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "rewards",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NTczODAuMDcwMDAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxZ3c0ZnRtMzh0YW1yaHlwM3J1bjY4ZjIzMndycW1ycGV1ZTk1Z2E=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "commission",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NTczOC4wMDcwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxcnlnbTAwNXl3NzI4NmtkNW0zazU5N2c4MHZ5NmRjZ2hrajhkMHQ=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "rewards",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NTczODAuMDcwMDAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxcnlnbTAwNXl3NzI4NmtkNW0zazU5N2c4MHZ5NmRjZ2hrajhkMHQ=",
+            "index": true
+          }
+        ]
+      }
+    ],
+    "end_block_events": [],
+    "validator_updates": [],
+    "consensus_param_updates": {
+      "block": {
+        "max_bytes": "22020096",
+        "max_gas": "-1"
+      },
+      "evidence": {
+        "max_age_num_blocks": "100000",
+        "max_age_duration": "172800000000000",
+        "max_bytes": "1048576"
+      },
+      "validator": {
+        "pub_key_types": [
+          "ed25519"
+        ]
+      }
+    }
+  }
+}`
@@ -0,0 +1,476 @@
+package usecase_parser_test
+
+// Generated by delivering the transactions to an in-process Cosmos SDK v0.44.5 simapp with the
+// Crypto.org Chain address prefixes and denom, and encoding its responses as Tendermint v0.34 RPC.
+const TX_MSG_REVOKE_ALLOWANCE_BLOCK_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "block_id": {
+      "hash": "B2E5BB4F1C315350919BCA7FCB25F8B87298C3C0079D2261DC71348E74D2AB8D",
+      "parts": {
+        "total": 1,
+        "hash": "F4B3979E57EEA82FDA3D51152A8594533E5846057E8C931D657E25C3853396F4"
+      }
+    },
+    "block": {
+      "header": {
+        "version": {
+          "block": "11"
+        },
+        "chain_id": "testnet-croeseid-4",
+        "height": "4",
+        "time": "2021-11-22T08:00:18Z",
+        "last_block_id": {
+          "hash": "17AE6D4C8BF111F2FD4971B4F953226C974566E1FEF09FE698E48088C542C08F",
+          "parts": {
+            "total": 1,
+            "hash": "1623956FF5B73C43E0E0727A5ACFBC893AB59276A16BF0B19E63790A237FE9CE"
+          }
+        },
+        "last_commit_hash": "D1D9466AE176AED5EDDBA6682BB6E7A7DE66FF92EDE00910F010C4655A6CF2F1",
+        "data_hash": "020C68DF99A303860F5F0A1C51374B7C5CC8C56108D0B026B281509165B31529",
+        "validators_hash": "E9D694855819994A74BC3024811B12BE451E1089122280B5DD8EE135FE2B874D",
+        "next_validators_hash": "E9D694855819994A74BC3024811B12BE451E1089122280B5DD8EE135FE2B874D",
+        "consensus_hash": "048091BC7DDC283F77BFBF91D73C44DA58C3DF8A9CBC867405D8B7F3DAADA22F",
+        "app_hash": "879EF1E65ED4AF6CDB34EF258A80AE20175BED3D058F4ABC844B3364E7AE56BE",
+        "last_results_hash": "BF8D30BD3BDBA2D54FE7FDA089D745487E6AFEBBD9C444E3E48B06CF5035F8F7",
+        "evidence_hash": "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855",
+        "proposer_address": "D5D7193CC42D507BBDA9FF38FEC0BD8343C4DEC5"
+      },
+      "data": {
+        "txs": [
+          "CowBCokBCisvY29zbW9zLmZlZWdyYW50LnYxYmV0YTEuTXNnUmV2b2tlQWxsb3dhbmNlEloKK3Rjcm8xNGswc25ydGphMnpsZTVqbjN2cjduZnR3ZXBzZmdjYThmYTZyYWwSK3Rjcm8xdGczejdzZjk5dXUzbWEzNHhmc3BocndtcW5tM2s2dXVtdnZ6a3cSawpQCkYKHy9jb3Ntb3MuY3J5cHRvLnNlY3AyNTZrMS5QdWJLZXkSIwohAuYc7NOAZpJ9D6luK61xSdbAMYGD/p0tHDYaINnufiRNEgQKAggBGAESFwoRCghiYXNldGNybxIFMjAwMDAQgLUYGkDKrNNX3taMPp+1k9+sXLtPqkyfhvL5ea+PQVJ3+O2rRiEKlEb9/wWqgbxPeDxEjhu7vXu6qOZiltPQDOHuntkm"
+        ]
+      },
+      "evidence": {
+        "evidence": null
+      },
+      "last_commit": {
+        "height": "3",
+        "round": 0,
+        "block_id": {
+          "hash": "17AE6D4C8BF111F2FD4971B4F953226C974566E1FEF09FE698E48088C542C08F",
+          "parts": {
+            "total": 1,
+            "hash": "1623956FF5B73C43E0E0727A5ACFBC893AB59276A16BF0B19E63790A237FE9CE"
+          }
+        },
+        "signatures": [
+          {
+            "block_id_flag": 2,
+            "validator_address": "D5D7193CC42D507BBDA9FF38FEC0BD8343C4DEC5",
+            "timestamp": "2021-11-22T08:00:15Z",
+            "signature": "Dm5M5Wk3kn+18qajhFWiL0Ab89VTqEWHa8UA2xZwOBbqesrqaFSpUoCVztopf4W4xO7y5Jx2wxX2FI2DB6hzBA=="
+          }
+        ]
+      }
+    }
+  }
+}`
+
+const TX_MSG_REVOKE_ALLOWANCE_BLOCK_RESULTS_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "height": "4",
+    "txs_results": [
+      {
+        "code": 0,
+        "data": "Ci0KKy9jb3Ntb3MuZmVlZ3JhbnQudjFiZXRhMS5Nc2dSZXZva2VBbGxvd2FuY2U=",
+        "log": "[{\"events\":[{\"type\":\"message\",\"attributes\":[{\"key\":\"action\",\"value\":\"/cosmos.feegrant.v1beta1.MsgRevokeAllowance\"}]},{\"type\":\"revoke_feegrant\",\"attributes\":[{\"key\":\"granter\",\"value\":\"tcro14k0snrtja2zle5jn3vr7nftwepsfgca8fa6ral\"},{\"key\":\"grantee\",\"value\":\"tcro1tg3z7sf99uu3ma34xfsphrwmqnm3k6uumvvzkw\"}]}]}]",
+        "info": "",
+        "gas_wanted": "400000",
+        "gas_used": "52299",
+        "events": [
+          {
+            "type": "coin_spent",
+            "attributes": [
+              {
+                "key": "c3BlbmRlcg==",
+                "value": "dGNybzE0azBzbnJ0amEyemxlNWpuM3ZyN25mdHdlcHNmZ2NhOGZhNnJhbA==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjAwMDBiYXNldGNybw==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_received",
+            "attributes": [
+              {
+                "key": "cmVjZWl2ZXI=",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjAwMDBiYXNldGNybw==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "transfer",
+            "attributes": [
+              {
+                "key": "cmVjaXBpZW50",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": true
+              },
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzE0azBzbnJ0amEyemxlNWpuM3ZyN25mdHdlcHNmZ2NhOGZhNnJhbA==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjAwMDBiYXNldGNybw==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzE0azBzbnJ0amEyemxlNWpuM3ZyN25mdHdlcHNmZ2NhOGZhNnJhbA==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "ZmVl",
+                "value": "MjAwMDBiYXNldGNybw==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "YWNjX3NlcQ==",
+                "value": "dGNybzE0azBzbnJ0amEyemxlNWpuM3ZyN25mdHdlcHNmZ2NhOGZhNnJhbC8x",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "c2lnbmF0dXJl",
+                "value": "eXF6VFY5N1dqRDZmdFpQZnJGeTdUNnBNbjRieStYbXZqMEZTZC9qdHEwWWhDcFJHL2Y4RnFvRzhUM2c4Ukk0YnU3MTd1cWptWXBiVDBBemg3cDdaSmc9PQ==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "YWN0aW9u",
+                "value": "L2Nvc21vcy5mZWVncmFudC52MWJldGExLk1zZ1Jldm9rZUFsbG93YW5jZQ==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "revoke_feegrant",
+            "attributes": [
+              {
+                "key": "Z3JhbnRlcg==",
+                "value": "dGNybzE0azBzbnJ0amEyemxlNWpuM3ZyN25mdHdlcHNmZ2NhOGZhNnJhbA==",
+                "index": true
+              },
+              {
+                "key": "Z3JhbnRlZQ==",
+                "value": "dGNybzF0ZzN6N3NmOTl1dTNtYTM0eGZzcGhyd21xbm0zazZ1dW12dnprdw==",
+                "index": true
+              }
+            ]
+          }
+        ],
+        "codespace": ""
+      }
+    ],
+    "begin_block_events": [
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "ODI1OTViYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coinbase",
+        "attributes": [
+          {
+            "key": "bWludGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "ODI1OTViYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "ODI1OTViYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "ODI1OTViYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "ODI1OTViYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "mint",
+        "attributes": [
+          {
+            "key": "Ym9uZGVkX3JhdGlv",
+            "value": "MC4wMDI0OTM3NjU0MzE5NDA3MzE=",
+            "index": true
+          },
+          {
+            "key": "aW5mbGF0aW9u",
+            "value": "MC4xMzAwMDAwODIwODIzNzMyMTY=",
+            "index": true
+          },
+          {
+            "key": "YW5udWFsX3Byb3Zpc2lvbnM=",
+            "value": "NTIxMzAwMzYxMzYyLjM4NjkzNDk0MDg0NzMyNjU2MA==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "ODI1OTU=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAyNTk1YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAyNTk1YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAyNTk1YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "proposer_reward",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NTEyOS43NTAwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxazgzeXk2eDJkd3o2cGEzMjU5bWZ3eTh5cWg0bDZud3l3engwdHo=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "commission",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NTEyLjk3NTAwMDAwMDAwMDAwMDAwMGJhc2V0Y3Jv",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxazgzeXk2eDJkd3o2cGEzMjU5bWZ3eTh5cWg0bDZud3l3engwdHo=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "rewards",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NTEyOS43NTAwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxazgzeXk2eDJkd3o2cGEzMjU5bWZ3eTh5cWg0bDZud3l3engwdHo=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "commission",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "OTU0MS4zMzUwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxazgzeXk2eDJkd3o2cGEzMjU5bWZ3eTh5cWg0bDZud3l3engwdHo=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "rewards",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "OTU0MTMuMzUwMDAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxazgzeXk2eDJkd3o2cGEzMjU5bWZ3eTh5cWg0bDZud3l3engwdHo=",
+            "index": true
+          }
+        ]
+      }
+    ],
+    "end_block_events": [],
+    "validator_updates": [],
+    "consensus_param_updates": {
+      "block": {
+        "max_bytes": "22020096",
+        "max_gas": "-1"
+      },
+      "evidence": {
+        "max_age_num_blocks": "100000",
+        "max_age_duration": "172800000000000",
+        "max_bytes": "1048576"
+      },
+      "validator": {
+        "pub_key_types": [
+          "ed25519"
+        ]
+      }
+    }
+  }
+}`
@@ -0,0 +1,561 @@
+package usecase_parser_test
+
+// Generated by delivering the transactions to an in-process Cosmos SDK v0.44.5 simapp with the
+// Crypto.org Chain address prefixes and denom, and encoding its responses as Tendermint v0.34 RPC.
+const TX_WITH_FEE_GRANTER_BLOCK_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "block_id": {
+      "hash": "17AE6D4C8BF111F2FD4971B4F953226C974566E1FEF09FE698E48088C542C08F",
+      "parts": {
+        "total": 1,
+        "hash": "1623956FF5B73C43E0E0727A5ACFBC893AB59276A16BF0B19E63790A237FE9CE"
+      }
+    },
+    "block": {
+      "header": {
+        "version": {
+          "block": "11"
+        },
+        "chain_id": "testnet-croeseid-4",
+        "height": "3",
+        "time": "2021-11-22T08:00:12Z",
+        "last_block_id": {
+          "hash": "CBE03EAE5ECA4212A56F5576609008157B710D008A2FEF101E48C1FC8B39016A",
+          "parts": {
+            "total": 1,
+            "hash": "C01599DE815CF7299D444772AEE11DEF575674519EE28DABBAB00173D22811CA"
+          }
+        },
+        "last_commit_hash": "A23A46B99738EA81AAA54F2BB0A341F5510C90C8A6B61B3C58AC961BE67D5925",
+        "data_hash": "302DDB9CC8049CD810A33C665DF5C417F6FD036CC95FD033E23570FBD4235C25",
+        "validators_hash": "E9D694855819994A74BC3024811B12BE451E1089122280B5DD8EE135FE2B874D",
+        "next_validators_hash": "E9D694855819994A74BC3024811B12BE451E1089122280B5DD8EE135FE2B874D",
+        "consensus_hash": "048091BC7DDC283F77BFBF91D73C44DA58C3DF8A9CBC867405D8B7F3DAADA22F",
+        "app_hash": "81832310E1906102EC0970A5F4FB620EB40AE79C3A16083048CB383EF27A8476",
+        "last_results_hash": "930DC0186A558FE83225D83526EFA4AFDFD845E649B9A2D542474BC868A584EF",
+        "evidence_hash": "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855",
+        "proposer_address": "D5D7193CC42D507BBDA9FF38FEC0BD8343C4DEC5"
+      },
+      "data": {
+        "txs": [
+          "Co8BCowBChwvY29zbW9zLmJhbmsudjFiZXRhMS5Nc2dTZW5kEmwKK3Rjcm8xdGczejdzZjk5dXUzbWEzNHhmc3BocndtcW5tM2s2dXVtdnZ6a3cSK3Rjcm8xNGswc25ydGphMnpsZTVqbjN2cjduZnR3ZXBzZmdjYThmYTZyYWwaEAoIYmFzZXRjcm8SBDEwMDASlgEKTgpGCh8vY29zbW9zLmNyeXB0by5zZWNwMjU2azEuUHViS2V5EiMKIQM0Npd6XZ34Y/6XNdyIm5rUQOGNO65CcAd1Dr8JkucwsRIECgIIARJEChEKCGJhc2V0Y3JvEgUyMDAwMBCAtRgiK3Rjcm8xNGswc25ydGphMnpsZTVqbjN2cjduZnR3ZXBzZmdjYThmYTZyYWwaQI0Vwsc9wkGKvnGo8omCSlIjMl6tY/IOooZNDLzb9fAbCJX/qgMBO97/TQD6dGgFdLGaJrTUXMgpg3g+a5GYBbI="
+        ]
+      },
+      "evidence": {
+        "evidence": null
+      },
+      "last_commit": {
+        "height": "2",
+        "round": 0,
+        "block_id": {
+          "hash": "CBE03EAE5ECA4212A56F5576609008157B710D008A2FEF101E48C1FC8B39016A",
+          "parts": {
+            "total": 1,
+            "hash": "C01599DE815CF7299D444772AEE11DEF575674519EE28DABBAB00173D22811CA"
+          }
+        },
+        "signatures": [
+          {
+            "block_id_flag": 2,
+            "validator_address": "D5D7193CC42D507BBDA9FF38FEC0BD8343C4DEC5",
+            "timestamp": "2021-11-22T08:00:09Z",
+            "signature": "UB8JlAyj25RHAtZ+OIq9d/thkIqHt0dRSthB2twL0GaZyan0SafeaaI121MRexJCwCmebLnx4AC9Pi+xh6ciCw=="
+          }
+        ]
+      }
+    }
+  }
+}`
+
+const TX_WITH_FEE_GRANTER_BLOCK_RESULTS_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "height": "3",
+    "txs_results": [
+      {
+        "code": 0,
+        "data": "Ch4KHC9jb3Ntb3MuYmFuay52MWJldGExLk1zZ1NlbmQ=",
+        "log": "[{\"events\":[{\"type\":\"coin_received\",\"attributes\":[{\"key\":\"receiver\",\"value\":\"tcro14k0snrtja2zle5jn3vr7nftwepsfgca8fa6ral\"},{\"key\":\"amount\",\"value\":\"1000basetcro\"}]},{\"type\":\"coin_spent\",\"attributes\":[{\"key\":\"spender\",\"value\":\"tcro1tg3z7sf99uu3ma34xfsphrwmqnm3k6uumvvzkw\"},{\"key\":\"amount\",\"value\":\"1000basetcro\"}]},{\"type\":\"message\",\"attributes\":[{\"key\":\"action\",\"value\":\"/cosmos.bank.v1beta1.MsgSend\"},{\"key\":\"sender\",\"value\":\"tcro1tg3z7sf99uu3ma34xfsphrwmqnm3k6uumvvzkw\"},{\"key\":\"module\",\"value\":\"bank\"}]},{\"type\":\"transfer\",\"attributes\":[{\"key\":\"recipient\",\"value\":\"tcro14k0snrtja2zle5jn3vr7nftwepsfgca8fa6ral\"},{\"key\":\"sender\",\"value\":\"tcro1tg3z7sf99uu3ma34xfsphrwmqnm3k6uumvvzkw\"},{\"key\":\"amount\",\"value\":\"1000basetcro\"}]}]}]",
+        "info": "",
+        "gas_wanted": "400000",
+        "gas_used": "78946",
+        "events": [
+          {
+            "type": "use_feegrant",
+            "attributes": [
+              {
+                "key": "Z3JhbnRlcg==",
+                "value": "dGNybzE0azBzbnJ0amEyemxlNWpuM3ZyN25mdHdlcHNmZ2NhOGZhNnJhbA==",
+                "index": true
+              },
+              {
+                "key": "Z3JhbnRlZQ==",
+                "value": "dGNybzF0ZzN6N3NmOTl1dTNtYTM0eGZzcGhyd21xbm0zazZ1dW12dnprdw==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "set_feegrant",
+            "attributes": [
+              {
+                "key": "Z3JhbnRlcg==",
+                "value": "dGNybzE0azBzbnJ0amEyemxlNWpuM3ZyN25mdHdlcHNmZ2NhOGZhNnJhbA==",
+                "index": true
+              },
+              {
+                "key": "Z3JhbnRlZQ==",
+                "value": "dGNybzF0ZzN6N3NmOTl1dTNtYTM0eGZzcGhyd21xbm0zazZ1dW12dnprdw==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_spent",
+            "attributes": [
+              {
+                "key": "c3BlbmRlcg==",
+                "value": "dGNybzE0azBzbnJ0amEyemxlNWpuM3ZyN25mdHdlcHNmZ2NhOGZhNnJhbA==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjAwMDBiYXNldGNybw==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_received",
+            "attributes": [
+              {
+                "key": "cmVjZWl2ZXI=",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjAwMDBiYXNldGNybw==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "transfer",
+            "attributes": [
+              {
+                "key": "cmVjaXBpZW50",
+                "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+                "index": true
+              },
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzE0azBzbnJ0amEyemxlNWpuM3ZyN25mdHdlcHNmZ2NhOGZhNnJhbA==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MjAwMDBiYXNldGNybw==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzE0azBzbnJ0amEyemxlNWpuM3ZyN25mdHdlcHNmZ2NhOGZhNnJhbA==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "ZmVl",
+                "value": "MjAwMDBiYXNldGNybw==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "YWNjX3NlcQ==",
+                "value": "dGNybzF0ZzN6N3NmOTl1dTNtYTM0eGZzcGhyd21xbm0zazZ1dW12dnprdy8w",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "tx",
+            "attributes": [
+              {
+                "key": "c2lnbmF0dXJl",
+                "value": "alJYQ3h6M0NRWXErY2FqeWlZSktVaU15WHExajhnNmloazBNdk52MThCc0lsZitxQXdFNzN2OU5BUHAwYUFWMHNab210TlJjeUNtRGVENXJrWmdGc2c9PQ==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "YWN0aW9u",
+                "value": "L2Nvc21vcy5iYW5rLnYxYmV0YTEuTXNnU2VuZA==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_spent",
+            "attributes": [
+              {
+                "key": "c3BlbmRlcg==",
+                "value": "dGNybzF0ZzN6N3NmOTl1dTNtYTM0eGZzcGhyd21xbm0zazZ1dW12dnprdw==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MTAwMGJhc2V0Y3Jv",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "coin_received",
+            "attributes": [
+              {
+                "key": "cmVjZWl2ZXI=",
+                "value": "dGNybzE0azBzbnJ0amEyemxlNWpuM3ZyN25mdHdlcHNmZ2NhOGZhNnJhbA==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MTAwMGJhc2V0Y3Jv",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "transfer",
+            "attributes": [
+              {
+                "key": "cmVjaXBpZW50",
+                "value": "dGNybzE0azBzbnJ0amEyemxlNWpuM3ZyN25mdHdlcHNmZ2NhOGZhNnJhbA==",
+                "index": true
+              },
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzF0ZzN6N3NmOTl1dTNtYTM0eGZzcGhyd21xbm0zazZ1dW12dnprdw==",
+                "index": true
+              },
+              {
+                "key": "YW1vdW50",
+                "value": "MTAwMGJhc2V0Y3Jv",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "c2VuZGVy",
+                "value": "dGNybzF0ZzN6N3NmOTl1dTNtYTM0eGZzcGhyd21xbm0zazZ1dW12dnprdw==",
+                "index": true
+              }
+            ]
+          },
+          {
+            "type": "message",
+            "attributes": [
+              {
+                "key": "bW9kdWxl",
+                "value": "YmFuaw==",
+                "index": true
+              }
+            ]
+          }
+        ],
+        "codespace": ""
+      }
+    ],
+    "begin_block_events": [
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "ODI1OTViYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coinbase",
+        "attributes": [
+          {
+            "key": "bWludGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "ODI1OTViYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "ODI1OTViYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "ODI1OTViYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "ODI1OTViYXNldGNybw==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "mint",
+        "attributes": [
+          {
+            "key": "Ym9uZGVkX3JhdGlv",
+            "value": "MC4wMDI0OTM3NjU0ODMzMDU0NTY=",
+            "index": true
+          },
+          {
+            "key": "aW5mbGF0aW9u",
+            "value": "MC4xMzAwMDAwNjE1NjE3Nzk5MTA=",
+            "index": true
+          },
+          {
+            "key": "YW5udWFsX3Byb3Zpc2lvbnM=",
+            "value": "NTIxMzAwMjY4MzM3LjQ0NzYwODQ5MDQyMzMzMjkwMA==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "ODI1OTU=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_spent",
+        "attributes": [
+          {
+            "key": "c3BlbmRlcg==",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAyNTk1YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "coin_received",
+        "attributes": [
+          {
+            "key": "cmVjZWl2ZXI=",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAyNTk1YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "transfer",
+        "attributes": [
+          {
+            "key": "cmVjaXBpZW50",
+            "value": "dGNybzFqdjY1czNncnFmNnY2amwzZHA0dDZjOXQ5cms5OWNkODMzOXA0bA==",
+            "index": true
+          },
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAyNTk1YmFzZXRjcm8=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "message",
+        "attributes": [
+          {
+            "key": "c2VuZGVy",
+            "value": "dGNybzE3eHBmdmFrbTJhbWc5NjJ5bHM2Zjg0ejNrZWxsOGM1bHhoemFoYQ==",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "proposer_reward",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NTEyOS43NTAwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxazgzeXk2eDJkd3o2cGEzMjU5bWZ3eTh5cWg0bDZud3l3engwdHo=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "commission",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NTEyLjk3NTAwMDAwMDAwMDAwMDAwMGJhc2V0Y3Jv",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxazgzeXk2eDJkd3o2cGEzMjU5bWZ3eTh5cWg0bDZud3l3engwdHo=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "rewards",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "NTEyOS43NTAwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxazgzeXk2eDJkd3o2cGEzMjU5bWZ3eTh5cWg0bDZud3l3engwdHo=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "commission",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "OTU0MS4zMzUwMDAwMDAwMDAwMDAwMDBiYXNldGNybw==",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxazgzeXk2eDJkd3o2cGEzMjU5bWZ3eTh5cWg0bDZud3l3engwdHo=",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "rewards",
+        "attributes": [
+          {
+            "key": "YW1vdW50",
+            "value": "OTU0MTMuMzUwMDAwMDAwMDAwMDAwMDAwYmFzZXRjcm8=",
+            "index": true
+          },
+          {
+            "key": "dmFsaWRhdG9y",
+            "value": "dGNyb2NuY2wxazgzeXk2eDJkd3o2cGEzMjU5bWZ3eTh5cWg0bDZud3l3engwdHo=",
+            "index": true
+          }
+        ]
+      }
+    ],
+    "end_block_events": [],
+    "validator_updates": [],
+    "consensus_param_updates": {
+      "block": {
+        "max_bytes": "22020096",
+        "max_gas": "-1"
+      },
+      "evidence": {
+        "max_age_num_blocks": "100000",
+        "max_age_duration": "172800000000000",
+        "max_bytes": "1048576"
+      },
+      "validator": {
+        "pub_key_types": [
+          "ed25519"
+        ]
+      }
+    }
+  }
+}`
@@ -16,33 +16,99 @@ import (
 	jsoniter "github.com/json-iterator/go"
 )
 
+// Policy on transactions the transaction decoder fails to decode
+const UNDECODABLE_TX_POLICY_PANIC = "panic"
+const UNDECODABLE_TX_POLICY_RECORD = "record"
+
+// ParseUndecodableTransactionCommands checks every transaction of the block can be decoded. With
+// UNDECODABLE_TX_POLICY_PANIC it panics on the first undecodable transaction. With
+// UNDECODABLE_TX_POLICY_RECORD it returns a CreateTransactionUndecodable command for each of them,
+// while the other transaction and message parsers skip them.
+func ParseUndecodableTransactionCommands(
+	undecodableTxPolicy string,
+	txDecoder *utils.TxDecoder,
+	block *model.Block,
+	blockResults *model.BlockResults,
+) ([]command.Command, error) {
+	return parseUndecodableTransactionCommands(
+		undecodableTxPolicy, txDecoder.DecodeAll(block.Txs), block, blockResults,
+	)
+}
+
+func parseUndecodableTransactionCommands(
+	undecodableTxPolicy string,
+	decodedTxs []utils.DecodedTx,
+	block *model.Block,
+	blockResults *model.BlockResults,
+) ([]command.Command, error) {
+	blockHeight := blockResults.Height
+	cmds := make([]command.Command, 0)
+	for i, txHex := range block.Txs {
+		decodeErr := decodedTxs[i].MaybeDecodeErr
+		if decodeErr == nil {
+			continue
+		}
+		if undecodableTxPolicy != UNDECODABLE_TX_POLICY_RECORD {
+			panic(fmt.Sprintf("error decoding transaction: %v", decodeErr))
+		}
+
+		txsResult := blockResults.TxsResults[i]
+		log, err := parseTxsResultLog(txsResult)
+		if err != nil {
+			return nil, err
+		}
+
+		cmds = append(cmds, command_usecase.NewCreateTransactionUndecodable(
+			blockHeight,
+			model.CreateTransactionUndecodableParams{
+				TxHash:      TxHash(txHex),
+				Index:       i,
+				Code:        txsResult.Code,
+				Log:         log,
+				RawTx:       txHex,
+				DecodeError: decodeErr.Error(),
+			},
+		))
+	}
+
+	return cmds, nil
+}
+
+// ParseTransactionCommands parses the transactions of the block. Undecodable transactions are
+// skipped, use ParseUndecodableTransactionCommands to handle them.
 func ParseTransactionCommands(
 	txDecoder *utils.TxDecoder,
 	block *model.Block,
 	blockResults *model.BlockResults,
 	accountAddressPrefix string,
+) ([]command.Command, error) {
+	return parseTransactionCommands(
+		txDecoder, txDecoder.DecodeAll(block.Txs), block, blockResults, accountAddressPrefix,
+	)
+}
+
+func parseTransactionCommands(
+	txDecoder *utils.TxDecoder,
+	decodedTxs []utils.DecodedTx,
+	block *model.Block,
+	blockResults *model.BlockResults,
+	accountAddressPrefix string,
 ) ([]command.Command, error) {
 	blockHeight := blockResults.Height
 	cmds := make([]command.Command, 0, len(blockResults.TxsResults))
 	for i, txHex := range block.Txs {
 		txsResult := blockResults.TxsResults[i]
-		tx, err := txDecoder.Decode(txHex)
-		if err != nil {
-			panic(fmt.Sprintf("error decoding transaction: %v", err))
+		tx := decodedTxs[i].MaybeTx
+		if tx == nil {
+			continue
 		}
 
-		var log string
-		if len(txsResult.Log) == 0 {
-			// cater for failed transaction
-			log = txsResult.RawLog
-		} else {
-			var logMarshalErr error
-			if log, logMarshalErr = jsoniter.MarshalToString(txsResult.Log); logMarshalErr != nil {
-				return nil, fmt.Errorf("error encoding transaction result rawLog to JSON: %v", err)
-			}
+		log, err := parseTxsResultLog(txsResult)
+		if err != nil {
+			return nil, err
 		}
 
-		fee, err := txDecoder.GetFee(txHex)
+		fee, err := txDecoder.GetTxFee(tx)
 		if err != nil {
 			return nil, fmt.Errorf("error parsing transaction fee: %v", err)
 		}
@@ -87,6 +153,19 @@ func ParseTransactionCommands(
 	return cmds, nil
 }
 
+func parseTxsResultLog(txsResult model.BlockResultsTxsResult) (string, error) {
+	if len(txsResult.Log) == 0 {
+		// cater for failed transaction
+		return txsResult.RawLog, nil
+	}
+
+	log, err := jsoniter.MarshalToString(txsResult.Log)
+	if err != nil {
+		return "", fmt.Errorf("error encoding transaction result rawLog to JSON: %v", err)
+	}
+	return log, nil
+}
+
 //func getTxFee(feeCollectorAddress string, txsResult model.BlockResultsTxsResult) coin.Coin {
 //	for _, event := range txsResult.Events {
 //		if event.Type == "transfer" {
@@ -13,6 +13,7 @@ import (
 	"github.com/crypto-com/chain-indexing/internal/primptr"
 	"github.com/crypto-com/chain-indexing/usecase/coin"
 	command_usecase "github.com/crypto-com/chain-indexing/usecase/command"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
 	"github.com/crypto-com/chain-indexing/usecase/model"
 	"github.com/crypto-com/chain-indexing/usecase/parser"
 	usecase_parser_test "github.com/crypto-com/chain-indexing/usecase/parser/test"
@@ -373,4 +374,69 @@ var _ = Describe("TransactionParser", func() {
 			)}))
 		})
 	})
+
+	Describe("ParseUndecodableTransactionCommands", func() {
+		anyUndecodableTx := "CgRub3R4"
+
+		It("should parse TransactionUndecodable command with record policy", func() {
+			txDecoder := utils.NewTxDecoder()
+			block, _ := mustParseBlockResp(usecase_parser_test.ONE_TX_TWO_MSG_BLOCK_RESP)
+			blockResults := mustParseBlockResultsResp(usecase_parser_test.ONE_TX_TWO_MSG_BLOCK_RESULTS_RESP)
+			block.Txs[0] = anyUndecodableTx
+
+			cmds, err := parser.ParseUndecodableTransactionCommands(
+				parser.UNDECODABLE_TX_POLICY_RECORD,
+				txDecoder,
+				block,
+				blockResults,
+			)
+			Expect(err).To(BeNil())
+			Expect(cmds).To(HaveLen(1))
+			typedCmd, _ := cmds[0].(*command_usecase.CreateTransactionUndecodable)
+			event, err := typedCmd.Exec()
+			Expect(err).To(BeNil())
+			typedEvent, _ := event.(*event_usecase.TransactionUndecodable)
+			Expect(typedEvent.Height()).To(Equal(int64(343358)))
+			Expect(typedEvent.TxHash).To(Equal(parser.TxHash(anyUndecodableTx)))
+			Expect(typedEvent.Index).To(Equal(0))
+			Expect(typedEvent.Code).To(Equal(0))
+			Expect(typedEvent.RawTx).To(Equal(anyUndecodableTx))
+			Expect(typedEvent.DecodeError).NotTo(BeEmpty())
+
+			transactionCmds, err := parser.ParseTransactionCommands(txDecoder, block, blockResults, "tcro")
+			Expect(err).To(BeNil())
+			Expect(transactionCmds).To(BeEmpty())
+		})
+
+		It("should panic on undecodable transaction with panic policy", func() {
+			txDecoder := utils.NewTxDecoder()
+			block, _ := mustParseBlockResp(usecase_parser_test.ONE_TX_TWO_MSG_BLOCK_RESP)
+			blockResults := mustParseBlockResultsResp(usecase_parser_test.ONE_TX_TWO_MSG_BLOCK_RESULTS_RESP)
+			block.Txs[0] = anyUndecodableTx
+
+			Expect(func() {
+				_, _ = parser.ParseUndecodableTransactionCommands(
+					parser.UNDECODABLE_TX_POLICY_PANIC,
+					txDecoder,
+					block,
+					blockResults,
+				)
+			}).To(Panic())
+		})
+
+		It("should return no command when all transactions are decodable", func() {
+			txDecoder := utils.NewTxDecoder()
+			block, _ := mustParseBlockResp(usecase_parser_test.ONE_TX_TWO_MSG_BLOCK_RESP)
+			blockResults := mustParseBlockResultsResp(usecase_parser_test.ONE_TX_TWO_MSG_BLOCK_RESULTS_RESP)
+
+			cmds, err := parser.ParseUndecodableTransactionCommands(
+				parser.UNDECODABLE_TX_POLICY_PANIC,
+				txDecoder,
+				block,
+				blockResults,
+			)
+			Expect(err).To(BeNil())
+			Expect(cmds).To(BeEmpty())
+		})
+	})
 })
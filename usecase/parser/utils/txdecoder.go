@@ -4,20 +4,39 @@ import (
 	"fmt"
 
 	"github.com/calvinlauyh/cosmosutils"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/crypto-com/chain-indexing/usecase/coin"
 	jsoniter "github.com/json-iterator/go"
+	ethermintcodec "github.com/tharsis/ethermint/crypto/codec"
+	ethermint "github.com/tharsis/ethermint/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 )
 
 type TxDecoder struct {
 	decoder *cosmosutils.Decoder
 }
 
+// NewTxDecoder creates a decoder of the Cosmos SDK (including authz and feegrant), IBC, Ethermint
+// and Crypto.org Chain transactions. Messages of types not registered cannot be decoded, which
+// fails the whole transaction.
 func NewTxDecoder() *TxDecoder {
 	return &TxDecoder{
-		cosmosutils.DefaultDecoder,
+		cosmosutils.NewDecoder().RegisterInterfaces(
+			cosmosutils.RegisterDefaultInterfaces,
+		).RegisterInterfaces(
+			registerEthermintInterfaces,
+		),
 	}
 }
 
+// registerEthermintInterfaces registers the EVM messages and transaction data, the Ethereum
+// transaction extension options, and the Ethereum accounts and keys
+func registerEthermintInterfaces(interfaceRegistry codectypes.InterfaceRegistry) {
+	ethermintcodec.RegisterInterfaces(interfaceRegistry)
+	ethermint.RegisterInterfaces(interfaceRegistry)
+	evmtypes.RegisterInterfaces(interfaceRegistry)
+}
+
 func (decoder *TxDecoder) Decode(base64Tx string) (*CosmosTx, error) {
 	rawTx, err := decoder.decoder.DecodeBase64(base64Tx)
 	if err != nil {
@@ -37,12 +56,38 @@ func (decoder *TxDecoder) Decode(base64Tx string) (*CosmosTx, error) {
 	return tx, nil
 }
 
+// DecodedTx is the outcome of decoding a transaction. Either MaybeTx or MaybeDecodeErr is set.
+type DecodedTx struct {
+	MaybeTx        *CosmosTx
+	MaybeDecodeErr error
+}
+
+// DecodeAll decodes the transactions of a block in order, so that the transactions are decoded
+// once and shared by all the parsers of the block
+func (decoder *TxDecoder) DecodeAll(base64Txs []string) []DecodedTx {
+	decodedTxs := make([]DecodedTx, 0, len(base64Txs))
+	for _, base64Tx := range base64Txs {
+		tx, err := decoder.Decode(base64Tx)
+		decodedTxs = append(decodedTxs, DecodedTx{
+			MaybeTx:        tx,
+			MaybeDecodeErr: err,
+		})
+	}
+
+	return decodedTxs
+}
+
 func (decoder *TxDecoder) GetFee(base64Tx string) (coin.Coins, error) {
 	tx, err := decoder.Decode(base64Tx)
 	if err != nil {
 		return nil, fmt.Errorf("error decoding transaction: %v", err)
 	}
 
+	return decoder.GetTxFee(tx)
+}
+
+// GetTxFee returns the fee of the decoded transaction
+func (decoder *TxDecoder) GetTxFee(tx *CosmosTx) (coin.Coins, error) {
 	return decoder.sumAmount(tx.AuthInfo.Fee.Amount)
 }
 
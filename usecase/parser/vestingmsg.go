@@ -0,0 +1,35 @@
+package parser
+
+import (
+	"fmt"
+
+	"github.com/crypto-com/chain-indexing/entity/command"
+	"github.com/crypto-com/chain-indexing/internal/tmcosmosutils"
+	command_usecase "github.com/crypto-com/chain-indexing/usecase/command"
+	"github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+)
+
+func parseMsgCreateVestingAccount(
+	msgCommonParams event.MsgCommonParams,
+	msg map[string]interface{},
+) []command.Command {
+	endTime, err := parseUnixSecondsString(msg["end_time"].(string))
+	if err != nil {
+		panic(fmt.Sprintf("error parsing MsgCreateVestingAccount end time: %v", err))
+	}
+	// delayed may be omitted when false
+	delayed, _ := msg["delayed"].(bool)
+
+	return []command.Command{command_usecase.NewCreateMsgCreateVestingAccount(
+		msgCommonParams,
+
+		model.MsgCreateVestingAccountParams{
+			FromAddress: msg["from_address"].(string),
+			ToAddress:   msg["to_address"].(string),
+			Amount:      tmcosmosutils.MustNewCoinsFromAmountInterface(msg["amount"].([]interface{})),
+			EndTime:     endTime,
+			Delayed:     delayed,
+		},
+	)}
+}
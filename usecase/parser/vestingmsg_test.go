@@ -0,0 +1,59 @@
+package parser_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/entity/command"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+	command_usecase "github.com/crypto-com/chain-indexing/usecase/command"
+	"github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+	"github.com/crypto-com/chain-indexing/usecase/parser"
+)
+
+var _ = Describe("ParseMsgCommands", func() {
+	Describe("MsgCreateVestingAccount", func() {
+		It("should parse MsgCreateVestingAccount", func() {
+			registry := parser.NewMsgParserRegistry()
+			parser.RegisterBuiltinMsgParsers(registry)
+			anyMsgCommonParams := event.MsgCommonParams{
+				BlockHeight: int64(1000),
+				TxHash:      "4936522F7391D425F2A93AD47576F8AEC3947DC907113BE8A2FBCFF8E9F2A416",
+				TxSuccess:   true,
+				MsgIndex:    0,
+			}
+
+			cmds, parsed := registry.Parse("/cosmos.vesting.v1beta1.MsgCreateVestingAccount", parser.MsgParserParams{
+				MsgCommonParams: anyMsgCommonParams,
+				Msg: map[string]interface{}{
+					"@type":        "/cosmos.vesting.v1beta1.MsgCreateVestingAccount",
+					"from_address": "tcro184lta2lsyu47vwyp2e8zmtca3k5yq85p6c4vp3",
+					"to_address":   "tcro1fmprm0sjy6lz9llv7rltn0v2azzwcwzvk2lsyn",
+					"amount": []interface{}{
+						map[string]interface{}{
+							"denom":  "basetcro",
+							"amount": "1000",
+						},
+					},
+					"end_time": "1640995200",
+					"delayed":  true,
+				},
+			})
+			Expect(parsed).To(BeTrue())
+			Expect(cmds).To(Equal([]command.Command{command_usecase.NewCreateMsgCreateVestingAccount(
+				anyMsgCommonParams,
+				model.MsgCreateVestingAccountParams{
+					FromAddress: "tcro184lta2lsyu47vwyp2e8zmtca3k5yq85p6c4vp3",
+					ToAddress:   "tcro1fmprm0sjy6lz9llv7rltn0v2azzwcwzvk2lsyn",
+					Amount:      coin.NewCoins(coin.MustNewCoinFromString("basetcro", "1000")),
+					EndTime:     utctime.FromTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+					Delayed:     true,
+				},
+			)}))
+		})
+	})
+})
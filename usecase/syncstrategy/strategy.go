@@ -1,11 +1,20 @@
 package syncstrategy
 
 import (
+	"context"
+
 	"github.com/crypto-com/chain-indexing/entity/command"
 )
 
 type Strategy interface {
-	Sync(currentHeight int64, latestHeight int64, worker SyncBlockWorker) ([][]command.Command, SyncedHeight, error)
+	// Sync returns the commands of the synced heights starting from currentHeight. It returns the
+	// context error without waiting for the in-flight workers once the context is done.
+	Sync(
+		ctx context.Context,
+		currentHeight int64,
+		latestHeight int64,
+		worker SyncBlockWorker,
+	) ([][]command.Command, SyncedHeight, error)
 }
 
 type SyncBlockWorker = func(blockHeight int64) ([]command.Command, error)
@@ -0,0 +1,238 @@
+package syncstrategy
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/crypto-com/chain-indexing/entity/command"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+)
+
+var _ Strategy = &Streaming{}
+
+const DEFAULT_STREAMING_MAX_RETRIES = 5
+const DEFAULT_STREAMING_INITIAL_BACKOFF = 500 * time.Millisecond
+const DEFAULT_STREAMING_MAX_BACKOFF = 30 * time.Second
+
+// Streaming sync strategy keeps a bounded pipeline of in-flight heights across Sync calls. Failed
+// heights are retried individually with exponential backoff, and commands are returned in height
+// order as soon as the head of the pipeline is ready, while the remaining heights keep syncing in
+// the background.
+type Streaming struct {
+	logger applogger.Logger
+
+	size           int
+	maxRetries     int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+
+	mutex sync.Mutex
+	// Pipeline state, reset whenever Sync is called with an unexpected height
+	generation    int64
+	abortCh       chan struct{}
+	readyCh       chan struct{}
+	maybeHead     *int64
+	pendingHeight int64
+	results       map[int64]workResult
+}
+
+type StreamingConfig struct {
+	// Maximum number of heights syncing or synced but not yet returned
+	Size int
+	// Maximum number of retries of a height before the error is reported. Default to
+	// DEFAULT_STREAMING_MAX_RETRIES
+	MaxRetries int
+
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+func NewStreaming(logger applogger.Logger, config StreamingConfig) *Streaming {
+	if config.Size <= 0 {
+		config.Size = 1
+	}
+	if config.MaxRetries <= 0 {
+		config.MaxRetries = DEFAULT_STREAMING_MAX_RETRIES
+	}
+	if config.InitialBackoff <= 0 {
+		config.InitialBackoff = DEFAULT_STREAMING_INITIAL_BACKOFF
+	}
+	if config.MaxBackoff <= 0 {
+		config.MaxBackoff = DEFAULT_STREAMING_MAX_BACKOFF
+	}
+
+	return &Streaming{
+		logger: logger.WithFields(applogger.LogFields{
+			"module": "StreamingStrategy",
+			"size":   config.Size,
+		}),
+
+		size:           config.Size,
+		maxRetries:     config.MaxRetries,
+		initialBackoff: config.InitialBackoff,
+		maxBackoff:     config.MaxBackoff,
+
+		readyCh: make(chan struct{}, 1),
+		results: make(map[int64]workResult),
+	}
+}
+
+// Sync blocks until the block at currentHeight is synced, and returns the commands of all the
+// consecutive synced heights starting from it. Returns error when the block at currentHeight
+// still fails after all the retries, the height is retried again on next call. Once the context is
+// done, the pipeline is discarded and its workers stop retrying.
+func (streaming *Streaming) Sync(
+	ctx context.Context,
+	currentHeight int64,
+	latestHeight int64,
+	worker SyncBlockWorker,
+) ([][]command.Command, SyncedHeight, error) {
+	if currentHeight > latestHeight {
+		return [][]command.Command{}, currentHeight - 1, nil
+	}
+
+	streaming.mutex.Lock()
+	if streaming.maybeHead == nil || *streaming.maybeHead != currentHeight {
+		streaming.resetPipeline(currentHeight)
+	}
+	streaming.fillPipeline(ctx, latestHeight, worker)
+	streaming.mutex.Unlock()
+
+	for {
+		streaming.mutex.Lock()
+		result, ready := streaming.results[currentHeight]
+		if ready {
+			if result.err != nil {
+				// Retry the height in background, the result is returned on next call
+				delete(streaming.results, currentHeight)
+				streaming.spawn(ctx, currentHeight, worker)
+				streaming.mutex.Unlock()
+				return nil, currentHeight - 1, result.err
+			}
+
+			blocksCommands := make([][]command.Command, 0)
+			syncedHeight := currentHeight - 1
+			for {
+				nextResult, nextReady := streaming.results[syncedHeight+1]
+				if !nextReady || nextResult.err != nil {
+					break
+				}
+				blocksCommands = append(blocksCommands, nextResult.commands)
+				delete(streaming.results, syncedHeight+1)
+				syncedHeight += 1
+			}
+			nextHead := syncedHeight + 1
+			streaming.maybeHead = &nextHead
+			streaming.fillPipeline(ctx, latestHeight, worker)
+			streaming.mutex.Unlock()
+
+			streaming.logger.WithFields(applogger.LogFields{
+				"beginHeight": currentHeight,
+				"endHeight":   syncedHeight,
+			}).Debug("emitting synced blocks")
+			return blocksCommands, syncedHeight, nil
+		}
+		streaming.mutex.Unlock()
+
+		select {
+		case <-ctx.Done():
+			streaming.mutex.Lock()
+			streaming.abortPipeline()
+			streaming.mutex.Unlock()
+			return nil, currentHeight - 1, ctx.Err()
+		case <-streaming.readyCh:
+		}
+	}
+}
+
+// resetPipeline discards all the in-flight and synced heights. Must be called with mutex locked.
+func (streaming *Streaming) resetPipeline(head int64) {
+	if streaming.abortCh != nil {
+		streaming.logger.Infof("discarding synced blocks and restarting pipeline from height %d", head)
+	}
+	streaming.abortPipeline()
+
+	streaming.abortCh = make(chan struct{})
+	streaming.maybeHead = &head
+	streaming.pendingHeight = head
+}
+
+// abortPipeline stops the workers of the pipeline from retrying and discards their results, the
+// pipeline is restarted on next Sync. Must be called with mutex locked.
+func (streaming *Streaming) abortPipeline() {
+	if streaming.abortCh != nil {
+		close(streaming.abortCh)
+		streaming.abortCh = nil
+	}
+	streaming.generation += 1
+	streaming.maybeHead = nil
+	streaming.results = make(map[int64]workResult)
+}
+
+// fillPipeline spawns workers for the next heights until the pipeline is full. Must be called with
+// mutex locked.
+func (streaming *Streaming) fillPipeline(ctx context.Context, latestHeight int64, worker SyncBlockWorker) {
+	head := *streaming.maybeHead
+	for streaming.pendingHeight <= latestHeight && streaming.pendingHeight < head+int64(streaming.size) {
+		streaming.spawn(ctx, streaming.pendingHeight, worker)
+		streaming.pendingHeight += 1
+	}
+}
+
+// spawn starts a worker for the height, which stops retrying when the pipeline is aborted or the
+// context is done. Must be called with mutex locked.
+func (streaming *Streaming) spawn(ctx context.Context, height int64, worker SyncBlockWorker) {
+	generation := streaming.generation
+	abortCh := streaming.abortCh
+
+	go func() {
+		commands, err := streaming.workWithRetry(ctx, height, worker, abortCh)
+
+		streaming.mutex.Lock()
+		if generation != streaming.generation {
+			streaming.mutex.Unlock()
+			return
+		}
+		streaming.results[height] = workResult{height, commands, err}
+		streaming.mutex.Unlock()
+
+		select {
+		case streaming.readyCh <- struct{}{}:
+		default:
+		}
+	}()
+}
+
+func (streaming *Streaming) workWithRetry(
+	ctx context.Context,
+	height int64,
+	worker SyncBlockWorker,
+	abortCh <-chan struct{},
+) ([]command.Command, error) {
+	backoff := streaming.initialBackoff
+	for attempt := 0; ; attempt += 1 {
+		commands, err := worker(height)
+		if err == nil {
+			return commands, nil
+		}
+		if attempt >= streaming.maxRetries {
+			streaming.logger.Errorf("sync block worker #%d failed after %d retries: %v", height, attempt, err)
+			return nil, err
+		}
+
+		streaming.logger.Errorf("received error from sync block worker #%d, retrying in %s: %v", height, backoff, err)
+		select {
+		case <-abortCh:
+			return nil, err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > streaming.maxBackoff {
+			backoff = streaming.maxBackoff
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package syncstrategy_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/entity/command"
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	. "github.com/crypto-com/chain-indexing/internal/logger/test"
+	"github.com/crypto-com/chain-indexing/usecase/syncstrategy"
+)
+
+var _ = Describe("Streaming", func() {
+	It("should implement Strategy", func() {
+		var _ syncstrategy.Strategy = syncstrategy.NewStreaming(NewFakeLogger(), syncstrategy.StreamingConfig{})
+	})
+
+	It("should return commands in height order as soon as the head height is synced", func() {
+		streaming := syncstrategy.NewStreaming(NewFakeLogger(), syncstrategy.StreamingConfig{
+			Size: 3,
+		})
+
+		releaseCh := map[int64]chan struct{}{
+			1: make(chan struct{}),
+			2: make(chan struct{}),
+			3: make(chan struct{}),
+			4: make(chan struct{}),
+		}
+		worker := func(height int64) ([]command.Command, error) {
+			<-releaseCh[height]
+			return newCommandsAt(height), nil
+		}
+
+		close(releaseCh[2])
+		close(releaseCh[1])
+		blocksCommands, syncedHeight, err := streaming.Sync(context.Background(), 1, 4, worker)
+		Expect(err).To(BeNil())
+		Expect(syncedHeight).To(BeNumerically(">=", 1))
+		Expect(blocksCommands).To(HaveLen(int(syncedHeight)))
+		for i, commands := range blocksCommands {
+			Expect(commands[0].(*fakeCommand).Name()).To(Equal(commandNameAt(int64(i + 1))))
+		}
+
+		close(releaseCh[3])
+		close(releaseCh[4])
+		nextHeight := syncedHeight + 1
+		for nextHeight <= 4 {
+			blocksCommands, syncedHeight, err = streaming.Sync(context.Background(), nextHeight, 4, worker)
+			Expect(err).To(BeNil())
+			for i, commands := range blocksCommands {
+				Expect(commands[0].(*fakeCommand).Name()).To(Equal(commandNameAt(nextHeight + int64(i))))
+			}
+			nextHeight = syncedHeight + 1
+		}
+	})
+
+	It("should not sync more heights than the pipeline size ahead of the head height", func() {
+		streaming := syncstrategy.NewStreaming(NewFakeLogger(), syncstrategy.StreamingConfig{
+			Size: 2,
+		})
+
+		var mutex sync.Mutex
+		syncingHeights := make([]int64, 0)
+		headReleaseCh := make(chan struct{})
+		worker := func(height int64) ([]command.Command, error) {
+			mutex.Lock()
+			syncingHeights = append(syncingHeights, height)
+			mutex.Unlock()
+			if height == 1 {
+				<-headReleaseCh
+			}
+			return newCommandsAt(height), nil
+		}
+
+		go func() {
+			<-time.After(100 * time.Millisecond)
+			close(headReleaseCh)
+		}()
+		_, syncedHeight, err := streaming.Sync(context.Background(), 1, 10, worker)
+		Expect(err).To(BeNil())
+		Expect(syncedHeight).To(Equal(int64(2)))
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		Expect(syncingHeights).To(ConsistOf(int64(1), int64(2)))
+	})
+
+	It("should retry a failed height individually", func() {
+		streaming := syncstrategy.NewStreaming(NewFakeLogger(), syncstrategy.StreamingConfig{
+			Size:           3,
+			MaxRetries:     2,
+			InitialBackoff: time.Millisecond,
+		})
+
+		var mutex sync.Mutex
+		attempts := make(map[int64]int)
+		worker := func(height int64) ([]command.Command, error) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			attempts[height] += 1
+			if height == 2 && attempts[height] < 3 {
+				return nil, errors.New("any error")
+			}
+			return newCommandsAt(height), nil
+		}
+
+		nextHeight := int64(1)
+		for nextHeight <= 3 {
+			_, syncedHeight, err := streaming.Sync(context.Background(), nextHeight, 3, worker)
+			Expect(err).To(BeNil())
+			nextHeight = syncedHeight + 1
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		Expect(attempts).To(Equal(map[int64]int{1: 1, 2: 3, 3: 1}))
+	})
+
+	It("should return error when the head height fails after all retries and retry it on next call", func() {
+		streaming := syncstrategy.NewStreaming(NewFakeLogger(), syncstrategy.StreamingConfig{
+			Size:           2,
+			MaxRetries:     1,
+			InitialBackoff: time.Millisecond,
+		})
+
+		var mutex sync.Mutex
+		attempts := 0
+		worker := func(height int64) ([]command.Command, error) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			if height == 1 {
+				attempts += 1
+				if attempts <= 2 {
+					return nil, errors.New("any error")
+				}
+			}
+			return newCommandsAt(height), nil
+		}
+
+		_, syncedHeight, err := streaming.Sync(context.Background(), 1, 2, worker)
+		Expect(err).NotTo(BeNil())
+		Expect(syncedHeight).To(Equal(int64(0)))
+
+		blocksCommands, syncedHeight, err := streaming.Sync(context.Background(), 1, 2, worker)
+		Expect(err).To(BeNil())
+		Expect(syncedHeight).To(Equal(int64(2)))
+		Expect(blocksCommands).To(HaveLen(2))
+	})
+
+	It("should restart the pipeline when syncing from an unexpected height", func() {
+		streaming := syncstrategy.NewStreaming(NewFakeLogger(), syncstrategy.StreamingConfig{
+			Size: 2,
+		})
+
+		worker := func(height int64) ([]command.Command, error) {
+			return newCommandsAt(height), nil
+		}
+
+		_, syncedHeight, err := streaming.Sync(context.Background(), 1, 1, worker)
+		Expect(err).To(BeNil())
+		Expect(syncedHeight).To(Equal(int64(1)))
+
+		blocksCommands, syncedHeight, err := streaming.Sync(context.Background(), 1, 1, worker)
+		Expect(err).To(BeNil())
+		Expect(syncedHeight).To(Equal(int64(1)))
+		Expect(blocksCommands[0][0].(*fakeCommand).Name()).To(Equal(commandNameAt(1)))
+	})
+
+	It("should return the context error and stop retrying the heights when the context is done", func() {
+		streaming := syncstrategy.NewStreaming(NewFakeLogger(), syncstrategy.StreamingConfig{
+			Size:           2,
+			MaxRetries:     1000,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     time.Millisecond,
+		})
+
+		var mutex sync.Mutex
+		attempts := 0
+		worker := func(height int64) ([]command.Command, error) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			attempts += 1
+			return nil, errors.New("any error")
+		}
+		countAttempts := func() int {
+			mutex.Lock()
+			defer mutex.Unlock()
+			return attempts
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		syncErrCh := make(chan error, 1)
+		go func() {
+			_, _, err := streaming.Sync(ctx, 1, 2, worker)
+			syncErrCh <- err
+		}()
+
+		Eventually(countAttempts).Should(BeNumerically(">", 2))
+		cancel()
+		Eventually(syncErrCh).Should(Receive(Equal(context.Canceled)))
+
+		time.Sleep(10 * time.Millisecond)
+		stoppedAttempts := countAttempts()
+		Consistently(countAttempts, 50*time.Millisecond).Should(Equal(stoppedAttempts))
+	})
+})
+
+func commandNameAt(height int64) string {
+	return fmt.Sprintf("CommandAtHeight%d", height)
+}
+
+func newCommandsAt(height int64) []command.Command {
+	return []command.Command{&fakeCommand{name: commandNameAt(height)}}
+}
+
+type fakeCommand struct {
+	name string
+}
+
+func (command *fakeCommand) Name() string { return command.name }
+func (command *fakeCommand) Version() int { return 0 }
+func (command *fakeCommand) Exec() (entity_event.Event, error) {
+	return nil, errors.New("not implemented")
+}
@@ -1,6 +1,8 @@
 package syncstrategy
 
 import (
+	"context"
+
 	"github.com/crypto-com/chain-indexing/entity/command"
 	applogger "github.com/crypto-com/chain-indexing/internal/logger"
 )
@@ -26,12 +28,11 @@ func NewWindow(logger applogger.Logger, size int) *Window {
 }
 
 func (window *Window) Sync(
+	ctx context.Context,
 	currentHeight int64,
 	latestHeight int64,
 	worker SyncBlockWorker,
 ) ([][]command.Command, SyncedHeight, error) {
-	workResultCh := make(chan workResult)
-
 	beginHeight := currentHeight
 	var endHeight int64
 	if latestHeight-currentHeight+1 < int64(window.size) {
@@ -46,11 +47,18 @@ func (window *Window) Sync(
 	})
 	logger.Debug("spawning goroutines for sync block workers")
 
+	// Buffered so that the workers never block on sending results after the context is done
+	workResultCh := make(chan workResult, endHeight-beginHeight+1)
 	for height := beginHeight; height <= endHeight; height += 1 {
 		go func(height int64) {
+			if ctx.Err() != nil {
+				workResultCh <- workResult{height, nil, ctx.Err()}
+				return
+			}
 			commands, err := worker(height)
 			if err != nil {
 				workResultCh <- workResult{height, nil, err}
+				return
 			}
 
 			workResultCh <- workResult{height, commands, nil}
@@ -63,7 +71,13 @@ func (window *Window) Sync(
 
 	logger.Debug("listening for sync block workers")
 	for {
-		result := <-workResultCh
+		var result workResult
+		select {
+		case <-ctx.Done():
+			logger.Info("stopped listening for sync block workers")
+			return nil, beginHeight - 1, ctx.Err()
+		case result = <-workResultCh:
+		}
 		remainingWork -= 1
 		if result.err != nil {
 			workerErr = result.err
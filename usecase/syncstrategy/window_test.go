@@ -0,0 +1,63 @@
+package syncstrategy_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/entity/command"
+	. "github.com/crypto-com/chain-indexing/internal/logger/test"
+	"github.com/crypto-com/chain-indexing/usecase/syncstrategy"
+)
+
+var _ = Describe("Window", func() {
+	It("should return commands of all heights in the window in height order", func() {
+		window := syncstrategy.NewWindow(NewFakeLogger(), 3)
+
+		blocksCommands, syncedHeight, err := window.Sync(context.Background(), 1, 10, func(height int64) ([]command.Command, error) {
+			return newCommandsAt(height), nil
+		})
+		Expect(err).To(BeNil())
+		Expect(syncedHeight).To(Equal(int64(3)))
+		Expect(blocksCommands).To(HaveLen(3))
+		for i, commands := range blocksCommands {
+			Expect(commands[0].Name()).To(Equal(commandNameAt(int64(i + 1))))
+		}
+	})
+
+	It("should wait for all the workers and return error when any of the worker fails", func() {
+		window := syncstrategy.NewWindow(NewFakeLogger(), 3)
+
+		blocksCommands, syncedHeight, err := window.Sync(context.Background(), 1, 10, func(height int64) ([]command.Command, error) {
+			if height == 2 {
+				return nil, errors.New("any error")
+			}
+			return newCommandsAt(height), nil
+		})
+		Expect(err).NotTo(BeNil())
+		Expect(syncedHeight).To(Equal(int64(0)))
+		Expect(blocksCommands).To(BeNil())
+	})
+
+	It("should return the context error without waiting for the workers when the context is done", func() {
+		window := syncstrategy.NewWindow(NewFakeLogger(), 3)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		releaseCh := make(chan struct{})
+		defer close(releaseCh)
+		syncErrCh := make(chan error, 1)
+		go func() {
+			_, _, err := window.Sync(ctx, 1, 10, func(height int64) ([]command.Command, error) {
+				<-releaseCh
+				return newCommandsAt(height), nil
+			})
+			syncErrCh <- err
+		}()
+
+		Consistently(syncErrCh).ShouldNot(Receive())
+		cancel()
+		Eventually(syncErrCh).Should(Receive(Equal(context.Canceled)))
+	})
+})